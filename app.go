@@ -27,6 +27,10 @@ type Runtime = wailsruntime.Runtime
 // the front end
 type Store = wailsruntime.Store
 
+// AppError is a structured error that may be returned
+// from bound methods to the frontend
+type AppError = wailsruntime.AppError
+
 // CustomLogger is a specialised logger
 type CustomLogger = logger.CustomLogger
 
@@ -130,7 +134,9 @@ func (a *App) start() error {
 	a.ipc.Start(a.eventManager, a.bindingManager)
 
 	// Create the runtime
-	a.runtime = wailsruntime.NewRuntime(a.eventManager, a.renderer)
+	runtime := wailsruntime.NewRuntime(a.eventManager, a.renderer)
+	runtime.System.SetProduction(BuildMode == cmd.BuildModeProd)
+	a.runtime = runtime
 
 	// Start binding manager and give it our renderer
 	err = a.bindingManager.Start(a.renderer, a.runtime)
@@ -155,6 +161,11 @@ func (a *App) shutdown() {
 	// Make sure this is only called once
 	a.log.Debug("Shutting down")
 
+	// Run the user's shutdown callbacks
+	if runtime, ok := a.runtime.(*wailsruntime.Runtime); ok {
+		runtime.Lifecycle.Shutdown()
+	}
+
 	// Shutdown Binding Manager
 	a.bindingManager.Shutdown()
 
@@ -33,6 +33,13 @@ type AppConfig struct {
 
 	// Indicated if the devtools should be disabled
 	DisableInspector bool
+
+	// Indicates if the webview's default context menu should be disabled.
+	// Text inputs keep their context menu so that editing still works.
+	// Note: on Windows, and on Linux when the inspector is disabled (always
+	// the case in production builds), the native webview already blocks
+	// every context menu, text inputs included, so this has no extra effect
+	DisableDefaultContextMenu bool
 }
 
 // GetWidth returns the desired width
@@ -70,6 +77,11 @@ func (a *AppConfig) GetDisableInspector() bool {
 	return a.DisableInspector
 }
 
+// GetDisableDefaultContextMenu returns true if the default context menu should be disabled
+func (a *AppConfig) GetDisableDefaultContextMenu() bool {
+	return a.DisableDefaultContextMenu
+}
+
 // GetColour returns the colour
 func (a *AppConfig) GetColour() string {
 	return a.Colour
@@ -117,6 +129,7 @@ func (a *AppConfig) merge(in *AppConfig) error {
 	}
 	a.Resizable = in.Resizable
 	a.DisableInspector = in.DisableInspector
+	a.DisableDefaultContextMenu = in.DisableDefaultContextMenu
 
 	return nil
 }
@@ -1,17 +1,21 @@
 package binding
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
+	"github.com/wailsapp/wails/lib/interfaces"
 	"github.com/wailsapp/wails/lib/logger"
 	"github.com/wailsapp/wails/lib/messages"
 	"github.com/wailsapp/wails/runtime"
 )
 
 type internalMethods struct {
-	log     *logger.CustomLogger
-	browser *runtime.Browser
+	log      *logger.CustomLogger
+	browser  *runtime.Browser
+	window   *runtime.Window
+	renderer interfaces.Renderer
 }
 
 func newInternalMethods() *internalMethods {
@@ -36,6 +40,8 @@ func (i *internalMethods) processCall(callData *messages.CallData) (interface{},
 	switch group {
 	case "Browser":
 		return i.processBrowserCommand(splitCall[1], callData.Data)
+	case "Window":
+		return i.processWindowCommand(splitCall[1], callData.Data)
 	default:
 		return nil, fmt.Errorf("Unknown internal command group '%s'", group)
 	}
@@ -69,3 +75,43 @@ func (i *internalMethods) processBrowserCommand(command string, data interface{}
 		return nil, fmt.Errorf("Unknown Browser command '%s'", command)
 	}
 }
+
+func (i *internalMethods) processWindowCommand(command string, data interface{}) (interface{}, error) {
+	if i.window == nil {
+		return nil, fmt.Errorf("Window commands are unavailable before the runtime has started")
+	}
+	switch command {
+	case "SetTitle":
+		var title string
+		err := json.Unmarshal([]byte(data.(string)), &title)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid title given to Window.SetTitle: %s", err.Error())
+		}
+		i.log.Debugf("Calling Window.SetTitle with '%s'", title)
+		i.window.SetTitle(title)
+		return nil, nil
+	case "GetTitle":
+		return i.window.GetTitle(), nil
+	case "ExecJSResult":
+		var response struct {
+			ID     string  `json:"id"`
+			Result *string `json:"result"`
+			Error  *string `json:"error"`
+		}
+		err := json.Unmarshal([]byte(data.(string)), &response)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid result given to Window.ExecJSResult: %s", err.Error())
+		}
+		switch {
+		case response.Error != nil:
+			i.renderer.ExecJSResult(response.ID, nil, fmt.Errorf("%s", *response.Error))
+		case response.Result != nil:
+			i.renderer.ExecJSResult(response.ID, json.RawMessage(*response.Result), nil)
+		default:
+			i.renderer.ExecJSResult(response.ID, nil, fmt.Errorf("invalid response from frontend: %s", data))
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("Unknown Window command '%s'", command)
+	}
+}
@@ -0,0 +1,130 @@
+package binding
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/wailsapp/wails/lib/interfaces"
+	"github.com/wailsapp/wails/lib/messages"
+	"github.com/wailsapp/wails/runtime"
+)
+
+// titleRenderer records the title set on the window
+type titleRenderer struct {
+	interfaces.Renderer
+	title string
+}
+
+func (t *titleRenderer) SetTitle(title string) {
+	t.title = title
+}
+
+func (t *titleRenderer) GetTitle() string {
+	return t.title
+}
+
+func TestInternalMethods_WindowTitle(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+	}{
+		{"ascii", "My Document"},
+		{"modified marker", "My Document *"},
+		{"unicode", "Résumé — 履歴書"},
+		{"emoji", "Notes 📝✨"},
+		{"quotes", `"quoted" and \escaped\`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := &titleRenderer{title: "Initial"}
+			internal := newInternalMethods()
+			internal.window = runtime.NewWindow(renderer)
+
+			// Data is sent from the frontend as a JSON encoded string
+			data, err := json.Marshal(tt.title)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = internal.processCall(&messages.CallData{BindingName: ".wails.Window.SetTitle", Data: string(data)})
+			if err != nil {
+				t.Fatalf("SetTitle error = %v", err)
+			}
+			if renderer.title != tt.title {
+				t.Errorf("renderer title = %q, want %q", renderer.title, tt.title)
+			}
+
+			result, err := internal.processCall(&messages.CallData{BindingName: ".wails.Window.GetTitle"})
+			if err != nil {
+				t.Fatalf("GetTitle error = %v", err)
+			}
+			if result != tt.title {
+				t.Errorf("GetTitle() = %q, want %q", result, tt.title)
+			}
+		})
+	}
+}
+
+func TestInternalMethods_WindowTitleBeforeSet(t *testing.T) {
+	internal := newInternalMethods()
+	internal.window = runtime.NewWindow(&titleRenderer{title: "Initial"})
+	result, err := internal.processCall(&messages.CallData{BindingName: ".wails.Window.GetTitle"})
+	if err != nil {
+		t.Fatalf("GetTitle error = %v", err)
+	}
+	if result != "Initial" {
+		t.Errorf("GetTitle() = %q, want %q", result, "Initial")
+	}
+}
+
+// execJSRenderer records the ExecJS results passed to it
+type execJSRenderer struct {
+	interfaces.Renderer
+	id     string
+	result json.RawMessage
+	err    error
+}
+
+func (e *execJSRenderer) ExecJSResult(id string, result json.RawMessage, err error) {
+	e.id, e.result, e.err = id, result, err
+}
+
+func TestInternalMethods_ExecJSResult(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantResult  json.RawMessage
+		wantErr     bool
+		wantMessage string
+	}{
+		{"result", `{"id":"execjs:1","result":"{\"title\":\"Report\"}","error":null}`, json.RawMessage(`{"title":"Report"}`), false, ""},
+		{"script error", `{"id":"execjs:1","result":null,"error":"ReferenceError: foo is not defined"}`, nil, true, "ReferenceError: foo is not defined"},
+		{"empty error", `{"id":"execjs:1","result":null,"error":""}`, nil, true, ""},
+		{"no result", `{"id":"execjs:1"}`, nil, true, `invalid response from frontend: {"id":"execjs:1"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := &execJSRenderer{}
+			internal := newInternalMethods()
+			internal.window = runtime.NewWindow(renderer)
+			internal.renderer = renderer
+
+			_, err := internal.processCall(&messages.CallData{BindingName: ".wails.Window.ExecJSResult", Data: tt.data})
+			if err != nil {
+				t.Fatalf("ExecJSResult error = %v", err)
+			}
+			if renderer.id != "execjs:1" {
+				t.Errorf("id = %q, want %q", renderer.id, "execjs:1")
+			}
+			if !reflect.DeepEqual(renderer.result, tt.wantResult) {
+				t.Errorf("result = %s, want %s", renderer.result, tt.wantResult)
+			}
+			if (renderer.err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", renderer.err, tt.wantErr)
+			}
+			if tt.wantErr && renderer.err.Error() != tt.wantMessage {
+				t.Errorf("err = %q, want %q", renderer.err, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/wailsapp/wails/lib/interfaces"
 	"github.com/wailsapp/wails/lib/logger"
 	"github.com/wailsapp/wails/lib/messages"
+	wailsruntime "github.com/wailsapp/wails/runtime"
 )
 
 var typescriptDefinitionFilename = ""
@@ -54,6 +55,10 @@ func (b *Manager) Start(renderer interfaces.Renderer, runtime interfaces.Runtime
 	b.log.Info("Starting")
 	b.renderer = renderer
 	b.runtime = runtime
+	b.internalMethods.renderer = renderer
+	if wailsRuntime, ok := runtime.(*wailsruntime.Runtime); ok {
+		b.internalMethods.window = wailsRuntime.Window
+	}
 	err := b.initialise()
 	if err != nil {
 		b.log.Errorf("Binding error: %s", err.Error())
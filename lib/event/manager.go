@@ -14,6 +14,7 @@ type Manager struct {
 	incomingEvents chan *messages.EventData
 	quitChannel    chan struct{}
 	listeners      map[string][]*eventListener
+	listenersLock  sync.Mutex
 	running        bool
 	log            *logger.CustomLogger
 	renderer       interfaces.Renderer // Messages will be dispatched to the frontend
@@ -54,6 +55,9 @@ func (e *Manager) addEventListener(eventName string, callback func(...interface{
 		return fmt.Errorf("nil callback bassed to addEventListener")
 	}
 
+	e.listenersLock.Lock()
+	defer e.listenersLock.Unlock()
+
 	// Check event has been registered before
 	if e.listeners[eventName] == nil {
 		e.listeners[eventName] = []*eventListener{}
@@ -78,6 +82,12 @@ func (e *Manager) On(eventName string, callback func(...interface{})) {
 	e.addEventListener(eventName, callback, 0)
 }
 
+// OnMultiple adds a listener for the given event that expires
+// after it has been called counter times
+func (e *Manager) OnMultiple(eventName string, callback func(...interface{}), counter int) {
+	e.addEventListener(eventName, callback, counter)
+}
+
 // Emit broadcasts the given event to the subscribed listeners
 func (e *Manager) Emit(eventName string, optionalData ...interface{}) {
 	e.incomingEvents <- &messages.EventData{Name: eventName, Data: optionalData}
@@ -111,7 +121,8 @@ func (e *Manager) Start(renderer interfaces.Renderer) {
 				e.renderer.NotifyEvent(event)
 
 				// Notify Go listeners
-				var listenersToRemove []*eventListener
+				e.listenersLock.Lock()
+				expired := false
 
 				// Iterate listeners
 				for _, listener := range e.listeners[event.Name] {
@@ -129,19 +140,26 @@ func (e *Manager) Start(renderer interfaces.Renderer) {
 						listener.counter = listener.counter - 1
 						if listener.counter == 0 {
 							listener.expired = true
+							expired = true
 						}
 					}
 				}
 
 				// Remove expired listeners in place
-				if len(listenersToRemove) > 0 {
+				if expired {
 					listeners := e.listeners[event.Name][:0]
-					for _, listener := range listeners {
+					for _, listener := range e.listeners[event.Name] {
 						if !listener.expired {
 							listeners = append(listeners, listener)
 						}
 					}
+					if len(listeners) == 0 {
+						delete(e.listeners, event.Name)
+					} else {
+						e.listeners[event.Name] = listeners
+					}
 				}
+				e.listenersLock.Unlock()
 			case <-e.quitChannel:
 				e.running = false
 			}
@@ -0,0 +1,61 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wailsapp/wails/lib/interfaces"
+	"github.com/wailsapp/wails/lib/messages"
+)
+
+// nullRenderer ignores the events sent to the frontend
+type nullRenderer struct {
+	interfaces.Renderer
+}
+
+func (nullRenderer) NotifyEvent(*messages.EventData) error {
+	return nil
+}
+
+func TestManager_OnMultiple(t *testing.T) {
+	manager := NewManager().(*Manager)
+	manager.Start(nullRenderer{})
+	defer manager.Shutdown()
+
+	calls := make(chan string, 10)
+	manager.OnMultiple("once", func(...interface{}) {
+		calls <- "once"
+	}, 1)
+	manager.On("always", func(...interface{}) {
+		calls <- "always"
+	})
+
+	for i := 0; i < 3; i++ {
+		manager.Emit("once")
+		manager.Emit("always")
+	}
+
+	counts := map[string]int{}
+	for i := 0; i < 4; i++ {
+		select {
+		case name := <-calls:
+			counts[name]++
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for listeners, got %v", counts)
+		}
+	}
+	select {
+	case name := <-calls:
+		t.Fatalf("unexpected extra call to %q listener", name)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if counts["once"] != 1 || counts["always"] != 3 {
+		t.Errorf("listener calls = %v, want once: 1, always: 3", counts)
+	}
+
+	manager.listenersLock.Lock()
+	defer manager.listenersLock.Unlock()
+	if _, ok := manager.listeners["once"]; ok {
+		t.Error("expired listener was not removed")
+	}
+}
@@ -8,6 +8,7 @@ type AppConfig interface {
 	GetResizable() bool
 	GetHTML() string
 	GetDisableInspector() bool
+	GetDisableDefaultContextMenu() bool
 	GetColour() string
 	GetCSS() string
 	GetJS() string
@@ -7,6 +7,7 @@ type EventManager interface {
 	PushEvent(*messages.EventData)
 	Emit(eventName string, optionalData ...interface{})
 	On(eventName string, callback func(...interface{}))
+	OnMultiple(eventName string, callback func(...interface{}), counter int)
 	Start(Renderer)
 	Shutdown()
 }
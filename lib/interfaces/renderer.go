@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"encoding/json"
+
 	"github.com/wailsapp/wails/lib/messages"
 )
 
@@ -22,8 +24,15 @@ type Renderer interface {
 
 	// Window Runtime
 	SetColour(string) error
+	SetBackgroundColour(r, g, b, a uint8)
 	Fullscreen()
 	UnFullscreen()
 	SetTitle(title string)
+	GetTitle() string
+	Print() error
+	DisableDefaultContextMenu(disable bool)
+	ExecJS(script string) (json.RawMessage, error)
+	ExecJSResult(id string, result json.RawMessage, err error)
+	SetCursor(cursor string)
 	Close()
 }
@@ -1,12 +1,14 @@
 package ipc
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/wailsapp/wails/lib/interfaces"
 	"github.com/wailsapp/wails/lib/logger"
 	"github.com/wailsapp/wails/lib/messages"
+	"github.com/wailsapp/wails/runtime"
 )
 
 // Manager manages the IPC subsystem
@@ -69,7 +71,10 @@ func (i *Manager) Start(eventManager interfaces.EventManager, bindingManager int
 					go func() {
 						result, err := bindingManager.ProcessCall(callData)
 						i.log.DebugFields("processed call", logger.Fields{"result": result, "err": err})
-						if err != nil {
+						var appError *runtime.AppError
+						if errors.As(err, &appError) {
+							incomingMessage.ReturnAppError(appError)
+						} else if err != nil {
 							incomingMessage.ReturnError(err.Error())
 						} else {
 							incomingMessage.ReturnSuccess(result)
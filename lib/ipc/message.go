@@ -3,6 +3,8 @@ package ipc
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/wailsapp/wails/runtime"
 )
 
 // Message handler
@@ -76,6 +78,22 @@ func (m *ipcMessage) ReturnError(format string, args ...interface{}) error {
 	return m.sendResponse(response)
 }
 
+// ReturnAppError returns a structured error back to the frontend
+func (m *ipcMessage) ReturnAppError(appError *runtime.AppError) error {
+
+	// Ignore ReturnAppError if no callback ID given
+	err := m.hasCallbackID()
+	if err != nil {
+		return err
+	}
+
+	// Create response
+	response := newAppErrorResponse(m.CallbackID, appError)
+
+	// Send response
+	return m.sendResponse(response)
+}
+
 // ReturnSuccess returns a success message back with the given data
 func (m *ipcMessage) ReturnSuccess(data interface{}) error {
 
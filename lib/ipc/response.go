@@ -3,21 +3,33 @@ package ipc
 import (
 	"encoding/hex"
 	"encoding/json"
+
+	"github.com/wailsapp/wails/runtime"
 )
 
 // ipcResponse contains the response data from an RPC call
 type ipcResponse struct {
-	CallbackID   string      `json:"callbackid"`
-	ErrorMessage string      `json:"error,omitempty"`
-	Data         interface{} `json:"data,omitempty"`
+	CallbackID string      `json:"callbackid"`
+	Error      interface{} `json:"error,omitempty"`
+	Data       interface{} `json:"data,omitempty"`
 }
 
 // newErrorResponse returns the given error message to the frontend with the callbackid
 func newErrorResponse(callbackID string, errorMessage string) *ipcResponse {
 	// Create response object
 	result := &ipcResponse{
-		CallbackID:   callbackID,
-		ErrorMessage: errorMessage,
+		CallbackID: callbackID,
+		Error:      errorMessage,
+	}
+	return result
+}
+
+// newAppErrorResponse returns the given AppError to the frontend with the callbackid
+func newAppErrorResponse(callbackID string, appError *runtime.AppError) *ipcResponse {
+	// Create response object
+	result := &ipcResponse{
+		CallbackID: callbackID,
+		Error:      appError,
 	}
 	return result
 }
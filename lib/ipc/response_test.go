@@ -0,0 +1,64 @@
+package ipc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/wailsapp/wails/runtime"
+)
+
+func decodeResponse(t *testing.T, response *ipcResponse) map[string]interface{} {
+	serialised, err := response.Serialise()
+	if err != nil {
+		t.Fatalf("Serialise() error = %v", err)
+	}
+	data, err := hex.DecodeString(serialised)
+	if err != nil {
+		t.Fatalf("Invalid hex returned by Serialise(): %v", err)
+	}
+	var result map[string]interface{}
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		t.Fatalf("Invalid JSON returned by Serialise(): %v", err)
+	}
+	return result
+}
+
+func TestIpcResponse_SerialiseAppError(t *testing.T) {
+	tests := []struct {
+		name     string
+		appError *runtime.AppError
+		want     map[string]interface{}
+	}{
+		{
+			"without details",
+			runtime.NewAppError("E_NOTFOUND", "file not found"),
+			map[string]interface{}{"code": "E_NOTFOUND", "message": "file not found"},
+		},
+		{
+			"with details",
+			runtime.NewAppError("E_INVALID", "invalid input", map[string]interface{}{"field": "name"}),
+			map[string]interface{}{"code": "E_INVALID", "message": "invalid input", "details": map[string]interface{}{"field": "name"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := decodeResponse(t, newAppErrorResponse("cb-1", tt.appError))
+			if result["callbackid"] != "cb-1" {
+				t.Errorf("callbackid = %v, want cb-1", result["callbackid"])
+			}
+			if got := result["error"]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("error = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIpcResponse_SerialiseErrorMessage(t *testing.T) {
+	result := decodeResponse(t, newErrorResponse("cb-1", "something failed"))
+	if result["error"] != "something failed" {
+		t.Errorf("error = %v, want 'something failed'", result["error"])
+	}
+}
@@ -186,6 +186,12 @@ func (h *Bridge) SetColour(colour string) error {
 	return nil
 }
 
+// SetBackgroundColour is unsupported for Bridge but required
+// for the Renderer interface
+func (h *Bridge) SetBackgroundColour(r, g, b, a uint8) {
+	h.log.WarnFields("SetBackgroundColour ignored for Bridge mode", logger.Fields{"r": r, "g": g, "b": b, "a": a})
+}
+
 // Fullscreen is unsupported for Bridge but required
 // for the Renderer interface
 func (h *Bridge) Fullscreen() {
@@ -204,6 +210,43 @@ func (h *Bridge) SetTitle(title string) {
 	h.log.WarnFields("SetTitle() unsupported in bridge mode", logger.Fields{"title": title})
 }
 
+// Print is unsupported for Bridge but required
+// for the Renderer interface
+func (h *Bridge) Print() error {
+	h.log.Warn("Print() unsupported in bridge mode")
+	return fmt.Errorf("Print() unsupported in bridge mode")
+}
+
+// DisableDefaultContextMenu is unsupported for Bridge but required
+// for the Renderer interface
+func (h *Bridge) DisableDefaultContextMenu(disable bool) {
+	h.log.WarnFields("DisableDefaultContextMenu() unsupported in bridge mode", logger.Fields{"disable": disable})
+}
+
+// GetTitle returns the configured title as the title
+// cannot be changed in bridge mode
+func (h *Bridge) GetTitle() string {
+	return h.appConfig.GetTitle()
+}
+
+// ExecJS is unsupported for Bridge but required
+// for the Renderer interface
+func (h *Bridge) ExecJS(script string) (json.RawMessage, error) {
+	h.log.Warn("ExecJS() unsupported in bridge mode")
+	return nil, fmt.Errorf("ExecJS() unsupported in bridge mode")
+}
+
+// ExecJSResult is unused for Bridge as ExecJS is unsupported,
+// but required for the Renderer interface
+func (h *Bridge) ExecJSResult(id string, result json.RawMessage, err error) {
+}
+
+// SetCursor is unsupported for Bridge but required
+// for the Renderer interface
+func (h *Bridge) SetCursor(cursor string) {
+	h.log.Warn("SetCursor() unsupported in bridge mode")
+}
+
 // Close is unsupported for Bridge but required
 // for the Renderer interface
 func (h *Bridge) Close() {
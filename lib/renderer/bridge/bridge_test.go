@@ -0,0 +1,24 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/wailsapp/wails/lib/interfaces"
+)
+
+// bindIPCManager satisfies the bridge's Initialise call
+type bindIPCManager struct {
+	interfaces.IPCManager
+}
+
+func (bindIPCManager) BindRenderer(interfaces.Renderer) {}
+
+func TestBridge_Print(t *testing.T) {
+	bridge := &Bridge{}
+	if err := bridge.Initialise(nil, bindIPCManager{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := bridge.Print(); err == nil {
+		t.Error("Print() in bridge mode returned no error")
+	}
+}
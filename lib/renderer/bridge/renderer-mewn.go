@@ -5,5 +5,5 @@ package renderer
 import "github.com/leaanthony/mewn"
 
 func init() {
-  mewn.AddAsset(".", "../../runtime/assets/wails.js", "1f8b08000000000000ff94587f731b3792fd2a43dc1d0358d088f4e52ae761262e47512ada92a594256ffee0b252e04c93843d046681a6181635df7dabe7f74854b25be5b248a00134fabdee7ee068b533096a6bb811c747e502888fc5ac190c903b71d42b0e73b7100e70e74c409f43f823b70efd8c96d89886e2a38e9ccca2d154d693d1b12866f522438b129565dc366ba595dd6714d286593c9a74630586dbd8480c9318248669dcb92a413a71c4d0d247f1f474b7fc02098629acb4815f9dcdc1e1a1343b82d96dc1a96506d16822d780912b4421317471ffea6c67aad5291bc578c8c1ae82fbc37669b3f1b8fa1ba2bd47a7cdfa41adc7e3d74e7c692b8f8f2adb41c43eda7497012b847c6d31fbfd77f0b559b36c34a9dcc5c1f54b50a663188fb989911b21e4ff8fa141c8ccf48a7f4bb3cc9647b1b8b993198fe95fd89dd42d222c5d5c3b97385008dcecb24cd076183aee5e73dd4996c24aed3264cf235eddc21442be2d1df2655cba201bb1b28e97340ab4098cc030e54e5ad95e17c4b125112c8a70a94d5afa25ad100dbf1cc5c8c42fd9fcecb6ef5b8b6ed7b0f6bd884e4cb60c26bf4032c5240809749c7d06496d58872877162d5d32dc287fb7374db0aa2ca005b4471e33269163e8e38928f87cc071245e7a08286609b219610042d69e5c1b8d4cf2973e3f1442d4601e8b590d5c1556f6132c77eb93abb242b456d766654f1af99ed16fca1982f2945ddab3bb72ceba9356ab9ed5cf0a5576d26adf5cc736d7b1d52a2bd95d0ee6f3a79b93eb96cdeeb5ddcf9ad2ea84e1a139403707e86aa196ec16f627d77c6dd6a878bee8ea65d2e056879ff08f8c24c8972af97afd5384325787ccaa34826236a8be7ac5f7daa4761fee95cefcd2e9740def5f0e857b587a9b7c050c3d502a88a836823f109c5159a8cda3fd0adc08a9c20ccc1a373f4cda3c83783283ef9b89199c9d0935870537a2e07fbbbfbb0dab14d5ab03774214eddd7615cb13ce32bb66f298c1236491915bf05ead2182a2679cd18d769ca515df4c6fcad753ba24597f26ad67f60db3fa93ab7a122a3af5a7f6f5d4aae2901105dd33978f835eb66eb069527b9765a318c7e3fac3d313c77822a4817df0abb35bed8177c83b692b54f52cb5471d9b3376cece722e8afd4667c01fe77a5115ca1f26a22286077cd05bb03be47d06595e660467972acb02b4013b33672c40bd8534b03b0c834ff0cf1d780cae7f8a0276a68528844431a323e223565bfea24c9a41a4a4032a3791950ebccd1e2172c50cdd817022da3179a482a9cdfa566d898ba942153dc3996a911645a230d9502c136bbccd202c634dc428fa44d80ccadd9ab38a9d213b2bab5a6bb7a4ad6aab0d673f3abbf7e0c23667fb101e5eb7adf2d688228f6b9e27ee90a3ed97720ab88909b9cfdae0ffbefde09c3af069db1e060bc335e0276552bbfd3b3527e2e37cb23855fddf4d26df4ddfbd7bfb7fdf7ef7ede4ddbbe99b8f0a37a12bd77251940560db7681c0f0925fa3179d7ac44da08d476512ea7920046e9cdd07e4f0c321879610c6580c08b6400549a6bc0f940f54d06cc844c171a3bd34422231f67c2ae97b7859179841036cf45aa8f23c3b542dd308793e1dc544fb491cc71ccfe3a9280a79354895bb2655aee66611d37f4f4ff345dd53c8e96d79d319cd84f9ce6fb8eb6179df75df673c9b2f429fe9a4f148b9f56e0b06bda886f9942a767c343da642314b38834730c8245699fd65e0ec2d1d478c6ff21af6c1cf4d1818d9b33323e468d2f1bbb21c4d8bcee9af1558648ed2c51455cae651bffed6a8d568fd464381f60161a68d46ad32ed21654db79a2f5aed4018f9ddd2274e2f610092ade267480d9456d0537a208e1883ecfb105e51287c78b5d5489552673ef20793441ead836897a70a215d1e56ce1a0493469495cf6040416a7b65dd954a36bdda64c4d1701494ec9533d56ea75415473173e46c593b4e397867fecc3d226be3ddd0818a33b972be6c60189bbff2550809e37113bd52216db4efa0bd6c139a5f36f25679afd7e6e9a9bf5fd720a7d4201b72f61b65c58fb89d9bc362d62c73a45f51fc5bf20fa513a4dde9dd84f4b62a5abd29eae428f3bccb90a28e711db8f8388c7adcfff2f4d4cd76f6252bafe3e32dec7fac3a42f412d8f9224cac49147213fa3c238e854c941fdbfc1cee4c59028dca98b62ac3c59399fbbe99389fcedc593cad4ea9de8b6576dd722b442f6febd4628d1356b226c354f0a8329d065fd4a3a23cca31d02918d42b0d2e6442cc706e17d4c4e776111f8bb242ceeda2a8941d84b9cdb9a84fd57f7aaafecf4ed58bf8592b2289d57b4337b469cb5fc9810edb59db4a8d446aa1ed93a39310832484d8d0e3617d62b62bfb85c4828b4236bde125da3313a790d8143e7fbabeb4dbdc1a3004bc833c5309f08b7ff8b38bb5644c7443f3c9f93b75be5a1cdf1634f53fff3d2e83e00e4718666e5d6d9b9a1ab36b538592ac825c790f29a99f461b47411b7f086b4d2959187cace565c0444b4d31ab2a71c6b1d26b1582757b70711561da55a7d2c68f0ddd1a0917b32628c137eda94eb26f4acc1dacb5474847a3116b4e1a2a22dd3f95845306ca355acf86038926640a192004e485ac77786fc34ab7f17a404434520a380e21753e51c85b8b7a75885ec8096abb5db9c2b241d71d54c82af1caa1ba70f5338fc6c90f4ddd8932571074ba0f1df4a05bf1216ce458e0c12021674af7c2a02cf80135ed202e7b6d615b41c2b5188fb1a91f4e4e45516a0a2c0af9214defcb947a76c3ea52a94dcaf4a87f89b8ca80be71566521011322fc41eda1b55cdaf440d5134c7ab9d1594aec80f1f8beec51d786e27d797f7fa2eabd7a161e3260620694881f109d5eee1038a3bace24a3f32f12ef99901096b6f71b007cdfff1226de3f947e4630f0edd99964736b53287fcbe92637a0d2a7a7f6eb1ab076ceff787850a5a6e78c8c1869583138a1bab5c6e881427dfdebe50dd1da801b4440b502a49037f1f1c6ae23276bfd1d595975f3e878679ea17457ff8671673eee32d47906d19d245512ddcb5f40395c821a22dbbd8b498acebe100f7a75ab4ca26b83e01e5556fe6441d5cbd133aa1dedbfa3486616a2f4e143f2d5d87d06e91aa2674fea111dd393dbab5666ab6e51b033f4d9049bc6f15e5d30927d43358e36e214a5fb52ca68f97b74dd93a10f952411c5e5e0192f6f44d38aad29537df83b26caf26539505037761dd63de9cd9b376f824bb55b6f30f86c36654549abc213d01c13f2b5a55de124f9fb9ad5e74f376401af5bdc6803c1ad252b7cddead266bbada9eddceb766508c8c676ba71e761a51d2c77ebf1b807f05fb11eb759c9fa67c959ffe4c0d0eda0d6e2e6afab8909bd4b62b641cc7d747151f9adf2fc821a15fa8b2ffea2f6f13cd308e117ff5f1e95437aa1421ad36132d55e2d33f86d438fd6d2f64382fa11e295ca3cbd61432a1c312381a71345d7bce884059383ac1fa4f22900afcdca5279a6b20669507b474f4421ef1bed9d5995d293a45888d9bf020000ffff0483ffc1ec170000")
+  mewn.AddAsset(".", "../../runtime/assets/wails.js", "1f8b08000000000000ff94398b721b3792bf32c4dd71010b1e93be6ced79b888cb962c5b5959f25a729c2c8f9502679a24ec21c000a024de70fefdaa314fea91dc55a5a221fa8146bfbb3d586c75ea95d154b3e246da0844514e9ac3c853cb0ab5a030b53366c16fad8ef03b86bb8db1de4d90c4083c12854a2ccf93c198d7c0a428cb494da4912895794e4d43cb0defbe3de326cec560d49d953e5e0bcd7d9c0ae03ece44272a076e59e163839f6cbfbf9c7f83d4c7192c94864fd66cc0fa1dc278017abb062be7392483115f824f6cc94aee63dbe3c70ab2d515754606c2ef366016d1d56e3d37f97058fd8dbdb9f256e9e5b55c0e874fddf81097173732df42423e9a6c9b0329197f8a98fcf61bb81aad211b8c2a71fdc1f38351c643180ea9169e6ac6f87f0da1b1909ea805fd01a1c484ab8868dea48743fc2fee6eea88d09656d4c2a516a407aab779ce909d8f2db54f896e39c96021b7b927f7355ebd42978cbf0c02b9a0974ec99a2d8ca578b589948e34f371462d37bc7d2eb0a211710ab3329e2b9d05b9b861acf12f8b3ad20726459e20eebdf6758bd1718d6bd9cbe41160ebc1281770220907c601af33f74c5223d62ada58e30d6a3d5e497779ab1b6555518004c8632308e19efad889112be9b4cf917bf44b0711ea2cf564823600c66b49ceb4f284d387325f978cd5c62cca496d3824b29c9cc07cbb7c942a2f598b75a617e65124d743fa2aad56fa7166590fef9db5c63e8ab5e8619d4a2ff347b16e9be798e639a6a2329c5c6e407ff97cfe28ddbc640778a72a87471177cd05aab94055848a930bb87d94e67b4323c574d6e5cbb4b15bad7eb47fa2399a7c2ed3ef672789e71bb9cb8dcc12282707d9572de8add299b98d6fa5cadddcaa6c09af1f1ec5b7307726fd0e3e7680a1c0921a09ee3c582df358e91bf31da8665cc639e8a55ffd386ae30cc468027f6f0013383a62720a33aa59497fbababc88d1ddf4522d76d43256b66fdbe2db589152929b25e1450e3790279aafc139b98404ca1e728ef5644b4956f99bee815c0d52c1c9fa90ac86dc369ed5072e6a2054eed407ddd6a045e5439a95a8fe0dbf39a865cbc636b50931850c841f0eeb8ffd9e7a31625cc36df4c99ab572403bcb5b6e2aabaa49660a25f411794e8e369495b72b9503bd99aa5995287f1c31c493c281bf566b305bdfe3c30a43434450722cf33cf2262247fa88445ead218bccd6c7d167f87d0bce47672749448e146325e39e4df00a51209ed9fa0f52673924925bc07493186ec199fc06125b4ebcdda19dd0ed082f30612abdbc906b4834cfa497c93d3b632e52ac4ca54f57a8cbd46867728883aed131cabe23ac0ed2dd9292ca3b637284e71dde9cea166b45c95b6b6e1dd8b88dd9be09774fe35671ab59b911b59fa776b7f1a69fca51e15aa0e5be28edfff3e51b6be58e8edbf27040182fc17f963a33eb9fb138a13f4e47b3c7b2ffabd1e86fe357af5efef587bffd307af56afceca3f4abd8065acaca9064d76d1588340dfe357850a90754474a3b2f758a350f18f32b6b6e2314f87ab781d621b4363e42b345324a73e95c245d24a386216125f52be5b866dc0bbfdf3f1f73fc1d1fd709a65791da47402c379b7c57954ccdf8f3f140a0db8f8410d43f17635696fc1d860a3ee74e4c493067e257b086245d49bd848cf0faf43bece646da2cc9e5ce6c3de925bfcb26c0de4df54ce0fff6fbe9acae44f8d475d0cf0421f166eb565897823477b1d219dc5d628c0f8757b4beeb16fdf1d04faebaca7ecf87a7b3d8e52a6d5e2bed72bb06ed1dab8ee918ab8128742f0aa09ca494c00d684fb8afb2c6b7839c7181d76134d5bac4579cd6404a109f1c69c607a32e762accc1b80cec4e4566d22048bc92eed4a45b4719ff244ef9078106e9ae7a83c49f84e6690ed23689e303e31f9e4a249f06429c0e87f4547ce257f4f475a3b5e0ebc902ef22c9e1e13cdf5a12f2c9a8a7d4b794157584c82c7b87fa3857ce83064b49c5e7a006bea18311c3eafa2451b8e73ecd186982564ec2dbf9fbeacf473118777af8dc865f7197dc189545a3816822dfa51640fff2faf06772f0f31c169eef9e20fdf590f4d743d26bb3293bb57ca1ac08b2a1c8bae1a3b406fb55657ec5e1e0ec03a8e5ca4f4e86c393f816e14260f77912af02400801fb3d3d114500269a57e70994bcf3f8c02fb1e0d4ff00e1983f7a76fab9cd749f299bbc8feff086f86e387c1fefc2e76ebfbfcf6a6d6e90517cc775bc63a8f28edf57ca8a569e87cfabe57bf8c692bf179fe91f98bf91bfef001ff77bfa1107bc9aca5645ee8d566b89af3bb5720df40beb3b96037fa63dd81b99d39ff9cbbff69df65fbd14b7a2e46bc5f50a43c5d705a3c5fd9d3e82fabe41ad02ff9f624ae65bef8d269ca42b48bfcfcd1d7e9a1cfb0db2086588ac549601a2a8b55ce281959932e1afae7e83034f3871dbf95a7932e3ff3870ef9fea566fa0f7fb818ebd0c75b91ec20663ec2074acdcb1d11eb47f97292fe7ed9036184deaa9a6218cbd3937b7608fa5031afa0fe2e1ce4b0b92a0c7758408527ab3f5d57970242fa8c66af8c67babe65b0f9460c74ad87e1fd810768f7fc5edf95808f1cf36677b56d6b78c3bf3fc8aeffcc77038f889e22bed123cc3516c6343be3da9462eda33d22f8142e8b2cd980f1d2b45b5dcf935e82de1bfb24eaddfb1f6d48fe256605144650c6a470a49b02eba75b1fd8a47917211965ca5955732570e32d20c1bd3593bfa618975dbb94bad9ac3418d355521d338a3072ce80deac00a2fa071e620431c22c5c5efd6ca3791ea763a4d9c371692ed26931eb2f96e61f1a53a4bb0a9ba57e93cc365c9c2d877325df52a826685a61ee3a716a6e2f6d8504c3d9bd8d8810faddf63025eea3f120f879946ba430144107623ad0bf38717facf64658cc370d8682f0cb82be53abf386e83971e37db09e99c5aeafdbecfaf9b6fc638df34f5bf3fe7d44edfc2a6309b346416d70f9efd9fa677cf2dc3d50baebd3caec6ea008874c9eafe03dfc3db8b5859ebb8569c280eb5de1492a0f1fdbe8376f8c12bcf447101b76fab863e7968d8e92c4e8d4ea5a73a769b1c7d2c262c7cb62dd021678c12a875f4e3b81d12ad184decdf1bc0f3f1c41e8971155fd5ba0fe90617d4b03ac3845eb60e2dd208613869224c4637325759f44dde488ca38d8f5406daab85021b13c6267e6a6638834dcd4c1438f1083c09b959098837665325b8c105557f78abfaffddaa665d84347a1c7599c5d7875eb41d66f081ceb64d9ac001d3e30454fff671d7b81d0421088dbb9fe523d0b64ee186a9a4ace44d6bffd0da132d32484d065f3e9f1d9bf5c668d068780b9b5ca6405ffcb73b7ab1e484b0ee683a7afe4a3e5fcc8a972582fee3df8741097657c061e4d60d6d5b28c899ae548958d1463a07190eafcd6a23895afd435caf043889a38ff5762022ac816b36a932714e7d356e577e5377e05640dc70551937e2a671b7660217a4514af497f656cbc95f422eb7b0c446241b0c06a4b9e970a055fd5b71eeed77dc263e98b019cf20070f114ac16b0eaf4d5c8dddb43e60099e84f99b428cc3052bf985f16ab14b1e4c8338ff74e9ca8749a91e5218af022f1cd589ab1f79788e7228dc3a61e4321c4e54df74d0ccf1c08a053d341b0a1639d01e2da7837871142a52847351247017c14ad3ce9354b1e1d037f9c3f2312b5104e1cb92bfc9b2ab10c8f75e8892fa6eeca916c9ef72c060a1a48a42344c8c955c68de62ce4db6c3ec093a3b5ea93c43ef001c07b1469d69d4f7f1d5d5c33878fa2ebfcb81b009c4ee618fc34387f322758e300e71c0bd5a01f8d7fd1f71eadc7590338103d9eedd89381726c39ac778075c81ccf6fbf6e7127c2d9c7bbbbbae3a384a1089b0e968c60e6ea85ead7c728daa3efb74dcb441071a905d0372a21cf68a756b153ac83bff11f436f9855fed9c87359a355995fc5c14e76699585eef5912c38317b8a4b8d43df6d85285a99e95fc527fdce65e6d72482e39b62fc915ff00d2fa39487f40d3ed3f71bc9b7c438769e1ac08f36ddbd65bc63da6392bfacd7ed728b00247fe92615ee56fd2efdadce6902da17763d54fe335bdb5caa25da7c88e28da6afcd6d1aa11bc974034277fc164888c28ee44ae42cfa378355a2445335b24ffe2cdec90fc5ef2df92b3dec6e03a74419495c707db5c7ede0e35468794d1a924a88c8705639f243e37cbb8ae6dcf9e3d7b161dcbed72e5a32f7a1532535615be086184f1a748bb047ca49fc6faf2f91c778df034c6b9d2105d18c4f24f631d9b7cbbd6359e7d1a2fa8007999aeffdc3a58288b0be3e1b0550e2bfe2c7afc3a0fd1732fc8ebcd33f1760b754fafff3c2be9d8d9549095f71b97bc78116c8751f9020b9e772fbeb917b58ccf73e521fee6fecd79693d2e2a21137819cfaa38fcbac2dd65c07d937a75036221738793698c8da520d828aa340cbf2fba068574091113c3414a78cc80f86f3598e6313d4216d5d2e1a690f1b794f1af9475fb05fc8707c8082b676cf2bf030059415189fb1d0000")
 }
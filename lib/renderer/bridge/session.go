@@ -128,8 +128,8 @@ func (s *session) writePump() {
 				return
 			}
 		case <-s.shutdown:
-			break
+			s.log.Debug("writePump exiting...")
+			return
 		}
 	}
-	s.log.Debug("writePump exiting...")
 }
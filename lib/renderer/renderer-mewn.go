@@ -5,6 +5,6 @@ package renderer
 import "github.com/leaanthony/mewn"
 
 func init() {
-  mewn.AddAsset(".", "../../runtime/assets/wails.js", "1f8b08000000000000ff94587f731b3792fd2a43dc1d0358d088f4e52ae761262e47512ada92a594256ffee0b252e04c93843d046681a6181635df7dabe7f74854b25be5b248a00134fabdee7ee068b533096a6bb811c747e502888fc5ac190c903b71d42b0e73b7100e70e74c409f43f823b70efd8c96d89886e2a38e9ccca2d154d693d1b12866f522438b129565dc366ba595dd6714d286593c9a74630586dbd8480c9318248669dcb92a413a71c4d0d247f1f474b7fc02098629acb4815f9dcdc1e1a1343b82d96dc1a96506d16822d780912b4421317471ffea6c67aad5291bc578c8c1ae82fbc37669b3f1b8fa1ba2bd47a7cdfa41adc7e3d74e7c692b8f8f2adb41c43eda7497012b847c6d31fbfd77f0b559b36c34a9dcc5c1f54b50a663188fb989911b21e4ff8fa141c8ccf48a7f4bb3cc9647b1b8b993198fe95fd89dd42d222c5d5c3b97385008dcecb24cd076183aee5e73dd4996c24aed3264cf235eddc21442be2d1df2655cba201bb1b28e97340ab4098cc030e54e5ad95e17c4b125112c8a70a94d5afa25ad100dbf1cc5c8c42fd9fcecb6ef5b8b6ed7b0f6bd884e4cb60c26bf4032c5240809749c7d06496d58872877162d5d32dc287fb7374db0aa2ca005b4471e33269163e8e38928f87cc071245e7a08286609b219610042d69e5c1b8d4cf2973e3f1442d4601e8b590d5c1556f6132c77eb93abb242b456d766654f1af99ed16fca1982f2945ddab3bb72ceba9356ab9ed5cf0a5576d26adf5cc736d7b1d52a2bd95d0ee6f3a79b93eb96cdeeb5ddcf9ad2ea84e1a139403707e86aa196ec16f627d77c6dd6a878bee8ea65d2e056879ff08f8c24c8972af97afd5384325787ccaa34826236a8be7ac5f7daa4761fee95cefcd2e9740def5f0e857b587a9b7c050c3d502a88a836823f109c5159a8cda3fd0adc08a9c20ccc1a373f4cda3c83783283ef9b89199c9d0935870537a2e07fbbbfbb0dab14d5ab03774214eddd7615cb13ce32bb66f298c1236491915bf05ead2182a2679cd18d769ca515df4c6fcad753ba24597f26ad67f60db3fa93ab7a122a3af5a7f6f5d4aae2901105dd33978f835eb66eb069527b9765a318c7e3fac3d313c77822a4817df0abb35bed8177c83b692b54f52cb5471d9b3376cece722e8afd4667c01fe77a5115ca1f26a22286077cd05bb03be47d06595e660467972acb02b4013b33672c40bd8534b03b0c834ff0cf1d780cae7f8a0276a68528844431a323e223565bfea24c9a41a4a4032a3791950ebccd1e2172c50cdd817022da3179a482a9cdfa566d898ba942153dc3996a911645a230d9502c136bbccd202c634dc428fa44d80ccadd9ab38a9d213b2bab5a6bb7a4ad6aab0d673f3abbf7e0c23667fb101e5eb7adf2d688228f6b9e27ee90a3ed97720ab88909b9cfdae0ffbefde09c3af069db1e060bc335e0276552bbfd3b3527e2e37cb23855fddf4d26df4ddfbd7bfb7fdf7ef7ede4ddbbe99b8f0a37a12bd77251940560db7681c0f0925fa3179d7ac44da08d476512ea7920046e9cdd07e4f0c321879610c6580c08b6400549a6bc0f940f54d06cc844c171a3bd34422231f67c2ae97b7859179841036cf45aa8f23c3b542dd308793e1dc544fb491cc71ccfe3a9280a79354895bb2655aee66611d37f4f4ff345dd53c8e96d79d319cd84f9ce6fb8eb6179df75df673c9b2f429fe9a4f148b9f56e0b06bda886f9942a767c343da642314b38834730c8245699fd65e0ec2d1d478c6ff21af6c1cf4d1818d9b33323e468d2f1bbb21c4d8bcee9af1558648ed2c51455cae651bffed6a8d568fd464381f60161a68d46ad32ed21654db79a2f5aed4018f9ddd2274e2f610092ade267480d9456d0537a208e1883ecfb105e51287c78b5d5489552673ef20793441ead836897a70a215d1e56ce1a0493469495cf6040416a7b65dd954a36bdda64c4d1701494ec9533d56ea75415473173e46c593b4e397867fecc3d226be3ddd0818a33b972be6c60189bbff2550809e37113bd52216db4efa0bd6c139a5f36f25679afd7e6e9a9bf5fd720a7d4201b72f61b65c58fb89d9bc362d62c73a45f51fc5bf20fa513a4dde9dd84f4b62a5abd29eae428f3bccb90a28e711db8f8388c7adcfff2f4d4cd76f6252bafe3e32dec7fac3a42f412d8f9224cac49147213fa3c238e854c941fdbfc1cee4c59028dca98b62ac3c59399fbbe99389fcedc593cad4ea9de8b6576dd722b442f6febd4628d1356b226c354f0a8329d065fd4a3a23cca31d02918d42b0d2e6442cc706e17d4c4e776111f8bb242ceeda2a8941d84b9cdb9a84fd57f7aaafecf4ed58bf8592b2289d57b4337b469cb5fc9810edb59db4a8d446aa1ed93a39310832484d8d0e3617d62b62bfb85c4828b4236bde125da3313a790d8143e7fbabeb4dbdc1a3004bc833c5309f08b7ff8b38bb5644c7443f3c9f93b75be5a1cdf1634f53fff3d2e83e00e4718666e5d6d9b9a1ab36b538592ac825c790f29a99f461b47411b7f086b4d2959187cace565c0444b4d31ab2a71c6b1d26b1582757b70711561da55a7d2c68f0ddd1a0917b32628c137eda94eb26f4acc1dacb5474847a3116b4e1a2a22dd3f95845306ca355acf86038926640a192004e485ac77786fc34ab7f17a404434520a380e21753e51c85b8b7a75885ec8096abb5db9c2b241d71d54c82af1caa1ba70f5338fc6c90f4ddd8932571074ba0f1df4a05bf1216ce458e0c12021674af7c2a02cf80135ed202e7b6d615b41c2b5188fb1a91f4e4e45516a0a2c0af9214defcb947a76c3ea52a94dcaf4a87f89b8ca80be71566521011322fc41eda1b55cdaf440d5134c7ab9d1594aec80f1f8beec51d786e27d797f7fa2eabd7a161e3260620694881f109d5eee1038a3bace24a3f32f12ef99901096b6f71b007cdfff1226de3f947e4630f0edd99964736b53287fcbe92637a0d2a7a7f6eb1ab076ceff787850a5a6e78c8c1869583138a1bab5c6e881427dfdebe50dd1da801b4440b502a49037f1f1c6ae23276bfd1d595975f3e878679ea17457ff8671673eee32d47906d19d245512ddcb5f40395c821a22dbbd8b498acebe100f7a75ab4ca26b83e01e5556fe6441d5cbd133aa1dedbfa3486616a2f4e143f2d5d87d06e91aa2674fea111dd393dbab5666ab6e51b033f4d9049bc6f15e5d30927d43358e36e214a5fb52ca68f97b74dd93a10f952411c5e5e0192f6f44d38aad29537df83b26caf26539505037761dd63de9cd9b376f824bb55b6f30f86c36654549abc213d01c13f2b5a55de124f9fb9ad5e74f376401af5bdc6803c1ad252b7cddead266bbada9eddceb766508c8c676ba71e761a51d2c77ebf1b807f05fb11eb759c9fa67c959ffe4c0d0eda0d6e2e6afab8909bd4b62b641cc7d747151f9adf2fc821a15fa8b2ffea2f6f13cd308e117ff5f1e95437aa1421ad36132d55e2d33f86d438fd6d2f64382fa11e295ca3cbd61432a1c312381a71345d7bce884059383ac1fa4f22900afcdca5279a6b20669507b474f4421ef1bed9d5995d293a45888d9bf020000ffff0483ffc1ec170000")
-  mewn.AddAsset(".", "../../runtime/assets/wails.css", "1f8b08000000000000ff7cf8c712ef3e932486eefb29fe7735379a3d4defbe890989de7bcf1d7ff4de5bbdbce29c967a292e104015322b0bb56001e0bffff36ffffce3cd63f94fbeefff54db3cfed31cc7b2ff0b04eb763ffeb36e8fe6fcfd673e8f60f62b876cef3390cee9b2c848a8406114c62b84cc7002fb95485962349457d81f4a239bde7f8e269bfafdfff76fff0efedbbf81fffedfc4d53c1dfb7fd6f35c0fe55fe67d29f3762c27d09d7ff331ff1f7b3994f9d1ced37f56d9d80eeffffe2ffbbf5008fa0f0c82fe0387a0ff2021e8bf09effbfecfbfa4ebd96e5b39fc253de679d8c1bbfcfdf1fccfba9cca2d3be6ed9f7f07ffedfffc6baab2bcfce7fffab77ffef97f567f22fdeb9ffff15fb1fec7fffa7f1dfbf10ee5bffe99e66dcc86ffb6de655b37c7bffe4121e88f6ddff27f9ddbf0ff2fb223fb57b62c439b677f1200ff6bf35c55ff2b6fb26d2f8fff7d1ed5ffa4fed72fdb4b02fb8f02a225b76658e6cf277937c31a7fa74775338cc3fc7f7cace40eaef967b2fe5dde7fc63cf5e200150ac10d12866164e18f71f8c36325b1d484890bb376f067ebf067283686611c7c9a63fb79e4000e398661d4f70fc8ff03d25ed4b3560ca27dfd531886d9a83faebf7889abcdf56d12046e72866138f86f02f61f25446df16dcd76c5e4d40cc318d81f97f007cf72823f6b3792de2902310c03f57f8cfb9f5063e81f1c30a588f8fde1e38f3f50e60f88e1fee6c74e3f7918ff64f287cf177786515e2adc0426939a21891c8611f7bffa8c3ffaf8c444b09bfceb73182642ff1eda5f5295a10ef607cfbfd8c51886fdfd11c14cf31fea758a86c8479e2b41048611b13fc560df3ffa8afa77f388f58b44ec8fbe98aeff9b4f611485c98a3389e08161d80af85b893ff9d992e5bf1e10b3578e3a0c23c97f0f81fa833ac134d2232097d5e10f5f42fe15fda794ec5cd5b65cc0681aa923c3b0fddffa32c9df03ce453a86fe46fd5b5ae6e391f6fb5b7a5ea058e1fc6b346d4c31710c69501b09662e615906ab6cb0b4601ed0da905b964ce3086cc832eecc340c3357bd50a73a40dd95afed2a6630d6d3b70a14a0de2d79b62a7d554310b613a21797bd3285917a154e8a102bedecd5107e1fe7805a7e1949176e1002d8a196045d68ba297a377847696d09582c75ea743f7f52e20dd60b29db838d9308896dc78548e213c24aaf74b123f107c62670026b519d953dff939bdc65685a90b9ea0f629e55bfaf8c554f567f8b766291a6bb8e06311e8c7199d420a710ddd170289c125bb4ef16ed3264e6f21c169a7c4ded40c1ac43ab66aa8e8670fd241d73d23350cb4062aaeba1b6706ebd885dd07bd8381b20b42d24787d3f555598ac01d1d8b62d10ad4e90b6ad924a296af2612d13cf7d5103a4b2e2b26c0e1076ecfeedaa63040a1425e674f048008c4d2b7cd836d572a65b73e00703d40159d4e1f128be35711511a2bbed0f9a26c2a6d2305980c32bfa7ad0039536733bbf2dce366648f31ea112670807689dfda4a08eed6006e8b6fe390fd6e3fa8579883986c5668ef0c2b5b227094807ba78dfe884d8992e1fadd07604a99d6dce1a66b18acc5440d4cceb7aa53b5f203a24ea6ffa8523d194c65ab8a359d6835cb82e181a45bcc568f9a0b0b43d34ba5c2512b0a7c2d38ec4d536cad28948d78a5533dec75c238b50da577bfa58ec1b0b8f9d8b9108d79efb097349095b75a0c62c40df8bcd86190704ed9299935065d53adea186cd511060d5e281b27b0ad78c20a078d4a9fed3bb4b8a9393dc52fb70bda8bb69673898836e41a2449dc3597ea139d49d1a2ec9558009a7c606701fdf4fb53e271912069bd9ca64a5ec2aeefc15e1151b304e1ec3840b1336c424ed9622b8e934b48e40e64789dd2d1f9b2c150363663d73ac8c413a3c3c330a5c8be8b5b32dc0da19a75e55c6a6d76ae50786e4e6434d6e95c64371ba1925bebdcffbc1caaeafb1ee8b95659d1046f8b9de8ce4f806a5d3ea3bd22e7734484d371ad5df1192c23fd99a488f3a21c146ba263a87d846003bcb72b0e69ffece49a3ede4e5e7e171176dea2c058baebe48eab10b0d6e8d013c3b8d547cee44940e9fcd9de3e94ff72e7048a32d92fb4bfbaa45af4ed202297292e7b6587a4edb651f338b5a00f9e05d874f72650938652cfa694fd01d51b6171a19b68bec9cf089220855dcd6da0be62ac7c6919de299a1b52477fee6077a3566bcd031f2c754bf5b4e1d3e5a183daa25a740b8a7de21ce058c5c4d3de4dc187e606ca9673a8a6d63a6309b95e2506d6363b6cad65bc4427fcf136bff3946925946ec197364193bf5859a0c89151b05484f4327881a65bc17b48bd472e731eec93e166e26ba8b0239d94dc9175a18367230c0fb3d1a15db919b80acb805e12592cd0c43cc8d67f60023498cc112354fa047f1aa5dc223ac87a86d34cfb9490295706413ab4bc6e588d6f4457b12d60761b19ed91138014c07539ccdd3d4392bf73b303a836f2b7f4ee6edf16a7f14343457218c8548ec25e485af689675e947ee0534807a4d0fe0f8c1c9943500d301a454ec65712c083282ed62b8e533e8ba8e08c407f1632deb381883bb28a620b9093bf92b73a9944b56016d5898cf7c2148d617a91b401d1338931131286fba6330657024f24cf96b614e07b8ea2180ea800919c4a2ea42da71c2921ef865a5c297d51a3d1abc4c164c36d54d16a45b928b8dbc0732a268ac5c1901564c0bd088fcf95402496a6ac7191c6e95ac7757932399d8a623b89331726890dc51a2346ac99d533ad2f161b28388283860d6bddd879635c5c1670ce6724800ef7de5e497db344d662e0d5bf43ea1f2b0fbd8e1022ff6416f3ee52f609e189a13c13652e074ec654cea688914bf059e676c2489489247ec233e331c697263624af0014550ad4f4ae9632bbef4004f638418a87f1048bbfc9dca35039089c28e2f74ea7ea7e5d2534f233cadc3e0f9cb358b3cf162ca6ab3dabf0aeacb12cd9cab97bb5a422892472f19bb1b02d5edad963b4e2634bd421241bf9429a4f05b86319446c28e8cd8ab9435deb191c7f9e5146ecec81739665316660e693f7d31d9d17e56d1cb826b44fd5f808723b1fd58a8dbc77b36c15d26271cacc12a26cd83c6a0993a4f26a5c7c2e941aea329e241e2739d268a29e914f86da77a93278e65b957195945d75b421480499ec09f1efd6c32b994ccf6a2139195a44687c5cdbeeea3affdaae510e16da44f50be3c71e01d105a57978c13a4f9ba1fa3a7b1ed81afbd1f83f64eef5af8dd259963c39f4e5346fccaaaf77ace68800aa1aaf03e2f2c087183d2ea6fdbb2f52de65320e2c2becf4f4b2fafe4f20eff7a0938061334cd71df4f484088762fa3b60a5d90a10ab315acf36632a0aab8fb123c24fe0a8a8921c7b727993fbc6f53b5f97b4fc8c31a8d014ffceb5283116c6e447cddaf6cddd47fb905e7163b58c140ddf1a8493cd8cde9a71961453f065dff9d602bf86afc6ce971f7e9b2c10a3f4c867d6e000d814995c058d61b941ce4d483241ab68b8cb5c6f68f750a85fa1e204125a08a52be9c3ecf681fab781ef155ec13275f77f48404e594e4e34d23ed4e89fd5649ff3823ed1ac9783e9e499badf60afc65d56e0bfb075eb5e485530a177a34217aa2c67ae89312dcad73c2ed8cbc7ea5c3515278aee4de32b645a41a252fb271348e9b14a24637b3f0fae1de94524bd4c6f4c6011fbc46d87be9951f49b072f75689bd3403d0b78703f659e3f26a9c3af03406b0259dae82d368fa55511b164bf03bdf9687b3dd8727dfa6822092cfdc9f3d599e959321a573b68c66407e4cd32feba02ab4ae69a0d13c23367a56c9f54410697ad3ac527fb63bc31a74c62f0ca72fda0d127ae30bbdbc31c55aa91d61378b215be47ed0cc25ae2f5684ed67ac72dcfdcadd6a28b44fe20a2dd402afd17c4dc7e3862aaa82a92e9ecab3bdb44dab226726ec1f56ed96b349b5067fc63acdfdc29dcf2922cf499a24e98f4c1c219d56c8a047099736c643fbe28087cb7d1c8824cbf418ee1599240153b9a16fd32d30603b4b1c6482f94e0bbeef6620090d9b18f2a690bd804463d7029826d4dcfd6072a27577c679ef1b2fe477d3ea28545fd9f1457ff86a16836f5b24623d5299ac7c12f05ffcd98deae9c421db55cd3ea848cb393a455aa43301a2e9aa2e251491a29b759765e6e23886153105a75753c0e632dbd06ef7f98e3ccff34d3c4ba9599a8b64b9c9787ab21b49c6870fce5a4a8c601e19c1afb5c0cf217f6db6835ac3530e48f998974b39bccbbb419deb9a86b57f2a36ad4fbe041f7d5bc660be51cc869a7c18775982b06312f14112b1432249c5136dc66d30334f48a9fe69bde10bb7a13037cb3f3566cfd5adb1098f386b7d75277891a7c2446054d409580ba96c84c6edcc46679b6cde3ed1c69eccc028bc703379c4dcd99f7e3f41d83a913226617bc9ad13a764f4de48745c67eea9d69db2be35e7718adef0e5db389c079472235d9fdada53c8c544dd095cd6eb45cb0906d60fc4ea0ee6261e25735ef475a2a91d0401de77b42544d8e4666b21cc815ad1987af6183e8c2835a428253440e5b63b9000a909bc415007bf0bc0c0860476597db0bc9af8faae30b97cc8bdd2f9e74e665977a89d6c00804e36582abc426558c96c19a97c54c5c0c4a25933a64e24714890a2c62d7dc4cab8836926109ac4155d231654cf1b1629ecf1d20945350ac2d00f613e808e9f0b17364af1ce6b76026c7cf97de81ab2ffa2ef2059289fd2a7884d34b7fd97be6498a2408567668b73209dbf5ba39d09b54b4ebb159e3693e89938a677ff70ef49aef887ef4f36c32b5d9e08c90218911c08999e283974248ed3c0336a016dbde4109cdafee0d1429a103ffee6c46395cce0487dcba3390b4ffcf0a85ede2f7d23df1c3e8c1babcef847d30bf433d1fc91f94da32cbf9981fc92b4e544a3702623742170ecce431d3cacde0880ca1a29f326e5da0d44031618833135425bffbe853804fb63988c6173465298d4614746ca99cc615746da991fc37e8c2630e5cd7e8c9c3325c3e28c3cff59538c8c3135c3f58c1630b9c3568c183085c30aac2bfcbd5fc6a144fac3c10a2664f2b7c53e665d5bc51cecf8e83cd3d9b0ce24c009dca6fc29e24eb398a8e6b6a8c0ca10c7ba0c9a6dcf58cf99ab8c77d2885043391dd6089db9b59a0259dff9e514911ad0a5f5d98212d1650740def7865eb03b848001ae1c3f83a1097f2330c43814438ec667a48d0506404c6797cb1df86365ac80febdbd2d051059872bb480a40b32ab048077457d7b72f0be4f9b0ec1957dc35ad92f0f4e9e7c26f3dc771b98f5108b94dbd0bc643995d326520fdc720847367dd72317fabdbcf1c8f8ebbb4c801c07ab6e00ccc921850a8187f3fab209c8a8b62fd83afe9dc46a2186fe917f18b28bc5bed973a218eb970832629851e9dc255f53a3686aa86acc3befca48b25e02c74abecccc385e7c2ab8ba0c10d4deb33daad75d5db305d2b3c8f1ad31884fdaa0ad1ee62fa28afb6a52d910d1eb1974ebea94e698132152c8a41d192e70397788f1a6d9eccbbb255cd4c90fe282da8a9f0e050a4ef74f4470a8810c61be13234e5e0084fede175bc9709bac1652d11fb1acaba92c5f7594df46ca0c8e951c65b20925fd3ef002bc59266cee3d4da9b658c1244c7403e72d5e5beda4c48f7855da02f770feaf2739876141906278b4cbbe18922bd60fe98bcfb5e2f4d0f2d95f2ffb9dc7e808acf6eec2ee39432804c9aa882ee3f61c41b3bde6abf5cad53362d5aca37fe31db1ba5833aea373f34a27f1c502873f09546da1df847e2341002689822001921faa7e27361a40999700b9e0f06be1e4f2c93c9d9fb3e05b9c196fac7242dcb08bba09f0da2c11a9692589247b10165292a1da37f6e38b1e9e7245b06f50b5b751f4c114c6490b8e6ded368795c08aaf43f300b57ef570803f6e223c7d91d9f1ab379b53776c1b98f067860d4751a1b00751a037fe7a7ac733d16138f79f98ad2a3dce709b735b18a7011724fe04ec0b0e7bbac29fc1632bc82fbfb2c1c97cd4c3797fc13ed5ee62f10a4ff4b7a07d845879a984562232dd4ac31932e1404461c0f984495fbd672277ae5c13b6ba77b126b48da70bcf3009ce1e38aa320fb3cafa422e5b87abe80aa1151e16dbecef5815257005f0727c9e0e04a86e60223da47ac49b94f1abf5f69a6d8b5ac82c28c5e735d2eb68a6a0f11abd2d36befdd27a5f0ece11e6c29a0355d74c7434e91606c31a1bc1c3ba891732d289c24b7ccb3e3b55c27d93290a5fdcf2867357ddd673ab364e0cd2be35685cb74e4bf4f087b56d973279486a8bbae2dfcccdda80c079cd3471d1ccd4e04572a406be136b6d468ef5539d661f93a34fdd2722e8f483b89ce7793aed5499eb6855004040f443e1e3217b5d376004007848d51fefba920d951688614078b955f03eb8e55bebfeec4f381c4e475069e278cfc56a44e08670ea05cb899bc09a94af99a4c47ca135b09ec6f101085f26178bbd602b94aacbd4ed273f0ddfe2b0f68e834c5ba4a24f9b000554fa6ddd3cbae1a9cdc1ad8ea18ea95e098d410eaf12bd6a2b50c3b4fdd7da10b1a57340ebc8a1035352a05df503715a8e0b1620279be2c9acf709944e07c91a8849f67edbaf15e466610ee98250d73a9ef6cbbccd30418aef6599b761a7d99b6c6b650d7090bc14b84880069df034678a3047f05e71732011fb1dbbce6906e1125f1136e79383c77e8c9845b4b5a4d04bf453e06095624f4e17c40b73d8d9628119f8eb518edce00ee69126e9c6294cd37a8298b17a5cabab35718dcfa42b636dc7c06c42ccf37c695aa03ef4978e3b061b24321342cc31ef61f4c11fcbd6ecdd84a83247e2e6e92d008eaa07692fb471bffe6b8babd277a04a508eecfb10c33e25412bc24b3a0d4e0e0019c21825bb9326e96072dbf9fa8e07f9a8cd5ca9f118c11388fcb83d0250816b74bd4963420f0b7ea86b3b48f8b4d09f01ce4027dd14656855fb2d53273f2d0dfaf18fa2e8f40214ac750f108b8f658fbdc4033c2ae67e713b5ef6160550305f22b1314a280a743245fd235275545fa1b0b55004b4439cdda3d74d2798b4e1afb3c56c288b5b1d247ac7e790b4ebd813c910696284d248db5e2cd261fa3c51e7566939d583feb4733f715fadabec717049442974ff74793adf05c74e3e395d748dd6219d6c0c7e3d295d4dd606b8bc94d1afeb37fbeed3f7d422c41481fdaa6e02fb0fca918f98727bf203df2f0d4946e922b223aa2f4837bfd41b03a9dd4101e9d3d7a539e44edf1ee090db23ccf7cafe9ed487225bc737c883c21d4bf3223ff7f614607912b51134b60346604a978b022c1919dba590bab3e2881cc2374f06ea4972576c6c921731630b26acb8af8c39cdab0f689118cb4946e5881c2168426f0f7bb1633df10c9cc38d6ef73d49a44ecead4ad7295e57ee7527e9f7229913087bd22d5635200b437b2bb458fe08b1bfc78b37cd61ba40c1f7d1a852d2191720898d2a5d427a0d032351e7b83195d58354cb79cbebc3cd469b227652cd67cdf59e4e45b0111783c04ef7384c302e0ef4870bdf89f4f2714d5b57133afa010d5101d7ef325e73fba104757e3f29bc3e6deef6388ef5e354647e59a425c1815fe24e5fa60330165423aa1f54376414bef4a00c8232b15ee4f514e5a10f360faa6c3f51ba8da04877caa2802ea51d2565031ed772b4a685c0cc6fbc74556e8505d07e9a9231f760a7682aabbfc65d3cf6641b106a87cc127283290529d514247a2464c2311ecdf2842c732c8eda86be2443c12dae90452e1fb63d74b7df3af7728950b8f276183aa11e76516ef7e9922f2e0c38a6b2fbb3747ddbfc534aa90f4cf8af025eeb9d8ecfd961aaa6d60a01daebca6c0be2d10b2102e4546ed005c0ab06bb2f8f891f5adc4e40a5eeda543b8954b07e050ccd51fde5813f6ed81c6746c0e46b45bea84175948b15ba1abf40ea6f7b591c37f0ab5b0cd2b3f8d79e10c82eb6bb42dfb62b64246650f43bf989eb60f6a25b2c77df3975194a15b84055669e270f21d92ec11f23b23ff361364e3d06cca06b3cb03974a041930b2734e778c73cfe0985d0846d0836fc656cbf763e5899307735db840017786e0199c691d319ba3da757f40e70207561a9144a10c2432c73914f7b4557ea39ac2b96f906d061e5dd545142719015fd2301887efb65160eab42ceff5a783f8e26467367ca0ff755ec3d48827984a345e276b59e35bfd73991e7302f7003a91ed9c6d7b524b0d7319d0e4f916e24d1ab3147ee954e3fb959b0fc63e8e40d541f4b3706407df0a75f418389dd38c73ea14f5fa64e26a7a9c898e2bcfb232132d35f4f2d9f641fefc7329ca4ad67e853ee32ed1533e0e2cc1c0bd272b0687ce96e494799687b7ad4e119a4011407ece200a203b3482a2ec6db72a9089ddd0d1482c060444857302526894233d22effe5d304f241e79e7c85d615e2bfc5c9202567bb18f2ac6f8530a1fbc9c1a19821f7834453565d81262360d93a6c3cd218384ee4ca5438c3161d9f43116487a4ba258ca3eecbd41486bbd299bf47d79749e5b4ea2ebf91e3c3899eee3bb17101a1c22c634f54119a055d05383e4d8c730a4de7d45c9f93ee3a961e0465b4950b6b98a12295cfdca91e1aebc0d5bdc03c8e9fba06f4470ac2c2eb3d5d8432d27ab33b02bb0140ec6990da25144213703f43773f51772b0e1c6ce696f1dbe2b0ec0ca057f0e04b48847a3c8be108c0ed3b8526cdcab502c0d156b8939a8606d272ecb8ae0f5db369ac5d9c6716da296445c6708479ae5dea154dc61b5621d1631e678bb8893b7bb1d1a9eca7b454a92791cb81598805b5e01c51d244ddbddbe7d3a8c1ade5e8c9b1372c4ab4c4c0e1dbf00d904e807f8297439346722984b5b1df9c77e4ceeb083b758dd74c2a327ecda95ab08d9e6ed855a0a1de569f0d75a8b56a7092ea39a364c29dba11386106178e80ee73e9eed84ffdf1fdb9d5e1f90e17c6c6df089dfb65ff034f385824de52f5f0437d10c0e569821c8ca5240274c85b0495add439dd1501a114af36e318d0595626141fc65bcfc2fe8c215224e7259ad45f67087273b76390a02716b9736ec8541981b2f94108f453f59ffd7de422ecac7b0322e1667093c37b1ea82588758eb54c0d4639b82c944ed558347eadb50657c38f6466958b9a847df8ea454f58f76ffcf1fcc40d2efbf7016f6293da9919d1515c10086d6bbb8eb2b653d41579597e44a04d4a774d4ffd19eb13bd5190b5c00a0164158d687e02965635d52f0394726644b80b27b9cd6f9053b2f10bf045798ba004b15c51561c7e74322b37c2fe5247c8b8775823a1687772e29540ea7992e04961e2d688e08b35d5330faffa09dd1a7dadf48ac74b4ca4f5b1250e4fb09a8cab7977a4086eadcb4f5ca7171abb214c87ae0e0e9717f6c15436cbfa13390b2e8d711472a3af710d2e66adf4992c31c8093b2736ad46d2c790d66f90aef6d6d73c3d78f674a5260537e97b5249992a34107eed19de9c8cf4240980f866a25da6d0dd99b7dfd78480f6ef279649e3ff02c2b9e1085195c23f5047053574e7c4abc8a28e58f2768d83343a0cd65b8b247867aff10d25e2e4a2bb1adb4c4cbee5b7792c7fe7f86e47f42bea3c9e2afee28e93cc455010a170dcc88752c7abfb4090c127f036e7ce47283c3ceaa1f3a93788cd22c7449284af23f66654ac754b3dfb7aa03efc2c4f7c67ce876e36588e022884ca1df13008cfeed1e1a3789e572abdfb1626646837ae30ab0fec73188b838e28fc066e2a2171b5a0285df8f474b39b3dc6bc966f01d2b4e64e637164e0270a5aa97f88d43c118a5e091afa4c9f472198de3baa20dd4ff4e82bb2e5be47ce51b67f0fe7c6bd7f69bd25a464005ef8b99c7085d31950859470752310520002a2028296567a69200f72015bfc6cc6d67eaf5b0713b7ca0895240e23f3759a087c236b63758fd5a1ca254710658b81e139e3d4a57e3c54d356ca6109268ce9d1766f782321e5868f567397c44b0019bc615fbc3b0c8fb3309fe836c34b306b2fdbf36487553d578a5090492ffa513a2326735303af5da9dd5a98e92e0f5fbe2250062b9a177ebbe5e1fb38fb981e849c64f0c2b70e69e17a2f419040a5e4c502bf48a014d28c17760ce6f5b8be987d891890d09b0e7f46b77d638e6e130aab16299e340ca3f645f6d018916faee0fa1321ebb25234edc8616a6d511394e354dd18062a62b1a62e595e919d04d1afa96fea87e7680e30ddfd60bb06362cc5543710b7dfebb666f3635368cafb2f14c222532e4de0d0b175dd8774f8d94744cc5afb5fddfa0f637e86580aaa91f2afdbdf59924fda61c9b182e913014a51131b846a8ba25158a79ff86e8b1d922883c0a9159b959a8a36285f511ad327003965a39b14bcfb1a8f0d9e58b656e57a53a274c2b2df43facdd174e97108eddb46e0bd32d1340020559951f07e20097f25f3a29ff342612b95cd307ae706e7af6667cc380f38a2d8ad6625cd1afd644f24e64db518dff42555db236c9a7e927e265f93a331445f1c85caf4179abf87a96c3356117250ac94ad67316e73993745d118cac12bd25fc649582e8db5b48aec77d5f7ef5625d34db6be79072f6687d93582f17c385e60566b68d3048c75b77b0eb0eb8890c4dca11e8bd9a3193be17dbc5d97f064da3e42c75f47722cd285a04e029ec8bfe58323f574a071cfd0b7d9af2007ecaa2d35e467709b1a04b4cd0733120d12ce4105c4830fe69c4740e9a8d3455469a9e52577636c4fbb49abe43d16e3cf16cf1d3c894ff02dfec9c1b68362adf9d0e2f3d8dd5b4d2fe2fb288b63b0f10921a64448a34cb8d79797cff0c00a1b2fbb88999cfd6d17c756a9910d2da737e61457efb573a31ad5af3335754c41b0886874ca4a6804c730b6c9cf69d6cd479f45da2cedbda9206276f134d690c2e29b6d8b68f565cecfd5124e8222c0d12e3af829cca6e891ea9d1222485f248d7e9284c26d32d12551843830c7dd47efd665fbf279c6234a2ef0435217dd4c597a02eb4994250863663870d59835902943fd768ada2991a5cb517b9206b5b1ba504fd925e7b56d2dce53e81d2533d6caa53bdada9ae497babe39c96ded9dc899e149fc5de1484fd074d23adeabcb6969672f97da0a1d0a590499e99c6a1cea55cff03ba87b09113d1ec4c5210fd24cafa12473bdb5809ad918abe2a086dc599c3043ba44dda455df6caad8200cffbed435819bb73844df08f90df1e88ac9373ecf01e6ca5f7797c8ef250b064c5da997480a355cfb4d5430772b6537726f8944db6f7a02f9e2137043d4c2fffc2438e0231d4009ae6a7cb7785cd08c3f11b7a7577f23dc5d5521506efc731063e0399b0fd9cdb0524fa1f6219b96681ac921ff008371c2c59e3a7d9444faeec8c078d2e90fd7b8b89ae04f428b262ca501f26c5fbcbe4faf14268a776fe22dbfd88b9f5be11bd5ab55bf4028ff80765cf2e2b16e88b486b47ab6920e09a864c8ad26a89b28d557a3b55ccb3686cc884fda31fce51adc2bbcb58a32bf686602e5c79e60a2dab9bd31f84e54df995f25d7100066dbd450ac13d46967b4dffc3efb8a1bc3cf84634ffdad3b3a3039f64c3e2cf98eac7a76ea9848e78bce0384f5a2fb2e9e239ad3639656f7866bc08a33a4212e3a59e64c2166bb5bbfa9f0af03b269f61cc9e3cccae8f78075dbfab986e881ea5a3490b08addf16a126c634340ed9ae5b96c7af8f0a3d210a0bc6cf04c8b0e482f6b64ce5efc392b9e1ee0be84f1e107034e15976ea1b617e31b88616523870782e7a97757c6a8a93f2e1631e1ac181949f919ed9973523accba8dd26d9bcbc59cece7097a20ec5f88398e6a43fb8d013f23d16a9699b958faea8895eef7e22d5670aab95617074d38515b837723ac7ef20c54641dba3a88f8a68eb3e00fed851449a85a2b263de324f98e17066d1aac7df3c117f4f3eb025b2d858835bc107b8bca98f2adb2c4fae0971c80bfa6ab8c74d3fdc5d979e756655f6438684c86911be5bebb7beb137e6cf38a0b497443e836256fe31b6eabe2ca8d7a2b144647b9b6b5336b5a577f2dd647bdda96f161d96f9dcc7698cad0979e908e836ed57ae70f1a5ffa3062641c5424cb68b0d0bd810c7c042afda377f35cc502e19d7521494f51494aabf64789715a4ab18548a0bc751d64dee0921eaf495f840783ecb444da9f0b6ed066e9bbcf260f11ff05a0215fdd81f5aa3069a3d5c933619285b82b1375b5545adef897026aa333d0e57cb4938fa6dcd5d5282edf0fc4a64260019ded1242a7919f7d320f8673feeb31349e5accf11446b6b3f99cc05ca11b8ad0f5c0663abbd188850c3f745f958c3b77fbebf22760dc3f4e3e3ba20c19a6119f4494422cdc61b65cbe95a56913de694d9645c398eb2265c7d08ea231e5d9fb5200bba7aed2552c095419fc4a8bed99229032eda98fd373a28d2f4c2f99528bcd3e1f300687cefce8c3664e3ffac1e48f5a3b64ac7e93f0ede7c67444db772261b5f80815e7820ae662d97661230431e8fcab3952dad40247447da01fc42983b822cd5520174c40070ac9e23f137aeb5934f446bb037de9bf05c89c45f0268abbec383740814438c8d5a8325660ba3ae8a248cc2906a82b6503bfa255ca3fcb4d7096c72610166c239fb22bb595f6f05c418c5f120c24f4b620b5af79a345eff8a416923472818e51532249fb6b64525b61d7ab2142ebc133b071401ca862f44232542f160ac4cd799524d906bbfd6baf2a2816584bd82e779d2a579d60607e311b1b781918acabbcf52b9eaca6df299dca2628a3b6dbd05ceb7a5f0e70b286cc40c280a5f71124d87cacdc5aa26ad79e3c16769d4a13e2dd625bc71caea54d3d748d5c5d1365542cac1f1b0d554dc38570582df4a726ce14d5e92220d1a39943cbb9bcc0225a2bee8930dd731bb1e30928de50df2afedb5eb556f58df12959d600b27ad6f8cbe87ed169db3ba00c640d7f40e345adb7a8fa0a1cd8048f1670288fe1930c516028af409b2642b0547b8ed872588e75e79c5a79b9291bb3339d77c171539d8913d88189847170de69499cea625e22f733ab17f543db14a84ee84791f26ded50587f84664eb75ffedab288f0a74e06f1215714ce009861fccb34082970743af27f59177a74fa22c760e2b5267785f6369fe539a692d7bda918c347be0275269923e6eaeb1707edf88707e4c78fc43f9ca8ba0d04dcd88edba061c9629dd09b5ac8f8d04bb407a5be737f21da4aa22a098591aa11325d19f9be6003baa91f19a2bf7139d1d79f0ad55f7eeb458dfcf2fac522ac0f0a6970f66259ca81615713d50a0392ce300d5aad1f2a6d1457e18c091da6dc0474e29f17a5f115efb757426eaabf1d5d5520ff11a20cd67b9257eba9b8dd30d4817df399954831d95f229ad45fa876a671581d7f29afc332fb009b0f81848ffe75b4e4d7d23e9dbba61fa923da3a3668c69d13349ad03f480c1533c0dc7d5078aee3de7376f3277172a0e81a9d9e3e5f6daf5e24417afe1563e70e3f13acceeb90519c00e81a0492f8d76bfaeff815255ab5083d6d1589ce3e760c56b4d1ec5bac9aaabd768fc7135dab260facc83b7005f1be06013bf679c1eaa03d51c82acb8f036ab306db62e501216f8d5079b3a4626ee1575b329b64de96b421bee983e7ae53dcad3dfd28a88c792a7955075e5091001908408dd8932e11e9b0a9f9235298768f201ff0cadf34080b5d16b8a9d9ea2270ecde79813b4a000887a2ac9b9c270c40c208cfaf3396f0f42cd8ee60f1cc4fa89f1188f4dcead180e82d848b1dc73fc1d0017e810676cc3e04514733f3b36ec4f7dd944c5bb240bc1ea22c65db9e3c7d29d4d6905e7e6967c6924453091ac47ff965dbbf2881d709aa721f2726f25a7a14c7a0a96830b54762d91d67b0da06eb2439e127f67135f1d6265f23fd6ce463e0a8bac11990f325fbcee3b63534b1fc1e9fbc69cce96a8421233cc6d705fdd5f7fccb2425491d98820609c21aff2e86fa55e6dad7acf1ad872567678483d16692dee3549dcfdd96c2ed3eb5f958c2294333dd5977396bf0f07f5fabbe1d5c34ed36f61666ad98b64987dd49aa687ad20fd885b1de6ac11412d3f30cecd413312e18fa3d280c70820c34b07c5f65728e0098cc870ebbda140481e322590358f721a76819a2400f4fd84c2129f6f3b32edef9b9fe78616857f1916a3ab642633759a39d3a053a492bea476334be0149410742cc787ab27bdf9364b5281566af89fe916d557f1757ac6acb17e2afa97b8d1a981d850c2a34e0546003dbaf909a06fd760ce911daea3c85f3bc41eeddaa74c37b0f803aa5a91f95e36807b5813d1fc8de5eb2389df2a9a379294f542cfa1d3b669133efcad7289583a839c5833ed0b40077736740ed0699f49219171ddb572d84dc9cc6cc7c1a331c4e6f86f068bc19ea306eb69713c85197c6bf34f331695cde24486fad425d74648910254f09a452326483a2f3a84b952ae585a73c04c76890d6a38447549ad85074da28990815a990098d3815aa0acb9fd379b7d78f852431d928148552e898accd3bb080956f6cca066f92b469d6269e5d9aaa20ba82308c90bd0f9a97eb82a30b953c143c803f398fcd19e2b449f236063f71109bec9d9c23f48c85684f57997f1df7e61baaefcc2609e923d65fea8738c3e2bf31864292adb20d0b2f3ef6e705bccfe0aef8d9277d422676b18bbf3c4b6cafbf673ccc967575ddf860b9b34b579851a948acef332f35e65dcae0876d52d6ed561d51f99b8067759c07592d1bac559db3d7361c3aabf1eb175132b1d675bbfc0e1014ca87ec1114287c43a128df5c48e05df799119465f5523ed89e4cb6344bac2a8b04164ef287ef4aeb24db90911d01b84b124dc08fa85e8748d5976e7d4aaff39bd694bb30044a7492597464aa7221097b072c367c461edf1b7a18b08ee1abbbc5ae13c206d5f8ee2e3e78f21fb51c07d27f1a40b2a769703b12f3c13e43eadc5431729d515a91f3a1e7893ee7d8c52c916d03c418c5a888b26277c66e003754227156195d6f341d8d743d07514e5c4e1109c5d08711763ac2246cc1a77a80882b6cc4935c5134d18486f693329362dd1958f2f54ee35663290cccecb38a248ad68fc88f632ad7d9a2c396187a3edc69c97dcd11272236de739cd3577e6bab7255378928e319dd16b6afacb4feaa1489119dd63ba406efa4e102bf4769733aaebaebe1f2be42f0e08aeb5e0e6a4b69800fc3f967de8615dfed3825e8fa9a38bafa3ac43b8c82b1159dce489e0f998c7c4c435e5d1fb1c6aa07de88b1dcc905d2371cc2df01571475f86571fb153357015dc68f0413fb299bf9135be242ac569f3d26f09e931ef6776f7e0f6a1287b4550fb1fb0e25ed32290e704e45d0ec830f858fe6fe90fd5ef3af3d0f3a066e8bd9e3ed6722916053c19c6e632df6254fd9e7c541896bd9469767e9b43f5ff28ec2a07491eb2c99f8345edaa6b79620d8d9b32dfe9c4591e81781141650ea0e0e535481ae59ca7e185d4164f1dd50119c6c9d1c67a847f65159d66aff80ed3a54ba39a6b5a743ffc16d18ddefcd0c7ebb98a428c7d03ea008f2247bb632f9f527b00d5fb9110b5b8b3a2f50e212919e4fb22f92c2687072779a5ceeedde106a0c64730e00a759a3bb3dfb7d94f3d49228b50dcc2f94624cd7cf6841155ed6719a400d0ec3e21ac92eb5862ca14c80a077f23e0a6aaf3c9aa6f38b908cb7a21f907e3ec2ff1d35d4eade683eb79cabef685e41d371b49e485311279f833033ea4bda67114d83f36e5a6bbeedd83c2c25b4a865e51b608048ec16cb390e98a16e7d63ef680fc6085596ae8b1f505a93e59976fcd534682097ec2c64955397c89f60134437b454aa06d2ee83ea70b3a4180882bcb65855dfdd7cef60f26d13d6ce6efea07c1f99f0fcfc4bd97653de3e1a103f84c82dfd74c4cb5c4705050c7afb9b5915a0ce931997dc4b429fc8df1475bde7db8bc60793b2fc46d41b305feb6c881f3ae998e75d1abb7f4452802d392eab1f61d94e14ee46552369e3b4ff16d440860b82df522b461876401bb955f3fb81381817d2042ae1703d525ce4c6961926c446dac67655f256504a4b12a7c683aaa491bf3601de0a02523f38901742c670c5dd7850526cf3cc5113e3b444b6a051e3975633fa28f709333f2e8152266db4cbad51a60e3ceaa87f441bead01039ce46f1c30fa614904de0b1e4181b39628932e2079cf871d9b02bf24f034c089c55f256e92b633a973457990ffcc86094805780970900464497db7241bb0def71b1b22e75e27db6a0ac170f2e023d4b4dd6623fc2b9839e261dadd396bc5b7be2fcb31b6debcf12f717790a012935eac26fc5be7912bcb096a61fa67841a974af74bc03618cc31ba2adec6a860d83e8c2659e18d32548b75e03beccc1b2ac50a128aa33908b53ba75cd5f50d0ca3bdc04ba07a4151ac825c1a42f46a5c8333f363ddef9e4272a5a6f2533e6b4fd5a8599d558d1e8af9ad6abfeba98961f2c43bbf0442e22cac125ce380626f5eda70c52d35e293eaf6e6feecb4188ed52b7214358b672d5763401e7766b65cdd60b8c96f088b58ffa2291a1013ed0d0028273f0594832d14addb0716b0e2405c88fb961e57e4acc72d36f744a5b11f98291231e994f48cbc6c6500a8e3aedab0c104ba684df0a880f19958be09512d60ed407e36a13634a2a2bf5e8d044ed629924b26f6a487d8d48c40f5ce92040f9dd5b5ab238454b60b5f5e8ad5ce9059951bdcb755ec55a1bffce3ab70ad9b72c4b40835a4b2cab4174ab01474efeb74980c65278059ecc381302e9ace5c51509aae669a74a5c8c25d94850b2c2285d976e4c73d136d5239cca95c01ba96cef5061bf9f7db3253e75f4db56a625bbbe63e2bba487f4454c6da4888cb0d17c9464a38ab11ad6474b5563808e22344c0cd2a1d4b3c466c8fc449814cdf13f3839ea18f9d5db6a1168e933637d9c1ce411fd521bb295580f2607481376ea31d85e19d607336d8559e77ab4167d80a4dce89065c26a9bd9af97484c37231d03f4d0d6336088c3db567ad396e81fa2daf027556397ef957cc7467d7ef54512ece219d90baea178521703a233be42a55b4bd822285de85672a89f00080651fb059c16100a5748ee31c315936ee3da55dc89bbd50d385f05268d904464ca5415e66a425632e7fc4d55017243790f1cadb9731e2ba656037786eafd6208e692f48b9d3ef487d428e1998ca86e207b8793232f0b51f58466c322d8fdc30bca11d5c98e55bba4b70b8ea86344b70c3311542707c9a22d2466cfb3fa5967e199385eba374f57d674217c0ee4c79b55158ea6323d3b7d56de4b669ebef2b16e3f884c7087bb96c0f271c80065948b32eaa98902b7ce02c69eae0211269b98a136c2ebe5b9253231f6bf36e68179948814a069ab8f3f14436694d701a5406cf0773702721c9cecb9c859c13ce97dfb51d7d1ab543bb31df747e25f25574f4f3a4bd53898e78b22beea54b844dec50794b41e879f79d01b0704287495fa3bf07c3f7e3375a4c2a95366fd7fd374d73adb724b00860f8802b712797077e87077e7e877de2ffb6f57b2eab9a7985cd1a82b60a549fefd7aea985220f8453b1a838045d8f072499665c73db59330fe69186885b5cf005465b06827409f4e113ca86c8c1067a55510b4bcde32d70f9b1ea4524ca17e83f787fb86677d47011a31296de12144fd74cca5ee7edaaa5095941b4f9ee79abef91df509ab982ce3d9a90939ec75005387358b4c45a607a0da44c6b8125dfe310f1d7d53f9fb856439bffad12a2a56a3a567ceca6ae98e7cb5b8d003ead8523413bb5146638b979752b0d4f8d243d2dca9f3970d3b5efccd9f78322bffbbd06a789fb248f5c03394074368eb8640fa83944ee901ac9a59ecdbd0a950ae3b27d612010ae32f8b6b83883ab003b613b16e042b5d92ba056aa0ed909c5b509b66558dd95bfd5a04d6e28f35fb58d078c948b7ae6d62ffcc51a4abfbfdf73bced874c5cf6d4502126b014f22795af520429f272d41fd251ce6a21625feddf60166f42e36752c819a2e4d417e61bfdbb26fc83a634416bf19fdd8b53bc2818b1573e4c6df0f2566c00dd28011da64b617299c479541b8c6fee532d45fd58f85939d1e0428d45fef93161cefe6360aa0af7b40144b644fc76b5a0090f5ba5eaa9f3cfa85a92cca75f4b3f57461649d8ddf1d4a7a27930da6eaf090cee584e0b71adc700d528622484ff32fb0d6acc94b5305b3ac50b59ca5a402d384405795a0e94971ac696df9e9a2e59e5d7af7b09f84fd693a435210e0f89c92302d93e5f1e7fbbe7213658225dae95299eebc50843246d5d5352eb86dc130d78b330fc2242da0495a3a3c1008a2449f9cf79e3c8f52a36257f6cc8671ed9aa2705a3ff54e3ed40657a459c61922199b90d1a821cf0c7a4c9db8e1752a85c7951a6cb8579a02a79a0ae71514dde764c5ca64fc05eb0551bfbe7bef16e59b1f066f1095c8a88d57901d239ae07d3e815cf4cc6cb9c1cce0f608958662ed71bcfc775cd3216bfb50ac052ce107d05da948df65f57927b45293073d402be14a13723b28c3d3ac70a7bf584f91b9ed4bbbbfb7165c4f36beed825762fe79f560f31f0bad585f3e22de4c2e61dfe7c84bedb873e8024d3415d5636dfc82b82cc810dcf8c3d80d23860b2f12312e62091ea75d657f1ac881ea1bcc20ec8550ea2a24a1ee74629a80e682193ced79036b44dd3b1038af6ec246f2b3292f83c98d9fc4c991c01c8b158f0ced628e0c228caaf6ace2f4bc34785b18abebf02a9256367d2eec7270c98b32b0c0f66b3b856ba382136f1b120351d1bf56373a8d4b8b3643c902f1a2ec042f002cb50b89f672fe2cb147fa0cc700a95cc823caa0cb034ee2b2ac8d0c2c60803c4b426f1f517a3aedd28d7278ecf3f1c880fc90f8ec49437f4cd7de02a5778740191f0e1eec446fecf19c5d2986bfb9b979be906cc517607e741a61cb0141af07c146e9908ab31a5fc198635bdf8ffc6d68f398a13de9b7b159e00d7525e34a31f4f5d3f2e4f025cc9b8e28e545e8f71400d8992448929b50209b45bb26164125597c242d9957748ae0368e3900538409ef38555a2cfa8bb82f51236c33191111bf7ecf3b255b776354cfc908ce5b67ce13b55dc150dbb3dff35c4bd30f4967c7f7fc87b54d04f2f4544f3c8550ddd7683a7691086d9885ba2035fc2268b574efa43056770781ce310100cb4f0200f71f7be0bf4842dbf32583c2062dd142b99954444e4ccc991c88667383c3f6cb26a23981bb77e89cadc00f921dc21692c17a97090146d90886c6adcd64420a72ed7ba1188778642f96808b91817ad58a7d146bdfaac548e6ca09303e343e379c441af08efd1fe6ec0bff7afde43ed296ba52a015be94e3affc8eafbea37aa6f35ce11c9466acf3b3b8b6dd8dbe5de3b17de75bee987c3ac77c0b9bcff996025739be8904958527dc7c14534eeaefd7dbd6a5c684c0013654c6621d3a8aeb2216e2b47b99164e2d1b350061a5055abdfe445bf445bfb8798393823d2f04cb0895eaa82042e0999ef2237144c2dbfa21a790c707c0bd908d049ffef722204236a6f5532959a05003d94d0836189a84585d176939ddf171d93dcf88b9c74f0cae848974dfa3d954e2f2ec80c314d68cae915f052f378d3995940ce10bf1f4a3dc3f2dd9a3f803945e8dc835d67666971d8d6d92145ce0173e15018e976473d5661247c6583f2b69b928980bf585a6af192e74f46e7037e109c758f823bfd2038fad4b362c8b60c433daaf2f2a61acf661ee2f6bedde2a30013a8291b3ea8a14403fe3a6c56ec31432ea47af5ec15f742dc2295494228cbf2ddbb613262fd76e25fd73fb83a13e5badeff96e7b580ae20df5898d033e86ecc378aead6569362fe5a211a25c3f964cec72d491009e1ad29639708f93e3aa30ddd21f8ab3bad1971c0c415e597fbc312264df7df17d0dc37428bb874f12a73c0bcd36cbcbf315a1da0337b4860fbd6f167a14de0d5a712037054befa39a165a75d87d4c59763c109d9e9ef701c475eec5f2543995879c93d005a6e1316c8e4ee4d0205d759df121487c861e98d004295f688100609a9f01e890e5a303008057838a1240094ed68b67346f1e056b9e347b3a89de95069c47527cc9ce897cdda674c51dc53b66b8f20b4120a3588ae2a91e173d0df24ec22f700fd7692d0ede170a3e8dc3aafb4cd505968fa34bd3a53535a6238f5ae0c409b7ee4228875a3d654acaa285956a10eaacd7cd35939581c6908cd8a198efbd9e002f61fcdc184cc7f4d90cf4a2a98d0a80a49e5a0f4663af867d11422ad0148fb1bed3dc7fe779ce0c729a2e12b3e0889cabdad95513fec6b5bbd58b96e09f761981ea3dc1d1f0b8f30e8079d22f3f2598368cc0c036bc4ad7026bf3e451d9eec4b085fff25a2c0941b9f75ba92715c5633cf6237f335b1d5f60a717c49dccc017008fa41f620b96a97cfbb9c7b5b2b7f9b7bd5d4c78ca2a8f25d7666f2413185315c0b8b94508088f3eaa82eea2cf47e09b26b3cc0b6473708103fef247b2997d6d8b4f9fe8c153e4e73720a0639c50a24be8dec5ba0cd25894bcbb6955daacc350895e9f9a83d0f6d0147598194fb934127debf5bce6c5e32b549aa367614896db2388a5d3d083bb7e4d9bbc0a7ed71c60c711774c539db6d0740e564ee183bb491e106188dbd09a92568570e8190db04a282ea8527fb6228d1045b6d01896fd32523a920511f30dafb4001ac5bbf29e38f05db25f146e3d8670cb5a5ed394eed8a3efb193cb08e3cb5775ece29de4537b8e58849f4340656cb7c82a99b43a24b65bc5e11e356aefb94630fc4201a9b68d628a0306b1e89a79f888c56bb2139ae84aaa5475da35c7b6081da86738a139837b2c597ff81e5b6f72d112d1450109ac3043983b56c456864a3a5750316205915d02daf7b84632278a6157632ffd515a89b465b515d27df5503ddd865a34ef562500ecf769945b421a370e2b4dbd8cb1a9a3e935b48191613bb15c1bbc773afd887dc81da827ada3ac6450765530420ee20ad8b4a977d0382da8217a3bec714a948fab92e310bdaee644faec294c6832bf064d976fafe08f4ceded23dd742f0e14ba8b5da4f2d0925032294ab058c0dde8129377667a3563ac84a392ca1510b1d463ce7a8f518238504234ace82b9518b0a468aae323e4a571201d102a334ba9de88bb8a5fa17a2a5a21ae27cb3319872da56376501661eeb5856d645b95c90c7480fc37e5d4cdf3cafab01b6fd08d6f6b6ac45d158883e6e38c7b4c1a272dcf4d649943440e830d9a4e62dcf9d87ca0de0396cc61c1dd3fceef012ec70b9e44be4ce740b30106bc0c606784acf6c833d313fc29ba6114105e5912aebd85bb9b241604fd1ba3d528de1e721af2931fe2ba1453677b71d8e485217dc0e11fccf56ca6770a2fe618b36625a68f91acce32300caeae87c7a45db5e2a96b51c62adfa02c41ba7cae180075b8e56fac3501c2b589c5a834b32da15564ab9ac0aeab18a61c0a731883041a29a2208d3050ca8f1350ca25a69ba100175d80f82477b14753dc7580d036d5c32391d3403b5fd39aaf1c14bd97fdcc883e2e36411e9683f9984f446e8b39f79341a8fd81c63a958db96b6211aba6c12bbf3a061fdb67a674af025fb51f313f9a89cd05d9da2e752ea50751c8b993f69679a0dbb4a58f47821751c4b4852bfce3a316d6d218a0b02d8094f7119b2f871b748e1c48e527f880660479a7e4704e611796a8978133d13f054ad221e051aa37a9eacd126dc32cf2d0d09437eed3a4a4e6c4b7deda960000bd272ad6c9a41aec5ec1a4d08180f4d1b1cf9292c1c1024140dd0b2d14368978a6d713239b6edd6c345042bf6123575995f5212cb2618920c78b1c6b5030974acb8a2ab856c8d46b541cbce46c3be5c5c2de00a8e771dd76d5d4e0dd265633a29dc2194d3a713e2dc335826f5035697a9904e52f665163624e8a7b133fb6e1a5b9b9b35b0e53dfb81b0e7f83b709a5cf7cbc128c36b6bd4a31dcd86671a2fc6885ef099ae8df4e80ca3bbd252b9d08818a9596ca2237a0c7d9094384af7dc7e6059b33c325901703581d55714d4c1db41eff91ad4579956dc653ee5552d9ff7438fb851e605447d4a5d88b94f446cf54626747d4b43125c18961fcf23547524245fcadd52b94ea91b48225758215eb6cd99cbf1bf33236208adcbb62d06d28964753abb62a12888082fc1718d8be7f3335845192f6b32f548a4a9bede37450a3de521d7b8194feee9f6b88e8fe262e93f40b93bff2d10a6eae5f6fa02dc90c7f8e35de2c2c6f0b99114b62a6d66ee82c320af77b0c6035dea9a6614f668655df1ac340d29fdfa26e9c0b5164d5e6098d3b04241195cf0cd9c117df04160cc768e0bf398f6a234469b4190446d1e0da384f6ccd686976c6e6c3f45875315666b1f090354aaedc917d33d004795aaa087538ae8b2a1f353f2a9b402ce7bdfbdf33b8bbb22f4ab0751db41e4bbc3826c218d9c2185eb0c3520479a8d86d13b00c57799e9b586d5589a820daf3bf2c79f118ad4e754bf610dd046334d22102e85325c061033e7f092b8befad31909fddfada014e562707e26f7407c03d88fab0b54435cd747bc2daffda9fe98239fda054e397db73cc837859c7cdf70f38e70e4a7285d0f14b1c6ec26f955962ce9a4a63fc3dd325089a211fc39cca3b4494f9e6a59b2040b3f30d6d073f2ba8b2bcf432ca8f68291cbdc03052436903f951e63663f9c8af86fc032bc42ba66d20bc7281779ea60e83183d19a0b9c2e99720bc7e8970167e08ad770a45bf5f85707a0e60b7f6286b86a0bf627765f14780d12fbfa20876f512e43e31972712fd45df80f22391d20fb084c06f731daa16a76f94e0f9968f731b45ecead64c399ca26e02dd058910f4f0796118ebb00e0fef85e2739e26f8da160a4f827f12405f2a7b55a3b14edd90797efc492ab87926ae24feed1d9e862fb61e1f6d4c9fe19a1e17e9ee362e2c601b9dec5d1a36dc4d947329082c07849170db44641a82d1610d9dbf905795ee00705c3a8f4d6abddbb8b66fe22dcaa1eaeec27791ebce1dd58eb2828631bd5d47e6d6f9c92631dab22a4bbf31265dda8b08e419ed7210fb57642e80f60382768622d8ee531f3b123f88cd89166c45eef0a84a51f2302db22cb238df431384ac30a34cd9cdd918d4ad08cedf638f066c373d618d548662a2bfe6610f765c842373753d2980f47bd46723c2faf0ee5e144fb6125379cbbc90b144867c8d0ed806ac8dd9fd5abe22dd1f1ca61e4e89763a428d096b67a2388bb6f6f40fe7a0697ca3763774f3e8dc626d67cb6d925f4039bdd737fa325d65e7f4e68c45496c4f439ecc9ac98efc91af04e88680bed0fb246f5a6cba163dbf614f49fd344f1f8e818ed253b5d5311c52a879043552b47f8d4f282988e91280eb829ddf185f97947136189075c1a6a892b9ac0953f862dd221eb0a409172df5e20891fc93953c19c4ddfe5956f84eb01b20bdc23706b752d0f731764510a7ba2c98cc0a9e90dd0137225afb3dae3b44fa19d2fd20428861c63f079710ff4d0733e37f959137d724756e405695a7cc10a4968b9831918dd94040a904f1538102c077b91918dc68a5175644cfb21ac74666cf0e6051ad7121d7135745d3e8de26e4eeda9599a0a8be5b27a6cc13f3cfee8ede5950444a0ed57c5f99ac01e8843d52f354aab41df6e66a32f895536779318d93cfcc1e649741acf1a865434dd2f0be945fc8a525aa71f5058267fa186f4c2131b4c19fcc6af13ed0c9f06aa06d1ad2e155ccfc81514436dc298017b35d5667b0f1d425d9af9d254e745af363c40a6e0c9b4c12cccf8a60b98c4831e27e61bb2388265113ce86b8fc17a75a6d22a54763f090022081824e98d3b9d81979e78a12ab9ce3ab79c2caac02e6ac2b0aa4d55311bdaeb313d4b266a9e0a487082afa2108e2ce9b88d285c9aa90505b5afcd7e296c5e2c014b9f59d93e1304f1279de0f7869aa98d363370cd083cd0184e679354439f7112f8f3e032203104941f12cc1d7b1817bc558c0ce571e6067ef570e176221631ab46040e44237555eb891e01c966e2516726a753a3932262c21bdd3223c3296c892d31078fa5f644b214f33fdd446666f58c7f7e5887d65d3846e600ea8e714f995d7a83bca04c6fe7d74617db8d42da4a29c190af2f89849d3fcc12bb70e083ec893abde1624d96bbf0b3a50df88ac4f8227cdfb9c378cc99b8bc2e5cac595997715cfca6a47f3aa895e13ca7dad6a27c9f09d5e930ee83316911a2efdbaf26c801b8266d83cfa4aeca82e44880258725ffa965a9f227a232ecbe0e045c4af991076ff17982bfe9ca40df34b0fced0455223ddad9ca51a587e38655bf29ea7ae61bc76561217fe88dc33f9b0c89506f96f0de2ec920d396d93994feceda4128da3387b7bf336e083fd1b28377033978c5d072350623ae3cef7ec70858a85827f81ba9e186619cc7d3d95987996f0d56f9a814285398192b06dbdfd3469b1bb5e82e2bbd7d1533562e397f049f7ad205a03b79028d069d471dd8c7dc8b7a2f30396ce9dedb8e824be8927af996bc000c7f41af491543a7b81cf3babf28d19a16bc5aa0119f7d1d3da91249ba78aad42ab91a0302f9364fb64e8388d9e6ffce427fd824dac1767e45166bf2b7c67fc2027cf82648f4a3beae758477d51608a39c3396d1ea067c617e530ccd78c78f43eb3dfa85292f558b644ea5b70d5c5c797f16e41b4abe7b75e5d965d484224f3004f33a3d5ff42a53ef8b983e84a016708260120d9bafc72950cead90c0cb477ef66fc35bb102a6d259807c0b7664f1292458aa212f6b5b30cf7f0097c35c917e31887b5b145b99988b4890232a88c660dd6d797532db9d6680f70f51894bdb6eebe7917b1c2c2320e203ed01fe494ac149bd84d76f2534ab19eba36fa8a21a4c055e2b8828a8e0eb72603f1408d8224c34af82cc9a8c8859c1397a8ad8afe9d817509e7c00fce80cc2b8bae85aa883931b49071389debd773454531c31ebe538a10ec7804cfc4fae4d3bfd8ec7893bd23e736692aaa5d11d31de8e5612612a5402ff5a856317ceee0ae2abfb0adf3c807f11104b876febd4de1f610972f75d03dfdf4c9aa7c2d5855ebd1a9c831ae6b646099bcaff8a8be8ce96d6e37a67e79179c4dab78f1e48b52428ad4e0491b34e875174aa04e683927d9d0be4dc7ed78c4242acae6fa31d46ed1d686f2ce2c53badf3f7419f2464c20502a0718279b8ea22c5ca2a1bd9b6d6f6fbb5b9c78b1ef37e147ca24c5da3020b09d96fe08c256e920c90c6df31a8093ae1f060e536e89c6660d4eab4208dbb0d8b1af9d8a9c110d3c2469915ce3800dd8a1b3385a42d853639a1b2132e5812f287cc1d58a30a4a301bd25c1a2aea82d2a7da8ade12156dc83e2e058ebc6b29cef951736bf07b495014c95a19a614442e54d262772a6081824a939c04b01eb4cb9562d90969df547d332eb62fdf4436e68bf71f6c2ed11a9dc8b1dc38df90b11f19ea205a527e78690cff732a361f5cb4425f56bbfd4de7be79eaa8498805737e8bae3c10d81cff19d6045e937324280473101c8881a54d93018454063a5cd01bb51142213e9b12a8c444465d4e6935c6041d29c980a7fea6a139a27a48f7bc9499991225c5b9d15ef492cccc7ac9006fa66d17190644a0f57d015c66f53fb9a9683dedcd6c16bcfb39f17a00f176379c5e99c11dc0b96710fc9427cae249122b278ce3861df40cedc0aa65b675b206b7dd794303b234dd70e26e8688092df9bacc996142988049686903a7e1d044d0908189cf495aa517b7a6054348ee7d99689f7e27e0a17e35272a05abfd8a4f538a737f6caa86564afd0a99049a3051a6fefd421d8c9bf7fa007f9a416a8aa9c4a13203bf60a603f07c3f077fe163363afb1f77c09c1327a0b1cf7f68a7e95c9c4b525ef5865028d4f38855da5625248908bf3f694b48a120436c8340b534615df21a844b433b48c9ac5644bcd1cf11251edaeca4357c5f272c554cc1972d518befbf38296b5feb50fa4ae1e96b183d3fe607853536fac47de3ad2f3c8a57b8bb474596cd82cf400f8f9fe1ec09b12a77924026f604c9eb8969bc66293bbf6288e1286f9a356b2b4651a11da00fc20750bcf1e1fa7028af8a6b59890e047bddb1550eaf467dcfb23515de47c01bc2f3e3fa00fc3b9b2001ea76d0538a42640e738e40a595620511ee47a316b6a3ccb0d4057f5660151603345515a779749d1531284553735fcd49bda57d97ec8f5b8a65165c3eb5399520c83eb93df9c177b92191f68b2bddae390ce9961a29aa8cd508a7f5d79fda5580fff6014a8af17b552cbeb744e6e538f9b72ad0196d33c3500a12a3a67dd7a72a8641f6528aa8e15ff8c807a28ac0a406bb24864aacc4837af68b67e2129910fd621a81bf728cd1c12804d57e67d29e67f2dcebe50765e0b6e5367a225fa664fc8533311f51945f8aeea77e26b8aef1d4d04928fbfa7e5383c03913b2cb830009acfd218d88d1f371ef9ce45836c5da8a89d14d80e708b08e9ade18662393fa131739a418cb13f6cdc9241e1d0ba2361289ebc852cd45ae467b3fe521047b7f2ed6d623e93e8697d27cc1257f784758f53ea54da9b4a93500bda743da8b47bcb88961994ec03bb73e8a988e0862fbc58285fe3da67a2c62004020e8ff474d0a4b2ca35efb2447a18888940307dc4b689b6b16e9c197252087d8e057a6e9608d159f15d9acd68b0955023d1a0c171a15a75fb215ad6fb540f88ca86982e6dc4fe112de7a52b53faad8032599720cfc35598ec7803d2a39be23ba25f65f240b7ecf22292361c85d486567d8faf98f63bf4f3e9eda1505a0f704b09488804c0d4451ad4ab4ab35b1ad29e143f204bc0f906bb04042d98de9294dc7cebe47d451b84a7b7cf8d4f4edbfd35b3a12ad38d9f09fb2b1ff59b80f7bfd04cd73bb71b5d00c8d91d13fb45c6313615dd6808fb932b581895c4a05266f71b6dd8b9cb689edf2f7e088dd3c724b3188ef8dc5ff48ef60dc5b8e350d84c0058179564aa4331e97ebef9892a90288826c8fdcb00346574a9402bc42290e83db3e80103e08604668b86d1971120489b3cbe23245000ad696e018f56151a4ab2549448ead1041ec074005569228372aa157568568a0b80bb0eb81a8100c99ac1303810294afd80ac1c5064ad8a71b8a8e35be1160478ef4418741d69e13be1f089e80f4cd6f97ba95403119d751d019812a1ae84cca8085aeb26f0e9697a1008414bc79a261ca98fc01e735826421fe74af6c1e8a3397eeed3c1d7b6b0461e5a3359c748b03921ae82a4431ca2ee4b0f8472e6f989a5f7a225c44d47bdc5d5f93c202d921f7980338fc86f738239a27083120c2e88e9ad20ac037f36ea16cdd59af9198d76f66eaeb293332deffedd5b8dcaa1bc09bfe4e7d263a0d8e2d06c44b42f7e976c5dbb09fd949943035011320fa711df2b39a4e76116aad75790572ffd47b48f4c6e893054b048f0085dc3f24e9036b8259f91bf0dbcb9161aaee6a7dac82c0bd216c586a1230484237bb183d2fa2be835f0ae665b2ab6487dfe60391a4e2320a93695ef675c81dbc5f31ac5dc0436350defdd9a6248491f56c64633f108c441e7bae7162358054a29f53c8d3aad0c2dcee2f6a809d1df7a9d241b352726eb891c46758cf5fb7e6764ab2e2ced277818a3e1ebeaabbb01e2498e846c2c9a25aba71d680d3d4acc7efe2455efdd5d4ae9c30aa01b1e9101ef4f3bd166a3570868c16896081327146dd78c063acb96035901835cfb36e01bb32b3eb384755b988d7946c8fb28fe79689741f824e15520a0ef060edd3de8664906109acd1899304764a09b5e6d13b05d9e99766bc560f71e426ebb5b33db253a6156b6f15666515243c629f4455f74980b195e4da3c11d185379fc1f64d2464eb4a625d5c74059a7c753455be2f68e2218933d71a0c303002a36ba5c294c50a97659c1f2651b2ca51fe0a4fb9ee925110615bf935082d2d04d14d7efb8a8d23ed04ffd4a032e0ea41cf134a5a9d7cf3dbe839f589aeaebb0b40ecd56da8a5e3407f5b0f3367c360f0d65512b94461ee4828e785289fb1dc6102ba0535517cae97441ca64684a1e484e0da27fd175ac4f8f68cf4d15c648a2ed2a7aec0073ed985ab287bcf010d2f5949db18a90566f0ead74325e202a87c17e418c4ccbdb08b5df8edf011c0498ca00c6391ce870a89c7841bc2c3fbb1b57471e127819b306a3993e31b49513170f1f1d1fe2814fcd478c0e44646939b24194e95289fb96d268a3dcd9dc83ccf242bd338a40093d1f1fdb9cd82602e3a2d5fb3c8a1befab9faa91bc1f37ad713efe983414acfa37c8c79023deb01396005ab52e64790a8eb2f12ac1aa7d39cc613e688ba60406605baf5511e530a4a7a60d370f83bfea4b8a3f1cf495278d9a77aac7e44e073f5ae80f2c67b8658fa59285f41c91cc98af6495924387ad1fe959023f666dc3cf0960303d27673ee3c9aa1b1bba7c2000a333f8f33286313d9574b9cbec767eb0f6d11d0702c347ebb6faf6feee06db67b12ed0721b0431afa44d5e77f3420cac40e73a10cc2757613bf0951d7abd6cf4be315492c65e8d303fe4786532a8990fd72281d197138e59eda4a3f3d4445823e0e91f1af81d08a99bc502f217659d916b40051216f9540a3d7e5215bc2250e37776be06c894d59e1e6a025c3de0b7558593cd7cad003c9dc0f6e3016dcf495c921210cb158d52588888b99204ce0307f71f68db78a60c2a1fa7072d8b868e1226f86d1dfc8625959e989a10b19442d74bf63ca740d07a6437b6f59276dd75d20ca9ef41505452fee7090144bd7dda99ec59446a8ae85fb75e7ab4eccb36332f93377ad2ed03818bbab0b22a5dcfe24aa38b53e24677a27dd00d1835eb6c69a42a9aede349990a4a1fbd13a687b4360375a90610fa9830f788d76d89ee0a98c2b84632d92390b89e0476386131934966291d46f3965de57bd1300e9d0df5034437af462d7a506f8f98cfb5ce32de2cf4b39ede5d5eac395a02b5122040b50d9e6f7a539c8020e2a2887e39bdcd5cb25fa860e170a638816b99d3f622a03637cad35c90d33fd05ee6bfaf6952cff0e28d3d5f2770214a4a5ffb9ca5b62feb3181f1c13f15a46cd7a6a5d7624ef47e0ec39fea64426634b60868460b077662fd9ab39e6d20f02b089b56b18eaca086f42afb142699ddfe5a4ba91fcb47f406b10b3daf05dcec6d501be1cb7a9e7e8877a3c19977ab7111d362680321ad87ba8f198e8de37e3d8b7d7fceda43c6da8cd6bb1b036be4aa729a248e626a90e6fb18bb3e3e72cf2e0c1d106b37d2e712c9623e7a3d03167eb19041bfa2b460b0235378dc58651641fb8779460dc3119f270d88771c5a63dbc36e6c778afba67029ff9d5de6f3c69dc08edfe409a93afb03268209a4129c90a8f8332141a7888ca95ce6fe0249dc1fd4b984914df68020c7430da52080723a4d11e733fa576c73417c4bb90143f4255a6fb1f8814b8f5390a9d9a5cdc1797d4af79bc30d6440ca58b33e727866c6b4be561a361b888d4f844edcf9432861cb98242ac7301941c491fb5494326aa395c64d822a317f8481ffa8f5df41c8817d64ae92bd176f22c6ae986b7a153270f62fd7ee2c0b00281e913eddca47d7608cdb76ef133a4c2c3c2b54b9e9d51d2228bdf84922eea07f51589a2aba116f69cc50e699c8ceda118757f3e67ef7c3fca2651e12ec32a16edbe160e5ddb67870bd2075634fba2d2012beb357882bbd38e08396905131b7160fdffb86beda39322b2139f9602147cc665c01c3cf57c982ce88317459606fb723463305538df827db48308c208053535d7682560a239ca198b3a792ea8a6a2c36a40fc4fb276bd8caae2a63fab88f25a2fe0de96c227b449f4ee323df702bcf4a952dd7c0c7f739a269983a04e6becde41c18bd28dae27ef8b76be8ae7d1b72d1f9c808ce89f27bbcec68c3cb56774dd834c3262ac968fefde6d0a1b1818f95ee581af8fa7cacb0dda3cc2ccd56ac041221acecb7f60b0192920568eb81c674d85592a09ab977370cae4ec4c09ac3fcebb7af4856535dcc07f86c06786014d30441251cc3d61835f2a960d2626f87567458720f87bbc861794b751243943885c76c874da90ee76b1373740c4eb9b319516b178b75b32589dfeef97ad9bd23f58c633b1c89ecf8165ce253d5b421b27ab9ca37a30f9b046506ebac01a4fe8166606047a937ea2891db3da27229ae1a689b2c2feff420990d05eb152a4b29030f28f580764bb2cb99d6bad32988c8ab7ed79ea048403a9afe0c414e28738cd1a2893be878a0ac82dc51052e9b7c605abf10cb2b80c3ba70339b22023d438d50be06a0693dd9bcc4686b00d41142c9266a37262a5a68f010a336b32ed884c4dec71e0bbacae3737ab365624b5e1025af7deb22a61f93ddb949e9d76ce8911c8e202d3e4a9c8a193dbe1aa16068329bbfd4d572f467836298a19548a110cfcf60d2f4c59e987e7c0c61b9af44da499237500cfd5dac73a017cbfd4a1b1a172b9eadf5ad8f3b625df68401fd301265621bc3295fe83e9e43de351a52dd8def6cbda7787774fd95ee436442d896ae4d9ee25fd8c814a06137f287460ff8bdcf246820ddde0aa741ad122970ef9623893634e27f5e43a7fa888a7d5302b2742f44c5fc72ef9ba1fe18d02af752c0de48afcc00f53000d0d042e3620514bb937988f28fb497348c3dc2fdda41e1d37ae7a7c1b6bd839bd0cce76f62bf45668af74766708c69b251ea191dc38b612995b5f055ec57d3c308418e5f854d7c40cea5f56747a945e87be3a9df757f76a2cabb44d2d232ed914ead7856dac7b07b473e6f4d79ded597336946632b4493a6d8d727ce956b953b13279b483440538b7e4e3e6998e07304fcfb92bbe717fd01446092341456d52ffa92d8dc85649e5beb5c98ad06614fb5871b7e5b745d89c8196a01f56660947458d14b5673e05b80a2df3161055cb7ce076018d84d4b05829679849f12495f279282914e0883b12841b5d425ae474808f6824d3f61e41890f0612dfe04ed26159382b52bfc50879d9e93ac0f93ca4476b971094e5b2565a67a4e68b86dc614b14584bdc9c280fd2a82bb5376f1759d8ab99d4ea1626178c0ea6d11f3c5e518903d46cb1ed00c775afb4a6e19841a39d3d33c26d26d06c658f33fa91d83599b26b67b02e0c79a7d8f88316df24baff79e547a35b7b6bcf0b6799b8da97d125326f6438fb65be8bd4edd640df3bdb32369bf6e5ece77a772a4e80e3005d8015ee0eb2ec22cba0490a2e1b19a696c00388efc9e3d99d367acd16fc31180478137f43a9e7c7abcbfe291c42524aa3c1487a6832ea84aa9b161b16369fbf52219e4111d584a58ec70d3f1f030ee395eea8f68a07c39c004eeb3acc42f0b24ea85fd99a116b364c10ae4af18992b50128985edb62dbc1058738f43a5e79916fc7b10d1edf49093cc890c7a286ed0d0a0a93b0efc6d4ec81d2013788a2e4cad69849cb7dbdbfd48cc5b1d2e7c2f79f16cf7320af99128179b78acfb3c3e6c1e396992a13a4ca653c5c548986ac6a95e52ea7eed18037c9c9f27eef52bd379e9552979442a4072dbcc31b2fb22a97c049c66721fe4c6ac108dbac9d907d47ff4b6df615bbbe2cb8d42303eb090ec9a143d325977ac387a127874b543ec2d11207b283fb67990a6939175457c56226b15a2e0635a116247393f80579e58ff89e9891849b50add939951813c0950fe4a12c9af872d92d14840d3a564e4db374a7a9d8d59f0c08301395e0df233dd4122e548320e07c1ad332e948ecebb6d6b50a523bf1358242a0fc00af32df2306f34613bf564a1086cdc09da2c6177b633bf201f225cff6742a9cf1af91d72454f655e5cebec8f3f72373923638fc9510c652352e0ef730ea86c5af63bc241fdbc08af021388fc080004ea8cb1ac2d97d9431f6b2610a0339c21e7cdcfe39785e85779d41b7334a6487a21afc7f7b9269b6652b237056192b06ebb82c3a3ca1bfaf784f0a483873c98e597464cf4402c5d5b5ad692a7c65953ebacd00e5e472135c5477c831b43a6234958eff77d44d6bc3aba2d8bad5308f825b40d7c2ed6e332ff4059f0bd353c1075307516706b40c8915d2d544a26bb9d94f0c28126ec9d52f7ccf95fcc8b46e54f42a6ef2149faf2082aa237c5bb41a1d5c61cc24cbf753fc00f441472d34f1b7cb461e4029c75f5089a6a5577ddafe539f03a7adeb8556e74cfb694b18f9ca9f99c9220f8109894b1545b81cbf2956fa6c8778c24e08260aef84c0ad6e0bae6b548eff55eff08f26cac8dd7fee0ebe645c4f36c4cac691bd5f18f0b3d751965632726bb49b8da4b2152f8b451f312e0d9535c342748043efb68b375a70c913fb03e7757539aeb01b033a240b509570fadc15b38630712cfb5535a8afdbae07b934f7a75a2772d5a34f981889919a2d82b68ca68ab38cacda291a924053c376f32a6e5f62c1c29ddcde0b1083a4cec6098ba1799681f0b0465e2c5e31792798cfeb840509ee329e363e7db2bed77737724ae98628a29e278b9635f41e0f32e440b1ef9d8cea93e37ba87941aa93238bd7e7abb3467d4db61c804ed05ea3a0a0adff6a126f0297a61ef765fc6ad5cb9b41d838ee81c330b407328dc05f401744de948dcde0b47295681aa3cac5ed6b0ecd5df77ce015d33a734629c0091636af64b7e0f4333e7df2a7470fc242e2137c13443cd61573c797a31a16771c34cbf9b40ad0cf7923bc0b00317c2099e15132120feb221b01618f8a3c9da7b9f6c2793b1ca2df1f397e2ea38b4382b527acf25b3df1d2471ee6b26802f1929cc3b4b5f8510e0cf4f272c2f592c77a492783c9be48c7fb917d9a01a1a47ff23aeed273c6e1849cee4d427290b73c3683d2261d8c765ec9861949658d77d9a8f09bc64e6d9a17b48e217ce2f1535b0c6616b8723111710bbf21d4814f4cf936947a8ef0ec58b7aa7e03e5418b48031114ac8474cd780629c0bfee1e0934148b2cef6f66cd33cf967b389245c44f42912d0b1043a77e0b97cf4be5c5e10d618eea02d1870b1a961852d4c8775e80f8b0d00f10d6895b574fcd97b1f5114d80451f9092dbb61a56585de5105008c038a0baf7f31e828946fad00a0fa6f046fc4e40aa20e594ad77995f643b66152dfb6e284d9e7023352a7e24260b34a5017003ce0ee6196ffbf47d5c7225bc7232bffc21e1475faa2f0809d669d653912f4b55a9a41d002b84ad676bae44ce7ad0dfbd51986f133484f6b164346a34e44be562d821c6691bd2a0dec2c7a434c3fa93979c6589f19475b04a62ecd12c57005c791f4c8baaa0a441b6f96eb66f7bf847542e676c25a5bb0defd0cb936b8cd88c57bcc9d47f1c167865595931bc781b8d7f8b92829abea2f65b340ae6d10385aac55d940e484d5490ec42b4b5aafb3e78ec1ef024ba0046812073f98b934c601a1df30b8f403c145cb11784318af7045a1242e3d1308f0af4788df03138c8079cfb4e2756fade2a4c176f9d83950230829b2e4577a7aa008eade00f942e772eff7f6aeadba4bccdae9a8ec59ac10776650b88a7e29e31d9f28d4f25eae50937931bdf22b1638a7495fd92252cb3557d23aeee96469f25976ae9498b174bf532a9da0c8362dc094967e222ebd5f7a95e26a34bd1f1582962c989e6a149e2af85d68fd323db0da45e7e2456770fbb455df9775e79214569f14e56bf5791cccc56155372cec96276a9880fdf745a997a47dc362afb9ef7ea232175e0163ed5cedcb63ee2ac13e434e7c79b5e741d9c45cd2e4007543d9aa47905486731ed35e004f0374d2843543960a7136ad6ae54858fb6f3f6ced317dfe5b6aa230862650aeed14d1536eb3219542ea788dbc7dd93613b3d1c1c6d2712505462f47ada1189f5081680ab1943001a72f430b6ed8a63e582bb7c390bd3de3ddd4013d958a20c162821843bc065f182e87ea09a2d70cfc41e1e629d8802a717775980591862628cf8eb6020eca3f03feaa8062cae4a188d0e3a4c0707a6a335347b9091b26be08bd409ce472be796be12df37f7f17167958143e387ee5aa5f592de6f2249c0215fa0f5387ae021f4f0a0d71c23a50031b314b8d308fe14413854146f0690e60c1f72564e5388470589378b79e70cd1da0d9aef4a495340296ee77db2e8b9cee8107fc7130292605c7d2a41cf2d2cfe76b9e842784b699ff3c394896784d8e878e8775bf7fc95142e2176f233da01b2894941818ba3037dea6f48df58637e6dc59a329116b8a4dbafb10d7793889f5f3f06b819c596e719288bc24bbf514d2e745d9284fe91adcb5eec92c5ec134cba4b123eaba5238300f141fea173d5f694c580c76d7cb33b2c8743da7dd76c02afaafbded8833304e623d48e6ec61e1ac5e991a25fc11987db54eab18f240fd3adfd31cad90c145e97ec06e8f6f65ca34d65ef549d9949b3bf2a608b842f5fdd96b77ffb8c9201f7e7bf8dd5a93b6f73312b9c225a547fb4e7cd94c69f36978e54b36a8f6823e60b2eeac897c5578ec4110749f7f6b5cd5fd650b3862a6de3a53ed7cc91e06885bb5cee71076b340421631ed8635a59d00568b3917bb3ead3ef730841de2df2149cd603ec2eef0cbcc230f19c01073a0303572c8a28c66b1ec0562013d9cfe5990f034b2c8d8b1c6b3be2e3c4d2226d0bb75fca397fe03ba7298f08936e901f5f6a1d2c48fd04261f8deb8a69d7ba9beee2625890bd7d77e88b79b1978ac1e5d8808e4d792ccb657671f21326887122f171fdf513919b18c44e5f3737f12bbe749b7ed39f975409e29b73a29291e795a68ef32983c5a6d719e8b37da94ef5ef056918ae00f237f55e7eefff95f0c63ee8c5333c2ff917eee9fd35e3321c3302cc308c5bfd7ffd1f51ebb1ff34a96d87e9ee2ee7a06f2403975c3809573d64f69a79c7396e17737fedf1dcfce5a50e429d6a962908aa21694bbb40844d5af555694d1025398168a9d182396e8a97f9025c48fe1eaad64295c2b1106f7333e4db1bd5a962fa436621e60545b11f4e631313628cfc83ed7b3a9f9b57a571a056910c4845ba1c053e370db614ecd21c35de0cddb5fa8949bf6f982d9d82f432afd6e8951b11fdae6f646693ec2da23ecc70c41238bcd40a0b868b1078487a67306d9397213214a1ad11a7feb1b496c14fb8e346b89e8275d07da0382995ea24d083e4a7b8072614f2e577afbad1bd6364d73e53386795cdbb68c636d9c64890fc5341572420322ba16140761d208bf60d32ad62a34f158c501d0230e2dad681e2515694cae96b006beb06060fae759ca1944a41143d953b71381c8c02f08356b469158f6ef3b1916daf6cb869e72c93bfbf39efc7074ac1c2e75d1edb7f14ad3bdb63f23db217001f7dd09bead9f13e4f5f79f37aee7c3e1ac07b7e0a822fd8f0e8375cd670c073a4fcd69558645ed5762cd1b231361050be82b5c88558613f9712cfd240587b0b2ff9e425f6ca028e2223e437ae2e3230f31b099905cd97b72e51b72a1d302f787ce585ef7e508e4f0549a477c903d8d03ef5cec6887866c5dae7bd13225bf6ca4d75f5416c948b7ef6a5ecb9baa674f5ce6943b87ee45005c0d1158e747a5b2dfdf542bcde580f862ffa182f380f2a5c64461e928ea9ce08d6e56e16ca56fcbdb35fd180f7985fece4526656477855825e3f894999b927514c9b19db19d3976f43029667e13b899cf0b55680fe090d5aaf3f6d170605b20502b9e9288ade22afa2f681fe10719c5a8a90a8e0942dfb7a3eb7a9e67153cafabcab2e7f9de77d3ad685aaaf072390809b939438843cb9dd3c42fb880838baab19b25beb9b1558b857e5ee6ef6f9d59d76c670973d63d3f011387a436e2afee24a39fddce92f6f8e96cd68a5fce190d2c5c7cc9200cbc537529fe1a7232e20f0f843d70cd4d5325e09b14fc4a3e07b372f5e9e2f14792f4c4d89ba89afade372b9ae3b31d5b79ad5697e4a3cfd7880c803d481fee2a43cf0145cf7346da969374e8c4fd3edc7da1b3a430e52bed898d0ef26e8f0a731a907aba6ca5744b790adfbf39210d7f8acf24b3ba2feea05bf9a7c18dca11477c23eb992f4d086f9a4547df60d139f67ee349e1092ab4c723b494dab78ddab7f7d2b7cbd2b758d2b743325939379013277bf8db66c78ea56e9fe366d6b7164eaa0ef52639a7254ebb9225b0e4e384608a59f5a8af2dae14eae518e8838cbb840cadbf3da50d85f7167849454853616d20d5384c953da8c80cbf896d2511225b41b8c42c763cd7351928ccd8df5b3f4c4f726ee8e8f273271f137a2c4021bca6e0daa2ba852b58858c61580ce923eb0a49ce6079264fa8c2a91be7ba14d6139a8608b545e8cceda654af88d7e16b5f48434eb2e03e3b3f87df9d5fb7a83df7f066087e80158edb2066a726c55988a4df3f35e7dd813d2a11daf3b185a50df531a3be203ad89b9ff4c3b50b319c24ad1a518d94281acc6e67521c2c9a199436ef03e99c826d41d761abf98de41318cff85aa8ee9ec66e6119fc74342496343025194c774ec1552066df824c23715bf1fcc3fad00a5b0197e4cad4f7efd1e98d1d9fbede1a75b6bc0b87741811e6598450402c092df11ca833e089cedcc96fd17ffd3574764b9be2fb5dc67766d9c7b626cd22009169d4df63cd06b47a4735c3fc8f7f55f336a6c77fff8f7baeaafff81ffff5dffe9ffff67f55f374fccf2acdcb7ffddfffed5ffffa5fa5b11ddefffcd77fb873361ff37ffcd7ff27d88f7728fff35fd31fcbf0bfd1bb6cebe6f8cf7f6110f487ed5bfe9fff3ab7e1bf17e991fe67ba2c439ba7473b4fe0bf6bcf55f55f79936e7b79fc9fe751fd4feabfb2742f09ecff28205a72ebbfd0c7308c94ee0c6bfc933d71e7dfe1f2fff762257770cdbfccfa4ff1fe4bf3c48b72e75708ee2ffe8ba3c21fa8fff1f00acf32f2eec2acfdfbab5afd81cdc8304cb81da876ff44f907071cc3305af4a7e4fec995b34fd950ea695fff1486618e7f8c047f2289abc7f58362046ef2bf384ed57fa0fde709515b7c5bb35d31397fa0f917db191efb4b01e1150c4c4aee0481188641fe9c64f73fbe31f00f0e981244fcfef878e81f53caff5a23300ccb4e993cfcf96b070cc3f87dce300ac99492c8924833c4a1c33022f2a7ca187faa7c6c99f83ea45233c40ec344ff5e58fcf9c3a80ca0b2253e67918b310c5bfc3361a63f91b56ec56303c873c588c030123bff597efff88a8691248dc94211fb331277ceffe65318456152fa8c43786018b6fdf38fc9fee426a21b84784eec95a30ec348ce5f7bd87fbaea0493500f815c56873fbea4fa33c2fd734e2a40e03974356812aa23c3b0e3f28febf13f33a4a0dc08fac7ea3f43cb7c3cd27eff74152f50ac70fe039a36a609e6fffa9c666b8c315bdd899d5852eb3864fb95d1dbb465fb646d9a92497a1b29303689d9d7371846c400d6fee366316441e2126503d88da40bb5fc1173fabf9beb283dd22a9cb8309c53b3aa7be790e23733f3c82ca2892b02cb3aa4fb1dac27dde44b42cd3abba8edc8b0373ce4b92876b494db900d1c64a473cdb7105a523faa8a3f2e6b530e5558a1c8d94af864dd0a96923819d96775d2d746a2a8e5e4562dd754cbe5ee557a27eeed0f784a93b7800c2c01917dcd18de210f5957dbeef8eec7182e20378f7bc9360d721289d00c668bb247e79d1f5ab512184e8df1408173cd995a8f756e8cc4578d9067c28d01596f805869f86e6d4c4a0cbe68cadab75c2c82adec5a22197ab42b7da0295a8e72f9309ffec144f3c22102f69d839d2fbc15425bd1b115a7836c5ce6c9f2dc4d425c5f3dd8d324c7c4f43b8130c3f1eb385444cf706d42828fa7fbc0bba3e3e09cbdb8a99694f6fc9e86ab43d36db6604fe262adcb5b6eb17b4bccf405ae37b9bad160273bfeea73e2e9ba98b7bb4aac4710495613899bd0d71ca74d7423cc1010033a8f323dc06d50ef065fcf111655087ad11bc6cc016e9cdd16a71ef0e18cb457483cbf4b5d6ed3a2a2bc3b801a365c0ede5aff546b5d543eed0725506da9afbbd4d34d9ce3334b70acfeb5fa26840ae52d63ba954904c2084ec84ff78bf207950e7c7eaee1407220f870eb4934f549d5968abcae5dde7e779a7098f1765a43804543faab9a349934e3aa6abe18a5027083ef4ce27462e47aff609f78f8758f7bc34b5dc3470caee0db31cc5eb6742e5f189bf9808d2c8d4e3458c62b76630aa7e3fc09367b5fd310e41e500c48a005a42cd065a813157cbb079ef462e708c9559ea3a0045a62807f97706c256e47e9f0ef660f65c369d489f4bedd2b8f2626e5b7a4fb3bdab79fbc9d1e4e0a3f9cacb0bd3ad27b2ac982954601fd4e93cfa12848df7eb9d7a8547eeb49b4c5ce3ac6cd72de290af5df18b7f435cbcef970ca992c180beb544103fcaafd941bd62c71d57253024e65dc96f928157cc3c22a6e16346edceaf6c84f09024f4c0faf0e8e42b6ddcd69b9189aef483e553cb562ec9315c337e02bfabdf7dbc716fe4bcad684bcc885cb2722871f4c810844b7bc68c74d0d06c32e39127078fa95bab12192e713b5b4481adaa536019687bbd082b858cfbb28cef3bef15a3d8c62b4bf2b2da9e19ae41e87c441f98edf6e8adc1907a6d8cefb28a2dcfb613af02254728a3077e77f98e291a128d5272cf64a48b410144a2d04495e83c47aa26650be4f7c7c37829e2c159cef2f87c4213d8a68e291a7c8d73b7e0e346954272a9256557e3279e2f9cabdf90198832787d799a483ffc9a1b6a725c65064cf652313a86af46aa09a5d9a421e4329b46f6c17888e94163df268e59184c8d4a7280e496248a522a2d6586a23f293ac06ee75e5ae7b1ddfbf171ab3a653425be76b4a2354dc3c408e42faf2da93ef8d8412fa6cc1a7a20b97cd49cb8196b5b6f5d797d1b94dbf37fd9dfa5eedf97c9f0fe0be3c4792a4aed9fa5942ad24d0a9c7998bd243c207da43a7956a1841e4a713f933d107f4d14f1a82a7ed440348b6d040e7c07cea40b411e530345880e9f8e623718f5f3a104334a25ac54b9962331fa638ccc7c93add1350e8d0ea19ca5b952d12c55e9c6d790903d8f53ce30f1fa915e980fc7c22e0eca54071ad7885787a9bf3758b4f5a651c487db319de7d00af97ed7eb62129d05a4a6483e417c504f073376c6675073b9a60dee600daa0f9f162c9429400913ee80fc0362272dcb582b06a221a28a4ee62e9175081be797cb98322fb330c809f1040defb6c8f9875f1426d49fff6e4d203c81b24f56811ee4f6803951f47404d3e3ee4c65fbe125da8a02a032e6022eafd5ee1d7db3c784464faa0b4617897273937ddbebaca0abcf4664bccd6547bda77fae04d6f7c23f62b5f74913dc046d211b8675be56ae5433b6e090aa7194b105fdd19ded9efa27e87fd765303ebf388f3798ab71fd9d2caf975bf71b92d008685b443f03aa9dd899db419b9864dff6c4ab5eeed4624263eb8058189f71b81146626602a1ce46a80be573e2aba30be6b7831bbeb0a70285a183bd0ccfaa36a40c5d4fdce04f56762343bbf8442eec2e71a15b4cf08c26cd98847f9709aec8f7f5066813b3a977e75eade1958f9008965fe229b54a7f3134c951bf84cbb9af0a78157a58c4b034c0127aa9a3cf8eb9403b84fa60581f3254af06a35dbfa445a9b8a8fca3bebfc70eb7c860b67746844285f84e127e8a47df58da98d3293f261a520aeea92e1aa345622346c9dd896d89715aa949fe06744025d664b3f85267247461411a8ecc5bb0e502cf994c418f8d46344163951bce92275856e442161c50fd02de6f39ccfc618373d164cb5b2b9c22d761c771305de9e5a89ca9bafd6b23178ba65df88f53139040fa00a4033a26f9bb000abd8f0937c4016e7103f180fe0cbf225eb107275c2d601378d20577f4e04624cd01051bbfa5243dc132f6cb76326b2361be6980bebb4aa93a49b648c9694bc0ddb24840ed5f5d9d3363367b7b79c6e997e9ec3a8518a45c83451c4be618f11008def823c12236c02d0a9cc561cd0a66a0b740fdcdfc145c773f3df041daad3b97c661660f8ac42f985efd324a938932e246be6404425adda90d7c7909dfa13e17a4a46664e5e98ec8b183815f84938c4709004b6d9ed07d165e47852430f7636015c42300e143ca5b5065ca35136170f64ce0efb6259c4af0ac992412c6d6bd5c9eff9fc20f73f5843d88a43a6651d90316dca09198356f4a082a68d1163c4b43eb7f9f7405f209415b1b40a9fb90f30fdbe55dd88c0dfc1aa8fbe15539e756419d17d6cf0a7ed48d8466bb18998c759bb4c74b300c01430463f9fb620530d9e9b076a078cdb41f8822330023fe8821cfe05be15e0be1af8699b76ebb6fc1ea45b2d05faae042a8f3b26165f3eb5e0a7083b9d6a2af1e39320347adc08c82f71d14355485829de3ec6cf3642f08950abecf157cf427f3de03eb201902e09e12272142dbd69c5b9f4c050dd557e54185effb39dd4c5925bc716db86867e778a2c3e39343582b09e99323790b05d5e0d8f94b0aaa4d07794a4d74e3837a70c5677322ac64fc159b94d3feeb67e29ae755c6c39a5c80843553d544d9479ccb61e0fca089b08cddc331a2fc0b5cdc1b52d608d64d44273df6f1f26969fdf7b2c9a9a262c4602cbca0b3d46421b5c1f06f8923512cf0775c1f11c1bc4e6dc2bbd91ab5a50c708db41024b7018cfd6bbc4be9ccdc4ed21294abc37752a69f1b329727a38a226dfdc164b1ec6f069d497823ad89850b85425282713c6b7718d0ceecb0268ca42b372e7a2dc5b6bf8ea5d1bf66a155c748d0c7905bc10ddbcc8107cfb09acc78cb7c57c2ee5b24d3f87f163540c83877bec3593765bc2f1b6bca428782132cc2fe013e8dffd1dbbc9f231b7b13177b7ea70d2b27520944e675689d074f1c8d68c2428a5c133b40f304e2694afe8085481d260012532dbc6d566dd4675f354376a63ac0412db4d35e0c69b58a30cd78b6c1b33febd1b90c3fcefdf25b1943231dbff8db653327a6fc43aae33f754eb4e59df9af338456ff8f26d1cce034ab991ac4f6ded09e462a2eefc5cd6eb45cbf90dacff13abfb3737d12899f3a2af134ded2008f0bea32d01c2c6375b0b410ed48ac6d4b3c7f04148a901452981012ab7dd8104484de00d823af85d00063624b0cbea83e5d5c4d77785c9e543ee95ce3f773ccbba43ed64030074bcc152e1152ac34a66cb48e5a32a062616cd9a32752c89438c14356ee92356461d4c333fa1895dd1352241f5bc6191821e2f9d4054c35f10f801ccffa02373e1c24629de79cd4e808d2fbf0f5d43f62cfc0e9285f229798ac84473db7fe94b86290a547866b63807d2f9bb35da9950bbf8b45be16953899e89637af70ff79ef88a327c7fd2195ee9f2444816c088f840c8e444c9a123719c069e51fbd1d64b0ebf53db1f3c5c4813e2c76c8e3d56490d8ed4b73c9cd3e0c40f8feae5fdd237f2cde1c3b8b1ea8c329a5ea0cc44f347e6378db2fc6606f24bd296130d83990cd185c0b13b0f74f0b07ae30754d648993729d7ee4f34608131185323b4f59fed0087603386991c8e67148a196eae61b41fb3dffc8f311866adb993d17be66678893177a663388fd17766acb9809173a6aab99bd10ca6a9b989111c5170fe799f14bfecb04248705007b2194c16108ec191c6fbc684974a4210b9dc0fba50ed2d2c1a38a947f345d27347a81c4514f862bda4946b16a1cdd856883cf9e1ec56f00ce454e6ce212dd2fcbea5ff3c6a75d7a95a528af6f1d773ee03923e3fa3fdbe85f79b4717ebdabaf885eabb48332983de2922c17a97465afa59c8cce03e7c690e95f8fec9869b3781874c717346822cf8594f0b99a60dc1fcac129e20e0cbf178987d493942bc3748d447d4c41d732f6a3b622d062e830e1c9f3fa5fb791f89ec5e8af109d5a49a02eba708248c17f9538ae8cb5d57ef8d73dcfd341e751d50599b6df2010a020bfeec2ebd03e4dd81a6aedfcdac9d2abee104e759cce2dff8076d79ffb6b7295449bb528e222446ce75d3801a13b0083ceeb1ba740983660196743899d4171533f389bd83143542f0913d2807887d7e472528fd7602305a09696a37ee8e568b19d5155429a84649da015a67b2fe775db6a37a0c5e49768543ca6191b1cbbfd111fd2c238895722233d4d6f5763bc2332774672aae755c67a89d4c0026ad6bcff9a9c1f41b12bb3bb96c00c4b5ba9a295dc3a094c104079dd1f202daa35c9595bc237b532688049eb0fef76621c3c82078337669ae74e514b568462165de0b8296dffefd6824bc3525e199ac57e345e90be98905d771849fe3f56be4e897b3796eba9af1288efbcba8c3cad677153b3244b93fefb167a2b12b1f13f7dd04bf0df946085288eb044910ac0c6ada55e3cb7f00b9e0f06be12fd95b3211c36aee5b9c196d8d76cee3f5d3ac849261c5840ee9fcc592ec415840e9a97010a6e4207807bf1d0b2002aeb0b9881b9c58e978e899c95c400ded7f303d57b5c562606f2e283efcde40344801c91afdd45a17a396df5d288e5999b914c8bbe82c68c84b72200ce7ad51e94879c93b78dbf61361c1be971399851a172e5ac831d8fb147dffa544c13de7a6faaf953f22eb9137a4de3f309cbf059603f7a8f2f04e101dcf0efcb22c882630db456913fd5c36af4c99cb5f75c4a3e5e302aff12c21de27b1df7ff7ddcc43bd8ab282a9478be9bab0abc78ca9f41d4ddaca0e4660905a9aa083772ccab741bca4373acc210c5ae18b289eeed578a8e7ca40b2a4408f96262d94e6afa9f9507f5f9b3fc37abcd90d063a9dc322fec759ace40771161939d68f2334d0d19d4f8935fbc09e8f2adfe565350dd1cc53bfbeaf702db7b2114ba97e4d6529d6b3180b0ae14d69c7a841d9383b6e60de8998cda13cfba954c661d3d20946a073fd44bc4ad37754657ac8448e7dddd5b4c44a12826f2c5558e9ee470211516ad9c15b2be33c31b9a227bdccf7bd28d00a187f5dd4b6c9d7cc0004b4d253f0177bddd646404f8277f57d96fb5eb57b363d6957c05bfdd13774522f58922c09d66875cd28a5811e91015c82c4c3b45845296016d8c366762a1705720064da2a77b7f80fca7e8955f5f812a2800a7ac663ced88a692763dfa29bdd1a709bd00ad4d6cd5670c56d6dee527d9aba937446917018f04bd0f4895cfab700e70e7af6b15d559241c82f314464047b32fade14d25f2f390c193b603e33602cf58faa16694cb239b98a46876833b1938748c84ea95e7ee26fe1d7b38558e6105d695b42d448026112c4e669e8c0382dd478ccc3429c3b47579cdb5d3acc9fdbe496020cc5588d2c070bbcdcb90d788699e47ca02d3e8a739166a10417266d74f06b0a1fd99929c5450e09a033dfdb63157ca3ebe3662d0c8baeccda1f3d7d170cf2e428c562c257b033fc5e4533482251b5d2712545b710ab6c1446e0debb268e6d96a83895a2d8d6404736b0b2712b8e311fd3bdac2cffda48824aca28b4b36bea541a4391cfa3ca6143a705700ba8a1ce8f0baad2bcae11a0ec5e363ff0b9ceaa9b41cc27df0748cfa5b8dfdc9eb2aef946f43a071a082b22c55ef77cd4e95ad4c9ab76d24a220d8fc4f334c852c27ef5213e67ab8cace8bb79160f11fb3bc47219327609df6120f5d8511d85bc80b886e42bd7d6123713df173098ff8860ffd183bffdba8a5b46dfdc45c8521fc67243c3db2345ccc9611fcfa9e8c5db4f072b707f811ae97af7dbc48f0bf4dd0c8a1e1376c788b9b8a0acdced3836fe05b0f92b3c2b7862263541c957ae06b62ff8a8f6f91222a13bf637074b2f6491628f680ea4df88ce86e6b9611ea05df9e1d8f16bdc16c90e21a52a6230418f2455257bc2fd9ab68926a231faccf54d8a24386834a5496d6b6a003c3a31934d70a30583d41704cfca8bf390e4443e9d248e53a24f98a633ae816f93ac738f26f35ba5dc629101ee6a98e3a3c861b6fad5ca04b7ad6349f5b6b0630a3668cbc6a39ebb162bd5f1b798d5f7e15bcf90e197f37032010ffca2655777127dd5923b4e154459c3931a3f095ee192c6cd386230340d4b82c86a05e7d74117bcfc7226baa385d2f24448c3ee528d0f911f31737b92710ad7aeb59d29805e28b4453bef07988d816f945dd35c656d13a9a6af6e0330abc22ae53c9152c52a08d90a18ceb0ecc1ee5e84092c29b7aaabd105acb6a3a24b08e98b51c7817673e87d8cbaeb724ba919e0870c99b7c0dcf723615d47a7b571910f20008a1e9703985f3321d37232f96ddee98d44e15937c7d05e7e9529774be1a2449181f5d309391a9f687b0ba73f0684f55ba028b97d37d5de492ada131f14171dcbde24d01e0f4ae3c700726a1c4d5899f0b1bdda0d7556327c39e43ebfd24b6507515cd6398664ec9111acd9d4f6c9cac85ae8c290c406e9907ff60e1ef7adbbec3cfc541cac3790c8334e8fa2ef1936315daa428c4e0824f3ebaaf30ca914bafc0e37e329e2f0a797ca8313363f00ec9999807a555da337d9bd7b7fc4152c6eb0a82ae45237969ad0cbf6750b754b6ab0174e072b5fe3703b4e0a7c4422f742d0b44ac962f1074afa76b06f9c01744c073b5f4524dc314c8355be1bc4f09057a9e3c053175ce151f87770a1d4bc1c807741e2613b76aa4034baefdb1fe243e264b01499bc64d85c04183580592493f0fc39f6b5888b76553ec2942c27ad6433c46b88b5a4f9c2b062a6f5bb3de65b7b9a30bb30afe56eec54b3cf7baf56dfba19686ac6730dcdf9dcd26ad28a601668b857fdb54f7fd5213bac0bd2ccdc5cd76054f379c302defaae33b14327b16308983859b28051320fa57b347b9e936ebbe03c264984a6fca03b307ed274ba3d1bb411b3723f7673c6860e6c96c594137f9207a779bda39efcb4a206b97168a6a815bb4ccc2229bd1820f65e0dcfb94d40f885fb8f1f1d43d18c0f4e87c75e29b774327b04e1f47a518f92c233c949ce4c04cdcd61d57b9387096115e3f8423425f359e7ad54c5180996c47e701c436cde9a9c1d2965ea240b2c1a4fe3334495661d86c1d8dfbd2c9c76d41b98fa862c3ff557092644a1074fe3ad397f02c45aa1c05a88217384a18a5bd5b448c2397bdd08e680b0ccc7db8d36accce9f784a97a473cbb6ffd04fe9063b8ac9253f4deb55cf8bf203f201ade2699df0100b0b8bcb04cf9457290e480cc12c3df5e8ebbfcc1202d7d6d591c3f3b4d41d0a3c78ef4d870b16499aa5f5a7da20ab4e4d780c29ad46da623a2c9bae5689a1b5dd31f3c0c7253bd988931667d29823a901db8b63dc5f61817bb197f13da5f2dd594d75a557df7dc5088428c48024952eb22bc60015cebf2521e4fd92fbb5b11cfe86725518398392d2e79acfc0c399d5b824be942c977fd3d793acb43dd6dc1bf241a35867e961e642c5e2c1ac73cb921538c2131d82bf181daedb84ee23dfe4aa7d8f1486deda5515badb73766c0b88571f3145529f3c2533c1bedd6064c5913f3e9f3523bcadeb2abae7b5d6e24d14441f004679b74c1b44b8f897ad013a51b14d475b87d1a20eb86cfe96a9578c131997457195eb0559ded5e43a60ae6f0fbb14e24f3f610cb68c4631f2275e15c3fcee0c77de6f4f0900ba3c3da9c042bcae1463fe6ea7ad7d85be9ed8ae6e42516fecd6167bfdc7ccedae6714dcafc6acb8b86ec55b04959901c329b900d24837d95c1671bcd41e705aaf1a62a7f5bb55e5d6f6fc109e4966c04613eeae9685ee0761eb07d4dbcfff15a6ec214e1b06fbc87bcff81d344a5136995866eca60b50750514aae7b04c4975f00503d185bad183b563107ea1e370093e9e698b2c5cb177ce6a58bac644490c19b79c0164b6f6b64ec18ebdd369a326aed62b03d678cc97a268b932aa6c32896f3130611937ead080a3a67340d7df376ddb0ed060811c93fef0c515807a0688f1c32e763880b17cb879b5b002a02e22925bc2b557ae3cb7bb2416e7287da10772f17ca2cff6ed348a941bb1187fc7e2c35198bf788a473eb0711f3872dc5040d7728f082bfb8c7b6e26dadb21f60e44711a29cd3cbb7e57819f6cf228ff9b94b1a26821c94e668b28beef27afa7a585231594ceb7a6439feb05cc31b5618439591fe3938ace6da53bf4b44bbc414ad140fa0e9dc9ea430312e626b5b13a48f4bcb8a571eaf0abcf43e4341f52aec0ba6f1421d52384d631b7c217395e4e2d23e0cde28c358cdd061bf63755fb9259842661f5fbcef33147ed2a821115ba6091fb30e87d4b3bcb2bc1a3b40bd248c04a50e7b0a133c94a10ef73914dc7036cc06e1bacfa63a56d7e2cacaf18eef8c22f5042a5d1b49beefd04ce7e2db6114f34d63c5131e0c52c089b35b269f7b3ef9c3775369c18db64e1e6ae82488971e224a81767f91f45dc94702aa40010add899767ba202003433c0ca9a0e808076f31a414bfecfc1ba986d524d59964b93d61ab69f66517de24e21a50982240bc06538f50595c0fdcb3e823896fa5e4df456110dbf1cfb48e8c440b0c3dd0780779e6998f78b65eeebe44e7a75319e58e73cd6ebada46b5a7bab158d785ed9998db6bb600f714fd404627b88209abbb92001c3675e0fdc71ef1d107bf969f88df891880bb13d0769e9563c8bf2117e3ba94dfc66f5c347ea8dc46fcbdcd50188500aca8bedd4069d28609b2b252438c81f21c2b642f7a9d6c6e7aa05e9026424bce521bb9d0a6210f390340878a4355477164927d1a4c85e14794bbfabbe44fa5c1cc14f3b5b7a4c9ef3b50c01b1d704f980b97a860f1d961b13b310707266fc5724551d6ebc20b0b66fd9442b1d6c761d6be01f70c3fe57b990626f375210d98a0abb5102dea18aff622523cd1e783949742818a6d38be9b6686f1922664f50bc4924c6f5cbbc0503f7679faaae994c8a1bfd353c7ef1b70160df3d00185483f3adc14687bba30460fb8840da28bc246208c3a42b281c1aaa48b08201b8d7f3e872b6db1a584a9fc46a51d52e487df126186f4c0b3692a3c6c1b9a8dbb522947dfa775f46ba36587ee90a51fca4478799104e9e36313235068b7472e1c4021b947feca2ea196f8bd0a2e37042878e1c155f8ba9835b4c5cf9f0adfc64e9f9be019f070f8cde40e88f672c6840eb0dea979534e24db3c36d547354862817e06a1a421ec8530746ddb96acf5c7c9b4fdefeab337e2561973c007bbaefd35c6b05af608557232ecb988134a8196ce4b4dc4d4d9632995fb24beb48133bdf2906fb54a31b7ec3c4abdf2d6f3934b7976241b7d22bb01c8efe635dad152ff0aba88c29f245002d9c05422bf53be6b402929dddd77c9246524497516c85d390712118f6ea7dc02717765ca71b6192a35671bad9b7a059e2f12fe3bdd28a6bf561fce3298d75212acd51a6f6bd1b6b121e6a1604a34225f2bbf0d6fbd15bd8a50503d43c85c6e76d3eca2463fc8abae0cd6c5bc444038218810501688ba9bfa00b22519751f84589d9e5e9333f8d675219740493dbab7ad6f59dc03d3b457207755e448da3ae7397dc74c2bad3adf3144b5dfe9dad3139d1075dfe262962d101d1ff909893f4902cce24f93aeb4b830f1106852d83ab0f6dd5bc07f0027a6ab725d8c08b355a8dcc2e0776105e4fa7c07ef9ce25bb1bcfecf64886185ed255ae9f1fc51eb5845f79c26190c90e7de36d40703cef5b2332319d7bdffde9d5c1002c23bd5f387715d1be17469a8ceeefea5c0ec098a3bd3b41f1f11cd9b30e1cd0d25dc2be6e48aae2e6bde3408a43b364d23d43a6e31aee7112c632905304be250a0f92a28d4bd4ba8399ffac0d4549ff21ee5dad539cf54ff93503ba65bf67a2a0d3c24754230a87f497eccc8dc2a4a6226f4f2a3215e4591d38b5cf8fb1c95d668507ddafb39b720d5af75a485cc4e8745f3eaaf3a4871fb8109e42ea4039bd57d74e345140fec7bc64cd2e4370991669cc6f4d92831a2a94bd3998d7c450ac028fa7d8b2984a21c2a28891114dd267c9250e48c901f00a6d4851ef561694c5fa8f069d9993b021cf3011e3ab16f0cdec619e63d374986a8a579215aa917095aaaa9617ce78e2bcc8e1cccdb8f935c1e6aeffeaeabf9e49984f831b542ce9cf36555f66e55757d1c1057c4861b55a596c14ffe5473a482893aceb9bac1ea89a65f009a4f593c0eddc4309f3a271cd0b82247b47dcdab4d62eb84aaf4d8443b8c73de333d84867e9ad3435de8ee77b216e3e28b970b86c3cf2b6ccab33a0ded4f37d7c537167f4ba0fc18256a7525323fd57d6276c7810bb18b1b200b9244ba2a26083f9b7e25fc6d09f643f582805cfdf0a029093e9aaa82b1a667919e08b8b2945f0cd3189a1eea8cd886ef8434c0c93b3a9227ebfa4560527cbd1cc2793cf4aede13cb787b9b2ef63ded88317ead5c9f86c0a837942a5313753465cce1cc5f3fbc76c3c137a16f8d1cb6446520e6a79b4f68dee6c87ede5896b7924f78a87168d0aa977d109def6345e3aae2108228a7fd0aa6947229c88f4e3f22e5590f747c613390d80618d9684b07f11200b20631cbe98ddade2875d0cc3970a8cc778aa21d79f4987e75ad8a3cd36c4f734ef92d631c08a0a5f468833c15bc85b0353ef28cd483393408a257cdc50337a5c8b344691f6432358186a10a20fd3a6a4cdd1d7608caaad882cc591f94977bde41084c10ac4cbce454089df0e7130a8ac65f1f8e650947d20ff5e4988c839278740e130df7ef34cbfa59ea6bf32fe7938731a24f01af6404347cacb231df2bc6fa08f62a833f3be143e0b8f532051262bf72e42a8c1e8acab4d17cb23c6292768b34249f7bfc98099d7f953400b68e5a7b07d11389cf9c2d2bbf92f36c89921e414df39dc3f65c921a720145d620cc0f07cc664eafb6fc15f0ec57edfeba4c021873daca676f94b992cf286ccac1fe484219fa69c169638233b20c38fce3dff5923f9dfbcedcde3116c702cbce867404ab953cc153e676254c4d130951c426ac99535da486d24befc10271e14955fa2fd5167c5ca88e7da8d6673f0180fa7c23be6fb661075a5a88a0ac85fb686abf92f2b6fe0c5e596abf17ef9ff668bcebf257bec99032b0deec52429f68bdf0f96a5b77f7a16c5669930594e938f3834d6d022e2ec33023961740ce1e73c938ce713ac3102cbe63a33aadfa49553fbcea104d068b7da5f8a86a26206bc613ceb1eff72ba49296458316450b3fdcb88708934d0805ec1442f3f8e34cbd2a64a06bd69325a8504a72ada82791894749c8807ba42aa4f54cf58136465ec5b001105e4f5330e230385792084b52662d5d0fffc34fd631d4d1305bb3553d1ca5dc0a2f412a21ef875bbae5b6a9c3ded047b0e97e2b13959a0ce4120acd933ee152b0cab915f0178befce718990323db6a1197de93724da950940e64d063ff3aafc0909ef4634c329c96adf0cc6857ed59657188149a7744bfc1123350e7b248ad0456f851b96bbd751825cd9773e27d248c65fd1cb82d17c71723b982ea85ec31492d0457d8130ba394d044dfe3be891acc7ab9381a45dc1311bf522b8d3df9005871899fe10d41aa514eb32e3524fbbacd83f6ea3c4e639e98ad1c02e131b95bdbde8543bd18528a57d2ef6a91dcb97df0810195cde1b255c4a68718aed3763a8e77571876683f94efad45e2f318ec4fbf2c8a717ac47bf9e367891ecb1a0952decfa497a39aedbaab02a60d12fd6c1a38b0ae7e42df476bd9d9d56b84795eb0a9321d865d300c96c4416ad5da81b51a2a907826099f1c8411c08d4f5186da779a28432f413b02304008c3a3fe0582243308321d55cc68445decc880083b87bf265ba9c43c1d29e741ce7323896320617723df2c57a451d2a0db75314e16187da4328b707fbce3f0e1a80b2d2978e1f45ed3f3753df09ac2e8bdf00eda22a1a2b6dc2281c6d8f4127691187a9e489954958a389fd13ae4e73d05b241c95da19a6a208f7d163c209e73a45997c57bea128368fd4790d839637c57e5b1ca63d660a9ba4cc96f580772ff57927350183e19feaaaef7245e62253fee0dd958a86944e922f6460934e165b79dd14b5e8307ad3a70f023057a9a467af36a61abe4de97d40d9fd883a2690cd9d95b9999485adfb58cf226a01e24a03f11bb4a98918f294c35fdcfa6697e9097bbca3c72bab5f84162ab596264d3ff3125087ac584f7fd87ed6dfadf43ff0e13f6a99fa662ae1aa5890dfa6f60810c34823aa40f9dd176335003767151252cd45234fe6e1de3d65270a3330f64e4616b911efc5836cb3f826e435c7af3e65925557a5cafb0bda13b9c7713013cbaf95c51e50ff6e4c9702ce9f01f55266b00acd1d9a3fb0d658d2a3e53c4b5ca5f15a12418f28e69f5ad603a70294304d5706dcad600616cb8b75044990bb44870f8da796b91a9756ccc5aa6e70b174f1a5fe48a31ca30c9bf67a200918c7afaec820a03ca28d18d973e2179078d80e261fbb8c791661cd755fae78218586ff510f7ff34c628da786f98ca7709bbb6ab1c075c73a9b69c478e6e82758fe1c35d9ee165cb4b4bed550e65f507d96dd27de827550f61705372ca28107a495fe1b1996d8fb378f5735f7ed714c3fa34e23b5e4aa825ceb3c356a4eaf9f5c6aa75adc5008b53279a261fde04df9fee835c235904b28581cc1982a6e092b6da99e4af8076165d4f30edd649da9623e24c95d292d8089059fc5584182359ff16c39e2542abbe90b58b5d16e657e80edebca7f5ce11809d8a19cc33cbb082547d18c1f79b598c4c8462d3616b31a9bcd9cdfba8e30939101ba1760d8035da93e7e5efc488ca37ed7469c08a854e229165e226b72bcc4dbb7d2ea1b88db4a93783257d5aa82d95c916915ae5c4965c479122838f936deeac924ac49c37ac15ab4f5075ca02d8ea704895b2076a6c4b716052718d47e356d48327ee647dbe50e0d1ea5cfbc7e98c86367b9a417d37276cf52c98d9f43a04951246fc470afac0cf140370749a590155e456d5b9e32baac7e05e0679183e5806ea7a7b88c00017d620e7831245d9528272c5d30c1dfbd8226befeaa5c8b66e6d59ef9c6415fee2ea7737018593fec80d790d1a09b3911fe9d3fbc0f1faaa5b5619dbb4ef0e901747c4eef9d17a626e66e990f8cb4bd07afc30fd3f1c262f023a9aac2a9a9c12f85bf6499e2d1459048bae109c820766b75d406bb83b7936ed8fb941432dc0ef66f76cc76c7bb8b62718d39302c424d18137c948bec9e4e7e30f42e6b40904a1bfbad7da517157cfa66db319d49ac61b849c3f0933c4f99e65c097081617b86439b53408db86a0a2ab59fc88adecd42741ea359bf288676bc7231e31d9041d7b7fdb9beea68c66caa703f1f04f52bd31d3310fef60c5c6ab4aa11d936c4145c587c1e1d839edaf72b45a8b82028dc0a7bd3d62b734c060e87dcb295ce528434ee1864ec8ed77ee26fa142ae106d998f705722279bcdd098339058672448b6649b52fcac5bc46cb5de7b9ee9747e0c5ac370e5838acd836193efd61f54c7c7f62b867ed4ab9186f664e251bcbb1d1b4d2bd516037f6206744922489522b42713fb026f0f7c64b043eb05083e3da9e8d0e64d2e142c1a335e610b937966f3c55102d65626aedd13777da6aa75014fc11509246293a5d8ba3bf2461dbf4ae2e84fec607e79b45edc73cc6e3a9c5643b35fe362ce3223d539f67ac20b9bf4468acf34295cd6f1f7e737965c91b4b969c035831682660cc85c23f69c749eabe2c59e4adaae2b50f9481fce4371b7eb1966786b160b5f4b714ecbaf1f073746444d3f0a18571e7fd2e5e7867670f74c83859be99bbf7e9bd442551c771ee96a183a9aca740e5fb4fe9cb77a1bbb37ed9528434f4dfc8836db2fb4b259447f3488d4eb2fccd075a44b88038b5c928fb0504e0426819a480cebf552384351d012f0a14b507c00c9e42d7c3025e934d1bb57b07e7a36d5f003e7ec942b266ced6d921b737fa34eb73baa6b88937affcc2b8fc38bd905a3adf2da638c4918255744a16b241838a0cebd653174f3affe1cf38cf6b0ef4cccaf3ab506d1c5e1068d8b28a83485c04d4a563a362f10b7ebf804cf852333262c40e294a305b3eae1aac0921669b0e0370f94ca0661ce36bc6964c7912a77b8020d06cb608ae2305eb7e3b9ec47783788a10ed47e729cc677a33f43f72a9ba329837bc042428c637f3b6c28f601892862d2e2d7058e9db82db461418feeb60f505888377b8761112e49d320dc46695a00c5011800a5508039da475845bc5dff7a942f6ea2539d7aab2bf6bc28f11430dede00b38567070613a165fc6e4666023367e534702df28e789e5716cf37b6df7206ef853ac92defca9a1e72896669e5f43762ba761b1e845b2ad4aff77ac133f4da13a5ddb903659234f92d9e54122c6168874e4753d21b4c3deeb64de7180d1b20fbb217284f4d50d453407407fbc0611f9744357647de76fa504a0a52626ee1432857fea4b06c820bbb03d946828a3aa1cd59bff63747c0b6b35ce9461a2a760cd75dbd0e1a43e49df846368bd28c17eac658112bb5e94a2f17c7ba37d933819affbf1cddb5b9a5000044e18208700b71bdb867b8bb53fd7efbba3833c9af336b4251a5d965a5c8ed61c46f97c56165b182dd384fd1997c5e659df2764a1337c5b208b4557a891bfd4217045c605da8b5f0dcba4c4279c9255bcf31cc1d5f9b4e812218d8d23d84dbea8a818fafbe69dbec49fdcaa5936d64f750b56e78282ae47d097f1b173c38ac289c21c85c2d26dbe602bc745ac2fd1911defd423f1a267e0726afa0178cd6d920306894a08b12be0f437fba0fedb74fb34c3da4fc3d05eec55260ea078f985dd5599e4c9c8832460e2b00f6d952f8fb31da7cab8deb14f96cbc7efe1b205ffdf97af2a3864680cdc0e729256dea35297f83dc991d97e0aab8b1c6b43a37c8d5460c8fdaf062538eefa766688c3fcb8fa0b5f95ce26ab3a2497fad61227a2d12272087963095c5348e50174c5add71513f67c257acf441d02dd070c34f023b4e1039c30bc3ba7a093f6fa1a5ea02bf7747f16e489ff857299c504d138009d97b13d6357f903a5fb88730f95bc092f7f94cf2a6a42e0dc9a4c0463253dd6feddc676dba40ffed3ffe3796da5071404832c6e8bb33f9cec5b900500fccaa6b4928ef5e12e7a91471c24425ace009934f4d006b2798a648fddcd33629e6bcf1cba1ac92fe51f721b990e5db8b262ed4b8fa9f7998bbdfcb435a20fbbd04690e7944118d94ea8adb8fb2c5f528248b240ff8228e0d8699a21d68f38282ee1d93127ea9d1a0442cc301f3c4a5469dcd15fe895b779da9c3820824329a4ad4731395f0cb10af641ebf918b4a4dfc72a323afc2a94799e86105312ad316247524e25fee47130e178845d221402c5912c170e01671c855874aff44181b486f00c6ab145e43cfb6da3f7bb3096ee558197d4bfa4c48278ef0551b2b8c010415f0b34975a8dd3d99564766c7bc84ed7c879fcc66705015101ac178f86b66a519c722862e45a74d788c9f29a3ccebf2e1f9475a100d8824cd7401c6f6a0b46a91866c46c44d844e679c9c512ab7910e3022f581c8bbd3e5dae42ee2b0545cdce8ad26e44fefe31ef3e1b2e1594eb3a5c2a32ccda7f9586fe2db57dfc50f74c0f857b5c953ff3cfacc1cd6187e747c34f365cb97caa18522dc349587c89400d470df2f5c40045825044c9e4721624da1b63041ae1b8b1c0f2645604dd6834762ad0c58d0248f28656c82cc30182e948518716513cb2414d1b3a84a7e0488d75e378894861494d9e6f3920ffc4531fb2e183b5bd4163bf0b289a4321ddc58b07ee25501e745ab376ce0a0f2e168255aab7d83dd2f9d9926992f7f3c0dbd6125c8d34b85817c38e076b44f0a1a2ee254264a2d13d215334706a8719d32a4348cb5bb6675b1248d332dec661e1bb778e1bf59aaf21ed889fee5950d6be5fd40bb5eaafe08fbedccecd1f64bf0825eda414d229137dd2da607362ed4a16e5e74a8612f8153f7dbed54a51da4f7f3bef30b4a1090bf0b9ce4c8f26012cc163c24c088ed0f596d51b71bdb09370088262ab902cc2a42e539a3687057f5365f1824b41a27028b43cb6a08dbd6a4eacafacf3ea1c4cfcf1e150c0968b6072640689598ca8e37e79cd6cf82888aee4fde391442d32b9fa4c1737be80e1930f218f1ca065002f1418400c1786260aa5920d286e64a96917564f3a98f4970bb8e5a2ae9949a4a44c5840928c2bbea8e688cdb5870d27af15b7454844c96bda16863115a1b3a0b27a9e62450c48549c766952113e81dc92c2f2a3c5eaa85ffcef2e7119aac5497ed1d334fde93ea265dcaa6de9ca5a80c90f894c3ed6f4a471a5703a8ed1fc9aff451b93fc34dba0fa14b5334635d5b66bf2a7facddf2e91a8a1854e5164492c03e6e1d00c6601015355995c6859bd8848ee748af09793fdd70e3b9ec55ee4b80db79c105582657750e6250e57005b8dc4beed56ef5550d5ea735a8cd8fa6f0da016bdaf632056123d1319345518a785dae50f8a9e5dc38b67da4d1af275a816ea345390486c2cf6464a9021bd939ffadc7368f1e0a9d2d99197855022cb380d667c23402651e791b58cd9ea8df5fad4d5100ba55da1eeeec95f06ecc7e36a64d5933710d0899ec3407f7b2078c671ac56b374b1733b993d948e3fbc547f429abd79a5fabc1aa78a854d3ef24e19748215b05987c1bf67e5e547c3285b6163d0048f054c65ad502435909be4966df6c1fb3c87edb3c303c64a6e453cc3719d79e0c7d385bcb658f82fe3c64ee92632fda214f419b21fd0d144db3bf499642befcc626c27a0fa9d4ea4475360e8b58ae85856840936715d211f224007a51d2516f29539cb7a1f4687db265d19c98008e12a81e723a3074208eb085c607e60f9f8ba809ae8f7c50f35d92bfe4b1cf36d19378249483929bda4a4c83cb9b5b6cefdc8a2cbb0f4312a409ac5f38100e373240fae943b98d5136de3e2e09cc557c81294f6b2e783c12f7fe516cad1578a644541ede438b2c8df6f6c34cfa464382208c5529dbe904a50d5ea5f2680a9676534912c015fde2c5ee74829edee47e2963a9813d9bbd21de5ce02af5bdca3018151c946fa946c9ead48c9e115ecd46982ffd20e96388c44bfb81f5182f8e857e7bdbdecdd361e6414062c4b552bbe5a0d19aafd38836cfcb84674ad36dddfd696e2de8d55369de592cd67d2141a904070d8929305ba6d6b2469a0949fd12fb6bc7e0b0ec4b0756c68379da6958d4624d9c335a9ff45199ee973bcd8594b3ffd0ac81c86931f3a012224e8984df316ff6b5fb81217e4273095cd8d997d8f48aa365c2a17cb9bb6f1835dc5223783efa39697bc45a35088eb2296baf15ae55ec1e960fcae50c909157e30f27fc782d519221897ab1b6666841f6dc838dc73f94a268a0b41ea2cc1519f96ea342e5ce0b007a45c13215ac6d6d8a02acbde884646c146905f36e291a0f5c8735e093b189e1819796dd139e6ee89aca5b04e9d8a014d8bad5a214ca5f5c71f8ad729e6f7fe226a01f153bac76689e7597fc476d2b8ba56a280f6ddece77598fea7327380b586f619b4724a279f72dfa1a993a5aee7ac98caff1d3b7ea77927999b17197289675b438067af823eb10019c5716b57a2262b712b9147d41950c92c3cd9c55f3d646b2a80336d31c53565376bafec16c4122bdca7828aee054a24f08da33247a812050215697dfce619820e75064cbb24a238db7f7b89743408b0dc823566dbed7d269a583f7600e569cf6c48b1599a76479ca748d91b57147419fdbd6968f5782082a7d0df9e082313dfe91aa37ce2135fc146b4a3537e56fab067fda2f067f0d1fe4c578cfb341b081abd772d90266968e44e4738e90ca4d17ae61c10e3f7581064a0a345371514946ed8b89d63e0f10b24a9bb262a511acaeeb5a2c43fc80daeba0479cb69faaae4913da98e848a091a66267fa8ee4e9ea63786cc2c6e9d8b44fd4b4aeb8d2cf3aa3c9653a72294d9e7205bd3ec426e2ddd4c9f95eba57b99221d1ff541165bdec13428575454c7f677f9df108730916701c1f6b62f5ebf8b58557603674767b09536d34e826798d3ab614c66b88d2ab9e7d998506898bae0707b3c7e8ebaa901e8de9c96c5d718e5aae7382a9c4931d89ca6f435b090fdf362cdee4772732638ef6709e3552bde18d24f9d5d8c4ca1aab419831288438d7dc663016a70813b39661dd951a64f8215dce276d5019a75cc7a4bdbb4021d6d576441deaa8aadd33667014f46388f7a4a3f3c7842633b44d38d85f9c5824dc25a579451f38b2c2f769ae4c2feb6282946a5628398430772923987e90138160c3a0517fe89177e419ecd0b919ea6069c9e64d3826c443650ef873aa5898304c4996759a6e105bb9c96cfc79395ccb6317764c110a99987dc9ed6fa9a70f266210dd814939e2ea71aa34e307a93febedfc717c294f07a4d3cad163c913d36a92bcec3aa47d9404ec3ec30b4c796b56fe2dd35af676dae5c51dc1d5355d8f00c050a6115250f002a42de4e375fd669358f9d75d4aab04682742400c8e52001647c40f1b1da0fc40bde901ca75f95c53c48b0d0a54e433a3998566f9558f9d4850246592dd0a94c7d839f857bd7047027006586e691fd39774b2874fc49db84b4324a6e939385497bf0e3419780738dd329e82b47eb9ab99326a697c8dcf19436c5e2378bb2759038d3139d577f3360855a8cb71c46f017f1e1cb6a5b2df44272e8497601787ccb3582c5a1833f63e409dbd7dddc6f62b6a152f87b3e7e89807bfefd09c457564e444fb1f993280cd92b14423cdfbcb1653597ece8539805ee01d80f3dea116ea862c96177a689c2fbc1ac05a92694ef00cf60a210c4076274b76d77aababe70f23b301015c44618d5cafc1e74a06ca602b5eeb3e7e4e32311f155143f172e83077640f349f9df3231479bf89b9f97556aa5527941813d4550ce64bc94343570829c3038ff49378c173872443b945705375cceb87308948fdae95be2191c8530a09220f3b66baf4fd626e20a2e875ed95c83a0ff783097c3ce68e4bfd75e49514e520865490a3b4bdbd37ef10da60fcc21dc19ddf37d411085552f2b89ef8f6663b790e333cae6fe683516406422d0f469cda01601a5e0e0fbfed21a084fa0163be9999143594c52515e94812a27650f9f9643041ed3ae965aa5e90ebb6890f811395020c60d527c40b24206728e4824a96358ffa06c8656e94c06a7c349080672b842fb8e62851b9584e95a6a957a53cc2474e3a54ac4e2e7942c09122919539152cd3d066e50725952c7482f305fac56cfd864b6adbf847ba5df8131274d01dfb77681ea54b0f29162ddf25218ae89f58d6c5c6d030c1ac021b989ad0547c18bfb2f52b00b736ef4b3736f853428a914d49e64713182b5bcce58132c453830915c348a80462cdcb84b53be23d6b17e9c4e15548d682a8a181f235f79c0a666bb2b06733073b6c020d1bb7030cc3e526df15aa940257dbd849d86315c054ed4dd50b8d999721fd50986a569ba2f8f3a71f49517df57e16a90b1db314e643a6b4cc0eeb26c62b3f2dc34d1d293e388c75441daa55996deabe0a3108a839629945460dd44c0b0eb6c53475ee5a245f33ea9942253de8cb806201b2b23cb9e4629c93562375475283bed0d52d10b96b713f47adccd82185cd9c1921f032655f9c2c760b17c1f5c7e35a164574599a1b5b21e8765b850ed2610975384db48d4def38e467f2e09ae0b644f9daa8706c92d951135ba372040192896c34280f525ec0492783b4efd53eb3d0cfb92db46fdad93b323c99f2d85eb1b7e738b206225387edda8b90c6c6e6b0de4ab006e3de9a5ab15f4c9c3d5b01d2e4d97596533b33b8e2900e10504c69276a7d01253bdff3960a72be29939630fdbedffe2b7ff54be6d40fd9522728ae6acc00520f138f9e7fc177df799e751075110906d0649f3470d2a843f4239b10413fb87e1d7a477175e5b0e61cbbec1dbc22e117a6d21a53ced3518a7dc600fa04ed7639177979a31f9475f7a1f3389dc246bdab77fae98f2e3147881d09a7f0e103b7d47abeb86acc1f395002899ace4b9a5f649c7dc15b112810955244892fb011598806938acac37b68040c8146f640e29ebcd339eb0dbdc502d24f979fce4984f5a8e0c5f39572361a808fcf39b86ada5dc515eb4a9b5063b3cb8e18ff258a107838922703a422b47d8384943d0198db7d13c8f83d4af7f4e0a40bd649252f6dabd1aeea072b7d5584410a15f7c6a2ed20b175db6dd2edf9e9d68fbc6c7c4fd465e72879997490337dafd2c80b62e2a5a0cb1af1b79cfd6ad7b9f013d6ecdd952f878db9443237298df48221d2b66c457b2f4b39ea7214aca169002e9fb5973c2c3ab89f14ba7a04e963759ada9845b9ec094f291d0b739a1a3a4500448ac7a2d9bc278655ac234193360734eccaa97403952184000e5a621ab042e1f351874dfb8124ccad2046d3c591bbf8865fcd72624a649ff580ccd159b50e66e663b8dea996837dc63309539d822c7feefbf711ad1c255dfab34f2540a8541a961c6a3d740fd3d0eb91e76efa8d249f0ea814d259dfb49a0678a109da84439d8ecabd443d614a8f3073b600c05565a0cee56b6ece3aa50b6b817be9dc5b062340140c7ebe229f12ca63a1f09e20a68387170bcf8cd4236535b61b3c67f66449d178eea025fa71e0a5ae3b8ca5273c628304e8d3464979c581805fe14b6ad3753abfa6563ab741c355b639fa1d0697bd4ceb4e8bb661a2dce10582ea6c5bbff124244cded093bbd57f51e42381419aa9e64a034b0bc78aab9e83e4c0d15ef7c1f80f947e767aefcfe6c0f8e56be1a3974eb11a64b1a19430c913c046288a46f2abed553532feeb3c809f176700d973857ad300b2dbe2ada7245f7a9800cd682cd3ca7aae38bb73a8b6b6b8f666225addec2203e530646aabf3aba4ed5373856ecb7ecc0b44a73cbdc8a80213de7862c2873b7388b0796e1dd746ab0c73decff2bc6320ea157995cbe240a05b85a2978b1eb9ac0f14585a1ebe02e469541a5919a3e506bff400e014adf30a2d51fe44c84638aec9dbf0ee9c59290d747279009962a8a07aed8f8861d61a55eb92c3f46ee6e46b93f3ca3fb200db8670e3a1a6eb89f8fbac58f2a7e13fced422b631662a70cab54e3fb14f17399e26ef07341696d09bd39e311fbcdef66a709da249b00fb939d7bb34160ff6008fc00fd4a484f3481d4b965757b5a1e5898c4c71462866386bd4e50f5d1d50a658209a36fa18a561b0574ec558e7a0a62fb7d1559da6b786fa57238c9ec5754d58202ccdd3b1f03d9b48cad2d1db3ad9de7b472be67e2a6a020ad355d015e8578d69820b44e6c46c65b41e9778f629d4d0639e6e76fac6068a440765711623be4ec366224b8675245baca92af3e0ba9fa4bfbd516c128f4810aa51f31aefcaf154b32e828836296f02549062c292a872e7daa7df6ebd25bd9dfde077bbb1c722378288283aa3902a938f11728ca3080be9dca2c14c8af4554af3961718ecac4701705d37c5951ca4473a16e493bc2969f857e4e5f010611ac7545222c13a1b23ea6e624a533d4f1eb01e17d3ad0559ddc0f8c581377dbe1e78ce904aecdd3873e1a2ae5870e779c97db5be4f06091af9a4992c9d43faa2423493060bbdb30902cc0ece26082cf9943db9bc923aaf72419ba32ac3a8db2dc1cbb66325ac966c361ee6cd2ae4545398f2b75c85787a8563db4d5ece7fea86f466ef58d77c955e205d8a1da6aa518487d73f1526abf2f6a2b27bd3da47b1b78e03fe7b5a472cc77cbbe53ca226b442013ea003ba9e404633d2bd262e72834a2fd3dafb23282b11050e539f5c57b8ae82b9cd7568ac56cc66969219be4ae05614e629edfeb442317d0d99e0ae19bd281d4ca32b1fc4f2ba4bdfde7ef6820f00a83c5803e3bc3a42a51969a01181e6a29bf898f01b6d2e0842d9cd05c9f7778c4866db7a322ad3ce801cbde6bf085349e707ca736d2661cc992f0338953ef9640e8b9a896acb474d5b14d66e977205efbe61a4fd7c5ee7d7a8ada83a31c3b56bd84d48bfd5f02caab66edb81b1c1ae6238e6827d0b5dd5af0eb9fbb537d5736443fcf26072313f3d65de27f657594e965350e849613ba0052e14e6d2a935746dcc3053040259b992637f31d791902c8b91719cee7667166938f96f0e83c5913269f54ee1979bba7e7f9de7943d932c839291b6be62911108d32fddc54a3502752081d36bb883b060bfa16d1bd4705a6367d32fcfe7fb7d947c2cc61488b1b0ca9ab3c31bf781fc36b659b1a0b7d737a13b09a1a736c42dcc53e842cb4ca435d40def30685ab2e54be77bc0392aef4421494c11c74a35b6b3a0afa8c481bad05e4585e841e9bc25974db151b79bbdae7b1789254a57a185cb25dd10c2e83578cd2bcc3bdd7e623905f6888accf85a27af0dd7ecc849dd0f0b227d585fd4e77428840914e2a44ddc9869f819b7b6c36946ec8c79b44fc7618ef1b3c17ed947e6261bdd151aa8cd186170a901c0d2c8bdc241214abd0c2ef482767384414b9ee8071a03cf569061208abdc4563c2bca0aa04b2c0fbb04bbb39d1f0f9af261b2da902d284ae0999f08d60050fa0dc9ede8022f230e8c1f6b6a5ebaad1935c0a8a23abe3141edec012eb2377c150a0d31722d91374aee092b188454c3f9d1f0909638b00c5559a0b6383fa523b72fc984b4d4ba1224b256c9844da91668ca15df8cd3f4740dea5512e9505ff622c0eb2ef700b0336d4bdf8edae510178163b56718b0875d4fb141c22f33841d0eb523648ee6d30c2ad30132d90df25fb3a4fb85e26f55f264bc6b5b98665a5e35a4aa76baf86b1003ff3859996905c047c16432d5916bf487c3d622b746880e00032ebe3c2a3932242a65cc17a713e77809f56af2aef6bd3f10484e0ef8c1cd8944ef2df1d35d4ad4beb7d0ea9551d1c0ee30a0e953503f4895744b8473914cd12ed93a22242f28931dd71402297b25e85b81fd93ce9c217ef31dba52901ea71a70871c3985da0484ce12ae5153873439de29f9a9ed8796f20b2fc967060ed354d70cd33e8c5ed572989ea087b61bcc3a9208f7eb0b5e620c9fb0ac36ac4bbc11679fbc981e27c29b16d590f9acfec3e1db82269935f93dde437d53ca98bd4dc77f581269650f41eeaf91f97ad29e0e1526e5ad99086d6f88e490070a0de0d8e3fdbdf0d5e5713d4a275554283889a325e7e35fb75a8ecabc6c4c6234efa38139f1598d83b166ca2e41cd923907a461ec5798688171127265218f1fd1db06cff391444564c952b53be09544c60d2533b4456a6dd69264744a2a8d3f63b1bfba48ceb75bd4d2ada3a83d81ae960a3e6c97d14c37d6dbe967cfb036cdec0914743c83e2e2d33a9dea42c55adf982c9745291432695c8c3194da49b60a606dceab4dc67eeacd7a0810a2c44a2cc00bbb62448700f5ba2083c315887283b11b52c2ac4820e50d01a1c6895269a71c89a658adca1e26490f835808b8ca9db7e80cd3705796b4d3281c59230442d8af746a4038cc777d7cc1077c32810b63efe937317399c33a3d6f42b85fec569f508dc977b935ba46fa86588111a2b21e4d0585e9d3c3e1cc2dbad15f1cdfa83eb790a2caae370df8b3a023a7c8cc47052b6203899b84af614233f19d9bc814c7c951fd833153077abb0ca885a86ba3248921ca867e6894ef13f80c4bbad512e4e4738ab5d417a4c2752fbc4a0b4e2b67702d3be4397674d68095059fb297db0aa9c84a1834faaab23e7c7de2006dee8dcdf6084557aa7f714a14cb7b1a0378f15bde0c4363e4088764b44016043ec04bf7437ef4d27ededc8e3b90af7ee8f515db177e835fe34afb9036a2de2d07f6958cbbcf0be2bc591cb08e7555f4eda352421ea15fc8d320de1977c45242b4b394cf4c588934a79a181c36ca298c9d0beb9aa1d2486c3c240c73db39307df596a944a2ad1e8878c5e9bdd3ad171b6d96b0551df8c8a82b9908f14cb1b2b6d72b4ffb9fdcf79418063d833e7cb40b54b10e25852cea27d630449348228b5b6072c6c740813079b2a9b815949deb354fb68a01b7713753aef2b6a231a875003a94ebbd44735531e78fc6b052a56981d546cb3c563ae9d4d7c01ef1e858c645370b33d08a9ed206b1bb48c7f33201a0bbd24b710f1d8f1d27b2007a4d3b33d645b88462571b35b64d0f5b368e0d3dc3e6eed74a4c84eb463d97496741b921bccaded99d2807ee4101491762155dd4b9c488df6f462c2e8c7a4ba13ec4318ac19b3585e3c834dc37be3618b83d085df2db9a4f097087bb0b97a41b071ccf442ad0d802d0828dfd96b123599888cb656cb2193da46004005caebcdf1fdace3905aec0eefb2d73ee7bf044ec789b27eee670eaa24c7030aaedc6720dda91637dbb0f004b9335b168ea3bb365ac5433f8dcaf8054d399b0923fcbe7991dcd9ae1442147371e3d0937ddf2d9d3fc36de8a99ccab24f3a9493e37f3034e49af3e330ded4ea1bb4c0d7f5cab17cb2542a6cf2e66d8ba1d0c950d8a2dc1ca1a6352621ebb0241022be8dcafb604f167d1c3283afaba78c29251a7a7722210fce9c0343cb68c3ede6bd6c1573f05581f3b8c891fcdb326e8d78caaba6acc202b71d88ddb898a5eebb7f50af3e59bbd5d1cec12c09d12452cb7262d830d23ef14789bffb3b6a09be819661c849d902888994547cfb79e312dbd1b3ffef6f1ea7a0f606a3545bd056c1414d9c277ba302d1d96f3077494ef6029e69da789aac811a7636fafbdb242928d8d7ead40bfe3a7d961ed3357beae432ef6e60004c6c94cfc472b2d294568d2ed73322b43ad4e2eb501cae8242b300377de07df51419120ab608ec2e6c99e788105d6a389f581b2e78fe89118e68d948bd1fe2053729efadfa4278e04a184073b08311d8d40d3af117846120010ee2e38bb1c2c60e20fe1e784ed4d38a8b23046da04c6f15cf88d98812f2f94446139cddaf8a5bb6daeefc45fb3f2c1b130347bf6799abe0fd26838244706892092ea5c1b96845559c9a961a7ea61e687ad99f7bf0b9e9bec48c64d1724ed3e8be9a216b56921aa807c7b10b153088e172c4d95962d1ea373aae15d9826802005f2abf1ea5f55306fccbbddf541e56e57b45959ba219b9fe2ffa2ee986130ea21198cdaef27dd56dc02e0d2665d6e04d6a516cfb931b6f5fdca0286d7e9d401d8bb44e80a7e143139249df32852a0ada62e59a1dc153b4e797a9418166af4055a20162d36aa677b6c009cb06581e84ff839b0c6a6f5dec68d1bed05c341a6e3a4c5309003c68cd94a9649b7874f7e26c30319e87b91cdc88d8cc4d1ab27608cdb8db1801d7470e8b1f668360284975a193fd09829efd229dc6f8631f566197227a56628a73a26873777de1b62da93f6dd5eff2115732ae848000f7ccaa4258fc8b3dc1e7829b503dad60a9f883f9c0b86da18276405b7c7233576d72f9058782cd180391926878c24fa3e1ede88f68eaafe9bfd0427b9032305f3b14c906f7077fc5c4941583000b2bf3ee0a49296d26402da07d2073995ec6930a7c9039794ed4899b7bf9901362e93d315b71bc90703a102dff931619404edaae5cbe64b8e712d68d59dd2e735cab304828d8ca23797839e484ff56874b672010860381931c3ab9d91697798f511972fe893c85f3207b35cb81fbb024a481b43c59069d25fcda0cc13422f6d29a98275b62ebabf8209551d9d293cad216ef561efde3752ac4bc1315e3add96ceb2efcb69fb50f7db5fe489ea233006badde9fcc213301fc853834eaf1f52693725183c550ce656af1be40b13f85a3ca15fe0706ac846453e11a69340033ae6871e00bd1a3d0e12847ecf5af46d471d674945a73241860471c8fa43dd9e8f5e5345ebae09771005769596d013ae4b4ba58b117f9c46b8a00528e2c006e8e3b582236e518efa232bccf477180dae1ce507cc8aaf1ad7092d0c7781caa56c62ef65f6e2a9e44422cf18fb025e2abbc3de072020bbbd929fad0ff19e8d5b6239edf394a435f2c6af6661c026010a1a79c80a7c69f4c1a305b54c6e9875e7385fa714155ad7aaa34a1743fec0c60a81605bab0e98511c07576844f0ae7d28de29abbb38c3c2a30203d1af07724d46a67b94aba4d56b57052904120cdd862388de70bae8a56a99ca7b3ed4c70299356e5eb83b0891f5ee197cf04c6db351835a6d61c27305c489a5474fd60ac39df45f480767977c98d305e220fb00ed11309ef786c7220fd897e518176f9c5465318e5629ac7f7da9b7bd1c072ea35e172e6a56b03aed51b46b4159dccfe1d35639e225cd1334c5f07509a1788f91c90dd9fe7cf2fa0af6c9d67d55d2cdfe122ead3911d791254b6cebf3c108fa1208e7c2f81ede7e49856d8f9b42315856b4562d59d2c11221da76e16c3b0c8e180716b00b61a7cd048914a4ba5d0e287f416afc58c3a59bd8d9a617ef672b77862168c28f764498f14a49d79dc7d2e2bc4017e853aca631f396cd8b63620673d10b8e578c0466c04329fae78a07d0acc47a0171f026263078fe0a5f647ed73d04e017bd042b4daa10ba50ae7e7d18c33b146bfde1c48bba4f38c16ab267d8d2f15b7eb1bd1582212ed8d0306a482b154776e0834243b570e267f65d3705b99712fe98932bac2516efc788842894b650db38a2a2d16bf321221ad58a99f81643e8db2db7cff32be471d18bb80be76826f897fae6960e94faaa960dbeaf63b320a0aae03ae5602919f67d026ac7efca1251027aebd1371bd708aacfc5b230432956075c5c1f84c8b3134bd9f37732119f7643ec7a7d13d6e552fe0c852f43cad71e2f5976a8c47b975bcf42e513ba05249a37d8021fdc1d68d9fbe3f08470216aaf93a79b9063f2f5180ad6a3c370999653289e57da8bfa78f18171d3979a7dbb605b6b54251aaba180c92dacd7581f57094c33bf46cc851d4fc5172a27c250677314763f92885f4316d429b3ecbaea7f778483fe4871588d330cf0165155c9ea8256c52fd41d7201514f64392bc132aac250cacb241c4ecf51fc9c9cfa3d64e552d4475797198a6777cad13637b4e9d32dd8199599e6ab63847d4d6e13b093f9b91430d946a4fe9de77a916998a76a5b5f112ea019aaefcd709651440eb74114d7595d4aeb5cf0a54d6302533b9a36d6510e72952b2370bb1aad7fd158588528a741eebc74f64400ed0044cddf0537e9251e2293e572701fd77d55696f0bedda2a840e31eea290fb32015df16954b0f6e4b6c436ae3b19b5e3c31f2615e5fbfcdae78bcfb4127a195bb88c0d552a136022182f9c8908fb0ef7df78d3edcaf4e537fa70f7de0aaa24a211ff6a547d3930c5f101e2fa33247ba7ae8d92c3b7dc5ab10371a714b53e9897818f0aa8f2812c4db59e4eadbb6c1a80bc7c5f1d585844cd88b00b938d1d6ce3e6abf1233c45c4f1fa1468777a5f5638a31e8a47cd9d210cb740b5ea92868d41c17aa8675fe6ac3dc3cce82d84e8d38e67bdb4155f07ffe571315246c0c9fcf0cb5aa61016a20970d985647d26f881203818f2c751a9a915a89493e1055af9cec84f51490b8b114a9b54e86b384a15c16acbdbcbfca6825811cddeac08718ebb682594b3a788df7cd6a9d8aaaa2d178bdb230aa69b82d61d87f8cb643b5ee15010249f6f8778b5c40492378539cd9a732898f43f3cd70d6199f1d5247b7649db94cd9c0842cdf95dc7e77cc39fc55df836d66cd3f49ddb5d7593650751d48da5c4b518f48919b101746256a9998b3a9b8d86f4bf439951d451e79ee233349b1be8b748a732274c256e4274514f3ee64a9742e84b8a2390fbaebf9850249f93a44488fc1d04e162c5e9405ab253376a6e9878c2e07623c9075878e378f23c0c780f254f1029947f6d8f4cd5e95b566519d3809198666f755a12aa190bae3689b563a042930abb89626cc4af4d61c3691d2ad9316f7bdadbb1a69c745820571298600a18e93fe07af2a1ec3df363259f8ce1d33789c820c7318fb63054a5ad02f5edc094d44a163428ad8a2605c2f257dfc4d4750095f5164e1db23e6c7f0ec65a82382dbaccd50d9b21cfc596fc887d3bc850a646725b384074356b218812de30e4a19d97aa008342f6318b373539268197df0c550194ce56e33aeacdcd4753fc122b2152959e6c2c31f9e11994143c7f4eeccf503792dc21bc65bab0c40cc588efff083a031e92ff30e0ccad56c1abe04367803a4d537cc1acbcb49207aa9fe5ad78b1ef3cf4800d731f5775d54f3e36f88d7dcff6e2e52ff2c65ac132f385a9dca80532aa0e7a1632acdf2b50facc79422a9224869cacf4684f3075271ec6a14dbc5f71b62ce344319ab97f6e389e3f8664b72b505e5a85f22c35fd79b1c6b84bc7f5d60303269ed12beae494c60ef0dfe7be79dc4cdcb13fef9d56f9ca764d6967f796d821e89c6f59138386b8c456bd9d2d6a223dafc65604cac9ffbff2ee0292c465522f1d7b497f21414c2015e0c1b9bcfb3db94c2f5d87182bf163dfae4ba6faeac31e7149040f7ea317666bf1f3c97018b69df876b300adbcd8e54af8203d751e852b603d18ef60250f6f83551280578f62ab72b1bad09dceb3b0d80ba801a9a1811f058cf3b357b662a7fee7db860a43d38013c1dd045b6517613cc143cf94d411f476c32724d315a00e2da1ce48a1f90b7ecc56c66c3c7c4831f956d7b72d1242e24d51acacc15df9849eff4ebf3a9cea63b5d14c05f244ad589a3979667fae0b0284d9a57847287965bd39030d586f5431d9a4ec43cde987154ae29927e0f7900416ba4164fabf475df6fc9bf20fd1d1fd5d5a247c404c97ccd00d6a7616b48fbb365790cd19b678b7d50ea682e43a6ec21fcf6d8303bf94373950a84d0f777aa1209075290e174456f4f1f29ae32033625f5c6cb00d3549ea4f52fd85a88b19fa9817e237fcd1687c4592ddae3fdf0957096691a1aa75e6da09d9c3097bd08d285e4638cdf16df28dd19fa3038e7d11f4e9e6535c1be27ff2dd1711f53dfc67c28a0dd6f6d94ab770d08882a2e505da7d2e5a1f9afcd05ba5b12f8ed697d7be6b7ac8df76fb87f0c5d9318c497a0ab0c5f7ebc41dbb339fb978fa3f0ce0d447e881f71e16331aa016e44da08da294b020048caa0bbe3257c154826619092ae36cd31ec95aa03698577275e0b263871ad9097fc25bb45f7c2a6eb1c77151c55cc6b92cb76e95cf8b35a3a90bb5f4053e7536d265a8a21197cc62055e75fe154e0c446e5a3d59a1f5524e386a45c8912a5ac242c43b1f12344504fd6d789d0b10049ea9cb0d03057d4cae8990b315a2b14906bcab83e7f092f1b996044c290758b56e5749621694d40ea71b4af0064fe054fa864b35fabfebbd4057bf010c30529cc01ef2f74c930c09d40eaa65d66cdf37d1af7c9a849fcf3c139a72180c83b4e77a977b1a90978de27226eac750effd223eae5ace2996339741a0d86e241ba4b9daa9b3fe301a064f3ef9257c9cc1b2bdd021916e10f6532f332a64a8ee71b4ae69fce92e7f214f174374331ab5b0ebe819a97f5ccc006d7ec789bf8a78b54823bc2e87adeba504c56eef0d28c1a06bb95351aae18b66c7bc2c45240a817e949b47669f2cbb27bf22388c060142796c98d213584fb9f7b9889e97239b956a1f4978618efd7581a58eb0c7d4901f03b4d911c99138d3defe6c46e65210b29741352b6eb3650629933211c3dbc3b65f326b205087612184a5dcad9b7ccf5305f4c8fb767e9b3065a278b3fe81ed63d688548f01e1066ec0c5ee076f52e3186b82c142bf50e73b5ee7a064ba9acd14ea91cb7eab1edb3bae5668ea9a0beb767fa234e30ace22aa7f22b03932febe40b6d5e7d02d46f08c934b19dcb6698e8022269b0fc9a2ece43f188a3069c697937b4745d7b9cf6f2e82e5728718c31dd5d34b63f82ec4e7915921fa7829c9fe06fd034bcc63cd5cc5c23195364bc1c420b9d760c20f1df875b5e71aa123e9e341a7bd44ec70f7ea820a40cb7d7dafb614b2af7fbd97a2933c9a84994cbd103fe60b69a8900e6df1f0daefc983dbded29c559df033bb3bb637467b338500031bdb54929949659fc326e5e7d882a807f2ea27477ef07018b3593e81cf23e4cf60073c10509d59bede311c8a16834f730f1f6b86115cb317b3d9f32d5ca7a562521c57cb2bf63352df5d09d2167b404f3c42609defcc9a367f27c01b94c79e68fa7603b3f9c8bd32701dc3acdcc246ef994c2f7495ef343f53a177cc0bbb34bc38596f03d2f62c1b6789f3c75594535fe027ad912d2bc54e639d8da41e7134ce2e5d89f66798d0a55ae63131c42f4f39b67bd38e216b1ac231b0f5c2c28cdb55c013a39419025523585938ee82d5c8b6237cd331c5ba55b977d7432e17c663aa214b553f2f085e750098b2143822d2dd03753ee121d3d2ecd7a140c3028decac2e09bcca03946c2aee711dfa56ab02a3b30421c2228949b352920c337127ab439e58f4570a91813d204d342bc5bec8ea191752c51433a5055a5ce807aed73074cd0d15ceb751c0f5ca20daa03054ca0012981114750de99e37caa5dfb4a98f3aea6be217a17c5b846eb1c6650a2b77df3215373ae8e6f278bd134becbbc5eb1ea7c4f20fb184803dbc03063c0d054de57a466261ad7148cc03f7c55733336ada1b631fabb68f6d85484e5e25aa2df6bb4b08f51e02af652679016b8e31e8e81407385edb6861f3b1cfa455944c16b3bd0f10eae6ec82f8f2ade4f13da5a98ee4e197baacc8bacde708d7ef430971e996eddbf8616dcb61d11d445853187f1700a695c7c3b1e4fed294cb2d80473fd4d41f3be2eee836413ad9a1366d869ad2a74fd73107b4b75606e2c81d5190a06f5a9faa1094df0751d5df2f9bd2d0147347c02f5338ab399462c2d95fa16119af2b360614b20be3c408ed065be0afd2aa5e635aaaf242efd2d24e9ee0ccc83736e0882c15ad5cdbbbf3a768ead9120029e2e4f2375a0bd44c7c7452d2f76efed5ea53ee5d20f0592677e7cae1ffae0a0481a4cacd1829f31abd031354494db95f183bdec91b7786d8621b1b71d75af23cbeb5f7daead881f7c77f65c68b83cbdc678ecb4f72e5b0d1e839059a7e18bb126d8703fcee1b516c2ef622f3e6c0695d0a35a56faf596a208474d976ee49116f160877a3360cc3d8aa6c3720b1e2da528622baa16036c55c57cf854e1b1fc6e00986bde0e04564e5d7f7302b7bbc70507d3231d0f1e136d352b51f2ec8facda5fac9d39522dfe2d4509849275a4a270fafd3cd879f0692b6f3db4132eaadd9662d0cc7c222f6e265ce63278225a61283c1f15ca4d9b24e859e526fc16f3c7f17b65dd065cd68c90751ea56557b7530dfe710c27f8daf2c0b6d4dcb9c987d32b9b869e3fc33ee1791256ed6885bf01ed00f14d0740251a3a372e37bc58e6259c2c41cb0a1cc85aa6f437638e8ebc3a2c6352ea3658314a2d5f87a211e9e071c542d2ee3023da5837440bed7645aa9e0caceed1efc92ea6765e33310bdd83a9b14f355220b52a5e2e7f171c5947c37460c3c5f655aa9461029346b18ab8692911bb3b6515b327cc0d67311a00da3c5717f993ac010914d605f5d348f4cd887589b13a6b517a772a4d482045f2689147814643689e570b2a86486464a318dc74c6eb0dac5e92dfec033ebc8c81ac13f2dff7175da058bf2b534fa5de1ef3a7715f6f933a1ace3315aafb841d45337e80453b1ce81c49828e29770d4f52bb98c84eeaa22a080a62e4475f4766e0d24e4508eb8c4ef7272cda65037a1d2444fc5fb6bf51b32d51e6fdf1a29eff1795e8d75db6bed59422803b7dc27af15bf31518ddba97f6c929a66d211b693235ffcebf8cb63cfc067cb19e3fa488274772b678eafbc31de658823ba00210af331da778edcd83c4f3fdb3c83b4cdeb97205dfbaa160687295fb840b2096f7ffc8ace0fcbfb771292e6a4cd03d5fb0c1bde868b4df08faff7d6812558b2c4fcf98ae7cd2e8a8bd2ea0d066069adbba4575a6b5d04ff9db8f7cd0ebd6da3912a4e9f8c043232a28db38e1b2c930b4270b59cc81f43fcf238bad43a2661fec37505d3d21b7a1d5fc60e7c452620d78d4ecd78cb02ad4be6c6e614c7dcf46db48fd078476278e7b16cbf4773df6808ad5d043b1d1a2c083dc6416bfcf0139b1bdb5ea74e27f6df34e02f45613c5aa4a23b1354e3622454b4513264f3e9361c43f12d06e06d82bfbc3cb2e786db2ce62c0db35bb50c5d75e21aa81c79da349716707118c310978a0a6d8eea9ada1e51e041a5b570910f204217166af883d3eed176a9c8aa42bca535282e05096188c0b11a47a2bc04be933c8bb4354c3abb41bfa39e1a98adaeb384d884869837e8d70ff53427865fa9f4b5eee550c775353e473c270c8372df59c44a12c9164da01a98d7b434579ff7ee4718a0e99334b3f3cfe8a198b4f8dee70b26a9148536c79d65027c2c0faf9fef0b3f15e54bd9165d7986da30fb470aabeb7d114e9cbabb527d902a41598ba522168c36caecc20d9d9a760b856147fb8423bc6f59bb235edf761a8e76fde61cbfe1d062328df940e472581615e757a9eb86bd25d85bc2b4de3db8b881c6e5816de4a6edfde476667e8f0fbd7c35d7956d2980fa38c7cc6a87c2d24040f1845ddd165c33be2e90b54a428c66d16adc465d47ca1e3718c2b8009db3c408f6c88ce72d5c7869fad7d9200b8ab714d5ffbffe9dc4b6b1cce9a876271f027e832f95f52d08fa053ab992b920585102e2d8094e29b8ac3130afdc0cc3bc0f7390ffa5afd6c7163383db19d6b1486315458364f9369fc1b801cf683737664e2997e672f410ad04a2e03f1b8d92e470099cb6dc4a6d709fe6edd2fdcc3c3722228ffce8db27466725b466a0f74af137dd118d5385adf192588f332502d214c5f9981616b7d0e5a4ad99fbc0f38bb985469d4017e76d191aa8ecb2cc2970406dfb81d902bb732065cbc47f4aed3ce72504e2254d7d0e0662560362ff5def6f481bde4ceb3ba3854978dfa80f55389ee94709b4cbddaea2b57230ea79dcf318cc3581be15a91ec897d70f0fd08d3b98e9db873f965f3b8c8fd43ea4281f7938f1e972c97ba894a6b4e503e3315adf5e7b0aab83cc69436fab329fb347633379037cc5d7a9bbbe899007a5f60e6698a10485b49b61af486ca81c04cc9de3ee2576d4aa4164698c0769e3b727423a8396b3667b43b4dfc7a637d8605c8f690c34fb9de7a78d8485d21e72b3b36bc9fd24b3c1538347341e11b9cb4172794d56ac48d4caeccfc09212032a7056909afe2f948a2d3315b04fdd4a9989f4f9ac04ae41c887cb58409a37d9697a7cdc0fddd09bf29180aa31151e7301770d0dd1ec0aadc38a6fb5b901a4285d1e3419c12b59313ba19dca34666bd09aebe5f42ce0ec757183a26cf42c705feeb2291f4343a2f95e7e22c9f3a051e528ccbbe3617ccafaab59f7d3b6604ca884865bfc7c5a9988a15d953a625f99e4b2b943450447f7284d5164856b5ad568baece23beee87671e9b73b3ad070a6e91ae51e26770dae126fcc8c74267428d4723129a1bd977acaded7c25e911c458583457afb68930a429cf243b8b283a4935f192b884cdaaabbb79ae5651d4c98b30dab02d90abac7f2bc8d426c51df7fbc4d3de28d4adcb920f9194bf16aeef4a5e6a349dc06f789d40220870b167b5d7575eda8024f6590d27a9ed2e2710e5a02e4c2ffeac5c726c7deef2bb9f143ff78d500f0861fb5330e3eb98a2db1a14263899349d8e76b10d69d4b6f7e63bbf8381a0d29808725a5688fbea6b5a4e4412d1d7bda894d730d6fb4534a1f0415e4bca4dfec2cbe7bb55913fb5d0c85c151cb97d681fd50f6a19ce8e94a9d7c6850b4a904ec282ea8d5a71e5ece9c15bcde0ca1bba75228951fd488af40cd4ed946bbb23d0ca0d2ca64d4a9ef46ae698ae2dc80026c78f9a3a6eae52aa8905035d88b9c1b6adac39b2a9ff7cceb229e4e78fbc26b7dd14f456fa6de56cb9cfd5353a33e7a3174b344acd4261fcc1e2d5df455c15f4554f5fc71761c546d4b68c2f3abb4d09f0baf2b7c2b71cd41b7fc84682ba1149d3460067f82fb65d5c3194dcd2db888695a8bef47997a325b638ba409e9f507bf42c5dad23e9b39fc210138670d39664c2e4018d976d1c2062d67af134235ebb40ec62a5b1b465537a19462bfb23347c3c4e07c0ca177fb98cfe0976e1b0de01674394e26d9ee77dbfb365d6ef773fd80553de20896d9dd1c6f089191e797f1556214fd8064c84d935c8523fcb81b93fba092897dfea4b98f735393f1e6fef2ac939026d98f498be96b362bcc896d92009c60d8a616f644b0f1bb8bc4238fd0013f13e4fe209611a5abcf668661048661f88d117c86fdab99c8304ccdb00ca33062cd308cc3b0c95fcd3a164326d87b2192735887d6d3ba51eaa38bd8a63b3be59c15961550a15332ccc36acdc8e6479dcbb0f175e7b0bbf40459067469a1ca2d3bfd40de281856efd429ff9689b4647b446b357ed8a2852c4565d41212a443a11ab795bd21d7dcb70321b9b1c4ecd96cc680a6c62dd50ce0b168da95e26a5c53499b5dfb1053d5de367ff405596c86d33b37c25cd73a5299dbe02e841f177020ac821dc28546444471d9bf5c167c36aeb509c9b047f2e618aa384c5662182b7b39d94e4ace817e241dfd6a4ace4d917913b74db0930656ec5df2a9210f7eeb0e200b93300c9089fd396e16ca8c35ef0994d782049b7882eb36fec134da12d0183811d1d8159ac9a5333b1345d1347b8c4234efa12125ee8a59e5a79fe38681236c5ee6cbf569eaf228629408a989f653134964f75e9e568e0ece1d2de6714af3dddb91c9c7f60ef2a9722a4dbabde498101f38e60d694f641389138ae678bbcee97ae101919760ea400d5447ae5f6013194494d37d8ee11e533fd8ee4ccdc03ff7bcf6666b64901d70e28738fa17c644c21798b936f8af65952dc5443aaa040a1f4292b83c3768c55eeb1c963455da52ce38c9ca6e834aea3bc19a5394d9c2ffbc0f20bed87d54701e50bed498282c1d459d13bcd1cd2a9cadfcd9f2764d3ee321afd0dfb9c8a48cecae10ab641c9f327353b28e2239b633b633c78e1e26c58c3f819bf9bc5085f6000e59ad3a6f1f0d07b60502b5e22989d82aaea2fb41fb083e6414a3a62a3826087ddf8eaeeb799e55f0bcae2acb9ee77bdf4db7a269a9c2cbe52024e4e60c210e2d774e137e700107175563374b7c7363ab160bfdbccc1fc54b465db39d25cc59f7f802260e496dc45fdd49463fbb9d25edf1d3d9ac15bf9c331a58b8fc248330f04ed5a5f86bc8c9883f3c10f6c035374d95806f52f02bf91ccccad5a78b479f24e989b1375135f5bd6f5634c7673bb6f25aad2ee9873e5f233200f6207db8ab0c3d07143dcf19695b4ed2a113e77fb8fb42674961ca57da131b1de4dd1e15e63420f574d94ae996f214bfdfcd0969e82b3f2699d57d7107ddca3f0d6e548e38e21b59cf7c694278d32c3afa068bceb1f71b4f0a4f50a13d1ea1a5d4be6dd4bebd97be5d96bec592be1d92c9cab9819c38d9c3df363b762c75fb1c37b3beb5705275a837c9392d71da952c81251f270453ccaa477fdae24aa15e8e813ec8b84bc8d0eaef296d28bcb7c04b2a429a0a6b03a9c661aaec414566f84d6c2b8910d90ac22566b1e3b9aec94061c6fedefa617a92734347979f3bf998d063010ae13505d716d52d5cc12a647fefb70e357767e30c969130f1e9203a4bcd5e9107482b1860db335d85045f9c6fabb8e13c70f34aa0b79e6cc9c90e7cc4420090043199662a48c1f63beb8a626723e9991a699d3409e1f138b56602765611d57b334fcf606d563b2c628203386668898fa91bde925733b19f51e626c95867fce09db98df8dee4fc0b62467d4d265387a4b5b5c60f7a14209372e867880b2926ef4dd3c63df50da10d40196b560186ed17486094b884c23041e51594c130cc7ffee7fffc47356f637afc8f7fbfe7aafaf7fff91ffff6fffedbff5dcdd3f1bfaa342ffff1fffcdb3ffef15fbdb11dde7ffee3dfdd399b8ff9dfffe37f4fecc73b94fffcc7f40765f8efd1bb6cebe6f8e73f7008fa33b66ff93fff716ec3ff28d223fd67ba2c439ba7473b4fe0bf56cf55f51f79936e7b79fce77954ff8bfa8f2cdd4b02fbbf0a8896dcbf6188611829a518d6f8db3ccefb4f48fa3f7c58c91d5cf34f63fddbfd93c53079e24579201482ebc70cc3c87f621e33fcc1b1e2486a82d88559dbffb374f8f3552a0cc3b88a44083f40957d38e0188651f13f46bf3f3cb417f5b41513e89ffefd59baff0165823f781257832bc4c508dce40cc370e8df0dd87f9810b5c5b735db1593f327a09a7fe90a7f9aac22f477188dc99d2010c330f01f5076ff833706bf8303a60411bf3f78fcf5773f7f9dc1ff89d1ac3965f230320c63ff21f99b048651d07c38289c459a210e1d861161eaafd11f533eb6d4e5e852a919628761a2ecbf02fe9f0d3234c656c19c452ec6306cf1870933ed7f9cb49e0b2bffc6e78a118161a4bf87c17e7ff815f59af49f928522f6875fdcdcff8da7308ac2a4f01987f0c0306cfbc7094cf1c7d436ac94f08d88bd72d46118c9f983c4527fac4e3009f510c86575f88397d47fa6b83fa0ac0e60be8bd36812aa23c3b0e3f197fa5fd74b055d44d0df5ffd7bb4ccc723edf7970a2f50ac70fe1d346d4c13130c69501bf1672e665906ab6cb0b4601ed0da805b9654e3086c4853ee4c350c3357bd50a7da47dd95afed2a6230d6d3b70a14a0de2d79b62a7faa86206c27842f2e7b650223f52a9c142156dad9ab01fc3ece01b5fc32922edc2004b0432d09bad07453f46ef08ed2da12b058ead4e9bffc4988d75f2fa46c0f368a4324b21d1722894f082abdd2c58ec41f189bc009ac457556f6fcfe97ec399a145b2e46819857204e63943e4da52dcf432d3c99a2b0e222380f2b989be8b7cc711892d2ff1c63f86d0e970e876987638c57ace041c4ca1e7b9e7a620b41fa77060c3b884c8d14c8aa8a21b42b8dd06a66ec6daef6543b3893169e5304e7fbc00a4f5f045439987c5b05a6250ec3b8306890b3d11133a0df0050ae648636d902fe600147450047b20467b99041b6fcb8ca734747cf8539a5cadc2e99dd2eebee9eb68144d128220eeefaa2df640e3f3b42b712f0e600a24b0f37ce4eabb96bf0fa78fb657dba60632a9e07ec004f29653bfa9c049d8d3f66c487f886774d79cbb5d167bf71d9a53f13affaa837a8a91a03bddb3c0f222ba46f5cd9154e1be68272ad70db64fb990a5481e4cf107fdf707d746013817544c85a4585f03bca21a7a30d452d322001f2e1d17eba9c96417b969a79edb6419ebbcd8c3920d69ef91897d53ab938130b37a60118f4adf6d87c1b7e229608764649dc2eb27d44c26f4356980339bab5b4141748dd96ecc9d6a2491a1b9c341114db9115590bea0e2b885b1b7aef80f48ceebc4e02f91de88f5c1002ad903e3013ac5c3ca26eaab8d97e483a477cd550b3d403af3e8ed817736a4401af2f1e353ec4acb733ad5fa7613d996368903a776ebe671b2fc6874c87304ec92417cb9f09c664b752d530520f483d74d3eaf0f0cc2848cd95375b520200314f99c4288df1c1cde8de0db7b2e30d3b094c29f3ab99aa38b8c025d96dfce9506a528f9a8bc1826d7b6725716044e1ec2eb7c4fd3caa8d0f1966c9c15c3b98c587edfd3159d617b14aa0811406e5deae98355b609ead74bcd1fd85387c67a11a769451b00eb258fe3c1f4acdb29c5f9ff552db2693a2a9526394f0074f119095bae7f825316a804cfcc11fff39617242de44aeb40dda995776033dd6c876db762d2212010c79b58cfe691b26663fe84f7dfaab28b53ed3d1a85b428c822d345ccba0648185659006fae470eb3259be9de116aeeecb465b6233d667fa8d5536c6871585f0192a753873662b22fe529ce26a2c9cf7fefd42374ab631e9e97e89a216e2301ac10ae1b4cd5574c9639673646ecab65c75f6f0f964661fa416c02e74490168afc0dd14baaddcdf36d3edc770b0ee7043cc1acb2eff96138221045910395cc8ed7f9f5ffbc3c95b246efef3f8e09ce96ff2b99588dad3bddb47724d28f8b53884e44dad179bb8432d6c3a8fd5afeab4504e1acd97d85470514145122eb2b46fa16c0d641b8699c94a59a1748fea1c65ffda0455104e0f6e2ae59913d267469a4845ba440c2d6207be422e7aaaaa01af90275be9731a93c1e875e252363df7d93dc2c4cf16d4a35711e4b99051d291f942ea956c89eb94b74be666104567c8d1905f0f59127521a898c5387d4787e62729d8ed3909c66b863a7c8181894e74048551ed0ae1946356e9cedb255720f6b5d44962c2a33981880db1205abf0d325b10dd4be0a4756b7e186906bea7b2bc64555624de5f2e49f378790d880dd32a47ec9017b41445b20b1ef2d62b14f494d5249c6d064764697610fcb189c8d7894546721d095a1a291beb449b47b7c06b0b99c0dd676fc89f541b223ede372722753f3688f3542063060ed78b1e5dcc46622a46ca4aa2e9d927c83042f37a2ce90b782ef4c0ca2b95aaa31f25fc176503e11910099ffd2720c61413addd6028d5b54786a1bc1e6d3aca42e50740a05ca99d08036cfb2194256591c48fea4f026b1e78393d557113443ecbc35dc5a4ebab43eaa9a4d875d74d50243219eeaee802d3fad8a422ffc66a6281864ccdcd0e220c89119803e55136482a28d1987b2da8736b99d4abe8b2d2b377db379aaaab730f19d1aa100f75eef5ce1cc8140c0784ae80e393b80d12dfb7212fce76b69074b31033a1a0c07da2f8ca62ff2cc0109908008e1e0460e7297f9748f046146aed1fd4b5c94e7fb86c8064486652f700d1a71b05c80a106f7a94fce6ebf9520c4a7251c68353f5511368ddcc444a24e88c14b600e6d500184d0f8fa42c25bc10186a8ebcf2f14ea10f5f5d622c9a2f25a55b6d66389d893742f4c33c5538e34f2c086e5195a3e3025092d26cf668c26f15d412c6ce53c1d190aa07a8d466d15595d9e6f267795e97b82f5b4066a3b4db5bb7ecfeb0c6e4647d3f867efea6392eec9f6fbdaf0f8ec0d7db7d7f217ebad87d44edea06a206ce2896df26db6178aa52ec8bfaee6badabd847c34d9e3a03f1f5808bf79b7d455cc22599207f0fd518fde5f5c7aa6710d4b6532f796f10967836a4082d1d6c3d6c0d9a36ce8b781e9879afb592bdd3e1de676f47cbe2d6dcf5bde1e7d83181f9bd92e0d3d872dc4ebfddfd322e6ee4faedfd6b33dc8d79da4f002e5c88af8ff89408b2b85f8f3c5f773aa7db1c305fb6f303f30afef25a8cb18351d4c868dde59ed7c068dd8564dd1e6f0ca1fe29ed8fdd936c0e85e3ddb860d512ad3e71b6d7fdfc95152be976f1e976d178103626708e527eaf248b5463c7a5204bfbd26d01f4c0b3f3356b8294f9065552b088055cdb030d774a11e1d3597d561fb43f364fcaf302f678053b7cb60aacd35b6a5741b3958cefbed64e4ef6f41e3386725be14927c38c11daacd7121f1494bfb31735b10407338bceb8dbb5eca2bac67d4763ee38481e25d50b417ea88dbe1df05067b124d41b0c5edda026b0e97a409df9760633e288d7d5631b78c3fa0a0a6969ae709fd31a3308fe33011d84389e9eab5abd7381abeca1e02a87725d9db3dc4fb38f620bb552df2f6253bf5cd0c1224f73c48274ae023a12e072ec149d545d8c087b66243e7dd3748286e5e6f26beb7b224bb6b6850a5cd50091f1eeafc54fc442a06c3afcbcf48df91117252e93356a106d4a92e7ddd1ee3e7d7e371548032356315fe7e37600d3dd34e86a473af2cd9fbadfafc1047cf45db5c7c82b4bcefe498ddf59ac68aa5779a065346b72acd33d69d69e419aaa6f68a11f364a9b8d27f421e39f14c73196bbbf78d9fd52ac99642a77a5c3d5459ea6ae81b3950e2302957bfbc041064f8e691c6d2b6cf114256347a7ce0c7a293942258a1e4d252203179cf14df949e8494016828d974dd434add9ab0a17165756119b75ba3c5e81667820cede594e04d0b9e79f561143b5d001ef3becdf327e32883d68e8ccbbf39c355fc1a848acefe29c01d3bd27996d2c314cec34d86df0b08db1ecee924ac0fc1eb44269d4ed73496d809d641e90b15f2b5e93baf163c92a795c6cf790cf19cdf125b566d1cd81f93fa9cebffef663eb64666bc94924b66131e6749efaea779e2b9983257866e14516711ea3625e9897a9e47ec64d62886772e5da3f58571035c7c759cf174d270059af15cb3846dafe5c2bf4fd4010334d661da258ec188971838f60ea99e31a853183ec1ea2ef6efc8fea18c00637f0ad401ea42ed002c90e902fe2ce27b97980dce259b02e48f97ca8dd96d9b6ce0ffefd4e9924e1f6f21358857a468811854948a1afbf3a40a465e6d83a0e832e41f81e3bb3062fed08621d4f6c844078726750b5b04fc2df80b3be3f246e1098916ffe3cf8900308ac6e935710e3e77fe6c741fb963e4766a1bbecbec524c2395adcb4ad2354157d1f551b5c8da9bcb3db9cf26add3c9a2d06283f499d33a45b914afbec41175bbab8c64f5cbb70830932db65b25b5a343b46b23a43fb9a269494e2e50791fa1978d8fac9433aeda8632567d3284233dffa7e1e4fda2c2139c2faeb942f755e97fa85d0c737005e5c2832b93b5a7c426eeddb8d5b5287bf54bc8e649184dd9195d33675ece35f004438cf0fa788ec46f9bd6445c7ff7c87b919689bd7e56f39849fc59de10dc6519883e108c65098e7e63dc69a99d1e169c68d1994e13bc68218eae669c68959e1e66346f3198ce11e46bf998ee12d465598c7e16146a7988de14e46704421ead22890c8df70b0820999fc6db18f59d75631fb3b3e3acf7436ac3309700cb7097f8ab8d32c26aab92d2ab032c4b12e83a6db33d673ea2ae31d3722d4504e873542676eada640d6777e3945240674697dbaa04478d93e90f7bda117ec0e21a08f2b47663034f1db080c310ec590c3f1196963810110d3d9e57207fe5819cba7b3b7b7251f22eb608516907441669500f0aea86f8f0ffef7a34d87e0cabe61ad34cbfd93279fc93cf7dd06663dc042e536342f5e4ee5b489c403b71cc2914ddff5d085b297371e197f7f2ee323c7c1aa1b00737240a182efe1bcbe6c0232aaed0bb6ceef8e23b51083df917f18b28bc5bed973ac18eb170b32629861e9dc255f53a3686aa86acc3befca48bc5e02c74a3f999971bcf854707519c0afbd677b54afbbba66f3a567f9933c32c88fb4415b3dcc2ca48afb6a12d910d1eb1974ebea94e698632154c8b81d19ce77397788f0a6d9eccbbb255cd4c90fe2fcda8a9e0e050a4eff9d88e0500319c07c27869cbc0008fdbd2fb692c136592da4a219b1acaba92c5f7594df46ca0c8e951c65b23125651f7801de2c1336f79ea6545bac601226ba81f316adad7652e247bc2a6d817b30ffab84e730dcdf12d205820c4363c806d32cc2f87091dda83a25d158e8668726ba30320c7b203ed4f635ad6d1cb779ac36b44b540e0a51d7715f569ad26adf7887dcbbb633a7d8dc47b2c035ecab283d027b3a72857fe4822619b3bdd709922008e2f74e9e6a674f937c9d4459e4dd5910f973836fc4a82aca8cdb6c72a7cc4fa3d0ef162bba9d92d816950b728be16eced97cd53c9fe41870545a4275535a1d0ef7caf8a4814e2b812a68b9f8f71b4e13501fd71eb17a3665df82f5c87dbf0920e4914c9dc9996242ef3b29628653bb377b67ec3955f429e43c80b43d2e998869734bdff0b6888dc0ed7c312b64bf30538f064dadbee12f412fbfaf9daed04c04891bbaa60edaa1c8f7bcb33608385f044da36e40fdb042b351b18663810d920a049a6198848ba756ec5701162e758dc1d60b917f4a30389f6bb906b60f7b2771aa74c4499fb7f7017c2d103e820fe2186fb359d1aa5cf018e0e5381ded679a2768003a0fa90a28f1064676e013963439138b02f0021b152263dd5eca97df62ea25e289ac46dcfda31414873ead85c2ce60b94efef8988c8c1cefc7e11dccdfed4f89b542ac8f852f6c6638530ee9e670ee29584354773bca93d47b8b85d4d23e1b3d026ebfcf24cfc7e41b7ccfeba5c78e3c41fc0eacd5c37ed9ae2a7dc7c5a8aedfc7058f00ee870f37bc2ac52f8600b12b9c2a29e79d3d0924e82b6adf68b83299368cd3804445b8473ce4399164f80de5ab4ea7d4913f9c096a42a72590cdcb10b155c330c571bba471ccfeaa73c93246828a9681ede556288ae9206ed5366681a24f80de892578024cc847dbdd84595f880323386265c1155f7e29567272fef89b122b8309590581df3966f588fbe2c5c944eb342e08723221807afb43a23f9782cd9b01ecb22b15834c44d9f6f9a323b008b3ba9ba58a479f1db3ce2a40698b3c606d6db09e27a68e2a0b968e918318dd5707490627b95eb684710aa14b6ca60ba3978746b5e7d8b8369f445ff01178cfe2d6dfdc43820ef563105d43cfce5b53dc3c33eb51c0a2af7dec92e1b8689e5fbebae998efa0daf6823ee979db9c0a89441b74c740b715230c13683c6b3027787e70b164ea4a0303bc8cd94d0c45c7eb99857dab0e41106f2a07805ffc33fb7663604b28528dae53470967fd85d2d5ab2f02e2b496da74adddf419c473ac060231f6b54ec6ab71e9f41bc51ec0b70739fff3c409bf6c69c8a282c5232e10bbfaf9e86247bfa70169e7e3b264f1a99cfc78120706fdbd131382e717c0e94d6b9f8cbe6c202301d332d09bda915f03df6bb3c458618551847f574901306d0277b0c400334e17eaee66764be777fe3822d200693d0dc470fb2d0e9afd577b8b76ae101235e2aa7d8b36302b36481fe46f7a206c7d4aa360fe662c7a3641951f979a6ca5c70260ba47d5810ab38036fed76c10f35aeac75c3162787bb48b794b96ac59accdc12fbe278652ed8b69d0b7b82ab73eb9dcebc23c9eea1eeadb1a54acb5edba68bfd5d957ade6dd526839910fc069415f7d502f0cd037d842cb33045108c32ca270212c354646beeeac7dcc2031dcdf4a573bb28a004dfd3a875c10f22b318f2e586c63000dcdcec83c5020b4ec6ddbeebebf083a3b6e5cab01ba9a5a3e426dd45ea00b8eb20301e71cb42dd93b888e5cb05be17477fe661e747ea72a323635dcb5718ddc365a283b9fc38c79cabdab2343e5492f704d0f336bdd7a6da77addb09fce80bde7b80c315c2ff92085695fce90fa3bb23dd47146c6a5388a94d6761ca35dd745cfbc5f18822a9369dc2fb37f81e78e31ab06096035cc3643b3e5913b3e7fd001abcb237cacc352dacc8c5fa7ac5e6b5b33c2c6baf85c4f38085cf0483f08a8f502630e6d55e5c8961f7543ab810569fcc93c55d56cfdc2e3dc27a9a4da282210501ffd3d1fe67c309c3d384424f94817706250804275e98a66d6b8c9c3dc566529c90521ef123d441d05e00e81e3c64f6de8caa4030f4b7f673876402974434d1a2bfb24667853686cf34db4b433aa510cb43cbfd8f9213e837baa5df1a1929ff92f567449b6716a816dbb8d271f40c73341161144f8d6ca51b3ecf6da53d34f0cd3034358c04f125dab5521fa49b87d8b6eafe67b0866da5a18f62fb974e14ac3ad1a50850791d0163a82d61fb83916dbfee19e9d2aa13e6c450f3a1d0f70744859744349b49d326c6bdde64b29cf538eab9be3e1243c24f633565bd4f0f1067eefe61a7d5f50c1a8ae57192d27029a1a8c22efa77c61c547e915e261e9811ee732d0de6604a94785e8dbab6d5772f526d62017b063553de5dbc298b2d324beb0fbce09ec8dfaf4c6cdf49a8eeb322cc81a732537a7222e86cb50ed2cc88674fb9db0f202eb6bcc9d5eaf9f6a5730068b626d4f1fa581d6cafc4818cf97d20a99a55672e46b8753186e45eb441b2a38ac64f86b97f372477aef8f26acb0f0cb736d097e812486d23238ef4f12121798d850504ef9eeeb7ae62cb841a2c5c05b4b7b02686b71297ee2ad0afdef4ee1588f8619f7b0a98d85c391971fb35e8cbc24bc3fbe1fde7b8f17809f4283ab816a93050877e134ce3890768053754cd695432a619e3193ecc6d0b4a9bfd51d1ad797354d4887d3891c3e7206c54dacdfa86b9b47d55323bc2460ff66d82d69dafe41f353b560b5dde28409e093ed6fad0b9e278de175377bd38496ca00756d5fdbd340920dd325535c56c430209ac14801aa37f6eb36f1cef4317337f7a3ebbd460edef067c20ac1a35b746f8348d75f431301030004c0e842093ed9208d5fd75a306c90dcf381104d37c65ac7fc71741e2b9264f3383363557fea6f85e85f8ad8a07fa5d36158e0d9850cf73e8c44b1f0d3dc58a1ab03a429af9e0c6659288217ef6264f82be2cdd0f07bbc24f4536c2ed0b315623022818467b337f276536b43fc8548a7aa2e146701498e509202ca3c13f717f86dcd7917dfc6cab860a7fd08975b4674174c9cd7aa119e87b31000d8cfb69874a60360f9a02cce330c95de8c7be3c16b38b666a9589b301a4faa75eb6d411dbc4a2431cd5dbb7d2dd4c89df12fbf7c8cd6d94c8cbed3b5b00d4650ddc294aa54ee74a33004c190588232f789f7ee9efd56248d38d71d81f86965340974c1b8f987e7e2ea57b1428fe7a9cbe0b59352aa4ec25629d761655d26236b8ad972725cdbdaab61abe543dce71f81170cd1bd64a6abb0e20b0dd1dd7a754577940ee018089622414724e0572b82db1df412991dc9d697ab97868196556deda78257b094e0395b51d2517762ad2ff2e43c2898f555dd4cf51ca7f2e49b7b75eeca72bae07a770009a6c22d2560bc999b0b7a68b0e3fe04535dad10866ac852c6dfc89cc258efef5cc5bce9e2e9590679c0aae729a7f2103c31fe681cf050fe1276bad6c770aceabd22294a31ff726e73b86b0969b9eb5138b967ad9507c8f3b4d9c9fa3bebee3695b8a0b7d76c59703087b7e0401020db068300c8bc9f0a543336bb23e0d2d2a827dc0a1500960b17030ca8cc139025b7ba143e28ecab9209a8c86913087f60509de95148d1c4d72705b81975d4b24789f65672a67efa80d3fa68698623de63374bd8da10ffde265e31ea93137917e01b10da90fc49331b27db2a240b436a96cfe8de593f41a4baaa52b42ccbee1f2474acb7f3c23a1a13198e0542128f8682821f96542bdf7305cf60bae9b85e02e0f400799399949c068c695861f7255e14a9ed899b2d9e4b183098278126db503bd58b29fae87f4ea35812b440b37c96f94b91533645f70aaf03544cbafa4d90fcb00286272505fc2691b050a7367d227a883d391c2737180660f17cc62f8ea757c2833ee41ef6d60986fa7c12d10a7f52ead1758b15d84ed70ac9b5ac67cb58c5dec707dda0e526d61992cf59edd4a60d58a174f54f2753203d4712c5c9019c60c7f6a3f0b781c01cf91b791fa2074e363a18d51a642060291f5db8d1c9121f5315da4d5a7947ed7cf56623967458cc5f7aa21126421af4bc133ce0ba83fdbeb219ab80eb2535e968fb9d0e8e71219e8aa46ff9b080f8d43b3b3ba085c3872631a84646062ade8e58a77ddea810dfbe86a78459dc85d9c7259d812a36f8a43198df1b0867f665030f305ec5580391aeee4021f9a54c22b1ce858f898536ffe27e054eb501fa02514dc1fd52e0cff832fda199c47194621338f81014634155b974f6c6b79d5f28f9267b77c7c08b093fabbd738ad16392dfa5446d5b21db47d275547364e62de3789bb920ddf9088ef144f4de929cf3e6c3701eeb140885b33037e836178bb3eed7f80951503322309cdad8d3b9381a133db29e8536f5ad7455b6e4ba852089940d7875268d13f7cc69ec1c9da5f72de35c1b896ace6e2146b7261cc307cd79082e795b731f2102bee9b9b6049559a015dd2149e70640484db8d1cd032c09308715b9ddcb5546ec589d633499d37aa9cd3190caea93c9f9e18065d0069afa4347a1294d4511887fcfa68a304cd079ed5713ef4edfea3e1c92771976e325bc410c8b528e926f6752176bc9dc2979b3e278e52322cae8c5a4b5338b03b32c3b8b7aa8302319632c4e17589cc95a0ca1cb991ccb549f22226b178a78b3d8fd41d9eaac56007057baeb2144bee737f09c1538aad7e8d330cd83eba146665959d5fa94b5d1c46ce0b30300b947c6c7096e119e180499ef87a5d14faeb308098061045b762166536a965a762f973d95bffcf7e0c526bb9753d118c59f37578d9078d92f6331f4d4e1f48ca6129988709aaf7af5f325503fea76cd1ca73aa2b25eab6c945efc829b1f7e1bb11665f5b5160dbed576ac87573dba591c177e2e29a3c63ce00053508894eda9fd3c624a791bd32464b734a9219265e93f035b39dfeb82c95fc8cac98abd1c7746e5a09cca4d1f21948cc6dadb696e03b8d59dc3b13bf05f9441301e9b72a7faaae6ee3d43a69ee912d4118e50ba7411b1f2ac44b5ef5dd6cc67ee9f514d866d0dfa9661b671975435ec73a1ea622d06f64adc53cf0b01c07331b2506aba7c2674b5391c1cdf87ca8ceda5f75f69df6f352d6dfbf100940020002c5351419b2cc3084e5a2017e53c49049f126296f85ecc91cf92765f6644f9176c26371bf2d0bbc7731fec03fdd0bb457288a89edbb166ca540bd0971b77ff9c6695fbca25d6ca868e3359fba065e68805339805b74c648daea8ec7a99e8ba7bda8a8396c572e1f923355c14b40b17006000035cbdea16a84c7486e20f4d692528e6348d94883cd9f82b8d38981d3049d3a8eb828f7346c74dd127f22de06d41db4192fed99fd5ee1a53243e55d90ba0b4a99ccc959bf87495717c561031f499e690f462b22df56834fcb27417ec40d8ea253a622e67758d7946aee100110df951de77913c83ca4a94999aaa1036401dff2ca78aba413f313c25c941fb201fd5477bc5468338f4007648551e4431609e4c00c44323b9a96af75fe509deaeb9bfb64d3e961485d5937c67da61060fbc2e9254a2953ecf3be6467695a763b3f64184c983b4ef518699ad1f1b5db1581195917acbdea5448aaf5231c803a9ed477ad413f5d941eccb69235f8c36180f14400ab48c086b0346807c4fb53a2f24137ea9ad5ddc3ee95fa111edb55eb5bdf6492756f5a5964825ec34a29fdbfdfd021fed1e524781df6c134f5b4924e9d95d4a970e8fb512f94389365a23a014379a26219e05c917ef92f6d2e0e3a3dc6c43c157d4c772e3c73aebba6725b7632c6231850f14b4f8fb42f16a47e4fc293d81bedb9d796bda8140477a5387b7104c520a577e65ed63b56e74ac8f95e3ee28cfc57000b21692c5eb0ee9fe3136d7cbacf43854658a51a5cb044c7f02f6ebafbe4441636fa706d77438802979a24462f999b37a22be4b9dfcef77b876ec0bb605c37c1252f32f2d39cec400d16542d383c86d3f42fe793d7c7c8ead8edc641530ab58839b7f7bead305206dd105cf7b8e3b50ad74bfb8ce45e556ba646e29a5d6e6ae8df5e12442682c22ca03cf75c911dcfb81c859f5d48baf24f19bd06bfbfa4da449aa814400ccb9bea1b741c3ae12b87ece240d955465c669eb2b95b5958a9927c8c848e26068dffb94e8813566a07e56a4315348cf8d140883e2e341b0ea37de83f9f2f5328b06e2aa2e73f1962c483a973992a1318d0f9acadb7d4c38dc35ac48f5af6427aaa35797b7e4897c558f0fc17ed5a172a78ae323568a3f51349bc40007c66b0257ad8a6c041ce094c6463806b605a5014c4daa1401092aa5039a2ed7e4c186c57f478c5d2916396a061caaf584d093c4b86a161746e17fd95a3662e555bf2829d8807dcb4953baa7e09f64919c560168675ecad275e9048d8e0e249e410648c7d29c08f7ec88c3d0ef5a098caaacc12749c35db4ba7a10c75e76f09853046055d8265dc583cd8f410cbcd9d6aa341c6726731c594204628f67d9f533e1530cfb727c4b3e24613de7ebdd372e7198ba5fa50ad29b58d15f84b714787892fda2c5f04938be98073b8d8df8a4d465c32c3ea7a0ce7cb9c2b795488194f3013ad3348f8aee6161b23489b151dd892804a57da1d7637c8496cc791304ad4f9f4b289d0e6b9741d164fcc8e0673f1126ab7d2b1744a274e2658dfe66639cd3e1587a4ac53dbccf6adbf580d90384a6add12a822313bf9db9f07d719d9d8716c954658c489def4de7eefc0de01104b69d624af6b6173f54150eefb69f01ba9d1e407ed3207e23bd4d4ecff0022a51e53f1844e6691b7ddc7af48b2f06604abcef6c264fab36b8477e3c178fd28f4315b15e58c76fce70c55cb05788e671729fd5df9e27ca517e591dce1489e32e2204ec4ece142e2ff1bd2ce57dbd7efb3399e62d81dfa36037cf0e76c4c50c5f4633476231d5947971d05eb3202680d8e0d9517c446dced11411bb8fa1d52ac0248fd678a0c6f3ec5e5e88ef95fbbe3aaab047e437542abf9be173a4a52f1ffd969bba608cce816fbba896df91f49adacb87ef4306af46c23f5153beebd4fab9cb715572e5b8a71fa7f29397e0534c81d7796d7a65e08245e9d596d1ce4bc55ecf0394edda74e2a227ca5f655bd55765148fb8c2c31cdc826c03c55bf83e7dee9b75d96859594d635607c6127736701917c6ae9325e53cc5792be1a38bb75454c34539bde22f5c99149734dfd22cd3784aa2459549308ecd4d6a739b831469d4d1b8ad1ad2e1e5308d547b0d5f6849449be372be1395bc8be8f696438d404541fc8c80a572bed17213919c31144009306e04d41704f227cc7db55d93b56a0cc899ed0b5166647c9a9f226de9f6a490c27373cae8e66daa4acbe1eabe1eca9215f1fc4b78929342c0c02621921b6c2a6bcc0e3c68b2c7dfdb970e192739a2d327624885e9906f18ae896700af6a972b6b0001cad591e07884e958b784181214c88162d89feb834c472b9e390a03d92fb6086b92ef653f5c3f9501ba5bb485fb1d5d5b7710c153bb80a6446c322291dd2f4b1c9ce4e6a10611800967427142673ba23fc962914f1944daa9cd72f936edfa0c40ba2ecc6ea3edf86eca5d7dbc855de779eb44f72a7bb62afb0e53749281a52b7c60e8753aada7c07c9523b50bbc4152e65190e6bebd577e244704a425ea702f015841cf9ad610a1ab79c397def4bb07c538fc7a4e22bb7109ed0c1087023e997cfb5e1ee7fb55966f4dc1477a2d3e8e6994155aaadd1667fb82015b0caee1b61343368178d8ca8f0996c826c9c5d2e8402613184d9e4805f770d68cc956bb3c8b65bf569a57be43c3a7df4861478031509df84366f0d28624d1c8fa71824094cbd065cab403f4f803ace59590914703ee55b0b57ad679720679b27b8caa1091eaf57ceb2ee5a0a6e7aba5b716f75fc1f73ba2c8d96298429d16a253c83729bd2d3d0fb597205c25c89e67793918093b22a8446a03eb44ac556f0b49565e912603d9c281b69e2d47a50bf6f3500fe590950d9b300597cf61d0500342df6fadab4f407bec84e74bb1aec20cbd689a965d747a065cb2ebded1b8443ce94c97fc7c09b8270e6d89f51dabfc58bfbfa670919343d889a2f4612bce149daf9e98e6a4a983d4c442ef302e4a784d9f00d202ff93840a0a58eea13ea455d8add2fbbb001302b77404b3326c8fe4525bbd9257d2073338faff38384b64cb0100081e28224c32796166726166cee9b7feeaa9716dda3478c93a75209dd1f197e4e168198362461d55746556df2ce7d83d3b81116b560c1ffa31e5874269c28d7be7d32734ce7b2222726aafd0ee188cd263a8943342cbb6bab1c69adb35e38287112f4a27d76089bb6a0816a91020a3380d58bf24b384cddd2dc1d6cd08b3f0731b546d7d66b874976c9eb61fcbdbabb26fc6c7b403b9d8890ca0a6ba24501f3325e0f47cd489f54e360005207d90a1c49d00131c830a4c690db0b763098d09252d34cd5d7080a8dd078599895562ba2d7329f194911dcbc876708f59abf238a7bc753c20beecac0cf32212699fcf78c96d81e4f2169518dbcb40bd56f199aebf0957aa46f0eb5d886f73caeb02cf39e19f9c096c2797d2137b8ea843e3e1d8632f43e990563e74fa234a2e766be0765d4e9f497b1b3b4e9ca61c288e47de75bf3d8a9f44cac59b9825e74e29b32ea05887b193f8724281ec09dab086d0eec71cfbc6719739c76563d2eae52eb2149f282faffc96b0041471ba78009c12d8c3176be5caed3629a3eea2c91bcd8cbf5eb36bf587762a63662cda714bc29deda27acf95801b627ce68a9969281c6317f6af42038f5722618fe88578622a02f5afd30968d782b92a80c1ce02aedc51d0659afb5f57438f5661a8a153cad351ecfd1193e3cd60f83462e4e9e9f26c6a837bbf07d9d0dfe8f4fb8f14e3560c6d1954ca7359cffabdab6fafd6a52d41acd06e7093096c3a57945bb6d3284764de19dd1f17586d635f4e6d556307cc706500775d9989cbba7a3b30a5e4096fc3bcbe7c67afaea6a1c81daa3ce2770582751c980ab0f6378ad01dfe7adfbe4a57d543827b3349b9831cf19dc7e582782525b73f78da847e4bafc8edb544bdb65755e0d050e595e8a093ff6bd83b760a6fb698ce7ae6312606b484fe3e101030832206f03ed10c7632b5b88f5f70ef0ccb313246b1b3be8ce65d958648e6c2291ef6b42b556176bf9f34404aad6362fc25334af29cfb04a108550798eedc4d9a3908d5f382eef3e8196b6bee4c8205fba2bcbec54be935f81ef681ad05b5901c028b5fa5e87df4e57d40908a230b028b6338ad4e3dd87e4d7dbfa9f2bf684d4008e6a97b387580ab7089f9c965304eab718e7941b2a133bf492f5cebcf7ac88fee71c3ee56513f59fa823aae8e35794750ee3e281c31ba17611e725622a5350e8fff86c2184db296ed49444404de469c247d4b659c693861059e43eb84343e2f9dc73cf295f77772fcfc64222c7e268045556b8dcf25f853937e42a0bae9d6fb4c132efff52d58738f1f18b8ad064ac6c178ee519759c45451591a7c7e1d159120eca69619211fb9ed217cc11894a052b1809716a45083c1a0413bc303e755a591db05e101bc026be2f8072e50604e55862408307c97bfef339e92cd6488b9822717ba42d86173fe592a337672fd3503d6a7d2745f6e3e25d7683e6ed4939b5675ee77186e8140e1b09d9f2433bf9630a599c5e6c6be8f6d26446d8f7ce59313dbce2465850acbbec3d189222f5e6910c883ad24aa8cf9a0f21279e7423a331818afadf2707580adfaa60d0e8d2dc18d537c7050ef811026c57ca818721306043a2af416157c55c1cbb50da106796a9ccbcf2f2a33e9e53c1fba9999d8db2f3967828e6ff680096764b56a4a7559ddeb03f8d4902478b219dcc0dcf84289e19b9a7c922e6112839ec83e87a2bc0e5b1e6e27691704385a8c00d71828ca44b29287a8e025cf9123c995c8c7be35f89c42ee1f647c4a1271d1fe3417434142c3958c704babf4d41934fbe9c5110c0c311c0cb77e3c09aefc005fc3617c78ffd2f7c9f4c4e6062d7d7c05e3d8141eea634650ce7c3f46d992279fe2220d7990cbfa13842d6f05499823bf318340f8871455590a5749e43b765f168ffe28b0451672da8285935fbfac22682aafab17dabd126a393752139cf2e387d086169fd0d08defe365de67105dc4807dfded82e3634ad3164725329d23093d1774181fe4f7af2eb66151f7b1893c2deb2f41e62543d5b84d2a636b28d8fe8cae6fd02a104e09552363113789f82041c9214630d020c229a81b8db860917dfa9580cc44d705988925dab605a44b59d1a9df7516e2175b02d11dfd0a726237814693ecb4963921134c8928e418b3b11fcb289bf3a95a150d735533bdebbcde38e743d23da7b73fe44ab5fa2ff5dccfc16b25f56d8fef450bea1cf9661cfe73f9fd2d2a008aad879c1063cbcf0ab83ccf1c85a01235aa16cbeb0c13ea9208766796d90cee422d567668865c453047b82fdbfc1d779d88351f33bce6711ea29d3c1537bfb7061246e8470c2b298503ed5282633557200159e2e5be842eb03b578b99a73f8d97f1f3224efa000f5c8625c4fb1a37d5b20d521017b3888421fd909519278ea31fcc9a96a3077d8b046280f6050326d1d7fa6008274b00b89421b24e42b21aa932426a8ee0c5820d9da2a91274add6d7d12d7881c3a077e814adcca57639a14e543f7a00bd6d2802596962bffa5976569fb151831e93ddfa2eb1db0ce6c250b35f26f36e4d249439fcef1c071060b599f81374bdc49903f67bc3304f2527511f2fae334411a5ed8f04a7b3e35118a7d8b3a5c458c450498de0d940b091f5f17b8ef3d8263ebb1e527edda29a4ee2d413671bd18499c137b7e3672f9616276598a7940115dc90a890a4202cb153b04070815600f449300be2aa4c60b5b64c7288a55e89ee883ba3a7466bd54c660ffcddaf84df48252975c6ecd26a2a894ff3c6d607cd4dc57afaf633284acd466a1d7a8ac7a715f1c8286a6314325cd3a126b5eccc8882129902309755c646309ec7a14067c1dc655fff95e0e6f4a0a0731d3da5a302f0452674743bc051a2b0fcda37ae28630ecef67901337ead85c817f54a156474196e5df0d1e724f4a3310f7b925225811232774b0660b8eb5a7e67eaa80b1a53109b80e2ae480470eaa838f29923c56f65341de0dc303221f54f37e257c41cb766b5cc2bf9bc1d1cd4f844a3ea779e65c6dea1a35fd1a858f753b0720807e127ae5b6fc8f4be2e6ad18fda238b83a2acca041d8c8629e988d8a67160f798416d6b69d97c9aa3087f546a9e45fc1506859681a7e1e4a8a0a1782527d11a51b4d12f005641c40f1175b553a70167f69ab675752f0a366501de62e45c6ff530367a17f4770d3b87fe14076cf30564e9f9b340c9ab2ca40724f1dccff35ccd2bd4606c2667cccf09d010a3f69d960082828261b00811bae2885a35c0abe6e0e349bd07a15d35c994c5cad9edc2339098566ad5973b4dfeb075bba25c03d65009e80b9c1dd9d8b41b50b28e9847a288801df558909c66e005b1ba40d303e8e103f62d98803a09454bea3041dc0153fa116fafc89e78dd79c4bb4cddd776b4fff545b0ec1af1fdda6a7224c1208615f4fbf54794be7dec7c7718c074eddf2f6e88685d2717b9b3c5e87bcea57b5d8408c9da3ab3284140dbaa8c4facacd2c389f42eee205753372498fe74b41e17189e60d5fc78a4c26fe82811ebbad139d0afdbca2dfd771055dea3526a4d232cf3e5ce2e12df8136a97516416b3b48070ad1555bded3d9e96cde26c8eb5475092932dddd1b85756d005092d77b67d7d66d285e1de817924e5114e6b90fd1ebb8c77c4e9148b486533994026612bdc8d5f07c0d6e22084d49167be76240b0f279639c3c5c47980a32657ecedaf781b1c970d2a70d87bc5225ba0075a9ef6f801c3488af88e272e2faf2dd452a1b67a89f68de9ea5e672ecc69de8325f9d607e1ab7aeef5b6c521b5bc9b8e0da84722a5303447cbc6bc0a1a8f4295549ddd4b9ede107c9b8378de2cd7f7f197216ad5a85475061496cc4a9e3997edcf591916ef45366a2324cf36a7110a79221aa082233276d0e424321b7b8516e60afa43c3c43af1f252ca2cda680afcd95caa342a5ca72428b2800d43f8a7366531d211d1214f5c8aaac4402eb84e348065726f969c304fae457bb5d712ce67c998f7c6ce72685ae9801d748e156ba1cdf9a943ff15a32098fc3728dccb8c7c466adfa763fe4f6bc0c2a7e77aac687ae785f1a258d14c14d8525a6a2ae9a9f57b66b59aaceb65491c0fca6692394fb9b031810cc3de9644587a81d1135bbf67c9415f65e1c1f363ef6b61d42cf75e330c3c5831fd240add30c6e1e767e695d0bc46c479a13750c1526b7ee69c6ebcd2e25be88e1e1834b7bfc3d8189ea3a9da3f9e946625a12c3172a2afb50b75db108c827bd01ca09b91fa41bfa0a8227c00e3099813c716d5ebdf06baccb8299e3b7a8908e6126bf712f7294811e01cd5ffc50865bee8f2e944fb649beb20a719ecca1961c7b102d3aad665161324d9be23d0f0511922dac696040f3ea0af8ae04a2b74d108e7e1f48dff8140108648b8feb5b84e51798216368af01d641b84e12c25de93a7d397f8f44ce59a9f80565c1d94303375bbdd515007a3b3d36207bf96c102a5474ac6df231c8e8021d441937d93d593432ef69f05467c11e697be74350f87b43431a04d749edd1fef4504f4ec517e29ab1eb56452df6b7107cb63f5247f9af3adf6f59aa265862f1fc84aec09c310792fccb029b3539a914f59ca457cd6d9a26c59582d2ea70ed38436b631ca07ef637fd1e4b6a5c5199d10f65c1dee28e3753a53640b3d32bbb12848a56f8a1bf615335fe9635b5aa5fb996006d097d68343f64476c7fa59b56ae0459a0e5802023d6ffdc014f7e50ad8e812f9021e104cd01bfc44bd61a3800ab3576ed52b41149e5604b7c57616d9c06251555440e844388f7f3ba0e25d80fa555cbefcfd91373a6c5e0a348b14d78ad78ff9de5b84ae5a16234ec589bae8b889873a4d9cbe75de56c6cd3f2fb7d0ffdc3e9ec985396deb0eba5926ed1a4169578b566c0004e6039ba9f24c415b9c2e60bfb913c7327f9d4ea1420e767ffed41516dc544bb4e80d2077c391849810d7a6646441096347128e600800a0b182ea9253cf640d42e901ca7523e28e4f51bb5d11803088a4799a34a5f83e037f628d6aa34db1ffa6cc36e21541b6586999637b4b429dfce7e643d91efb354fbe84ec34369e1e72d13aff1dd752b13afd87130412570780652b8fc943aad2a04e7eb2283c84f1f2e01727b12887b3e4582074dda4c31c3736086befcadf04ccc612911c8239e36d5d4d452cdfce02e486df79350bc0f084f4c5337bc7030c5780cc4106a32d73b9cfdf503afb05d5e2dbe0c3ca8a20b218c13752800a02fbb23721954ef5c5fcd1b8a7526912f650a34c47ade24e5fc20b7a6fc508092c73ec2698e30c7b2968d1a1e7f2525e17778c0b35fed7771cab23c3ed22ba44aa427fa7a186bd8c87e10203d9ad6e0457206c1fc51e95e475e5ed7b1cdfcb2e24462c374df534e3a686732b65beeb63ab426b9e9b30a480ae99c89e68a739f3c20a94c3dc2ce2bf3368c14a5b247111f670b8a8a26a4014b6b22a092fc5dd7d82dd0175c098ad2956dc971345a6c03373dc2d0ef2f7436f6240d8f91b63ab4c4c48004cff6623d47cdde7ebb197290fad57ccfaaa85d79a4230123af835fa608c9df678e834cf118bbea7b35d53c4c95b86b5a4d15af44153ff08262ac614b697e63476826bcc4e84620764fe807803f08d2dd11f52d566a58abd0bab5d826a1a30f785a01d2d688ee49a0ef8ba0d030ed161546e4409460793c2a123889af6f5515f8e5a7d8a3de87e2cdf6117d6f4a9a519ad5827745dc499c6ff111d9ef5b17a283ee9b42448786baf522720df3c6b64fcaae223d3b7d0ec79213dfac7a9f7a905c2db14bba91dbf5db6b60e5da8168220b12b54af4adb934d16f95c662034a57dbba985114d1f642e5b8eac9767ac67e4032c219019b44fe796efac8fc96ce163cb5c15b082ea397508a5f50538d8dbd4917f448565ea71402604a970728865f659d961a86704e013acd850895e63b5a7a60654ed6e426e8317dc34082c82e4924dad133847e07415129267124fc82586aac787c86397f8d568590dc7081a883d1e7c79d667a7fa60cf4c2b0a0bf379c69a1a32211c54d3b87b9e2433e4e11ab0a27eca206ae664fadc5f6e802e78ca4cc0b9618f18e6bf36bd50df1d72fadc7d6666e4db504770871ddc424ed206a60db74c31b113ab1e2701f3040fcfb32d11d9133317c9d9f85ae7eae7089faebd928945ee34f7cbbb41a182f4db6241c111e1d95d7f94c41ce900aaa2c21ad0c6be312fec5e55d8736e6fdc423a0e8d6edfb23337d91d43d74e4dd4b42e7a5508377f573afa1cdaf409e441ab39988edaeb16889b039de8a81e7b52113111f6dc63e512ed40b0357099f191e5ecdfdba843c44dec1e946c3252426fe68fbdb9eb73c1f2e4afce9bf982906670991dbbc13cad08daad5ab1f81e6a12a15a8aba5d0a4fcc8fcdcccd7980e2b92b758f0cd9043c933950f04c6136aee6b7fa6eda0cb6bbb40ee62293c3346f6f8e7941eeb105638e095041dd8a97649595d1268800cb24553f390785b58d1ec915f09f2d2553c53af65f8200d1b4d008eb9c18955818400807bc59bc84ea05a373621cf6d1861a7406a889f321762048dd331595381273ad23f370de65147ec93c439d89b961744071f8d22a93715240af40e531a9d92933569495ddaf41f401a051c611b20f4267f2740dc6ff5fa099abcad7513263f879253ae4ba55b14f58a51182eb4a7c6a1ed7d1474dcdcbc1aefe7e4e9798d8efbf7323398fc8041e0ae4c846942ec13a9bcdc0a260fc4b92206223e6e256540d4c80818eced349db868a78389e27a3018c8ff082ea22ad90c0e9ed58037e3ce10bb58a8958def6d813e02ca57c3a68f9abb960f57c04d3b2822b0e2857287bc649756517e937cf13db08d978197f379d7f4252a2c91f66934d99b8df1712c053a308e7f3526c4f132c0a0455284911f5cf2647ec7617053830fdb25fd6028d7f497c5c04c0652945adc884ae11f9baa685fec1cd2e1864f64b8931aa5cc58b27e6860e3543e0ee3b413a1c86347281f0f904213e441d460161d36584dbf812c4e048d4021294a3d8c5642dfcf0ba497a2a035902fa5d70cae4b15ce80a0c383a4f15e78cc643dac7501af52f97a926ecc7cc131c70ccf0ad8e6192dcd0bed8cc918bea04648ea24a48e546380490167ff0137f49e42947b2ecba6480db6b287e8789abcb6579ffe4f59f79cc684c66ce1b9cdf198cc10f6c844cdea81c0319e0ed6b751ce07213d4cda487e29047259d079dd3a4601430b7c0bcd0a44689fb879e9e6024ce8e95a6db5cdc82a02552fbfa00e2d5270bd983ac6ebdd58922e3a844e4e841ee3194a0d31c77dd0ca3aa84dc18de973a580a9d7d2662434d7aa3c24512847eeb71b24275004750891181e7292b3a8b03946f8eb2ef642857bd2cadc6182cb95f6848d3d82a2a8a22a6a8d045337281798b8827e8812962e0f57ef0b2c70bb9e6350642dc8c1396049bea1fc37117773474543276ba7fd3ccdb50f219a0b9ccda9d9c63aec02ce0f7af683cf89fc88f239b2aefe9065d47e07923d3096f805c9acdb196b2a389c7924aac24646d44d813a7d86688ee99ad9eaff846d381416ef2732345f5d1045c2feb286d33766bca7491504d928ea3ab12141ef9a7ad5d65f7cd0297b61edc67fafe17e3a2050ea96f29f4ed4cfec813a02f4b13213f8f0c679516733168663327f2e28cc95754e99df633836e1a03febf074e5a09d1f5688fca80a1233abb9b473021bbc01cc4dd8c96b88b4f32828b6a00c8e89cecdc365f3149ca2b0c5ce9a28beebd49050f1ca733b763ff2518379fbde4c61056b934b802344a5436bd20a0f11c6d09237122991301eff02d5905bbae738f27b330eb9de65d05448e6398f23c6c9d6e11d88cb465c57f0ce9185944ad9dd6360cdb5c1dc26fdcf1e7c9285ea62f4341025d7633aaf3318021ac322f70189223b509c9080fe3d42a0a236950d7ea98e618dadd08907a02f841e47b31e5166523008d3e4e26d090a5cc07805c60a1f84e2d95af70a7ed50fd2fad56080634103cac7d55bd34a7495ef848e80f54a5dee0a8c9f6017637174eb251eac7a2872f262d530f113922c8bacc81935fe3e79c999a7f0a26b569afbf4c635ee6eee524d19010e7a8063f4d5eafbc71d8280f308b125caa29ad6a702ca624500b202d780a046a3ad0ee5bbfc20297cfa498b11cdacfd6946cf8111b0f4f8c733a7e0d89e89ad145989a8eca2cd9b25bc3d0b7f837cdeeefd231d79c8148e642288d98051686bf7c6efa53491c0e837e0359e7be25ec283014c88448a6ea63011e336d906657c68955656638920e6688a3251f3990cf5371a82bd46ab77b3716b0ddad56b7c69867a1d60f438b1c65088ce3363bfe325b6ad69917955b634a6e88ec62984cc82e25dd12dc27fa1b0f8d13121eaa4798dc0e279d8b5f33579789f6528b7d06fa03180b73d3f838eb67baac12d1e19597760009aaacb9728bb5662b867400b1a8bec8d1538f9a411c3304a8ddb276ed2549875115e7863d13487b77e74411e82dcb759908e1fb43b63e9a5dac7a47a5e2bc2e1c61c926285dbc554e42e8b4525855e9d0477a244bc914b0dfe44cb681d3fcb16ec2a7582b226455c8a25205edd730e8db8fbf970f634dd46c85768891d4366713898e284e2e926546cb2e08c102a11a0d0640101913301b031ede22a5804030ba37f0d4a5f8e8dae1fbe6ad52be06a714f2cc69db53834866634bf0e2dc379f14042730f8803bb105c779133c9327e597095b61b33670db84aa9a460bfc4193476c23c124b4ac013f66c1a9e954a4d7dbf0c8bc93a3ccc33ffb9ce6d98cc3407a2aab19fbffec214771ffb130385b33ea1384305a03ebafcbc84820e2118b36587131121336b7668ab7a384b04e63812f870027a0b5b10205c0fa22c565a0c58c959c69841c0976a61f4ac84e7ab8ccd03d98030a871bfa4468a6b0380f60292b59a6494a759c400aa3477b78b4cd8b194455aa30e05a50d6c5b8ff94e6f96d3316ef7d98d46ed7c6397f2019a84abc92b44db50c2133816a3c6188b75a935465a826790b832e0233090e2429500f072d7ec5f51e913ff1d49c61ce4211c2db45efc2f00f8d1bf62ead7cad6b4e208bd6dc02567a58314b11045c98fba393f3af31dfb362ea7182f67c8cbd647ce82f81b6fd688f57ce08bed7b19ce7442f3bbbe39779d4f737443b65ce1616ef34c1e048c0878fb83bc1fbe8d919f052ba9bf38db7185339448109f8c0481d88474e90568d04ec4bc017119365a16ef30ba913d8cdde3e9dad461d77f4e3c121018b0c4de2969a6ed3aac89b2a7539bf22995d845077b559562f9e41367e99ea0448d7d02d20bc68a0568761c30acd7e81ff6625bc3961fcd7e2e8233da21f5c416e1f463768a68c1712c3468951dd957e49fc57e21110bcdb6abe28f574755e2e45a7ed4e45213f389de3453e56e2f5ec350650cfc316830d30da9059b398c8671c51e24a5c690ea447b7749df8723dad3bf5ae423a26846d348fa23885f2571f5634ddc6628f97417e0c3d6637a35acd384e667cffca99339da60aa2da8497c7fb2689cdbdfc50fef2e43eb2e1c8e65095ba817b0236fa5a60e629d6185bcfd5e15c543b82929d5b16eb3e7f1c043c54cf068635d1b1384a1a0c9f89ed62a550605979e024531d247d0c01361e2395a8365171a82c9fc807aed81052fdb9c96d609518d520cd77531f15d0aa1ae54214b1b4b0e0adf288cd71d92078e80157f3f238c2af50cecea8ce3c3f77ec28405030effa4362d53dd6be1235a57170ba4b482955638c7842e6928acf7864ecb560b5e4675d35ebda307bbab96b339beceb585be61278da9041b5d223d85c90732b147539c2d8fd513bd256dd9aa0bdb2f4660559e15911bce2fc794fde02f36db982e4960f9890ad7709448f766187d5c6467a5bef10dab04c878fb0520365fe4570d54bb5f27ea1052057e2b42836ebe91dbd9790914550e591a44747440aa1a3691582387239fea6a4354558250cc72d0ad086d00e6a00bc9ad6dd0315233459690204dbfcd02aa5c719fb52f8a82b021adddc85ade15f8ada06199d6e167c16ad19530d225f092956b8a37618e9080faf121601b26da8fd0610c8703589b52ea30e07e04c2e380ca8f61660dce5a12f7f95db5f953fde022268bb5dc08f03571f9e54ad1963b229f68958f9525a8ae91da64eb93008a463478c5613bcd6e23e53f9b81b3b85ee31b37e73bb67cad35dcd453ab8a33636f3df8fa1b5a19ff7e918c17614e300d190a258ba5744ebd7e1e3a4adbe81949815c2cb752e215b2b6c066930ca08e23d4a0bb32d975fdc6b31cb1b24a8f780e340901f8d6dbba351f5175ed3af6c3e84743f55bd3b128f633bbd74ca3c79ef692e8081b74176ec10bf232464b24a8b99c21d499423c3108f6a07e2d885478eae353560aefdcad137a58d2deac1956d4f9ca66217664079c79ec9e89ada1ead2b8ed118d01efc473d87e0ec9eb3745abd38821dbed42cf6e67132b1029641fa434094c761bc015e1d0e80f027dd82f734afbd3c6531bdd521bb9bdf2c6388c10806571b918de4fccf9b8d488a8eccebdc0c31b43e81e7d429a5d58edeb3575140bf0bca37bf20582914a5536c8f470f38ee7b329c634cf2596d44a31515777be74b3357e84e298060ccb76e47b0066f898024a2cefe44bd425f761775164af22b2bba96882375b2aaabfc9df49dadc042aac408ceb3cd5582f7c17094bf380c425d6907f88987071e3c421e411a6e50e48c54415a99f6ef67ba08179e7b218f53eb89428de9582cf7de2fd3dcd61b41b06278a017d4d043a49ae0ac2eb23e33c24f343323ae639590a220c58ffa0216d0690c5e359e20f68a1c8d2bad6016cfd15b5fa28e9ab2561ab1e29806549f3f8a7fb0ad8bb600d6f52a192e9073f59d6c19bb022515db6540a412cbf0958d43dc5ba1e3e787479e03b7697b703e719c3987347156ad1581ece498dd9803bb9bf7704cf53b860e8d8c936a8c98962e090d270933ea40542104c29900d9d8bd017009c494ac125a34f9ae5188da0158e09a1abca2e2d067d1dd7570a729197015ffd42b4cf596c4a9432fec1c4a903dd450a36c5dc22f6b111bbd617a18101d757d56abe482acbfa272b5baab0703764cfb8b9720d626ef54fea9e03b1ba8ec231bdf35253ab3b1d197bb55ff1c2a7b67723f0587c25057a726d01cb99f39c588d6e02054caa794c241682200fc62782819dfb6ee4fcb4613069e4db64dea9e1332986184a792982cdf1b0e7b03bb9cfd8c331ccfc4620a09ff7f1ba7b7a7bbabc1cb6df15cb44940c30ece787287b416e6626a37d1f8a0daff40ad9c78ff515962f98b34f155741dc2be5d0174e476f63cce8a1f1a29a24ce0a4ca2784e3792d2140d643efabd9cebeb973a4b8f9d65c10411a9d8add56441132ae92c25f789250a07b2d48847872cd490643a4ece52203faea5afb826ae59a570a7e7543af34d98cb776a1d2f5ac31bacfc344a7e83ddc8308e0343df0dab7a27a80108f988955269a7ca3034ef90faf198f653b4f956e66696c09ce390d34fb47b117b3e899db5c45223cdf1f9ce0bcd157bc32d5aed0296bac8ae14dc207a7a89f440885e50fb1e3200d7024805642692b6d184e7aa6edcb6c8db24df4e6e05e26916c34c7a703853d2c6f83e26cfd9db4c46e447f97c0a74271b3667c50aafae7fe4b318e1591428d71c9270db2a987bd35aefdcbb2178258ec436665651771110c76913e2de4bea406b93003b524c9ba3ca2dd8b0a5d4bce5099b0f34e90d6b182b4dd9c8a3b41c214aa2ff1821498fee641c474470ce961975127febe1d72b16ae572ba207954e8f2031d296e0347ea34659d397b96fbf2856dfd16444c44a8b3bd598bc7f922d3af9204ef59181c053443ef74305996c9323ceda464b05ea41cc2c0ee08f2942e4d9bfcef7c7e25109e43345cc96a281dd1c8e22d1538322093b41bcd51132a7be38698990a82a10e4d158ef2dac200ddaa622b6c498e097c4b959bea1705575be6bc5f9ab6264cd7e12d89904f16813f9fc6e22d166906bb19e5ee487d00b4c90f821e4859f1e765b8c4b0eb41fa488e8dc91f2cc368c63c7d77937081bdb21cde0ea2591ca640cb7c683f24db42f2f2d97d64ef713528a7a89282511ff8e40747069100dabcecf1022fd2bc705caf8f983e42efc3c38f25576d3afecaeb21a11a4352230625e1049cddbb20a175360d24f759beb3ca5defc136f9c35c8277b3d32a5be87eae48580927534abbb6f7fd9a5e87935092540c8ca3531f4427b0babec6baa3ae6d80ab132a07726e0ddeffb20aff377d79e15d9e2574fc47edd62ffa6fea69b9800b3695e98916c0e4749276dc4ada95333b9ffec26fb7e05c42a47ba34edaa01906e9f47663051c35ebce418802094ac951bb8967a6ebdf0788ff9babca001835f0a9b7a5f72f90ce81774173c46b9a86548bb34cdd230f964ae8d4249928bd00e52b7c03600566b759255cd76686be7eb4413e9fb1b24c224002eb9c68a7831589ad0add9e7b4a60d323222ee25c7bbc08c6f4da1d7af94eeb8a5c74fd27b25d38df58dbf30d0f5925d23fa530861397e83cf298c2a708cc6b1dc3ba4960869541e942782fa5db79736a78912dc3fe5598960dc03b84c3b2b2632dd0d94c764a02648e2515f2824515ee78a9e421481cccfabeb1f04926ed5a1703f0602f573f88b0a65de47258c433a1332f04fb51ad00cc3ae944afc5abd9df23ce3ca35350bd3e529b71bf06219ca1c470a0b640eab1b1674ec28e43b3b61310322cae4d07483bf7ae020f5a7fbc6d582bf9a2c08ac8ca06cc8eac3169d3b4563aa264697dc0e56cb451f71143dcc66459586b940b6fc579e891d4bdffe5bd5616f87966e449d25fd245c34df8ce1fdec938d7f44e1e74dab9a30ed2db7d33dcaa4dc9ef06dfbbc2c9f32bbdaab3e7e638efab95ab0ae8f0e499a36a08f6f4f3af0affe793c2a1a79388c4f57fc883d128be9d156034d0eb595930fdd6e969e1f3846c860412135d972b535c63dd4bb727fd0719df2841cbff29c3b41cf101e49b382b49f96a42653d3b494ffd8e98b333c850040475830b433354b4f93ed664cd1dd283713487bbbbee61d0856327e3e886fba638f8b162ba09d7298f91505987caf8ca3a281e67a560e0ca6eae9a83c837fb0cb6d128942148f564356291f588146af4aa35788433701d8036c0d840698252e37720fc6311b11bf13066bae7bb01050b8831a2966edfa636d58290c3baf85d2733fc0663846b730e7a6b43596c6866be044d5033aec0df9fc7d395919b698bfda821c77a695d9895b1060ce6f4977d7aab90255265308bee6615624f27be83e4aba50feb27da64bc91a247faf50b7adc728023d7fff051127c1a620e705008cc21420d7a5e1529eedce48e766696a38367f3412fb5d89faf9156606c45f95c439fbbdd093d44be572a18333e3695a22b890f605de4713d13117e45d3a9edd77ef1d763f703b1ad62ce1a363ecbb057064cc3b737db6eca489d322ececc4e79f0c1ceaf9b12cd19ef7310889a869c3cf44825dc544eac354168426e729dcb4df01e85ce5d4e0e0c778681dc8a268de8faeb42319b99bc678128e38c21ad219eb63c81f028ba16a6115f43307e0215174ea494aa587fd1a2e00641b8c36e4cbc72cbb459b1f9fcde2d938798b13c32f5b78db219c5f4f87b54e87dbf1584007a98c411c7ad64f1f744988254ba933d5bf8cad77a5751637a5f5db165fc1033ab0e947e7c5ac77df87256293326f60942746db8dc3d54d3d3b152b8c1197e041b30ef5c7c927821b2216b5f7b3f3f6eb7a2cf5ea578c69eabcce5b40eb93af6c7c06fb7993fa65e839ac3df705116b2e6f22a52f5bfd840e7a3aa755d28c1cd29658dc528c1308ac6fce7d31c8f7a13b73b9d4dc7f2f8916bdd094f0edb5fe70e1b72998a43be98a6a22eff0fdce0da8e919cde4a6820922a22f7d582af3b8d942c4af2f40ce4d16972d17ba0eba4fec01896c12667f58cfd6ad859992341b5360785a5125c5a0f45242fa5755aab555f9cf371ebfaa477188893ad2f67ab4040a6430cebdd996dfee85557a922788c3da2b2a12b91ffeeb135a03aca768844ffe628170c75e874b80c903c5871de2171cd759c131c4752929ee815d3a3b1f552702aa0edf61513db86403fdf22a4b0989ccd6bee5e7879ad24bf3eb60f4d2f1e2ad161b273890f244372de5295ef60bed3e4385fe20bd6412c8ba0b64222426e7b4e3798ddc85fd65b931e0c98ce715b8facde19abc6f00cef5b54727058bfb10fe50e8589795409726044a33b7f52e2e90a014849d5f63b4ac674e2689980f79babff5e0aa305e11a6257f5fa13ff52438127646cbe3e2525bdccfbad81922bb9bb67862164b6216f4071330301aa37be7e0219b4065b81ed053b9f2b2d884db89d90ab7d9fc12f0dc87abdba4e1ab06f860dcd4227e64bcd80a208444a05bc3d1c2821acae7438b60d1ada0a4bc51a206af9f29be19304e2b35ef4f41522846eddd995252380b88c77ff5795fc085fb00a8622e545525a73dc8065c5144de6042b9a0fba01b3c933374822d86e713dc55185daf40c9297a52841b3280b1395355bde3ba65aead7f280b015b74d3d378a696688f5a1bfc192bd657f041bc47b6d425519de05343f646900fce68ee4cde1856e334380b04e9e00ab24c999f68aea75d837a05ec57565f2b47cd6908e265222e8068cfdc587546852f62a71df2b131df6703e0e0c1e6e0fa64bc69045df0144dfeb7b4d3cf0d7b8cea0636c8b4e12121695c80eb52b41e79bd89fa0839d4bdbec70faf839c04b55583ea77ad5f62aba8b320b44ff25b70c5a674ddff90d2f53df1771f1c53440a29346bd14325bf84414bb583a57dc16434443ea771d6238618364453a3b98ab344bf3be1715234adfb8f000631d50b5ce22c1e0f983167091105fee22cdd6dffe0625d4973a38e5b1cad86a0eb2cd99ee9efebe6801d81ef727623ff64d5c39fdbb669d26bdb049a98ae1ef43d882b17d07de260d49c6a06b36462233c588a9e00d1f7504975f0f7660a24e700cdc673528ffb4a5412417508cffd66d8b7a949388b6a4d0e24272e0a255e3f877c64797463877d579480e66e06202e418612cd94c7abc4516c032a9add2ed5a03163d45010db1020d4dec6617ab626d7a3a792842cad334c9cd3a6c73c5611b1eca1a21f2bce5554ffd269320145c8bcc5e703ab91571cd93249fd4c44bfa089c2989468f6b84bb4f56c83f60bc5b5dc06511dd4b1298f34be78c0fb41d3d36a2ca048ca24bc394635c4fd8ea9f55e618ecabde2e6139c609fec95b6727a2ba756be8ce42bf1abe369db0dd6b42132c20f9f88f7c5ccba45b2260c2136000f0295b28a873752ebc493d32cfa1b9844c06bdd7e06bc6f392d02aff4870e150998f29c48e209a6ba49827ed324d298e71f125a0674e3ee761e8b5aa207cf4bcf1c847a12b8814a6ba06d923a2b6a2cbfc26f324d302b2cb2c53adbe826b23c5b2c4904df59585d59d5f727270e6146369fd36ec46cacb3482b0ce1e72f0da2f4f1c998c9c78c2ad22790bb41256a2cb2bfa4ee8d3ded70d4e7b30bb0e4028be67c4cef0bc9a9aaa8c8f35dd2407bdf160ac1570b9ee96514ab45095ea1e6d10e12492c49e18a2ab1e0db3f4c55ad2f865f13590cd0b51ac3f391c1ea5e2877f7c3250368019c68077d75b82534765f260abce682eb443957c4167107704ec70ae1fa0ebb0f469ee2192ea4e7c5d0574147ff623fa3d1462d31d34c3b949fa11ab174b90de5dbb6f48dcca01e2be777cd00f48fb13453e8f1347f1899e4b1c3bb3e9ba8ff465c514635e5527bd9432a4af5c5b0298882c3f45321e74288f0228c2f33ebdc7c69f9e04fe8e3156640b244591f099a734e27ca5f315c75c3d67ecc11e6e1d3a32b891c6eb1442ef587c65c0bdc1ff738132894ca1dfe344ffe5527bd2cdfa7f5ce75cb01d2ea38f98b83aa7bd15ca8b51eed7af2e7515e9c3db87871a5298b0e9c20da1ce47e7fe3b4c464358d1779493a965ac3df2796c93778a399c7d0ae6b9c178a9c84fd28d35844a19829b1da28534728cc83b1413a50f5c8b988d8b91d66945de69ad2c9d3ac623108d60a92195a65a0aa71364ae6819a60899bc5bbef6f0ab10e2f261e221f7496015c5ff543beebbe937e60eab6efd5bb138ffad997125203ebaab03eca868db30a499c758cb4fa05bdef92457f3ceaef3702505f7c4df9aa84ba7286c8924f8ffd805b604123427cdf5df0c9e6aef110490e1a627c349a4f8053b8b176fcaea569d6ad37e9ce8d126ebe4c2d47e80f5f51fb36ed6c7c5361d824be31d3c8b4ceb8dc5817cc7678dfa99c585b1fb2f3766e8a5ed04a7c2e3d9638ef22eb8283380d8d690f9e3e2dd9a2d7faa7b925ee94e4acc35f630aa3582ec2f5f3a6b98fbca670e63e8be5f1d15a438d7145ac2359d1a13cd59660fd5c0b74cb820cf20903fb1f8c9da44ddc3e4799c18ff870aef9906a19f8f564e42f8cd768e18d9255adb83a093810351d7f3fb12706d7e933ccc7885b4378f4513544eef1ab5ab60d7c049204ae6bd8e95c64e1a002017bcf33b626a838e6c419b504ed9435d8c24e5c4dbe8d83960807a47e8e58fa272378721836732c933f09c836486d53f89bf3ba457308ddbb77031060abf9690fbeb86f5ead7a08e7652b000aba41567da895b2a1fddbd13e6d00ce4a87f88cf7138a2f138678dccd150ffc950560f50770123daedc70f1c126b4b63fba08292a902959cbd00d96503bef3f12ed2617e9b10bdb007b58b43700862aa62fb4d721e480ac462c44bf9ed4ccc766bbf5034f93e0f191fd704b3fdcd1016a083d60a5e4de6fcbad9d89e26e05ab5d76ec1ca1e0a9b15b109bf998afd60fe0499aea23f8a4a13156776057c28bafc05f7269969fd4164c4e5010b113fd860a1b04d357a7b3f35ed7835ef9b2c3b2d8744727fdf68cd984f374b307455e0f69698b73390d8c3b2cb78a5d5f2c7fd715bcc5d3f040ab89a76fa83656f770575266f2c585d0520d27ad084bf9f95e182d338634fc4a13e9c5b5e6dcec9cfaf038a5defaf0516640f3b8a4327ef53dbcd7e7477be1cc21de3d7e05914092ddf234c45f4b2aead79871a1fffb60efdd07c8707ac203fcf7dd2635f3c4e1071316befc923287fcf4437697fbf4ad2e8b33d84b6d485623872635d25edf8fb0d74dd07101b9d355bd45e3286212ca4c531f83f2ccdeb344efeb2481045282a8942d4251586daef6406f93bbf8cf4a3c2c5649628fb6144c0bc251c0f64051cb3e99c91d68e3b9c387da76a5f1451af7247868c286b23f540f9cd5c8bdcb692ae9fd6ce61f5fefad433b906409fafd15e5f50cb8036a558d01965a6b4d8f975a6bb9d87f5fbc57ddbdde5ce3227932f3c4c900898848272ebbf51fadd4e27d8477d01e09db15841b9fbe949d4f44e8eece28065cfcc1aefe8c9fe8514434fff2c7f28b4a30b47bb1218800086bb17dd836142fb5141aa016ab1cb2687a0aa3cbeb8f78e319596ea063bef4fba5f6f7c58c66b2989e26a810f37d22a06c4a5877741acc987e3e8ad8b7c86047a71c276edb1ee3554c4e07adfd288b26edcb5ad3db23ec03deaca92a61d3b12701d970a7f7fc029b545bd001e18f2280a782debcdcedb9fa1e1360160ff4fce7678b4bf8cb000bab3b709fdd86ee542027f4dd20ba0fc4675b18a66aab0665ecaae4c716fe4cb7978844cae0f1d8f966f0c52126ab5c79f4ebe680192c31d8c6664917aa284e781cb7102a9f5633836945769213d44c09e5078da299f9b8ea4d0baef6c5630d4ad5a1766b0ff6387a61d7edfa593a174e52561c369a4e133c0adf99f236a386454d59a4ab71093ba498e46b5f8c4243f22a1d0e3048a1b33cca18386330964360537be21a3f1881cb83dc4d47958b8102a954c51393a4dc5cd75a5d14fceac5f0253b7e9ef93bc11023dac9030fbf2b71b23a50f49a362c855f3cf190605de1b826ca37f475ff0dd63ddc6d267f250fc54621280ce0adab1df6575b8d0dddaa9ca933d424e341a84ea4ea07632ecb222a9af85d74033f4c63848e6db5bbc8a2e663a4b98d0a935d178f7b886c47f115c8edc9f7ce4d02e6fa112713df76e7b7d9d859303aad6269d1759adc1bd0371fb9546725137b9bdd7936dcf57d519863ec02eaf4e5b3622887c053aedb0c00fb9cbb44066ce7a7022ac9d1b2dbbe431e1e3290cc98e0bf311f6ce5a1814ebeb1e0cc14d39717f173d1f647af586d4ddf25d7528f2e804953c59783017df785e25fcc0e8ff5e7183dd9fc2c03b517dd70ad4bf8f8ecb200f02c78c582519c9af1c5389efe496a48cfc617d01005b6799463be2846f6dbc83fbd3694f67a60edb7f99eeb37ed9de94987135452117e3ae3258c1a68db297d79320dcc93486e3d777cf012a6773bd77d65188179f318df85cb565903c93b1256cc41d1c83f82ac9b085c9840a0903fc95e8d4a512ddc213668d7aae2353738ae4d52f782d7f530cf7e4baf995eb2a88c4a334a10714d183dd2ce06a1ef19f4b61ccc7d0f1c38455cd97d9e9abd266fb43082a54ce371bb6dfd99dc35f11fe5b5051fea00361e629784d6ab1a139fd3b0d81db49c05666328c5a065b3ebe9c927b1ad99ab8e371d7e0f4bbc1318de6a32c7f1e04ad667b877018d95554d99403312b0fe22886285bbe0f44d4af4cdc6cd703dedb9adc30f4471d4352f2ffe926f8aca358ed4a2a9b1016ffbcc8b95afc6c23a8d061aab055645a54d185516f5953d349653606842df6a545da45734959e2a48e772a6d0a4423f0980b9dfbcd10334f5e72b37f0227d956fbc04247e5b3a5cf17cd364305e7efca914941664aeb9f6445155d6e13b08521bb7c7e6b5c8ea871653c567e8829407a02f0019f09708a779dc15fac3e584e171fea0da595d350a396d122b09a7956ae277ac46243df76f02c86cdd63bd28367c1d3db5eaa48f4aa50b744f5a91e9eacbd06e46ddc85179df5d2f898f9d8cdd09fc6115c2a15660062d751bb17754baf374c57b223e89c665bc95b3adb976e75228a41f59617f7ac1e2ab9b64c607d6994a57f930e6de4a6af091f49f6e81991f0eb5c0e3c89e816dfe4f66815a907a196dbb4406ba7d4d47cf6a93bbd93b5aae815c7c59e81ca06e620946b81dff75a2794547a57c5bc1ecd310e81bd370b4d5dad4a4cf148860edad45ec61aeb4f9c12d09317753ea3b7ca8adbbe9341cf31eff23f017923335cbae4fc5293884ec886a844945567404f3e2ca618cd427ddbff4d5057e65514aa97b62fbeb6c248170ea03b00451963c4b5a4ad50b1fd0665a74472e2d7f3d7a63462ab4bef5b999f91834141b98eab42faf26db5ad0ae46e1c3f9c9bcee541fdc6a12d1485935266af1d83beb66f01d49360c995b5acd3f7da12cbe659023c88fe5ca0866527c91ec382715ee658456c5e5ebcef337914247d0fd95b4ad0d457e12dba618d2975b76c851b39eb5141d0db82db619a8feec2032fa73485fbc0e907bda5d7bef6425eaf244d5b98431ad80b04a2df0dbc98d3bcf844241f3a69637ee32e5855106eec8a80ee68ea2365c7849efaa056b17c74d3d2bbc5c216b7791c091cf0847d1ef52cae2141027aa9bbe9e6a9e21e471ec9509f75f5a0f536678bf655595426d363c901e4856007e1766a54771cf629a4aee6c45ed124acf36df0b1670101eb0c851c7c29260dbae06e907b9a37f4f00f24e03e2fa448ead62db2654f77e79989f0ccc71c6f46131a79145314bf73ba5a90c4c6016f12d22a40eab5a070346ce64dd83bfb76e12c5a42340bb0e3efc2a5c9865aa489236bc4b9a784810530fd816f77dad637f49cacf867d4ad4bb29ec9d47bd89c33db857095744bedc2015302dba60a0386a75482684050731bfd32818b9d8b6064535edcc0366cb08d1c6a7df70ccc84d0ff61309fe9333f45930ceb01a6462bf541074af8a3c42fa082962371413431e132a42696b3ba03ecc36eb2da91c34f34861ad41027601decb35be96dde9b307185f1e183d0a4b0c313c1448ace10e93431803f41888f5529590dfe92f32b4f648286b91232f3a5655cc10a2d788f5dc1ebbf23b549dc1a935dbfbf60a6d19f5536e9fa0190a8d93962048315c9ac9c7519e0557481f5d92ce5b55de5db5da21424e30b9059a119c91ea60e8a44aa82767763bc6beea7df4bfa74e5d4bb466887392010ebe72ec747a783b7f6a11aedba197d1d621673a575582c66f46d1a0508ad12b9a5beb7ff55f4e10b64f24b64e4636092d2c9476faa2f0909d6683653912849896872a3a0661b3e391b2624ef7ad4de735188671124ac7ffab3f9cc4d79a4d90c32cb1557e817045c7fb9c7f8c103a37b7334b1ec855dc65bfd42c3c367445258e49ddb63badb7d5461bc6a016b2fe9dc574569a105b069b89cdd2ca66291b9da6f7bc53b161fc8ca43c659c3a647b21ab7568b6b90aa29a404d5cc6035ac90360e57468972fe9fe5e4454ae131cc2530a48ede832e712bc1d0fef18ba09acd9234d2595e23e2978c26475422c5542f7564148bf4c8aed5615794f622d80f9d05101ed3c5dde3d340727f380316d9cb60edb39ec9c057414d75186db01d54cae222370d119f1512574fddeba8079c9b53b880b7f680bdfc9ab4097ac98c134ded72a7f3f89b7c706259f2a3642ba45c7b9ae8d94e8f49d815f850f39b01b4e01cce5e2c52374d9dd24528c5453857951c53937051d6618148d5b052fcfb670b9631e11943a4ff7313769409016e802994c50f0ca49cd1a687c93fc79a13cabad01dd643a8bcacf2cb2916e7aacecc30d3d8b7d5eead84d59df3520f7b608629be8b68371de50173de056557c915a43022f1105d459caeebe788051e4b2aba3c2591f90d549f43440bcee65a0736b94f9818bb304e9e3be48d71e9783a1dbf5798806e2f28c056a3ecb71cf0d13c48815a696c422bfb5f341c9cc8ed08b1297b4a4f400d801f72da31c446a340723f5812685dee2a6e8fe999ef22030bfa129083c4a3a8b2368a828bb38acc95fc282165173d9e57d1ca56b490fc211ee1e058a564ca5185fdc649ef3d5818caf63690f3106195763e554b76687ac275f3bdda5a806fb7d49efa9d04048c08b29d6b86581c8a2936c1082ce5226cc7b0bc890a8af3b640228d3b5ef3b35d2aa1bd94d580791e2a88ed6cf3260628653998f4b05b6c5390c064cf53487fb9909cc808f87a251b4c8da1775f701c75f9f1de81c20dff27b58fbf7ead2b729d29754b780263916fde7f75b438acccb4a3274326c18fd438e8c95bc781b1b265df711a726e3d04639fbb667e0aeb7b889d67e9add89f05382d0cd8770eeddca7c90235f03e4ca728f9320e52ed910279f3d1e2ac7b945b7e0feeb6ed23655fcb3251a33e3ae2bc503d341f9a1b8f8d64b79c204b0b9de1fa39e4c1f70e9edc3ac0209e3edc89c85713f568554c3e9542eecd55f2344028539575bb64aa83494c007aeeef285022e6c279cbb23bdbaa59ad864d70d15515ee66aa46ae3aad43756ffdd8deab1830a079319546bb739aec0a62a8a5b6aaff74df65a3fd5c85bfcf551e7c3edaba78111bcc326ec35e4f152d18602f86d17edd72f7ec810f3e56a51d0e39e3c5a548785261e4e6b7705bce9bdb09a2d57c8d9c1077458a63dde611a3b447ebd9a39e16247d20a6f48f3655e31cb05f740cb395050823a5516c68b5fc831e480ee04a46003f0b262846c4319fb7d835218a4d4d35606d1bfa66fd2e9924ec58ee677fcf24d428b6d1e50ed7d46d1f2fb36cedbb5f53923fd4826d250f774185f24fa25fa65fabb7ad607b4957745ca0e36f2739e77eeddc952d5d4dc9e1f5f5fe6bfaf038c507df649d57273190ae3977ee8645985e9c89d09a4d5a7e3f93b20e5596958b80d556e1c5fc938acb5aa79f5afa67602c330fcc60806c33aff42989a61194661c4fa6fcf3b36adff7668c49009f1be00f303b795d06770821532d519d243d27c9388cd42a1153a07691ec65135c7411fcd01191c7564a0319808643c9437eff6b8492750e25a42ef5c83bf6ff8a2e16c71cbcbcb1c44afb2a2c13ef5821f052b0885a840ef5b94554e93adef1ac0e9cdfbc72522168e34acc53c4f7a7586e5d5dd0ccb778e8509d0b73f0dd703c8b236cd327f56f0dd1d0fa0f4d6a5519648fa13eacfd4463e5551d5cb69fd6eff433f171dcab57c277e00364ca63f40cd4ba0a0be4ab001b0068e95a0fcaa48993d2183705d980df623a1bd1cf5c0007ac6da3f857f001bcdaa68c34de648b5c4494439a8739ec67e47df9fb2d942021918b9634f7e6b7462eeaa1904681f18081a42f1f9c8af74841f4e51be1b3445e485ba6cfe24b842d27e81a914c7f60cd9be9e74b7ddd230b5991fba23acedf367945fcd135eeaed824e0426a78c4966dafb8ab44dd4e9f25ddca6c50d1b87853cd2fbb204f37d65d5d4118ae8eed35f295dffd1ea2eea3678b6ea10bdfb110e613715733c8366a0610ea89c903ff6f8ac0e2d0ad4537864e2494eca61e5a4baf5344a7112e9a8ae5d81e2a00f0302cb4ee3b44a9ded26f1bed4330cc1185a21fd756b0627e70ea8fcfe11853f0e4318c8e575128d7b7552ce1ca021c398e5c97ff30fd43191c7cf5d5ea64a6ae81608bbaa822341bedc71983b70832a4fa07058fda7783e7b07eccc14d2e608cdae9833d78a98c8d429437399562b52aad769cd7a15b0a3b2ee82a54f4e0ea18ced65a361409120c1344ad3474a23d4871be982eb3b0f92cc7d37d58ee7795a2a8aaaaae09061ec7d3b3ecffb7a56c5d4f332d7464104ee741e5cf89bbc89fdd0c58d4002520b20b3eac492f9765e6acf6bc7cebe8de4e518c9c0bce6b445c7afa370649ecee28ce4f51ac9c0b2a7930c4ac03b45e592afc90623f9e66f34fa3ded2cf9cc9fce1e02ef0905649ffb1f69e5dd484f265fe3f724996dea5f179233c01ef4d0f97ad2567c634dd7f8cb0c0095da956c09014f7672e66677cf234dfc216d73ed14ce8e7699abbe3dc8ba87935fdd9918908b257a711a0c369c0c7e92796ef78e75226cda8ce22ea9d318ec588fef20053bfe195b24f4fa0a777cb6e2ef28fea27d26e1ca0a48f8eb742b23bf61ca3d0d153cfd1e3d4d183d9d1b3d6d1c3dbd193d2d1c73fcd6e233570a7dd2d413f99a4572bf1e3e3d859cf681ffd4e1569681d3b683248637ab7e750d037e61ac83902607910667500df25c798bf76cd05d9bda140d73eccc53b0c6fbd0a473fb84e0714dfc7a6ed76f393730246b4cca887519e3af7e3f9d3921584979de43cc97f82e01f50512eb164d84341506198f8e6677ff9b5fdb39b2f0bbca488c5fff66f0704aeae41ccd53b6f6f5784f830fc7111605c1dce8ce98db9bcee06abbaade23961ba6f8ebd206df73c0444c787ecd13b523cbde5600ff696550d488ca501a3c3e5cbcd049bd129fd277e7fb02cbd2051f5edf7692dc816549942802accc3af47ddc33807150c0ec3cc8df2fe308d0c71d61d3f0bf7cd43594a8f7a4a645c3b8e56e47124d1d2f9317de21adf151a320ee53cb8c69bf71ec4d2793931e674bee0abd8361c4189aaa886198fffd3fff51cddb981dffe3dfefb9aafefd7ffec7bffdbffff67f57f374fcaf2acbcb7ffc3ffff68f7ffce7d3d80eef3ffff1efeefc9b8ff9dfffe3bf26f6e31dca7ffe63fac332fc377a976ddd1cfffc0709417fb07dcbfff98f731bfe47911dd93fb36519da3c3bda7902ffb57aaeaaffc89b6cdbcbe37f9f47f5bfa8fff8657b4960ff5701d192fb3774310c239537c31a7f87e7ebfc0963ff871f2bb9836bfe19ac7f1fef3fff79eac5792814821b247f6aaa3f7192d1fff0f00acf32f2eec2ac1dfc595afd015b8a6198a89da18fcd693980438e61186dffb3c9fd33af9c7dc646524ffbfaa7300c73fde50bff6ae59a67fd8e04819bfc0f3dfb27de4af61f25446df16dcd76c5e4fc01cdbffa843f43561140212690f44e11886118f48f1276ff636a0cfd8303a61411bfbf7cc4dfc3ff7506ff27aeb3e6f49387916118fb8f3eff481846c1144c9ddc3b6a862472184624fe8864ccbf9b124bc08f25939a21711826deee7f25090cc3a80cddb3253dff62176318b644ff80d31f7bd6fa080a088ecf952002c348e21f90fdfeec2a6a47e635ef1789d81f7dc9cbfc379fc2280a933d6712c103c3b0dd5fe5d91f95e6ae9dadf093d92b471d8691fc3f204bfdd9758269a447402eabc31fbef4fc2b5afe339f896b6c37079a46eac830ecf4d77f4cf2d7eb055dc450fd5f2910c37c3cd27e7f8fc60b142bfc65614c1bd3c4f43f4b61b6c618b3d59dc44924b54e22b65f19bdcd5ab64fd7a62999b4b7910263d3847d7d8361440c606de15fa9d3822425ca868f8bca15509538ab78af658f4c29c0d0ab5de12249cd2d68597b668c8c4889dc2d86e86f9c602ce4cfef7c2307b5d953607fcba0ae1dd4326806fee9a34bc91ec7c9267389b8c3d54ac30f1876e3c21fbf76323d00f450399b9eaac23783393c91df40d2d754a00051daaea6789ab60f0bada0328aea3034267442eff1c8fb360ae07d15bdf86d6628400525e15ba6a618aedeea642b9e3447f83e98a9c6b4c9f44805be02b99ddfb781d34725c5bf851ee4a112a8945d7abf551e054f1de8bdefc046f0725650f781c31a507cf6a543f806f7faa5bfa4371e10af2555e71dbd01bc637db0f834d647f95399c6869b5fe117b3570638a0feaea1375d2501f96f161c8bcd2971c9a5aade4a9ec66cdbf9c60635790eb2ad462e3a0bb248072bf8059286973697339f7ee91c625d8a102812df68a77f2c0e2b5e76641903f78397f88bdacb9c714894d2c7e3b8deef56bca66981fa55a67e141c862250be88eb22de5a65b1d78657a294a729f75bdc1d444106b0e44d4bbd243573b8368d0e0f9d35c32c8d95458762151af4e21bc64def2f918e80f97e33e32381919608935f378a610c932ff373af33abad33bbac33fb5b67162f670ceb287e861cce84e5c418a705e92e0f75b8cbcf6533be65d0db5a5e04cfcb30129cd66da04cbd4faf1ec963b354782d065385b1cc0821e0ad8054ddcd64a7cef90565bc517de12b1990d91f572ab45cc77ddf85e9b90fb1677a9e38b2bc18b7c12409d330426ff4ab40a56f57a5b02558a26fd2dc6931082af537888ff406f97286726956a0370cd8681b07cd9eb091168f7722a7314f33b96e655776e77a102676e229d2dbf4bb2aacb68aca80ac517a4955638b371321c4d2b44166f7e04f844da42ffd5a89c6ac689a0e497ee32feacae64c8fcc24247c84283940cee7e78c539a05d0a27ad1a06a1eb4a93b364583b1f2e9e613482f9d2f2170da2a686b3ff80c193a2c183b8b85e0238e8d4139f7a3a7fa69ba505e490a34f2d37475341f0af1819fc6006961a3c13558a05a68293f1cf2386c9210fd16e908daef47d9fa41cd1fe52cf8306dd07ce1494135fb92c659e4fd86c0d3c2e08da4253dc9c05bd0c0238c3cc1310422aa4f632d01a5595c1a8543e2721c0ba7462e050d59225da75cbfba936314eedfded6478b3e29aee7dffdc66b1295468b43b43e25e35934291ea9d3289ca54b92b230535c0729b6059b05a93df0eedb499a20f254f467f4bd48d9b8e4cf3666eac76c8d71941d0a953a7aedf4d7d549986703aad9dc4c6bddbbdad94c651dbcdad64c133c7971a7fb3da58974776e9d2d47cab6f56844a4c67e9a471d9cd9d06f95cea38361233a370f47a63858a8af3b850058d4b75b1c58ab542354939e7a5da2fc2c0a11a78a21011edd8d293bba8fd35d92253e35c1376e604b2b3223170ad83cde07636cd64a3de2302cfaeedbb3f36843188bcf7b257179eacbf77b34dbbb35b2d5e31c2ede0e5aff1c64eddeccb6de1393f508233f994da8be6c906ef94442244cd98867772682276acacef5c54cc8ec51d2633112c0a33a558f0ce52e280f1787e52ba9f261401bd50cf79e3bcb9a0d3716e821e3c2a6c6fc644a9d7fd563733f493e35348773aafb5e3b4d31c444eda724047d4ae34217c845b90a3d4435db9b29ad4356db7a2c02d6ff7f3b71b188dfe58bfb89228a19bfb939cbfd66ecbe7920762678a022a2f32a466933463b32e1b3876717be6fcbe8e393e8d7133ca29d3b8d81c903a7b499d3edeb572554b1e6f3d24813be7fa8bf2ea173f0d785ec85b40c7c15c50767727311725ec66458a8f5b64cad42feb0d901df94a7071c0d29e907c8343475a85ffe2e7c2cc6661ddb98987292cc1bfba09951d24a55fb09cb79eda67db9e0c44f456c17a23a2e0f5bfe44ea5628a249338cbca7b61c2125793f2b734bd5e43ee133ad3c0f8c81dcacb74c02206d16e097283a3c5f539fed54919b3b424be3950f31b184ccf89d0348858739e7eb7d1522b9800a906615be79b42b1389bd02b50d0faac34e0022fff015fd94d8e27f68d948d5c84793608d2441f3fe50593a99d84578243fff332fe2a28891bce45f8076490924bc5f13d687063441540e8d6e27f2b9bbc10b943e6e9aa494f330fd10b7233f8b3f9351fabc9817afd1a8ee46b372cb5ef0aacaafb3a86292b96b501263e920f8c645cbc90162005d4f8c083a80f42bbf32d06892fbe7683e66ded6c97a08baddf60000c9354bf05f9978d7a14c205559b41fe3360a8efb2b0dc0d680c481e3bf18be3139c1f704ab6801063d02a24dcd0de0bb700885070b9d7d88a2e4a769e8becbcdb7981fdbe1d21b9fd8ca72665237c7e22340286d7e329d6e4de03a02d67f92abc4d5f4faaa94080b9baa1a12268a48bba16ebfd04bc1c021e6bd237be3ea01310510d03226c8572d32e88782aec50fd66ba55037ee5d21238ae5efb664b8c462248ad736aaf5d4ac8d88f66f5dc336e9c1044297f41cbe4b311fe877ff26d26f260b894f49359658552a6cfcbbaededffd1295c3b907dcff7655ca5ba0f06fb87c5283943990cb9bb0cd7443f7f018db8387648caea571d43ce37935a1cea634817cd8df7be8b9f15a39750d9f941362a11deb273d9386c4940d3b748df9d7ef9755c637595f87a7d5cbac302138d71692829382487c9ad872224d28a5495b021e5325a064741d1dfef99ea33bc0419ac8c64fd8cf601eda49dea58f4e97b28d6f5b7abf61af272c9438ea40187779bcd4f96064fd891a1a45c147447d06640830fa8ada122b2054fd2b0ea2f9102327e458bfdfd375efd9dcb566f0dc7e98a7dd3a6a0ab1aca7d5664bd79c33265ec85ef4e7ad9be1794a73bcd0b33940caee3da4e0eebbf6d3fc55d228a21b94028363d9e1d306a9b6c158d7cc1d3cb2b5efa8255a8ecb967a6efff0c167c617f9919c3e4c782cb5221c952dc525937027d4feb00684bacf643ea7d9a62eea3a66e3ba8c7d3666ebb88cb3156ea168066fc087fc3a1a8998152e04c0182ab350d477b9d2f84a5853d9f26090dc4491e1fda28c24e62b8595f8013c8474af00f43692458930ce58fa743764312860102e94662ffb8e33f88ca0bd8e8b9f7a45200302d62364a5b97489e4d689c5b691a1df9d228b4f0e4d8d20ace74f991b48d82eaf86474a585552e83b4ad26b279a9b5306ab3b1d15235070566eb38fbbad20c3b58e4b2ca7141961a8aa87aa89324fd8d6e341196153a1997b46e305b8b639b8b605ac918c5a68eefbeda3d4f2f37b4f4453d384c548615979a1c7486983eba3105f7e8dc4f3615d703cc7868939f74a6fe4aa16d609c27690c0121cc6b3f52eb12f6733497b488a92ec4d9d495af26c8a9c1d8ea8c937b7259287317c16f7a5a00e3626142e5509cac944c96d5c2383fbb2009ab2d0acdcb928f7d61abe7ad786bd5a05175f23435e212fc4372f3204df7e02eb31e36d319f4bb96cd3cf51f21815c3e0d19e78cda4dd9670bc2d2f290a5e880c13847c0afdcbdf3f372936fe3636e6ee561d4e5bb60e85d2e9cc2a159a2e19d99a9104a5347886f601c6f909e52b3a0255a0345840a9c8b649b559b751dd3cd58dda9828a1c476530db8c926d628c38db5ceb03dc37ce8bfecb1753ab3b5e4a412dbb018733a4f7df53bcf95ccc1123cb3f0228b387fb4bf302f53e9fd8c9bc410cfe4ca7570b0ae206a4e80b35e209a4e08b25e2b964982b4fdb956e8fb8120669acc3ac489d83112e3861fc1d433c7350a6386bf7b88bfbb093eaa63001bdcc0b7027990ba400b243b40be883b9fe4e601728b67c1ba20e5f3a1765b66db3a3ff8f73b659284db2b486115ea19214114262585befeea1091969963eb240abb14e17becfc357869c710eb78622384c2933b83aa457d1af903ce06c190ba6168c681e97bf021871058dd26af20861f7ce6c741fb963dc7cf4277d97d8b498473b4b8695b47a82afe3eaa36b81a537967b739e5d5ba79345b0c507c499d7f48b72295f6d9832eb674718d9fb876d10613e46f97c96e69d1df3192d519d9d734a1a4942c3e44ea67e861eb270fd9b4a38e959e4da308cd7cebfb793c59b344e408ebaf53bed4795dea17411fdf007871a1c8e4ee68f109b9b56f376e491dfe52c93a92451a75c7af9cb6a9639fe00220c2797c9c227e37caef252b3ac11738cccd40dbbc2e7faf7bf859dc19fe63bc9d791cde644c87411dbe61ac9d216f1e63dc9d591da161028ce56f2166dc9b551c61677c86356f51601c87d96f5e65548a69188e61c49c8118de63044714e22e8b4389f48783154cc8e46f8b7dccbab68a39d8f1d179a6b3619d498013b84df953c49d663151cd6d518195218e751934db9eb19e335719efa411a186723aac113a736b3505b2bef3cb292235a04bebb30525a2cb0e80bcef0dbd60770801035c397e064313fe4660887128861c8dcf481b0b0c8098ce2e973bf0c7ca5801fd7b7b5b0a20b20e576801491764560900ef8afaf6e4e07d9f361d822bfb86b5b25f1e9c3cf94ce6b9ef3630eb211629b7a179c9722aa74da41eb8e5108e6cfaae472ef47b79e391f1d7779900390e56dd009893430a15020fe7f5651390516d5fb075fc3b89d5420cfd23ff3064178b7db3e74431d62f1164c430a3d2b94bbea646d1d450d59877de959164bd048e957c999971bcf85470751920a8bd677b54afbbba660ba46791e35b63109fb4415b3dcc5f4415f7d5a4b221a2d733e8d6d529cd312742a490493b325ce072ee10e34db3d997774bb8a8931fc405b5153f1d0a149cee9f88e0500319c27c27469cbc0008fdbd2fb692e136592da4a23f6259575359beea28bf8d94191c2b39ca64134afa7de00578b34cd8dc7b9a526db1824998e806ce5bbcb6da49891ff1aab405eee1fcaf2b4ae7cf8b5533cc05820c4363c816d12cfa04619530a0856d107dfec60537acbaef0dc1f09a8118bc12a03da65882c171342ae0568d99c5d599c3e9b98cbd5d574acd02f181c347feb50d6226b308174efc520bfc91e8e97b4e8b5d208856e53e5248325e72659d6559563b39941a863ea0f02da294dfea88734b780f29daa59d0c3e58b3f617195a1e79e7c6b83013b66379f1ecc454c7568edd39d0e5437d72fbd5a0d35b867d774265aacc144641e141b598f9cbd7fd57b5c2e539cb84c14f1ebff889ff9a3774f90d9bb2527b4bed8495e6791cd2e6a2839fa8c025fc28f5b4283fa2345e0b82d35f22d5e8558c94c7df027d1f8ae739566445f04a4e20814171dff9618e582f51075c7145bf187821520ffef00b7e5e08efdc2b5eade14fe4a6eda97872004bcac412c87e131fdb3f8555f4c64ce1d449c8f730c09cd99c9d5ac9dc2fde044ac9d2afb89a9cd2ea3158887b67808316ecd0df57fc38612aef9cdab5c5987eada1a4b1b9feed319ae9e6a2830905b02e7d5bc018dee798c7a271f355c971893cc31286a48c15118f0530c49d0ac64e5ad4b094df5e1f7c90861f5cc484838c6993898ad3e84b882993fa94607f325b4fc763a3014a3f944bca3efac21b37f4c1ab6030062457b7201ef8af2a990f5ecaecee05bd0c5403dcb7fc0dda7aa29be364ab5108b7d3d56f85c75416320edf584362fea6731800304df4c13c4b02f9d1b45b5201af5047fc4a88533190498d2083d8db2f1a238069096d84d912022ef13c0c80636c9077d04fa7c9bcaa003e6f80aeba375a9f2f4c25d366435f5f3f7b0c0603fce8402938ab16876890e19293707bdf4d117fc4c51c0516d4b76f3e5ab86bd7302e5f5d5b98028105b9e9c382c786c5d899545b298caa00970bac80069c416a4c5fabc8aedba6f95db6012c6fabd7fe4daf9b0254c323834f215941839983b4c827cb609d0f8bd3a1862785a36666ee1537db5904d4bc0a84ae2009f78e69a0ea24d47a7d3460dd3ae25bb7891c798ddaed9d46f7acbce620061dcdce0df89bdbc228616775a62d501c28574b068277b03c76bdb73c1e7b14849bb2a67bdaae476f9394cdd09192fe14dea386c6785377b3c2725e792608c8a70cab9f9c7017765f786d8a6a01ff706e93962a0e8d902f3dc7d11b3a0f24402b795b88247b640c3174c35ea9fb6bf40b049e3d5b863f8fccedaca1fdf8c8a953fa440a34dbeaa0c02db73d5c8e68da29f0a42c915ec9ad897729a4a06de8f94848bef619f4a92b268717ba585d85b0c2acc0914ba88a766140af6a1212ec76e9bc7c9088383ea33c66b2f826e3d19a5834bf84173587238ec347289a9cff4dab7004629a695fac2192470f968e84b11c8aede02ca86e49ed081d4f19ba55beee8b3803190780d92e17872dcd02bac44e812cb2a22a762348479c7f06e3ae73efff6e753d6b9e4f9c5e1b09bd603683c8a454ee4c775f82c01b5d3f640dd1acb01a97577d76dca72fb845eb70981694aa462bff18d1bcb280cadd513b46ee0fd04adbbf225a224599c613f935e8a1d811bb427bfcb850b97ce22a8eafe7878a498cc6640c630909c2e58397303981b586f1560dce2860e6fdaf83a0cdfad1359c80207f7e151ad9d5a720cbc6122f78a03a0d9a38b75789782e85b2c56c1a3f7a3cc093fc08c22c8bacb84e2b74fefa980b8b96712dc471a519c9851271df41ea3a6d80df71f4b600937dda8b6ee390657ea1b4a654994bf1562e88c69b4395288186c58a78fbe58e4fd11cfc42552b92211b1fdd92db68b3f5e6889a3e0367ea68a078d4e18a76ac077f518ed0accc300c120a17398c3d1b275242786dcda9a7b0623d3bfda543e4ddf292e1713205037aa08ac1ba6814dbab2caefd0b043211e94ee792ba62eaaf544cccafdf138338dcc9d9cb83d94779ec515b46f9aba5aa0a2cf61238720baa5e84f4ab235b5d8a26891d7d86afecc36fbd16a732647639f4aeea18914f521a163eacb5db75d360d623102dd3587d904458321f39d4b4dc5c8befc8f4df2d84c0eb862753a2192c9e363acee96b9c55d75adac0ea013bc5df2ec31efcbd0cacb8c6c57e5fd9eb53e20e690516d6219764be23c7d6dc10b859c9b79258c3e3ea19977435cb7184ee6808ae031ccbcf25c2aa789c1aed017209c1faa5bb6f07f42b5890711c0271e3212b27794e4099a772c6be2f8f323ab9103289f386dafcb6152b4951a3aeea8036a8763cd0e4cff3cff6ab5ae84bab2cb5e03a251b543de8146174e2be439425637de0dcd5d99eaff35ca1c93407e7ed9f97f4d7fe580bf5035b158f8a43b12f72aac7bd347923b64133f2bce2025606b02014cf1ce74405e6541ba3fd24d49bc676a7ef62c43038d1c75598f3229983363604d851a71dffc95c52c6aece73c18384ed8924775f042bfc4ead2a6170f2aba21cbdd32fd9e263c634a299a68a6c70997a8c19c45ef1394630e96228e9f78120bdeedc3e4f460dbed60e0eedad134321231527491bf6c33332e4ed42c6415c2aeac7810f23c40852dc5dd3267fc4c747b0891a524b9cc97c2f43188481f2d4afe66668f850be4b21818c6e0a20e8e2767de052b083dcd506c75e94b8751ad8556330bd134ea8d4a8da88868b4c0705950cf0c29ef3c3768cda58643d87cd677c1fad7586bb5f2a53e9a20ea05798ba58a1d8f803e6bb44b30a5d4521cfaa06bd31941a48166056ac9acb5dee755e1ea131b0ec11e281153ca95f80cf20baea10b30fdecea453cd88533662d78fb284727c45514845839b03bf51249febaf90d4707fa719083b1a84a6c6d9329ed2cd1ca1ffd0d2e6400aafafb70c34d8c160c32470dceb501cfea6e6d3c6ea5ba43cabecc92f936bfc8e6ddc195b6548af9bfc881fcf2ad3e9954466d90baab6ed40f865cd1eb40959a63b1daee1b2d1990087eefa2c15ef6d91521973a906d644dbb6ede670615b1825570271668da15688491083704e466cc0016745a111b43fbe4172a905ec675a2a263c29fbd48a8f22478cfb2454c26b19f354e5f7747dcfee9fc6ca5eed748be7e9c2e7e32bc4b7ac1ac3e3657b38664969c8691cd4f8072ccdc2944958022d4eb843c0dae9bfe20d772e877a9969e23ec36a306bcad3f233924c292aaf5abe5880ea1c71ded6d943987c157a2a65791280a0377b7ae0bb2cd0ec776d716b815f7cde24e98ac49373a0093764a630d53ba2f5bcc70a78b7f70018b3c2d15ca614412573298d19a071ff0e359948fdd7f2222716edbeffbc2b69a82a60765eeb02a56465329f121824925fb1aa09ab1cc358f3980de99c9a8b821300f77b3131fda15e3e57a8eeb921bd44ada0256a4063c683b105db4df984857197be208817e4c1019539f94dbb57bf74edc1b8ea0f2384018468b7632457fb7041053fc54f6f40904a735dc5dec25e6510ba90cfbed05f2754bf43cc4ed0bc501567ed0ce18ee56c109063ce5f2f588e9d5a88754758edfdac6ccb7e5c63afa278594e16d91ae6722a87351961b27920be9d98a40227b6ef202c47467598cb566c59530c73ddc3c7fbaf24f21fb057e10f648dd69d3afcf5a66e41758520c3d85b1372baa91acd31f9b41d298a25cc10295a833a68f3e21c5d7e8733dc5db21f0f432adcd01d9be5583784f04f4d12cbe2885da8500504ed59b175aed3f70b134e6fb0f393a068f5cfe5d0bb7578126daefb841ab6aaa3d443b57dc080c23ec1f5f959648a4389f6f38e8a106f7a53c1497cf0ca3ccc98c6157299ef1cb0e11501d66fee9e18073dfe286664ca7f36bcc3204233cfd333c0c32faa472a62ccf9e7afaee69c62082e109048bbbcaffb40566348c33b62a8c5eddaed0fa10c7aa132d99da16b8171f33a9d733a50dd956ffb30d25b6fc3788edb5522c1d23cb6748ebd120771c4d99d1490cdfb1cfb42ce37562ee4ed11f9d1f96667f915881f5dd179340af48caa32b890c210ebea01bc856a5ce3cfea101f482acda7d4f575ae6b00b4c48771f99c68e229a9eb1de20a21b167839c189beb726f9ca9d771437fd9606d00eea7c182975bac19f4b2887394dabf441bd94044d764154fdf01c0f0e4cfe755ff1c089718873c2ce9c4259dbd3f2dd0ad1915b35833303d5284f789bbad6dddc1d79b2f67ae85b6652ef50600af596e8ec282113357ab3d26d0c2d7e66cbbddaf9dd296f3828417ce2fd6a5a297a81334782774ad719fe43fde3369bd4ca205b7ad26ccfb7321ded242a6b3a22910d84bcaabcc2803c1d01a57b0af386e4ded49c76f288fe412e0d1eea9a9ca5cf0fd6a6737fb15133a595575e971195f991b9d1770ec71fc10bf43f69b831bf401e4d204f899d267ae74fdae2294a0139bfe980fe21c854a63f355619951cfc911b24f6e3cb46cbd12e6e196a861a6e6f05eb0d73a15d92d300936e7805eb080dc114729623340e4175332dc0e578727c097d36fd2fed5dc889a314be873383467870e6403b8b748c0ba8d1c29fa3841f486a1928c8409217be27807e4266916554ff07d3d241d7515a3d24dbc33dbb633048cfd13f86d081a2edba6689ee627c9a9d0e519d260a66fc7fb667755170e3aa36225b419fb39246f7951b4b1dc129ac0969b8223364e2131e4bd1b2a0b4c6716060471193ff7ce1168df783f30ba1b65a754d6fd2fe61aff78b911f08e48edee3d4be7a265b6cac765295cbf14db19d8d0a7e6f6f842c4866ce221740e6553f018a149977a34ee3b914628583d45654b22ce4e7c4c921d63ed4447989c4abc35f835762ef72e226c43368a3e281d800bca99176b384dd3c90f997206bc134f8050ce70d7eaf5995d8e960c93b33bd4b66851421e934a7eb77e399f2b99d01d78bfd6934faa534c0d87c0633e4b9a530b2155ec59685d045a6c4ce81ad3e5a8236c63e2407f7bb0ee533559cc10c86d42ed567ecde6537e09f736f451242cf3b4ce2ca2296c9d27e5aa2552dcdf72f8b566b973f31a408402648c7fe097083fafc2a085895bb6b5bdb9b4d71cdb053891269fa95bbfdba6d90e1443321435264397079cc45fd580177a4100744ebc7e057a97de5755a0d70432964894bb3471277fc96d0497c0c51d38541ce3d7ce20e89cc787bc3992c9cf9b18b65f0e305e3f57bc5a720d639d29106341c4e6c48631039e9e879feef7bcb764c398101b615608da11bea535446d856d9e4887ae52c22a1a80de32f8d1b9b07426c9a1e996e5e9f798da3f12cf3e5a0ad1de52f2d9f81b6568939c0c4314d2fc44ecf17bcb0ba10ee1572dc2d4a0df10556d80842d68368b42285d2171fc4a31dcec2eccde59c0445f185c38cd3d353782bace50ef4a1c85890798b38f62d641b5d059d62e66113b270e7cd305d36f7c775e7ba49c85b837f4c58a3cc7ae36414194883ee14ac802006c7bcd2eb62749f45348127de828faa568a623e34cf8f073f45b181f7e6e672578eee8a1a4b4ba3cbc41b7e449194fd1231124034cb55f1e7d985f466e9a1f8a45e915585bd9b6fdb1f4d292552ef9d371392af8abbaeb353809a5b9f7aa5059791910e983a1c7bd2b3bbaed704a249b8fef584ab454bf136b96e3943bc4a82c976a32fbfdf2e7817adf9190ecd6c4e5cbabdaf839cd182cf52eab75b0db1603d8a9060722dc2ad1ebd10712844c9d78c20e181a37e654e3f8c42ea74b5aa19b546d7a5d6b5e2e054b9641d5ffc7a159235b0a0000ec4014b895380f77eb7077e7f43b7fef9099a4081ef18ccf9a98040930b864b8dfeb87822ccdd9abca7c12331e739c50b7582937fe7b13c6bb3a0ecc7ecc38937542d15b13d9c92abc83bd0019cb3ec359eb7ae0bf411fddfb1e380cb8eba5df33cf3538eff56b253befda0bb2994fc402c74b5c6125d459cb3785f7ed2e3895c294d1872ed60bbc03c32ed3a3122a92aa9a28201e2080b5b29222803cd5fd814b1a7845303d530cc6c26e9eb644325e708644795aa3a7a6fa7d5f3b8261f3ea7061fa97b1e4caca4d6e78a2ec2800bef16db3bf632a8f5c11af325bdf410ad90613ac61abac3cb00b3cb5436392b229cb7eeea8fbe6d0ebd03d479c687b6884b14b9d710a0714cf4ce62efa59936bed10993634e8c2284a05173a91dbe7656e78c426f315d442a202f95ca8764e402367048ca15175f1c3bde855445e080b40d019afa749908a423263b1f0bc5cd934c1b3a1c231776f7fb759ffa0eb2734b16cda6ea85a35aca633e08a68c21aa0f6ccda21c302acc19820ba40d594a4435d5976f0654c822db904fe42bafc862604841e4384f6f06fe86a0c076bd411a45fe249d6fc7e8379308c0487905773f1f8c84fb87f4c08b14bad7906dca96427b72dca55925ca8b7cb30a34ce377d690d5a6545645d4e05dbad588d217632147388dd627e270c6e7049c1760963f63912ad6a2738fc6c0d7efa3ed2a1d3ff4665bd1a95e1fe01b00c1228ff68127e99fc374bbef761bf2adb28c55b1876d445b7263cfdd0fb9e655a1117c72186cbe1bec4f55418149329dd99531e5b1ddb1b5e4718d1eda4f4f6b9648d185cf4c15326e64715d7725888b51a8ea1a2ced5db1845cf0977601f303a4f008b57a0fb1aa25b60078baf6fd26734be0e825a24181d4ae332ee46ba7712bd2f8eb8153e7ba4e1aa50f8bc089caa8f00632a47a160b0e268844e33dd3939a2e1291d76f67d58ef829bfd047d8a3c3fe6c810e22b3de26d9e82fb0b0c5ed0303f1857fbabec6e05753948e3b5584dea475de7b59ce1d4101926ff8e6248fd4751be8ed405b49b9a42badbedd642184a5decd824fc6538a9ac4c04f5cee8d488b9850e1be6c77ae677fa91424472e943a81f7cae005f94ce8324fb0522509cbead46056d93b346d01fe9c20ecb96f0612bb98e4da857f1f6a6dc0de50bad944ecfea0f306eb449828d06f9ebf33afb0d69cdf4c76825d0f634a5136d7a8d5fa48f5411b95489042580107b05868a44551068542922b2b38a061b2360194b0d24041ae171093be9595dc0e2073e97573f7c5771fb9448884bede6ddb50aadf3b4c257ebff05142cdbeed7ab5d29e96cefcd6c74b8e0da84e89a2acfd0aa7069c594d98b10521bf0d8e4453f8dfed53846678b3c5b7e293effcd29ad3ca48dd9739f7fae31f3eb7c5d44e4a3b243328cfca2843161f67f46f8a1b7cee7b948290a212bb2be2e93254a6fe9a00b27251371d875f9dd22f12ccd4aaabcdaceb970af9edefb7b7aa192636b2aabcbd303f47aa987ce4990bead4b324b9db33f8c6a92cba74a008e0fa801149c436d24db34e0eac0b6024cd827382e1fec8e04aec30ec0d7d0a45ace230855f60e209d104c74e331248a393af2fb5ae1f3ef44c3e52e4431ee36d91470a5e0a1e90c708664e95e53b025958029ef42d2f00e879c866c9e5f4d66010250ebad0a0315c164a06347e56d5d5aa2eb5211e3095b4acd37401737836578af219772a8d4729973ce7a1cb6c9d5e703f02c4e48e4042335ea37ab908ab85ef4ef153e094179552c8000627c1a19daa540b5294b21f6ad9e0f673abc5f5d50f2c4af343813a03602af8f003147eefdd1d91ee023d0dece228e79390a4493242dc17f5919b323df7d5bf5cea62766c3b83cb8a486734ce52b46538f475c78493f0f57788439215c2437550eb17493631e171c7075b79581fe4d2abb31e9d4858034a6690a27d24d2803e67588848ceb96d40e8640ea7df7bbe90c821a10663519f028915b88db202bd43da314c365dd8eaade2ea1e8e4dde09b1b579b2e89548e8f326d9d81eb7706cd16112939ee1c7f9a0bb6d8053184a09cb240054ae0be7e765b6fea2d0044da0dc04ac455ea93cc052e2fbe45fa2a25c4533029938957dcd4e7653beb67a37a340c2bc587b8527983cfbc007539167594d3236e031188afa4482ae8ac094c8b35f7771c4d2d54c189ca51beec572ca2a1924fe43e62d51069de26a617df36379f84875efedcc1a15d3b8a5dbb8520e0dca871b3712221ac1bd3de4999e1d0668d36a2bf5ad171f687ca932a2b180132c4d0040e9627be06247bd064e8e4b293e1459ca49a60313ed257362e7af345c612cd1b0caa216f1060dddf0e759156500d322ecb299a08a0a90f84077b72b4e11d3574ee4f7e4a891275cc32f62ab72deb2b426166b82e2e8c6102b6e879ff3af4b9a5cfd9c1ab29680db4e685a5687f2a3bfc0de495410411e7831ee04099e58842c52dea4f8da2d5c5551b60da7658e8bebc47275de87f367a0666a015952b0d6275e73cd33db8839729deba85204b0bd43f5ea46fea628ab38f3e142202740e9a33bf8d1a39d23ab083174d273f2d2c08849f17a8b59358a0a64a0c1589d2e2a0f67cefa0a52b551735c485ce6e4ca35d8955eff5de88fe195b8273dfe277395589d2dd43a399377e04774e5399b9a49344752577877031a47fc16a987570d869b71e05386f89df8bcc07274ac0c0517dce39f8d07cc48fbd67509c9d37742006df3b46c6c4db8a2a1e3657692af5854b5b62de4793e4ad9317f17c7aac172d27a06e9c79de71a838dd66e268ab22a987128e78ff32f70d5e84bdf7e9a53410649537e6e6856f95dd888ec24a0f20d5f6638a0f9da2ceaa1a826a1f2cc0f39f21ebaafaa27b5fada790d97d05be0c2fe05caaf0b2343fdf2cdf38df1cbaff072184f17a3a02c03510e4be0785ceb0f98903aed28808d00cda2d85b23a6e3ebc1f08176667a873ccb9af96ba9739bb449e96b56a6541bcc7e8e0af5a006d3186b65407c81680e5b4b5532607e7d600e42d20abc0b748537320f02a7c3eb92a46beaa7af2d8935d134eef51b8594ae2f0807690785c83d72c0a67bcf96a2696bba60e7c0ce0b56c8d685208b992936a28d8c5a628217e09fd86c996d5519a90a78f1b191229705e5133f49d925fed773a6b3627ddb66c63858a34fd3f45e64132d92f90da1dc791a15f345064bf16d57e8eed52ec85cbaf6a13f945598a43bbdcc1c0e267582ababf24d6134ba03f0cf9be83cc3dc66c7471ec24ee5fdd69f10ef4ae3061cc5dfbcb7a3d99baac6e4266ab525158d629d91510ea5fccea574cd5493249653fafa47a63eaaa26f34a0df4b7720f003090e10a3815f11f9eee338c7a21413b65abfe9f4850f491375b7fd09ea6fb7a6bc6fc1e542fa4b3ea5cdb39a6bef0691f9092b7cf652f653aebec134e0ded21e222e30ecda83339895c6ea0cfada5863887012a3fc12209c9bd36c075058f76996b1427c5bb1265feef636722e15fa17b310034a8cf7acc7cf8e565c9eeff8874a074d170b1ef33c90bc1edb076f2491cabdd31e49475e7e30b62bd6865e5b03b6883c15a21af12ac63eec2c63bc3a6bfff6affdcd91af513b429f71e2a57769103ccd01c89740c9518298e4d731dd4cd5357713e052aa4f9f2ff80b2dce694987a65aa02728fe195037010d4e3070d279b7dceafd9dd02e50fbce458b47a43af16b41859029e0b21ef04bc3a56c2bea3b70924eed1dfe2ab4e767804ae1e8435b35965110dd59e856b0f4622c6eee2434ade7b39a2a1c9146849250f010d4edf1cd9ed3c070d9e9ee3750284f9c1923d5fe9c8994c9a3054c79c8269354972c43f662e42d72b8e271bb880e2fd60999281ae9a6030283a8d7702d0346bcda0144893ca3c4b48b72ce3d7262349ca9c0d0611eaaeaeacbaa244d36231b475dcceae7974794051d021f2b4116a64f3d33d913b852ec1fefcea6c4829747f26cbc367325010d3b4aa2b80c63e49353b13559c8c60f7c02f28217a4d451cd2419e400ce1ffcbb61b4296be730171f7d60f871811cf3190a97fae4cd7daa2de7d140c5479cc98db3cd5ac3050206a93fa411c686cf6931c0c09b3bc48039a91d63be5e208adabe1904a36b0e6251dd0ce6d710b724f7919f337d3ea37e55f41d5721fe381d7dfad1eb4f289680aba288c39713dca0c8f4d9ee52bf2affe228243d576228010800568580a25d8aa6533a43014c4199b4b636ea5bd7f73b94609c9bb4ad9a2733db8ee85750bdd980f51186db94379a1747fd9f42f783b9343f621107bab0046fdb2165e64eb785f758e8e883cf3cbfe2e82e4bf4f018719051f13b9fa57e954e50c3215e87d6683e802cdbf2126faeb9d96648a37bb2844d0b031600a2a17e3913b3d22319aa44f287f4e86f4b59491a32fd048855768ca987d457e31d98ec7e6344fed8ba3447c6e88cf899fadc566c95c06682396ae2ae255cb89164b7c85486186578f33e6b23653469b9ffa4721c04ae911a71430a5db4bc468dc7ba018630096d296e332fe84e0dbd260b04d3327e6c442651f6a1e7070b12e7cd010acbc01c38e918234ae425f5f83adfee4bd28f298964fe9d51fe89102e9da7cf463ac4ff006db2d98df5d70ed7b725b1d1bde6084d8951eed2fb561d6c63dfbd1d04ee98d8c508350b699b664a6b61d9ee8fe6f1381cbdb906c89274937bb366720c3d6ba78c608ee78b5734ca3cf2712a47b125c75b7322c8878e8130928927d92f4025a4eca8ac3ee25424ab44f3c0c0e40d2a6deb785a438861982e1a84c8fdb73078b8052dba9c3f1702c032bc92d2b9f19ceec6fd6651f790c094653ff368769819e3e2ed33c321f77ac9eb62e89a087f7664b7d64376f66c0cf42971f4ac9f2b51c6e3996a30f7428472748f65b2dfa2906e93a5f6f940ce8fa8abc7eba9c4b0e88249230c675447456995ca1e2adcb09dec39a3ce68a1e5a262ed7df7782927b1d5a77e36c52db221e1d34ae37b6266e31d5b4be616da0a7fec4ec1435de29b9a2477fcd1f7316aae3c363345fe70a1fbc0bb2dba8f943a028cd31ce9f95bf9d09251bcd09e61870931138512d7615fe117b85bab27cb4ef7b1bf4305bc0cc2e80a16efeb362dea0cfd18bbce62ed7852ef1d776ece9c4784f5d7281229a491a3836b746c923c8a7922daddd21d6d5aa9ae185522d711171334de5881baa5f6e78bfb9971fd7706679f29d86f25f5ac6e2397c39859a5997bb4b96209705e38e608021fca2d4a62ae88d480d70c481eb3983f92a14f3b012cdf838b278d022bf2e13ae73088ee00a9375eaf7892af336b4382d82bd18cf56ef727cc99f81d30396d1acd91f45c5713d5685b25ccd7ecd153eae50203f770ba151f37bb797f1d32057bfb6761d8303555174f8e6875aedafed658499e4f1200fc3c774adcadd95ac44642e97889c06ec088e8527b7a7baac6385b963ff65bee8223fcc929980216cf67245c58586b79cf0b9f1d51046f694da27ff9e237620bc6449273ee027ea5f73caef5210aebf5f6939f270c0017a8accdafbeeb14eb34a73afe5452f27c81e5dd007d82afdd5d9c04f2412e8b3b0cd0dc3161fc7b700125aeeb6ee3bec30f473b17726533063b2357674521509ceb9d8a6cdfe55f80c93474831021b24d979b63d83d40c3fcc66e3c11c9e823afd9f7a16a89cb8d57000756824c287b67568824a3306c204583f7bba04215a8cc907226732a8b138a305a2f198bf45017d83f04f5ae208b29aab352cd18a29db9389cc9302552753573ed8861c6f2b08589e869cb11cbc60df8a29c462782e342d88244d69ff1e70add94d33ff64b351941c28251ec97dc446ce38daafc600ca60aa433bf74d7490a298e565abc2506a7ad10005827f00fe92ead0830f0b2f04477112b32e3cca4145ddf99fd3eea6981b89d323656dd3aa576aa5448baa82a794c37e75a086534d97c804d1194f590458f51c2d92afbf5097239467ffba680008f1140bdc3d4bfa281083b57a3884c91e55a47c9be706125d841c58b7c4d6398a3d8fe00d0f4c1f1b488cf2a051f12964a42d284d6ee356f303904bf993d3f675cd080c040b0f5693ca6bbd271533ad7e0d176bc939d848fb168972f2166c4928913ff521a4f443a4113f0c418e320484abfdbac16a28fa89ec4b1b0c41a8dc2e33182632971f23cbf9d2b627e5a692c149a565cb57d04ba8ffc88a38d63c919d71c1835f1273d7028ae90c76f5fa75f61d7eea0c8d9fa28b9ff287380957d7a634a2b85c24ad572dc44135f9c8fdae22f69c3d64313005ac59e6a113c8ede58cc5e079f23979c0f9277cca556cc415242710656d5d95c34ea177067a5c760e0f8dea04743c474d3d5c9b8636f08f8e5ac177a3f5a6837edddcf62f334455a7e6861ee545b3173e55a0696b8f72658ba56ad6ef30480577f6a7b6908243e901231011d3107636efb098897d5af47919a1e58c51c76ce7a81b92ec075fba419556c1540e4e8f5294fdfbf5739d00ac5e73b7314b54d5b3e1f75bc3dff8021e33d87d3dbae5b7a21918e2f82333361c3c096bbc1768bd340b8dfaa940e8f3accc1b6215df9bb812800189a29b89b1413e604181ee0b40723555c29dc7825e54d70e833f0c4d89887b74d9cfde23efecc9de5f201ec7fbc24e02e95c7d92268d9bc40be49a711c5d24e615f206fdd24e1f0f94b094642ee36ed6ca5d704ba9a09518658fbe31f9d467cbf0713a5695ce107a9e9df9ac866c84cbc0a0af126f90cb3602713248ae63d470400e73c0a87624e28ba6da2ce3c8358ad0dc8af4851610fb0c9ba5c733f2b176bb558c09f935b32e1ba4eb8cb81b3f10074f80d0c3683b35458b5fdb0dca4d6900ad093a5d50d5c2b9cd707851416aa491943b48fdc29ecbd9393c437a51b629181ff60da82663bade1bb39660c3a10ee410a491343f7869ac954731b99b1c111e3c52f5e9d5ba3a92b02f5e0fd35a38711955744e6ef6b60e8c5031478ca1145ae12c076f5e2a1ac9bdd151c64d1c68d5b3846a124fe5901d86ebefeb3c23420dbfd9aa548ac8886c481598641993086f0c44af02e6cd1525f64fb6d422054c5ca719211d8b8ec5fc66ae83d0b4f76cf41fbe6ab737b38757f4b9804a40cfa7cbab24bf61970677ad0c88437bf426b205da43315413786a16cb41b28da18aff93bbf6c406172c93d65c261daba9013495d36ae60493f3c64ecdf3e4f7a6a9bfc82ddc2e23892362b69ddad1b0708a7e948cc1126a9f9ad28047d31d053198b724453e4b1757cda3a0401895f1726e62b311558f2e6ac3bcf6bb52513f5335999d2ee4a801ff1d642136b579b675286298501d42ec1a672c104175fd96b6c233027bae6a35c3d87a47213ccb28acec4a24f0160dfe58606711caad26dbf6aa0b27eee2506b363ddb8dd1195d8dae166d547598f9c009731b49bf03c0b2b13babba64b4dc31ba42a96f3de0bda172379c8a5f5210801d530e788ea8b74feda40f057d042d00fc5ce03a1cd1aa2e8e30d992207f27e92abd193739846ae112c97d2c549e44726f52d81d1581ccb48fd6ab2d534f62b5143f782cd36a7cc15db637394887194226ef1a0b89b780bbff9283475da6f444f9211be24e699c9e742287c437497d38095e4dbaf69b3d2e0144ae5a02bec1cb258e96adea9fd46a82ea4c89f74e2f528c61614e62bfb4bfa5f6d692c3a936aa37ae803781e1a9be57c947c6fdb36f41b76a7753c56850ddd481daad0d86c334498f941866c326a8baf675ddd7a7d403cdc667970a4e688c15c15938697391a62d01a856c62c21a1cb1aa407e955529c3680ebe480b529d247dc92227fb27e45829586855111454e015c876fd02a28053250f5a5ad384463620cae4d3652046a2fb065cef2be2225fdad657681a6ae228ffa3bbe5b7c43ff187fedcc74e0b5b442b37d6482f6951796b096f875f67863b9093d7405cd037567e1271aa542783527d276ef903dc1a1c0d5b634e796f946384619e70b2d43343f52c6c18b7d2a6905ca59d119121041bc53eb1c5e5953854ed384ef0428a9928618d8a75e29b246ed4ce55edb70b94f7e5b14837fad01ea60aa022b49ab0240f0709381fb200f4c1225f490a25a0238ee18ab360a107581946cd28bfda729c6bfd59028ab0892573dc2314f3b90bc2a70ddf50dfddd2e0c45bef5f046f0ee8a74b6360688aac8fe54e88f98a8a42fe312ed8f944158bab8ed8c39d5d20fb91f3e04a165a9194a18d9b17243247a6d5a11aa26e869a3a364e4f1b2f73efc94d247e2505a30c0328b3c4d30c69c4abf1f00e60a0951a2380f12520235e704d1e49c3100491ddf6e19815f022f942a9296adc9352a7d43ded7d84a8fc812b0a566719212681c7d7159a99779ab2b680d8836edb007f020ac26d57461a4a7f4f3ea3430b9335281d321d87d43bb0917844ee7e53582216936211ce8345eeee0a16c4808c61f124a47935ba16db68cc063f2455ec7acb1516c930e7c254380a8d0e0d5a969c1c887b22394f01f15ea99d90cf6037ff03307723269cd27f326e4715028f2418f18b7822ad7cac6a6acf15d3b9fd466b13fe5655e405c0e75d6dc556414e7d854457f8963b66b88c41d6f8f3a0de401c176c789f8a04184ca625f68746502190ce474d6cfc7e28d45d3a090623dd1d57236c99815da0ad965afc649d1ed1a0be676c64d19ce18ee1c804723fc0c3ed2da13960dd3be6ac0433bb550b7e205d7b0c9cbfa5efe17dc922c5733b58fd4564820ee574a8b4cfa1309cb6e5ce2d8e0368e3726643454b65e87c8b9d5dd28a855a0ee0435d00e4d48a8f6addb9335daec16d0276dc29c64257276d06f15b53fdf3dc6f808b1fa88b793dd1b9ebdf9e5bb544fae4d709931975be42e41c3f60074001031124dd708ea0734749014b9ea2f24465085e79baf617499309c0621495c4d250e025cf99e22dafeed1beae692963c3ba128526696a861eeaae50b2a36b605748f1bbbfec483eefa10065f6489efb8ec01b1702e7dd6b0cf56ab18498fa6184db4ccc0b3918ca0c9528dfcedaac42f329425b7a031c1ff16f6448d51fbce55ec31f9317ddb870980a5d9b3beba1fda601176d4b51225be4099676f4025634379c56690faddb06de7ccf0347e2f36553a11159db3f7c644976c48423b69f222ef82867c2b452a68f79c21ffe6542af9f0d3c6f9bab755bd344b017c6b016b1bd183bf1b904808038e510576f7bb0ac41dab8ff46639c5abcbd9f879082e9699468e26373dbba1d39f35ceb8863a59e871c842693ad2e4c9af029662fc0cbc772aa732a7ef71a83a71a0ef3523ec678bcd58fc8e1c5d0a912d2f7e062896b14ab790b374b7cb2be45573922382629de04510115e861fc49cd4ab92da508bd9a7ae99585316261518ca023544806482171923c1200d9601e2027cbbdc1b33a37a00df5e96bdd4f99b1ac02410fd98508f801666f49328f9c966c8d247db1db865b4fa24dc8626538ba170a7ae0b26bc55ef939e79b91a84ce21b3177b00bc747f4d13bd02124ba565be51995fab52cbc4c759b42b21b6bbe11986f1d86c12b89119bf84a1de00e8f3d5a3b2419abda1b5277d83310cadc65baa8ba9160bfc5deb1a313bc35c0dad7fa83ecacbef7058ee85cedfd5773b9299afddcd95f1e304b6f76c2ef4e20d6d548fb0e08326dabc57f72dc4ce4095fe14da8c00527cbdd2201de084679738827862deda58ad19b2ee890b9630e2227058c638a8cb32291a39837084b86a35901e2521fa7ec3357aa241c33b11473e5c6ba58f266ac472cc479562f5ba612c7504b0008231c485f1126ef3e8ad2788494302daa5dabc3d01572619eb136dedf90768204753b3fa9cce627d057295c5abd4a2171463c38aef7a4b29790cdb4b780b463a53aa7b925485600250bcab48ff714b5fc406330e96ad08e3add847adabcde47be4b7750876ee920f3010ec47a15671c15c2285f73230cd8206eb136693f6ca0e861dc5a45ab12dd806e1bf1ba28edc427259d99e4b75ed05483ced427e5388c48c5d125023064ee9f4307d26e8aa776fade8207510190028df6ce2341a16632419f708ded43e86ccda0da2d266b15b46628329a5d6d5020cb70d0df40f2d372df18b3546e7180867914265ec65567a44f827e01022d68f5eb41d05e32045aa85e468ba382e6fe46b327d8eb5e539c28d4e3ca7bdf262ff2511bc47bda4e3104474b39a6fca24e3bc55c113089b7e1338ff4958cf5aa2ee137c757868f180f86533494960388220d1bd123a543454b927eadc837e87d921cf3ee9e38ce539ff6ba81a7607254fc2b7bfb1991ece48220124428f1b166b06407324d5d5d67f0075ce1914ed472333630555ca7fc048b804809c36f88e9ab4cff85da118a443f2231e114cb8dbb9e89fd82a6c97e0863df5dfd2e0a884017ed6cd9dd78805398bd27a73151425be8b055495555cda430c8a354750e382545d26a4de72e616d752dce657673390b21cd8b20775dba6bdb596f7b5dde2632c3b103be02515b30350fa5eb8e2a71b30052e2643f4a9ced0878611b17fd1df208b23a49ada8557c702f3cea8bb1469542512ae94b6ababc2ec94b46ee425041f99db8d4f401b441013818b7a97fa824a2225cafaeaaeb3b332f80c4e336cf8c02b0d1a2d94627ef29e647984de1fcdeda761d7eccacba2b6768584d45d0cebdff46671e3d63d15f01419b683b92e2e9a3c454f1f1ed4704c2ccc815b767376437f074fb5e3b83737d3130546d5aed033a7498a8a02e3bd01325e107a673af5111eb78bb95a68bd628bef7014f8c0fbf9c56d34fcbe022d0157197c4c31e969d4ee94805dcbddbbcccbaa2f8406dfd7b5c8bf238e3fa6008cb309a6d96f1ea7b32e5819fe3af50714734b97e4920730f826b8f2473d599eca15f8e9f2aad3e1b8ba0ab977a472897b3b7222adb901092ef9ed3aa59456b9eee8a8185eed806b834308f3be42dda5fdcfd02e46aeb5a7a416867ecdfda0072097457b8299f367d28199ef493650e9089005fba9abec888e0cc909fa22ae2938ec0a331af128a9c7ce9ca74b7d24da8be07189370fbd9f6b7098d77bd27509d24946ea532ad5ddf56a564f3897c39efe2979dc6f74617e7ea845aeeac9ec3fe1e51916ac8c18428dd5a67d819a553a68c446a58a35b35ff57195d9e506de5051f3f87b4916fe3d8428fa03e27688e480e610bc6273d48a7188bc945535dadf69e009326a7c72349ce7847f04d724ee639c66a1757ace574c094fcc8bb958767bb91cc44b2f2ac38e2ca2d356f4603be748b62c9252db84d0c9e757c21e472cd2dda3eefe8d9d283330c6cc99202d80047c99683646033f9cedea632466f652559a5511abdd1d0162aa4baca6345c14d6533a2d817e4382b9f2faea1e3393aa81202385aac85f3b3214678d1253c0213a9aab47871797343f65ed663e7b754d4183d81660041c4d5a84223c117b35c91236263cab23bef90c9c4964c4b3baeca5f69b504c5af586a3e9f01e600d01240f730c1121ee8a8a7d0be38e175421398aab5eb17fd3ed3c75395874c1ae2341347c8310c4f3102066c1e57dbc953f412ea2220387e43b2930b5666dd1bf8e48ed94444d22c75491ff693c6934e774d0a89fb1a503d0ce0116c09a3623db7eac8bc46f2cba28330db29057eb96b934fc79fc8da624a28c1324c58b30cfbdebb44e88d0862c9d7ead3d45214da246821997c4203f573a25647949005f0c5e5e7fd486b756af3dc0e39fbb1cb478fa6449f702db34e5da4416d8ef575e5c4c08fe3158de4afe7150eee641dc901f4e1f01191a92bf614c5609e2cc14cd4e98746884c9fcdde20f386762f9aeaa36c632134a04992797b28e8a56b7dd6566a6d2b5e7dc9307f436518a6d71586e46552ff16fa89d3716dcf7c9145f57dcec994f5e72c47301510b44991a5e2ad056cc3858d5dd79b5d3fbee4d740e788b913c2a9d26a24078ec431ec1fe537927260605d00a886f1fb2393f4b60cb35b3407300ef62557d845d4d136171b3bc18e288c6862bda7f51827bc3849afbec7d78b3c09ff34e40e85eb1017f57331b1de520fa382814c6421cb60950b38b0b25d201d42015eb93dd02bd387c535e9a7bd820317becca4b054916653bc6b614fff055f53f9112445b08793f23b745a9ea277810af740a5e2e99e58c966924a19ad9c75d2da1cd0a01ca68e2ae4964ff5ec610ce35b9e190ef091a639cf5c36173ba79dcf73c37cd06f083fb78a8118d5659db4de7bac7f38d5d5b16a30fc68a4bd6a1597171de72d7770ecd15a2bd730658a36e399adbc216754a4cbcf9e22232ff6f23bea37bb15fdadaaaba27bc2559d5e314c80286029234e2d208f2bce206b65a95c37ab8b1b437de6e6cf566fa8f7be22bad3baa8e64dc10bfce20909e397a4ccc955709b071fe1e7ca49cedbd5874b8dac57420542f534f23efb5bc1e6414e5434a6f1d8886c0c878526abd12ca6aae370ed1bcf2bf2efbe39765797c72c7268a32fbfa9001c1ccc11439e36ca53f5a9be9793a556bbe509a8e658371af1a1255d73ec162b54c8e2f8fc8f414b0f1536149d4ed334730a1b01a16a0ca7e8317c1b75d31e289b0ea0ad2d1fc28a75d70df0503b1825e94e6970c0d27fe9bc940c587e06ec64a5cc54dc0ba51f9d9025e9c22f9789d36ae0e591f3ba7b18615ffd1661dc53a1adb2fb5b28d4167c4ff749af30df7424f656a57fda862eed244e65e2276c0ab9d0251a63e580256176531cd06d84be0d92f7f1f215bd93c2b284dce91e152255926b3886d6e9863f7deb1d2320638c503c980b49687f60371af0789e0dd3c353a49b02e8e4a004f9dc385d6b54755b6e4c7ec28db53e4810012b3be56f8d624be0dcb87088f5fb611c286a6ba5acb5daecce66e4cc750792a05f0c05c7e1c76fa4d28023fbc65d58a7e508c8c173fbec071a218c83824f90fe15d9c3410bde6cd6a33d3eb84d3a9a3c163656fd5aa815f3e854a7a8521e33ec22d8692ceaa684041544db61bb36be34a1bf0a8d1b0a4c6b1efa2730401a8faa410337e12a068ca149b1a6bf9ec58b3d4f64ef3048dc130009e88217ab4c644bda4d5767f9e109842cc2a1800828397e38d97d16ccc2e49e6d512bb6c4128eb4b4a7eaa19dfc6d3ec635dc1a2e3aa47241715e8eef468c9c7f959b98f0088cca5c2be1ab1a610ef30340bbba19dfc6eaf0a20c907868b43728f4c2cab238e974a08b47e3fac118573787590c5b5314abedcf4dafa9ac846a77692d252a644ecab688a344e8d014867fbdfa2d4ddaf1e761699122a8d743ed12fc85603962f3b7f52bfbdc18a688b454f4b8a3674a77addf183bd8ccf189fc33c67b1d639f41c9a42cdb7d8db1fcb462d126c59814a834f47baf041f0b8137c5c27f2180f944c40757db4e563995d288583ef9806f8223e3d4819ba18446d029f2264eb21aaeeaa0925e8ed2ac96c8bbe545f2f87b4eae072bf005ebd4c6e4f507f9a63957301f4afcdc28b339b7a28d818866804c5fd51ec033a0b0d80a86aeb99e993f8c6882e0b7d08adbf8ceca724fb73666ffb1abeec5cccb3591563ca92878af8eaedbc21e5222f03f7350122658a1ea814ea1ffc97931a45be492bba87b614e86e1d8b15191b18f79b72935ebcbd20d92d6c6a24fb3186841a3fdc8864721378b824c4e6f1d57ae8ca4d9162898e890b03fc57d8f6422ba4055eb9e9903c5660c91dfcfe8a75da22b1dfdc1836b5ae55b87221fc890f4b42269a1e369bf205f29e8ecfb16c2ebdc1fa4b84e0b60dc1bd5541a827327589bedc90d6d7bb9085a3970b8e7f943a3f7258e432d8364d38b6072a2b242807a170449ebe3a47d01579c51edda2b0b68e813d2f81ee47407918f2cb353d2533ba209befa66a9d60cde5daadf1add80ade6e2f9c5c85135f3e14bf7317be02324093be735e2907767a7a6529ab19c4b8da35b62da54fc9aa3c5fc5605fb34dfae73c37d26a0ed602208ee78eb213ad898fcd1ea1a09c23814c4d9c28f11400ff78076bac25c7b86ce5dfeac24551c9cc16ff5283266a5d143843518e8e5cf39263ae53e289bad0e32629b4047bae0cea5d041661432e53dd0d265cbba1b5c4424f5c47c56cf86071d4409afc2950e12ab41fa2960e22d2b8acf734ccc11e3ac3e0369b5ea6a311a14afc3f0655eec073cd68f048b8a823a70344b54f3bc0b118e3d5520d2dc77e9fe7890c38bb7e41dac4619df0cf8a4c0fe618d67fd0e9e3c8f8c338a329612234cbc2350a11b2f94000eb3508c8db48cc29a5be4ece05a3429dc06bc7636d1cfc32bd1cbd0cfd12bb75adb53063c22139936845df177fe8d789a05c458b8e7f126f193904b216cb475a9f70b23d6a85d1ec93dd9017e000780e7dbca39ef9a3203f29d8b6c6ac64db8ce55c88aea4333b0d767484bacd17132d4c69b8c78659523fd70e65a152ddeb196dba2f9be8a6e1c91a110965c32f893f8c3a1f96e21f679f9e45675dbcfda41fc31bafd5abced8d87c02887abe32c0e19b04f9dcea418f2c43f53c1666cae454503e0aaf9517e844b059f9b0841a8ea2ff0278b30253f1b7ffcf67675902f414e575ba9f908f5db38e47482f5eccf8d7902506baf76622b3860fdf08eb6f40cc253a555da7783062323667bb69d40024a1b1c155695c543b0c75beb0016d46bab7b6081f12d357795f13a575616cd88dd5fb0e53e4a7343a11fa466e1e25bd81d9099b089f9e6dc1353f8300bf4d97a89e02b1aa8b8b3fa6a1f57eba02a8e223d8317d73671c4fb3db92ab3933f4129912975b7c79c37cfafacf6e5796a5fe12cad45758183a11246f1373e38823fd1e2b3d8198049976ae141c68eb68ceb4f89fcb6fbbad672b8dc500a75eb5dbcef08dc085bcdb6d6a4fe06343cf91d918655ca79043b30af7a74b89650015089f189fbcfca389f7645872450d91d36878ce453735830420f19b093d4937e3dbd6e309b3664c08fa72c7f1182a8ae34056a40b37ac40cc02986f31a9e46168b0c86e0e2fef35fde4ab271f6fb24c1799827d2a6b7aceed3323a39fa29099408d57bd5abcf7bd6ed689145d548f5407dfc0f81558a715679807be4d09ddc170d1de4442e2d3b8f0be697733bec334c949f1a20725137d701630609020822f768607cad686a36607a80bef458769f7a41a997353e404178bc125f21bc4289cabc5a06bd447f0a8853a785be03e0ae6c695ca33fc1524916f059901368e2877ad8bce605378bfa1d17878493e27019c14094da280904525f10d404eec9600a8721879939983cb1834ddf5292f8a5218c296cfdb0cd67c8d16f78664841a304c54031af0f9b4b9342d22f314b20f2671317a0d3f31b31fa1472ba2beebda39fbd2efaacb1674ec5e2076f08b96c30d3c7f6597fd64b17c192d3529e84f90a50184bf0f31a216e8467c585d4e0f99cbb063be053de6efde5b80eb899432611d665bb60ef8a206eb8a10427d4367da941f7f219c50eb3ee0ca13aa1caa21a25bd2fd3a280562de9e4e2919529d0555c1e7268b7d40b4f0387c843cdbef1c0570a66839cc7a7b29c2ffc8026f4803012785818c594bc8f52404e788c3308c209ce1f0238b9d6cc0d91dc701a098cb28b22f7aa4a054759dd0af1dd96a00f98994d5d028604f39ae29bc3f6512ce016839df165dc3acbb59adf5792167d74b158e0f3029b3518f04b02e2a14015ce8b1b02937b7bf5572c66f0f4bc8b4fe127c1b03086363e37a2a9c3e3ab2f4d2a627ed5ac9be7e9c71b8c75fb88062746c06a3077f84217f196cc7463d3658aaf5e8a9ce5b1ea1424de4c735e514f6c4f34b2f38f951aee7923f10a6bc220080f0f43ee3499b6ece8b1586d80d483b8626172aedd2d3ce76e90f320202bbf8cd189ae489a228a0b8ff2e4344199dd99425b89a23242a79f0011d59e2f2ab1bce7af2df2b0b20a35f6f20dc9becb135957705c22d4bb26006d3b81dec887242eac19b0070381bb1b2c7ab9c85699f1a4922817dc439302845f810181f47c35ccd143ece1df18962c9b52cb2cadc567f123cd40c1a294005a92da7b5f03c12a09dc2de5c414587ecca48ce8ae0612226919be7a8db50c04aa3f7f16b8fd8e7cacbb91e2567af0de405e9114022ccb04da806a6dfa1b8176433ed7f981beca1dd74dd48e471db3578d0d6ed14c873b6779bc2e081d72e5f91ece56d7fa94a114ad60efc643c4d8f409a5e920023f65443c83e9792f557d656882f05c1cf55916a8c1f8a6698cdcf4c6d913839f47f105334dd0f35b7ec273847dc20a890f6615754d86963b1b359fd48d1c42b64ca4a4239cb86c3a7d4c825443d0e4d60219c412404ffd3a754dd9fa64de4feebcc28e82a99530036ea3cd7bff0ce36cbc8ab54a9dd775812327a99a464580ed46a22b48719c19a581a29454d32326e98f322bd12503d5e8bf9b0eab7e203d060cfd549986843d5332033227503f36e8c5bd001e604665f5c565aa01b23b44191e9d6015f450c38ac5e4157c87e663d2058bb32490a447e87e3db4c9567bd75d6f9eb8fcc3ffece2406f09aaaa4255aa320e6b6fbdf486ff6ec331bddc10f110a75c13ce120d719b0040ee1eaa6aa02e06e1f4794ff30e1308bc1fb5d67059789a109d1e78efdf643e22833cb685ed4a3f8d52cc73549f1697bab3d0e9484e40bf895a48b2dd2b5a14574a2d1b1cd6a93b2735b4be44b2d88475ee152a76f03f27a6c6725b42a5e2d1c486c6634783a38cfcb339e378cbaf6649b6014583b4a0d4d867c52e6b1dd4700d5474c377d2f87e8013ccff31be64bbd6b1f34d145a0ce68738789b724fbb82ef5a47954f02e407fd36ba7d9ffd7464a6c733b535ffc2482b2ba06f177f1a1ca3f8920b7f38648e2dfb0079764a042082b90e82b3ec1e782808c4177a3efaed531f74b70e3fc271c420bd8e23d936575b07df1d912b68ec39c4cddfcba3c15e931871b86799a6c6b64a2b7e52e839ec910e2a2a740c3eb4954d90a3aa338a11eeea6f7e37350c073e799c2f0115454391b9613749d347c06c3636ebe00d3c916a23be3e9426a01fb638774f5b44cb200896ed6de7e6942a944338e0a505d7071cb2477bd67ebc585598666c5710185a1bb91568759e291ebf3695892e99b801b9b45f9bbdc5f6cc775c7dd342ef9ca7c8d94268e4514525bdcbccb381e883da96b6edf84641aa4c0e17c1ff301052c568c47ed12b74edabcc14716fe1413fe6441aee33c2eb581b5b9c2beafe3cb18b19617a993c53f211347969bb9855b4e76f1c62fe19601dffa4764a853acabfeb17a85e84267a354dce1b0f4525281a447fd153a6702f8d469a52a14316b38e6fe1760f3b584f73a97e22d71c9fc3ced36cba5c9990ec3fd6dea4875e67c912dbd7a778bbea162e334fd5ab649e67b8c00eb8ccf3ccc5f277b77efff7fe0b5bae9625774a888c1319274e5e14dc6415dacaf5bed43d6e68e3e3e756fd36f9d68bae79193a8bd1feeb11cbcd1479b1a944d6e2d57c93589e253ea047bd0be5acd383aa554fcb7d240851e1d84152715ef3a6073fba335eda6abeeb0df988f5e52797a337114f29e6c3cfd89934d6bb30f6379436dad6853851f77b9a38c6a7fb8445617f0f170e7e43be401fbdf68ba219ec33e1824f1ba3916326c1d487cbfb8afc17f5fc8720548c74bc361d3cd75e2cb1770694ad5b6f361c9ffa058d69c9bf85e8f3d087472a09e08f43f5d6eea94945c9d8de3060dd4ddc32fd5b164cd3bc5fae1e255b23dafbcb2ade26cad5b17be3e7fce221878a1b91ea599834b0b387b002cb973b157fbe09afa45c43545858713ffc928cd4401f4302a23f74d6d40cab6389865012e5e9d9158a3372eade48799e6d9a69f5b383566bad44a38c4a42152a5328ac6896aa4dd78a646eca2cbc870a822eb640aa878aced3994bbaaa88fe6ad97a42f413ec8aa7dbb88e09245ede93b3116692cb10944eeaea62cb3be6fd2dac4b2d0562cf6fadd5d0aebb65444eb70593c3132c0058d8e2adb9fae7aefda2e7ce8b4138bc4defe819c526afcca99b73c9afec9f89e4ea77c2798c5dcf02a4d04cbafa1345dbafe61f0ce1588f53a865025f1c76b0844b9fdea8525d6c092ca4db68b70ba870577ef08977208dd1bb1d5808ec4fdd232ba9576bb10877de05bb2a4df91e381a61fa6b0a7a7097c76c7e2eed13e85a17b11274fb3a89606888e35da7b4cca6fc263ba14a9c570c1dd5df569c2c4d289268573f0173c78c23a3653aaf247f636777bd07ed2206d6a2705601f647a736c44fd0a373f9e9663f762d8e83848869f6af49a069ea20f36178b86e220d5aabca1d0ad6e5eb5043fc96ec9a1b034107efd8c4c2d4f81036e547c4ee1fe32161c236bc915bc7021340c5aa5c4d275f55d64c1b9c6bd39faf25b82f0707971c59cdee236ddb321e0421f7d53f5d6875d9661fd6c4836269868f45106e98a149f0b1064e1e494d4814ae473bc56caf0c6d8d900ab3608831f9b55f7e3f6c41b0d21d52bf6539095affd5e0842f4b42cc46bfbbd6f0abcd62a8641f429f11cfc1e7e0bbcc10e546ef1911d9a7103bf730facf56f425ec31528a5a333df7f6f4dba1e4973d07a7b5b8b55aab064e9be0776c13694410d8ce94d12e10d400911ad65fec7e19c5f93bd678f41beff7b3a6e1cfdccc949be4d749ce335cf3d0d605064c16169a565958b95de869f12129e266cf2515370984d033666fd7cb4a5d4b97db330fdb34abb309b29dd583e7b1dbd06d629b11f02e20b3d947eb8b87fb7b668be2f76497196a16146660f8b42624b949e11f2ef5a4d2f7b780da7309a964fe2af3bedd1a6c14cabe34c21fa3cfe265a85b96a22c629a54ed58cf8284f3b4c7331e666132b3653e5787b8c73b2fda05aae9363d143286791ea4df28d2733f44d87e82f02abc59fe92488564d896b7d548a0aca934794ad406ff1c9be0295cd1fe0228a7f38e5a45310db3ca0833ce90874a8d627dfb9a4b2fa781765012b9d2beb676eadc49ea6d1ceb38c98e1cae3f046c96cfe616e9183df549b67bc50d5786b01bddf548d18a25fdcaf6a2a89fbd4852e5b378901bcdfdb9d526a2f718e75899a21ff387c3e4c4ad2bf709e6632ae94ca3199ac96885c5e79fd8d4e00b6fdfc680417b16ca327249c1a672e6bdb18c25471ab21f310e9a2493a2966ab2eae0789b109237c87ee4fd8a0c1e66bfcad6a50a47a68ebbef22a3dfdf14520522d0a7136be284129d57cf06eb5ef28eec5c37db85427bb51a7c9597897b79b242b313a078f775dd6add1dffb10cd5134b52b7314b5e62879d87fcfb3899c68ceab96290ddd1d2b0918886b046304721fe797667f8a8b9f7beb161c01ba24ebf1e2e1e6f807e059c6f185c7d83e68a73bbdabfe2484a8f69fccae2c44832b0e34811875bd0f6b784bf6485291c6013e8f26c6452e43b7faba3123e0b792cf482d8262620ce207c45aa9ec883aadd415cdd91597a5b0d1bdb2047af0d73890ca1c25b03f571fbcd221fbd01638d8d589dbd41822911e8e370d9e0416ba6b86e3e0612d7eca718c71ff2cb56611aa3e1b6fb616f082c03c4342e8695fe3a340d956d5978ddcd1168f7a48f3d9f7b1a35ecaf9296f7c31de3c4200be456b92f7cd70f5cf2467ed52398b4787e31b19844d2b0669eb5fc30d10550ba817fc76795f21fc34f7a3d20745e0cdd5c8d296ab45eddb7e74593d2fc57f69937083cb14f665a1ef87d09a58a2447cdf86c2f3e4ff60e26e22a9304f59b9a92dde5f8a8f0cbf52c784738305e21d293b81a6b14e31702c7f4e933e376d95a7b639796c614cb0e1554ce02af40191dd1288336117472e6e1d2623bf7697f49ba822adff62b33212abf97f95cc9a229d16f62165a3d2b8b5ecd208723ed86b7d06987215be082397c48f33df859e9d8b9e015d188142daf720ce06d199763bdc606eaa138e3d8ada23b3bb39903710308927e05d6154699bcbe6d0e1d8a52eaefac9e51f999e610a8b26356c939371e40daca9d6cf25522625506e8f353f9d6824588a0f27bc8489df848670893b89430f7c77fb62c04249c1596ca84d32af1b8288d16fecaebd74042f77a06e17994826112233a6c7ea9e22476beb8874f2d95906b3e6c65ad248d12b07dfb34721d0d5b2e705512f7a85399dec7a69970c60aac5445356f199642671423eea4df6f3de696ad296ccb43edfb97e4e3fa3d54571ad108ad6559c93687092e67aa0c7cbdb0ccaf2e2d18689a2498145692132e5ca75f29478cfb13462924e7609e16e1197e233d7d10a7dac39dfd2a96762370c84d2d9df0230ebe2d3b6f632422581add9a30540708caa84ffc342390c7384cff5825bbfc995fc5df1583a0b2eb1a6621d7c179c84ecba764ee991126a636a365ecd78404aed744b3ad0b8abf61bd3257cce83f5aa453fceb2f9c1286ab90a056900db04b3049bb5dec05d11f7c73ba0efbc0a8ff12d8eb5148eeac01fcfcae2ddf0e69bd055694a85f62d17e5a39a371464edf7f02affe65dd96bb58745dd89e6ed8de2b037a07f96531cf43e27818eb2f4be12556317887d24933054b0b0dacaca06290dbf735128a7ed719aa366af46d4146095ea3cc948fedd16a7cf240cb13ee4b5365b31e7479a23d03ff1cfe87a0af295ba016a640a4bc5c5152aeb95b4e69395c5ca4ef077164f9ab2e943fa3d28f3d1bff1b4be80f0fbbe0fb49d4767b8f5d6ca47d80fb23e12b430743dbe69adeea33232b3b7799bffca53e6747d156f4ab8cadfa85a874ca9f96648c9d72654cdc3bbb7cac9d6eee762bda990b401f5ec9dee78e854c0b91cb41316132207122dd2b5f3625cbc79cc2121baefe1dfb3aea46b01bbf61784f1abdee3d72a676e2ac65ce990b1e4efe657d9c97900662799241d6cc7bce3d8c1086b79c69f6610136bb258e60e8fa365996432cee8896a3abba077f96c52f971fbd92d490eb1e7250d14d858016142fb5ad9e7296da81023b0793310ad67c7f9e3f87021aae12a6953138692f0e9342c85dcf231b30505622b75dcfc31abe8ae33dde60364794cd4cbd58c21b36417c82fd4ed438ad2af8e85802858f07cac30c625d8a9eae59a1a514851d767171d81bd5d2d9df94129a9451cfcf4b0cd889d113dbe3d6c526d17e488729b6a5ba1630326be8dad9bbae9b94e17047fa2ca7d37c0ae675cf675b80f09191033966fbe5834ffbec51eb2fd9f0266da07186c37a96cd44ac2f0f2261e8c231774bdbce0ed7677ea47662f9ed627ef66de389bd0f2d0281f8e951dbc5b217b0fa7b959938456abfb5c980af254cb489eedd6c08817b6174ceb2322e94f01d38bbe16aa0af423cb0d29a612d6e2eed68122ef4c013c21110985e1cc8dde22fc4d879d8c1476b780139a4cc59011c32ab96fbee0b1c6d5251b18a42f8c9ae5c735332a2c677dbd74c04d93160d462d0b4105098a07f64060f0e7f66fb27c0deca49d01555e46dc4898ef68be9806c796b9d6a597695fb59c8243af4fea89a1d222ca275a1d0db78acd518bf1fb23b197e662b7a57d0699ecfe0f86e5d6495bfb0d2569c411c1cc3beb415cf66c1d3cd0ae924164b768e24b8e98b7b98a718db622914ee8db2287a28bd6e322331f20ed265492c9ff2c544ebca235e68dc556e577a0fb4988ecfc8ee724fb86839c4ca2e69495632d1490c34ecfe4a5707f5f3a9c628f820a6da27862d517c5e6377e356f605f8f51ad387983b985df9806573cd89cd253bf4ee66e554bf33a2dc4bc604425b2ab4fad3961ad160f9bcf4e7f40773881239aaf61db13b57b8eb7955974f814994d7f3a528272f33b4bf47f2dd87fc9825f47b342cf09bb41bf1a126cac4c0023f49177de85b3dd53f1095dbd472b3219e29ec924b03c5a470c892483db32c4b62e3f73e3fd1469fc3de4fbd9d21a76433e1464583559da4d4b5ea577b53c5e216eb63376717ee677ebb290f76834de0f90809f3556e8511dd09371f0c76ce8f30c53cfe89b46fa36ed7dcb5ec8f0f641a9bdc385d91e2627ed5b5506150f283f228b1bfb0c70d6b66848536179e14d4d6bb777c57165235257fd1194405e577e6d512412111faa098776f7f3ca0734a9f6271ce6a9d41dd65b4d1db7286392b852723f1b4d790f270981ad4e121f14171565bc6d9e93f5326cc841d732add7268eda19fc757563e1465af5c90020917652febeb3cd2856313093f859d62eef3d946bd47106ebc20765cd067abbd349748b4a1b4bd32cce27613a2272c8bb9f2806bfd6a24e895b1244f098854b7275c4b0a0f8adabcd8b693a8bd87e43e25aaa2963d2486b9181dac98e32a0cf333fa59f105e2d81bd054bd574cdd7024a4acfb2d3a9a862ea5ace21e7f1af45054fc850f56b1b1d83ab3a91b9ebc3b0cee105fb3f0d25a84dc0fd3d95a50fc84a029394579bc4e30bd049678295c41321be892112a2ec1356fd45c74f3d56987a9ecf2c99bb06d03e531964ec15b6b36f2c36437f16febed37f04227e5e43fd01d905d731bd42969f23214799029f4f8d3885e158c8b024737d685a805f77dd3ef13c4c64f256749ef3813c4d2388479f2c97a023a35908c9353af62db5b4ae4feda3352e7c55083ab9d89b624a44ce439ffa7c7f1c9eb68f026afc8400d731b7701f1b4e362d567c887940a75423459fc599fd8f7c862f938f3263e9f9af5fbe0572021f56cf2c31065a22450588a8d74240fcd43c38c280d09d25ddfb6b8a5f2aa1bf2a844213aeffa853f3685cffd4a0df3081daa14592f71bf67e96bad78ced9c6f7136d5f733d7e3cdf5060ce22a928b85f996fca474f443577fb7539dd3539ddd5e8755fe26db21d1b462cfae8b9384de3a891e8e4826721e9e35a2162e25403eb86a0e8d2dd05c833604031b1443d7ea674fa0a8b111341481237a81ff7a3f7a26c243e82a74dae98f06dc201eb7a7ff5dbfe57bfee44d66ac869e02614998b41636eea6daee10958343b41cce1098e9d5ec0c42b85b83e118545cb38e072f7cf7de7bffb01127c079d64c6d5310ec3e804a734af98809342136c40b75f0eb3086cc48218a9c3d00be4eb9d709c1b679a573ef3960c48438492e6b7dfea148eb54da5d6a79a13c068eda3afaec1fd16a7d8b8a4619a78bc28718bcb4ac68b4a9268b809cb0984ba47aefbd9042c766b3642e8a3d3d59a66209b2e4a86fa9e5f067759736ea2f713fff86150a1d7526fbf6af1c24d1ca819d36f7da01f29d9962dfbb12e4151dccbe0ea27603d23cd9a9f15d64fc7169f72b8863e119959421e26b4675fb8771aa6f0307cba216b1ffeba87a4bcfdd876672873692a686f93e2fb8a16bf051dea05ae3224eb0ec1d95196d6fd245bfcbea3727af3c43c8aa87c55b4213480ea15858d075516fd6f6a3e54bf3cee0829097da9e832246fc00247ed5949d3a6636f4897695c9294a77cd7db86edf8c1ef061eea46477549e3f0eb7a4d5c425652fa7eb3ccb5a99f5d9524ae21bfb7195fa43936f2795c79b1e25b6bfc799643317076f3451845eb29914d0ca8b25235f1b7cc55741ee401e2bf5f20524e090b946d7987a38a9f2b74d7ab372a3398919bc850b023d8f99943f67b7ff229791e4c954bd852384b1e38e6c1e7831a7bcbd575f01c8e686e6bddaf6b2d44581fcc9d0aaf66faf8d7dc416d9cd1c2673e42a8f3f3fb62f0a7026dc199c340d899c7895549fe4203592b2e89e82be0df7eaffbf3f6e1b2438abd157cc8d0fd00e1eb87ba5a88737c1922777cea01119da715b640f14e469f2129a37bd36af3954f0c62cd0c4b7d3eeedb8c923249d15aa8f266dad45051d227b0f5a9042689aafa27ec15b8df1fbee458568290d0f113b4ef43fd7a783cef08fcc3d15e4ed38a05cdd8adefa91725892ea5c645fc4cf9eba91a82ec8fa2a7362d54f46f63bded75c4e1a7a601a328f123dd67c301828333b7b0a57fd69be0d6855934edc7acc2a70db43399d2fa1df5e2513005ba65831777cb7f725d4c5d6803fa1e51bf1b0379721f2dbe9be76dd37c410442addb467ac7b6403ce6a47c0a6fb8d358fa2653de18a7593e0ece93843b35541905f755da1111c7f5c4a474174286410897b03119dc3a905fab3c681990d56ec98359c3ea6ee48dc4c8399d51afc669c5710eca56d935e3311b31ec7ddb4f74159a3ba83691d86e37c6e51f3dd31ccfc24589a9abfee25d4cff3c02aa497d5ffd824d4b48ad5acc5fefaefee80b985bb6e0d058a483436cf4cfac34b46b378f3e2e2ea75f4aff2025a71f1f9791243544db89092fcf73f49870f741d4437b09a03141ba6390a19020fd7dd5b3f9b3ee47a850fdb2f29f058f442714a041cd3e8f7fdac1b20c630325b0e16287a823d8e65799722cca57f2106c72212acf97b61c524ac65d8872ae258755d754eb57e6b539351abd3583f971e4d84f306d634d926c97b1fa245560393bc6df88a1aa3bd4dea59ca4493c39bf5d5afbe053b1fe9d4be96e8ee889715b7a1c2dbc9be7cffef3467e71dd2da4aed16d6726849ae3c8773acdf419207378d0afedf6e427a807db279fd29677d27b870becdaf66e5051d143783acf1bc5ce51e0f8809e810d6a30e64bbafdab9f1e00f60edc1a48ff6cb007780044006af00100700048e35fa8d2654d6418c1ae0149400e48e4bd2610f7588907bede087f772ddff41391fbd65fd1324bce5fa4753990aa56aa39ac08647b78f64e1c267c915b5e90b4d3e8f42659864a4dbcd0132eac5a5136b49b2b4c51dfd562885a25dc897e6b64cbb82595089cdfcc34f7af94346272d094007ec4e8cb03c2ce0ce33dcb8641245a173b1607e8e3b03884e7d9c7a7946252f820f70aab2bebb033e521cbae5f98a4452f5d2568170334a8893a71bef2db8913229a06af39602eb8994abe454fef464d3d0df88ea23798353d41931f1b68f1498923fbc20ff689a689ee922ee1ee5b31f4777244a7fce551a5d12d33becdabfb3862213609630b0661aa7b254e1c5d542e883cb565c9f10a356dcbd9a45e65f79e791f8508f213cb5d61592c38abb6cba266531da27f9bd8f1245b671ef427be83832ffb988ab816a2777f54ec569903d63ec6f0482c16a8b045584885019bb8d4e2fb1a49644c1fe6e8afb0aaec60add02ad050a75d25ef6d3980423bb2bd93d563e74fb1c59815dd5c8bcac816062f8f848460bd85620d668b7559377066346873a3d9b2691fc514285f42e2055fad49303613331f749b783cba6b3504a5b51c88f0dd435c741f58b9b484fada068ebb277ce39bfd75b7327094ed9a42e0633fb1bf0b096440f1568453735ec8c0dc949cabcaaee38eedcc73a34fc809082778b39e1f52e13d442276abcddbcba29163c350adfa6a2ab5aaa71a61d43e628898df51d7549210c5be6f47cff37ddffe0a82a1a9cb5e147bdf4db7aaeb992a28e520a6f4e60e1f1259ee82a5c2e8820e3eaec66e9685e626563d11fb79995f4690cdbae63a5b9cf3ee0945421ad2da4cdeba93cd7ef63a5bde93a773383bf9f1ee68129f25904dca243bcd9093b7a1273379c948dc23cfda744d86de4925aff47509bbd09e2e19439a6627e06c9266197bdfac7841ce4e6217b5565d72803f6f23018878b0feb36b809d23869de79c766c37edf0890f5fd2fb2167c910ea5b3a13171ff4dd1e15e13630f374f9ca18b6fa7c83e0e6c5ec13aa0148676d5fbcc1b08b57471b8da78ee4c6d6b3589a0fbae9361bbf83cd16c4ef1d4f864c71b13d1eb165b4be6db4bebd97be5d96be25d2be1dd2c92ef8819e78c5277f6d7eec44e6f50569e57d6b93b4e632bfb4e0f5d46d57ba8496629c3042b5aa1e0ff4c5933f463946c6a0901ea5206bb867aca90afe822e9984e81aaa0fb4967c32758f2a3a276f6a5b698cca57182d099b1bcf754d078630f7df6d1c962fbb377274c5b9d38f853c36a4527ef3e5db6f758b57b48af95fe7e30eb79d1be6124e509880cca28acdb17555570e3401a67e7674de3f86afa1988c610e902f75dcfcfc26ab457a311c3f9beb8450fbcb5363baffac713c2303372438b71bcc1f315cc531934871ecc70622fa53efc0bc3efa2634e4d84b1c8c0271539b0630e136df41c21189307099699e82297b9fda91b59080bc23920227b1bef7a624e93713b08a0d01d5fc9ad51c1663cb2d375426f29ac57205b4b4a520ff7df79ac2060454f9a76901dcdf49bd4b020614610d006f3f56231177affeacaaba65b2c24ceecfbb3e62351cad01f8efffa8e66dcc8efff6eff75c55fffedfffc7bffd9ffff66fcd310efff83ffeed1fffa8e6e9f88f2a1bdbe1f79ffff8776fcee763fef73f2bfef73b6b87fd3f86b99eff5a9867455f6ff3397dffa31db3bafccf7f9cdbf0dfbed991fde75f36bc4cf5ffc8b3bda488ffad8d38dbbb115daeff6af36af9612386f55fff3fffeaf96afe51a8deecf9fc99ec2867466208fe278303aef85fe1ff95efff19d72a0df3f7f5ff664b1c701bc52304509b0a57277fb05ae1905af1088d0775a570f71fff9feb0f6ef0f5fc27d6136ee60ff6677d253ff7df9c7f38fec4fcc1fff8ff607ffcffe4f588526ef6bff99a7f69d078d0577fe5e490bff3fc3dffc3f53747293ffbdff6dfebfef665d253fc9df30ffec7b6f8fbfe338f252efc5b5f293f772836a62f727fedf34f4c2c7961f397b6bf72fcb5df546a8a3f9a7299ab7399ebff601f890bff8ef9b38f3f7c7fec3ff90bd9eb13c94bfed6fe5f3d9bff99efffeb08c546fd73ef94df9f1b5ff65fa5ff0bc03d3f184c00ee4497b9fd92846237dbb0120141a975ed6b6fc808fc44434eacd0657737a1113a483e9d74e32d90e12e52fcc606d4ad501cfb294efc20be1e18ddc5f68f3af76833cea86a8e6cb04bed9bce161a6ba9d88e0791cd5e6dc87071f8df2d6d39eacba08e7590b0bde89f6a72146685b5400459f973d90ae732daf65925e7d57bc1cc349bd2c172aa63e1d0372198f14bfddead07408d453ff4b848e932da94ff59462a5fc3591fafaefafff737fcbf8dc4c96bf75ff39f03a162e445ff2b471320c4df15c94cecc149ff4be9a3a484cf72b1e5bbe6ed1dd67c24ece7104d54d19241ae77a298db2948cefd02ab2047405407199b2d47b50eec1b332213b313c66a91af48a61ca574d584c4351c3833915479b8dfbe5c5c49cdfdf032a6ec960f8ee7916f8e91d717676bd7ad0f193a1aabb06576270e5e1707045bfa0a70b9e05530eb6e4edd7099d0ee71cc1dc4cf5d5f75379bda02bf44a42a2e146f4333d6e20f117f3347aa56ad5da7f87bd840f53fcf898e751d7b089be90d67d49c28cc309dfee4cbe652a56d161dca6837b8e1b11b18a4145ada117079843ffc1bb74d55c9eec1679c94e15cae6263e8c3640bcb37d8be4ae9ae4f648797646bcd84d41f75fa3531557e575e946635c0c45c52454147c25b6e82c7bb6d4095c95ac28a29cd4f7323d076e4779cff18f7d3fcd618d6b97b29dba56a0fb97e5ed60fc1937c2bad1473bf28ec77f8110fd1b9c9214e2af1f99cab07e9afd480a13ed160675b3f4a47999f50b3f30690d940753ff3159bdcb3092631fb045cfd62604039ac56e14d006d612dcd55ef30e03e50482f84cea4a2b482e16b8a1987c78fb36850bb3ca0e363ba9608e1b91e139c01e4dbd6cc8a394fe83ee17a209329a609bc99f3e673a9e85cbfc82abc6f10904cbe5f20a7852c3e31047d1cee062d4082173939698550615c2626b8faac176a591cd90beaa21a16680ca92abec4256929a73153ea0270b5058aaf6cee9dda62a433b38e36bb1ac1b9d4e94ab753edcc9b16ea801ecc974d0292e0e7c704dccdf12885e42814563f693d8a0b43fc66063dbc4ec2d623319f26cecf32f80dcfc189942a610a401749c8a18a26929f69706123075a662a17b4b1df96886b73958809ce5daebb99cc356a207273356d0eeb0c97e44ccc522d073ff54076f22a3b7fbf429fba7a4119ffc1b2902f8050f322b9fa387ddbdf2a3d1826ff2e4149c2b71135fc3eeac1478d31cfcff88c3f8102025157b66f4454bf17fa16d8b4a284bf52382b212b8625de9a7ff2cb7246b22f13d1006a3036eff4d437457ba4c3e4f9ebf997853903e76b9483552891dc832f5bf95147c56107f65c493750e3ec6a85b31d5e8c34241cfe46f99284f019df70f099dbc74cf392f0b73aa26acfcd7f0c83092ccfb09a7e6bdd443ea4057fd6f6a8b0ea5068b27a2458b01c12592afdfa453f115a700dc61cdc81bb82006aec1fcee47ed850caad983ecc1723e3ecabc3e7f5e2519736feac38fe356c1976e2bcdc83c026aaf88e00e52c6ee3f2a65ce6032c7e4fe477352c2f59ddef854ff406cbf0a16ea2b83e5ce91a185dd264b1e508444097e5a75a632ea4d3bc05e2608cf30dc65f65ff861e222a52816b48158d585b0ad1b43a7626636a08622aeb4ced856ee4ac30dcbf707c7e7974f3ef05ff8d04541ac74a7dabe3ba6fae829f7cbeb701142c3f33922bb7a3a388c981cb2ba9c32742f746e6766166e3c7051d354765f1c9db088d77845dc5a8fb0810675d272d7f9cfc341357aeb1b64f86e1787c78a4a5f53a0ef9c73fdb8957df988f3a6b0c117c9adeacb8aaabd964543e8b5ee964519869f2869b58810e6c839836ff662d6211f295fc1cada22d36e24d35e22c12d6d6927b4df2d9144124380f50221c818e3b0954b0613217e99cf64022dc01b97cf08b7ca43caffd8616be15379d8aea3437c74770d9813bf951b8019957919177094e09cde0e922616dcf138ecc972291e07906b37f2e8d2900060d07f8fd9488026332101291bcfc8b3a49802541296d0be1712013b7490c843a230566a9360d1875ce45d4bc192f4b532b72a73f3f364983701f40493aa972b52ae99fcdc107df831ad82943219ef69a85607dd91511b624be65d4a6b6842be4eec477e91eb60aa972a048f1408f2b064cfa7e82ef34a4160468f87c150a494d11dc9a600f766e4d1ce417662a14b731605fa7652e8f6d799caee1e13d42df6e9e4589f9baa071a1d0956d457d73185975fa2691a21239dc7720b836fd5f99d317440292340789ad8a8eddd1b726e611115037c701370151a3d009b98915dadbe10b6bb40cc12d30e715f6890012f2801758d1598c507b78f557aa297fd169ec1b62979578cfb83768b628493a35a0f2aed2628886c78715b55c8265a6e4fde007fb1fd2fd0a1670c24afd1ea9a979842006f442742a50a3c913908cb77383fa22dba7425280b40a5b129b9270046d30ada97636e800dfcd842b735458f346b26df3c7a99dc77bb183cb2c794de4aedb96cb89210c16eb4063b2d58d9c6219d50dde19b381b567cbe0593395b528f4eb670004d491b7ed71c1075e022a7bf2e326ad925037881fc6cd956b51957111c2f8b54848789562662dd7acad9e39a1e1eea50ff8d6aa0b358e544271c83d899f7ed2074931e0b5c2bb59ca45f4b691d9243562dccc5059e14013ec796a28e4c4583e8fa92b4e4c7cf91d943f59440014a937b4f979867ec9b61ecee5a61b77d13621b971d7d110e9b640ccb11353201d7bc11937ef366e09c232bf0c2d30bd88801bc059a9771cb151e6f294efb0c29be499690bf0ec8d1911952dfe6b49f5234dbc07f79503196bc71c584b516e193013f4e1081eaa20449485216595f3cc48f4cdbec40c4b596263c1bd8711826735253b27155c6b7c81133c1ba90d30f44b6f1202666d006e7fe0536ecfcd9648f697a6771bf31d6e4da7fdfe9066728eb239b5170edce2e27cdbee76adce5ae69b024185fdeb11e9020518e78910a7886bf6c605de9d65359f879027f61de034eb3a0cdc9c8ee5c3380fa03887bf99615b9568d7b67f1d2dfbf2f9dd89185fb6718827ca9a771e6d03c5fd96e7a0c1a963c008f6c0f0999bfc3603d6366c1922c98a1e9823c6868a88f7db25f89d8f764ddeb997e18402cdb2fb349f2280f8a2ec8caa8eb5f46d812694119b3fe290b674c3dc93b29b119c10df71fdc22ebcecab307c3d914f50d9bf98ee41379f86d32a89bd42a9cdb2cb9d69333e091814fcf608adf8b2b901f42ddb2930a0291b7ef7aca1024682a99f5892a1091cede152f3591c98141230c2828c7d85bca393edfd5a193f0cbac47d9cc6fe79707dc010445412c47f805d83648fbd5111620744bc74bdfa5e7e0a0adc19648fc462e8a630da02619c13fc3638d4e167ce8870b7f01fc02ae3d033e8d56088031fa95c6359822518d07aefd130810cec1b2e45788f9f1a74847177f8b11dbf5d70cd81092f58f6d269855af887a2c7271d33f8c06b9b2899cf21dd23dbb0945ba9139cc374445770b549f8d6a356868a495eaf6c28cec7201a19ef212780a2ac39767033112749523870a22b722fa8a9d6b4deb782c131a10303d9efa145a48abd59a48d6cc0bedafbdea1f8ede9f72a8f2ac3bebd7afd5805983db0799462e3f3827f702d5cbfb7a3c8d4758a4870ee9cdfc4e8d918d585f1c947c5b6422698bca5840ebe03590f2810447e36898dd73688afdeb0a43f0b5a85580b78d2c3de0d892071ae6892471578307d2da76924f20a21fc8a9ccf99f5bac61a343146be68390cd93e17bc0df0a81f9e7c61a1c8a90b09d898ce64b896c6fe917670f8ce977150f85e8ae50243b432d22c585693f612cc218943148ae7107e1b1fb39cd0ba3003b91682738e199dee1cd8b23f32170b15f8f0915d70559d2b104b751081cb2d4c599fb56244785830c0877ca3df1e7090c0ba729ada12d01d57033e80364cb198b5884c9d7b7e3c4b848700e6584edf144f1556de04a1f1618a98ae7e2953414e023a20396ece532fffd6523e71372393c657842353f8a8956cbe365c080575e0015d7e06349b9e93b6c99030c5833d0ce49b70a1f8e03517772adb422817d4bf5a272255b8471ae97f1cd60cce1973ad0e69f8d6f63003bba6d36ac7f7d3fd88cb4fae94d3a24bb1203b3021c0e7a433031125f706e216e4dd97737d804addfc4fbd7e142b9bf777f6be8496985eb85106ac7d6a15e0dfc0634a413e156585f9ba34d8b81636cda1948bb3cdda943d7e773c477658008f18818c53054c6d520df5ca13b6150ede87b51ea814a5c2edc81e1040614b28ee2252adf1dc618912c75d20ad5b5989763e6c840bc6996395ee9912011ae286bff39b8753aa89aa710e129776dbcbdd6b4ee15bdd8065980f61c7f0a18cd321360a2716d4102390e6110828ee05dc2c125f582e5388f9b2409a812de3aa60c027c53abb2b8c8047250c2fee7a635d5681ed1a2b4274a49cfdd74053a506bee45998dbe83b040a35996dc4060a8499a53c5bb76ea35471d6204fcd7a6f1e63a9d604d06e89737db2aff47c91ae923f822cf44bc6640ec6e6c685e8e0579f8357988fbf4035b041a25ac9560116dccf412e4787a71de0ae5ad04bf5721f0eabd178569b1d97d3b9e64ad3f0d8498cd1086b2a1dbe20fe00aa78562fc171d062984b4208891ef8ee9895987b83cc758a313448e49b86df9a1bc08a03abe96ff3692359db39941f4dbc4563a963e78b7a890e1906ae6905d215dc4c812a4e4ecfaff041f8dd665743728e07687b73734b0ebb68e8d4386b5dda0ba4acad0ac9e4555c498b24aef9f813b5ec8f53d72781b385d5143c2b37f5d88195283edf759da76420921861ac85fd7c362b4aaaafa8022fe1d479a1596ae104f74d5720adf2d56c993370316ee35540d9af272d39c45f72c70e0ae1a7a33ab69e54b84a0b2a112d4c8f3904f3db063ec67f5bf9699d9d5d1ba1e6100103a5a655f3f22d13b015e66bcaeecfd84c85eec4bbeac862a9fc0e92143a4bb40f7b2299dae83e8f0287926adec35ffb2baf592d01ab720a4ee4aedbc08fbd7139a436d2a057eab27d4525e365a8e6452673f9d9932acea8349a4447febafc0ed262d3408eca80cf84db42cfe728f717bf56ed3abf2b568350d90755ac2fc06db721ab1b2703d1965cf3d24ca276bc691724c028135fff2483099a88f23f04a059d241db05aefd66bc1a0f9a5a90fea2414501a33a16c335bf7bdac5f1362fbe594af6e33fcc20ea4070cb9a5bf82cfaddbd37021be13646da7977f8fc0a7e0a290123eec3a012adeca28efa546dc3180e32571d8447c82f6c315720936fcfb775a44c772568bb403ff83271cba2241e04d763a08088e25e5e268d4aadee74058965627c9116bea47ee359a1e4a8fd5a030f7bd743d1d9eed07c89442107f5d50c58172cdc1a042742148441551610adb1b62059e547a6a98d536d2440d45a3b3bfb615486b00b3230a55151dfb467438abfc21f83e039645fd1464cecaaa029d46edf2f5ad6180028f04379d199af6df27229d704f4dd7313543b9680d124570cbd533561b8a3d7866d787ec072458e7cc244251eb5d830c95e464661085fb07c678998ab1a3684496a28f9b9b9ca113559f38b9877768d2127d4ec0877ff2ef7eba498a114dc08c41298ba03b55632daa0766c99c3ac5333a3d255d03d6eda65ba53f8ac3c85e71b28fae2b1ae419b279b7c15b872087682b3aee2fb717b0e0614d86e59a1851f9213c2a17df2bb84917cee977a46b87adf95394d0acd444f3e020a2c359a81432cb429c80fd2af79c26dc15982bd8e5dafe5fd1854b9e4ecafe55b00e10a70140f8cd4376f881ff050c2ad0e2a14cd8354ab825fb9b14caa4b78587d5388e4b92df590a93bac4d6c78a134b32ab86d1faea081b31626207f8f69bb85b1fe3e52fdfc2065ad71a633c35d700d1704147fcf3e911e1bb251fd8f1ac5289bc0169508f9a754b3698a04e284a30b4da0f5535e544eb8481a1e04a72e187e11f55713210ee5d4f6a825c69d0e907535592bb5728f1dd55b084c0dadfad63cff13de38e9558fa6f21f83ab540dcbd3f340ed15e6d0c94a9557ed35afd7f01cf51ad86e8041e5c3723637de011356274ffa5a52ccce6e40d3115eaafcc8f2ce837dbdc64b25aa96ff7d003705dc6dced78d3e1194421fe746c92f54b29a1201dad5ba09582e8c7dd486526ab1ac4958a5d1e507d41bd09462469a8a82501ed3f962ba72aba86f4675d5d56497114b38b456c346518f09cf75cfa55c4a802e4d69bbc3bfad52db556be08e85e994053a2054fb47a27ad4cf009770755f5961e05e81cb3b3798f8304a8e8a7921a99c71c341514dbebd1cd8d8ed118a0c141748cb03a47a6491f69d7628f51270adeddc9041f73bd15ee4b907e6cf849bb55a81207ff4fab7ef70048e729626fa42d18d8213d3fdcba251d8d54249836eb6ebb8ba3dd8d7473a0231c9cb150197dda1d523f7de26f7a44d0278cdaa273a5aaeea7e20d66937e665b2ca88d717eeb96656288edbb7da76795b48650de4e081f989ac69c02d207e683dd7acdc5f7660d58529cf502d4d47789f473db276b05e86935a813421347b492a6cf75c52cc9c92a87b27d7624ba7a458ab551da0746398b0090aad89b264fd4a4011d54235802ab6c76c018139b2a9a6d2f76271d648275a61f64af94ba3d13c5c9170cf6e5aa37a6c57e4060997c2bcf37ed13fcfd8b865d2cf2aadfa44ef6d82276d08b0d747dd53a23f4cd017d6e27d7b0308fffe399f5f233094be6d912315901cd0540572d687b8fe295c80d47c0960d1b98c7c7cffd2d67c6ba5e63125ad6066d7abf822135840a38b25389ac3a0af074b43c8c52bba1072f51afee1ff001e0c40e99b604a130ed4c9e1ed40e6ac21366fd270a0041e146871a278dfa007dad0b7563ef7fd69f8b6e612345a18c0f3cf24a4cf9fbde1b74c524f6099bbdaba3ab8c730725ac428bfd20b9915be913a73c1ad74e939e320b2b331ef459e822b3fd680fdb808624e6e9bfa70b77b8130b396904ee681536407b52f49bf6408b9e0a77d9bc61d7048873ece96431ab4a14fad53e582f18238135c1cffc610a6533006e03c12aee3e175db13fc035c556ed871e652a60d1bb2e4c87d17b2d68061e4f0a02f6c5c16594211607cf9161665ba5c40407c81948685038d78fbc1e2186e3b6b73851c86b2ffaa01bf936a038843abc0d46dc2b4c2e814cccece1361011cca16e0c2018f130d231b50b0b7401029a19ceb33939f68b36bb2eaa7be68a9aee43b9e5c2636b9b2afd6893eb815513b7cc0df962b86ef160f3132c16f5ac07370ab0a708c80cf112701c69aeda06b649d3912fdbe94c9af804d04ac21347c09b82896abf96b55099588c22c6660dcdf64aa352b5f15968596c2ee609e61836fc59f8d5195dea1704a5fd630c813ad2b9a9238c2a56714d2012a43d09e70c9133dbd10efbfe3524dbc808fc807dc8e90b9354f1c9a1c37b8423550088fd9bdc2a820dc8102e4a81d5b0101cea159f6fd71f3150f95554d0c0c716affbb2f6b2f107605ad28ede511f7a22ae3d5d6b3a5350f910d7a8587a02158ca82bdbd56c884bf17db425529c052d4d51276bf72c45fbff08e382671a0f70d720c72fbc62da73dce9f0a00260ba4e6ada5ac252906561b6a7dcc4c80e5c0be2c36c14125e2e7d53a64ca60301efc70342089a7adb387daf7d9656bfe74be16b3d872da893a734f0b54cc9c1fff39432ba7314e1bef81b233595124c106cadb8699dadaccdefe1e21e03652fb1cd5d3c14ba55563450a6f6276ad8803971674b959814ec088d8d5a34321df9d53295e12f923517c14f0048f435f4bea4dd268f0d9964ba8239f2a4f7c4aa9f874931e6339d10b0cc0e6e96d7aa9e277f1f0f54515662f46a0ed9579a754ec213cad6a6f77015d67d970517c3504f00cc7b3b24d4696432a50b4ca97fd8c64b0db94415bd657af168a281b82f84cfe27c4705683d98e859018c24060d6432897d0c0cfc221d4960b6c3935b70afe7dfc550cf854881320d719b680ad53d72698bab4e06d91de96f8292ea7dc3918c0697f0253f4cb0397efc7e25bfba23a78897f38fc8e0a296735f7ad87cad6d5907bbb5acae54c89650efd4c06a77f39c3e56c157721506af19c776ec929fc37a46ed9cfc13b7f915ff524d6fe6d8f29a898eebcb2ea99986a98d81d4c39d0cffdbceae9e6bd5fa09361379cfa4d7ddc94aa8d196491a70c4febac4bc6afcd0af8ff8be4b2489e560982f88158e0b66cdc9d4176c8e03a38a77ff1ffde05b23ba37e919575d4499af6229b0810070746d738de2c541798f3e8443ba8f83aaf75b827a338c7f702553c196ad9b26ac813ddae31b5f353382f1347c059609e15c9a98a6de6262a5d0ce05df81a9b2b569bb38140efe752986769a5e1e7a811b38ed8f99c1dcc620f0c55eca39c179fd6b2ff3e464aaa8f10ed716d113c41a543c6dc68cc92b51b69a2e57e451539dedfd083a45aa2d902ddb009865ff4fff3c93020308f8ded49ff1eee95fc4118bbefc3c4f636e4963290a38bd7bbf94828dcaa6d46384f476d0c353cc8db8e631d33d9866b9b2913f05a536ec568812815725dc632e06e7c995993e586da49f893f072d038f755b6ae43d6b7b9c26e5566244cda52f3088e0b528af782bccb7b84f3187181416803f943fae937e93903f65014d0ae9c7672cdd5b853839f83a001d5992ec0b9cf1a3d1eafb22e809a9786d2e6e072462ce624a06e5cc87eddbe604d0e4b27259ec076764350176b0661db72de48f0e7105ac05c04b6a9d96095cfae7a627f886fc0cd42bbecae4356cbe2c8a9c3debfea3ba89ed43b8462539c2074ce72941c6aeadf1337e880f91a4874e908dc18b6fba1000f5fbc01df16968233316bc9c42cd704ebc492d7a0c9662da3dc53df84ac0a541bc29d6c8252713b40708b584b890003f80b55146ab87e3349bece7de6c547c211a18d821b8eb9e332d96e810954e507099e9b402dc5d7b52260dd7c763b0cf6699a6052134fdf42c6a0fcd9741dd32afd9a6ffe6da180a4f1edb7efaf976673c284d52aa239fcb638ee48b9dcac5febf244df057accf9a1ef82f6e687ad0712501738c30b4925ab9fa5702bb046301db49386ed836a85e5d8688c11bef2b98b552a187ef7b145ece10fe46795e523733bc1adeaffc86eecbc5db8083d97951e23844994138b22f85a7020f1220a791472206d3c371e6eaa611f103f01d0115960214a090610036f76d9dceb37483a59de6f0b815860afaa79b1b4024b56df0bf32cf321f4a8c26a7ee4bd24a845f733efef2d0150d46fafbd4966d67acf41c19b023ee1766a75dd81517da0cfbcd86d2c9390ae740427830520bc2f5a24ac566123783155bc9f6a81c09ab8d666e1da4ee2f951b5b0f94ac839c0e7d3d28ce0df95f666436894447fd4c299bb0cf4aeb61fa7c82c6fad64f24c4b903a74bcdc119f41607a1889e9412c0424da80e8d469b8700acc0bc220da380801b6dc82786c519d5eafe4034d76185bfee4a9069f08f87d14c6d1bfc2206b052160ea6b9bc3784569370421be2fcf56c08cab80614d25dd95320d6fd3f001602366bf3668b0065725549c7ee98c7ff3fd073a6fba2e97fc2b0cc3112e052737c1d77a678894237d9257c7a1b4300430fabafbd51c42010903a1fd9072e5f656d004de051fa01a13d3f77cb15df2c17fea8a0b5a07f404f381f2462bc90489452fe1303258159037e5d754bcf1497fcf928dfdd94efa116dc38f57dd5a4638a523758b5126b5a8bdb8e0453910f884b118b101ef6388c73786a972b1befa78699e1883720d0cacb3fc51945f7fb0be491a6cb07107f0235f1fb10deb88f08042a8690142505c90e2daeecfae031334c0b59ce7d650d751ab6e86e3676ceaeb238aadfee530626ba626baa039c5cb67b616e6a738a498709dc04df27524b1fe011377d1e95a00b9f9f082fa12fd0c4e100115a87d6d22126c147218d3a4562b315234317219a297f0580a98e21550aec028aa19eccfdd319f61221222629d03ebe5cfe382824ec0024289d0871e1cc20e2483e110d3ef15cb702d18e8aebb08b451619569c5abed490cd1bac655ce1a3eff74800eb9d4bc87b5ac805353249b0fe19e3a6a307fbb2d1a8dcecab59ccc830454574cd6ad1e8045d8aa1ed7891f83a73621a995e4d727797bb25d07bf9c30190d20e3bad1b509a4fec65c59fc106224f1a7cdde2ba494e1d5fcca25c068bb44f41e69aa957af00a25e1a166e899a01eeb62e576d0a5baa8ab04fd3327820fb331f7e1c2bf401e6607a8c94863e510ce65407cbc8f81ad96e4cad7c4f50f95f916f7291ae0a00f38f997134528ac0d17819c1c7cfd1898b31070d615a9a2dbbf45d6131c6a39dad25bd822cf0cbf7b887399762e2ebf074ded6c592c9d20217b2cfbf21809b7750d86e32bc1a815b5e132e0b9ed73ef3d476a05306b59fade420bf77c851295392f727a1532c1efadadd914dfa0e5ecffe2a59057c552822e76a68a7a52649b5ac5ec69f07c5003805ce46c0e1523630e2142bcec834d7db79ebe6459d7dd6ba1cf87d7cc8fa5ab8eb0a59f4bc97b33ae89696c9a204683900c9b751c9444be87005c5228139c32ebc1ba5c201075f7bcb700d85fe383b8fc64c67fcf9de576a62dcb99c3a1085fe99272f18c20d9fb7aaf7314993f22b5eeeaa6ddb326e9033b8c12ee5b1862af5d32a316dd3bee9fde87b1101649ae6c00f859575ec4ade735807d37974754a4aa8936cb9879a380f12c6bc1f3957bcac94e8b61cd7fa491ff6070a4f32acf80a0ab7dc08b9a0a5690413a6f4205c9664e017777990bb1b19c22d801849a82642ecb1d801c6caffee13770c8ce4c976782da306bafee87e044eafb800e88ae47122bf57e1b1384ee78050527c927e77b580b6d182b30043731b623ed79ad630ad5970ab83b39657ec31e444b8f281969823cbdaf4fae7f6a1ef50be0dbdaf8a15c95a99b4ee031ffad1b157c3ddbadbac7449c77c8ebcf71a96c863c78e8bc7bfb9bd593d075355d02050739385ef5235d921baca9c9acebdb81053743b2150e1e7d45bc56c0083630ce3a02bc241f167e30ca9e0f22a2287b029dd6778e13d1b7c50ab0eed4ed7d90e3e80ca80f18009cc9b913e9e308f46e37a1003cd7a681b49564a160bb3293a1cfae8908b75cc04a791af00e996e54a5d262cbe33827bd5cf0c8fc4ed3f2c559872b24d518910fc1a5352f4b68e6e1841dbe5f76fef63902482454c3f4fefd00588b9ecff82ce49eaf4b5d3fadcdc3bd955aa1eef7284d87630e6e610252c5c98de7164500d77289b7c3cc41c08c820791426d591cbb8c4f856ee8823744d9a6080739bf7055ebfd26f45b9f5d935131c5515d69e99b9792dc09648fe23ec9cf9a2cd9475b23ee0ae440d4d16489f81b8ddcb5466a6dabb5424a5c85f810857b41f69713f9fdadd410ec7a30e716b8a909fec2fc84f0de3e6ee9e131cf1012a64e36b3e51a0ececc93a877987cfb32cd4bfbae54c080d10c54bb18d41525ca3c5bf009478d4824013d0158c8a8cd4bca680ae18cfcdd9e28d41fb87d55287bfcefe05e861103c3bf9666f35bd8b50d3e0bf65a9fb78b7f09d90b5d20f47b2d1e1d3e2840d5abd820dc846fc0fbd2fc168e521b188cecf65fcea9b5c0ca602fb89a521a98da9d23fe8a8d91fd294ef7b55a5d64db20a238e26c2be4e2c2765684b3125e20dec06dd8c746a8dbe974a283336fdd7310d23a6fc6d7cca3a67735b5a6d42ec1f144098b56528f8463b8e96af3d671c5ee5303a6e2dbdbd307fb836c996e84c0611423ab187422098c23a75c940d6db934b52b9359e2850f9bd962683ccff0ed39d0d48e3473a82b1490f3c9800f858e2e2b45efca7d849e2d5496faef80c3e38f0d39f9cbb1b8837d8628d28c7d0479e27058513e519ac5a0f30d7e931f1ac27a1b54fc4a760f1068918f0523084dd92cafac3e5c91d0fd4f2f1d358bc9cf803063ff0c37c1d36f21362ec8bd81af7853b804865775067c1fb99c9db8ec1a05b8a3f05d2c442ecc049a3cf177c9918b5232dfc640266cac479db947fc1fbe94966e1420b32d297d43ab4e98ad69f8ae64a90dac330b67759af00327c80e45426a51a8492d9dccbcec9989636299df60761d1f6a1ac9f4814af88490625a50887d23390b6bea46c8d9026b8aa2317f2bc920648d83ea70300a77bc249fa7159b244227dfcb4610e110708901afdd925e7fa1492a0f2fd6740291024eef058de9d947fb01ea45b9c92177fb40882f950aad85b729dd54393f015bf5e7ae5eec88a9972b125dd51afa3ce3c70268e0097e4ef734e30d40e0e7830d2ea54d041be0fa411b2b631e6f7f79654a8e5dd4b8e45ce257b14f572003c36dc0974248ad453e3a0256362ae625d0700fbd0555b23882b435b17ea00d9a0515cd808f818fffebf211882287d96d3bf47a45a484e471bfa6e6fb9ec21ee18c735686343ee40f8193649ed1e8df37fca8526fa56e6235b411105df239a98d7b47ce37bf46a53585e15a6eff0c02bf1a22750436502241e8262847cc2f2fbad108d4797341540c606f0b04e4fdb0eddf2b5c6b4bd519e9521cd89161cc5ffe38d0422aca64df7c74b6821abe929beb162859aed09a600cc489a11370c402ab6e9c809f920d0ca24740d03805db63ecb23371bc1e5f475074f982b2114381428589d2d51793e619809f2add79436a89a0861121cc5f774303a513d4a00ef720aab155d1f8820b44a5a2a19fbe5a5b02810732e0502b2fde5643e3fd2040efe54298579311c9df06d5291cd043a11a9710b7185f8c8ee208d3f56d5b14a0ceb5b9355e41a6d5080c7fdc2b17056c9d1b312d5c615a4dcf6c5c127c7c0fe700a7aabb00f9ebf318e070cf7557f749e19990b37127969a9c6e275ce3bbe558213dbb69740d1134e03e504e7c3fcff7cc7154ecb929116ac6b834aadd8c4a332eb0486f93b82d581f663c0f3e86cab3a4db6a14cc92f18b0b3c4602482fae79b826bda8e666c540ccc7819827495dc86957c26e53cfd76d3bdcdb989901d98e105784404778f7805023e1b604ba66cffa042b182f1d9b01f4eb9d88187850dc3cc1ad849e2751cd510eb0b8ac92795bd9bbc9298a9843023da3ba568a0a4d350ab17ecd1f3c99a35a5bd578a459b730ca080c157fa357190ddc648be04b0dba7c09b4ebc6c5f6b97e89b36183200c52cdd1d5a90a86929b905184aa4970953cbefd25f0b4fae68da87cec39846e7c656d5fe422d5179044e4a4a62cb73106b0274cc04dd8ad5aef15830fd9e7b962fd226069a54b42e6a4b86360d6399cf6777dc2ed62140447b4893e04f79031f201be415c6d983d6709b06f8aa692485751edb6abbd1e4090808d97f4428c66611d09394505220cc12f712bb1947687fc68856643e9a0730910202c055ff0f92013a3852f2289db8f823c91b3811b8c334e1ba7ba44548ed5e2c93c579f55c2729ce1d842c636440c029e20008b7b82f791c09833dc1714d6c80643cd272796169cdafa8adf75835c76af605ee93e50c58cd25af887f146ac5e27156e18111c497c3da965d49ea82f811aa4cb08359292231032f34bf10cf8e67c66423a5431b75729f73cb0146f66df55e3925ce81e3539d18911589918881a688a4b8cb929d60241477d81a03eeb03f61181526442c10d0b9362f217a4a4342d2c84f095aec2be120e7298d82238808061b85872fd367d1c5ae9b54eac22270fc643abae53ff8e47f9f9b173b603c9fdb62cf0de8a8f80ede2f1d1e18d8b01a83380b80624391dc3b6396ef5851e2e033f69e6d42829a86198f54b36cbced2c9d265d0fa19410f38733519ceeb6d662831055440bb00d3e4788c72b3a003f5eb2e474a2f951aa3ced0df0e2ca93ebc855fd3b879ab53a05f923f211030febe2e2a4f58eb2b1ef6771c32a17dc711484650db307813c9e5232eeb5cd1fd11af365ccaa816c71ad3b1bae6f8c7681d884adb2902a0d86544060ad667c1edc2d836268c0fcb1561f2ae72415a952a475f10602774af14fd9a2f355f145ce300b7fa05642efbb5fd75c9f45b01e392b71432399f77cb0d01b5045b1c0f6e9edc273bca6186cdb681aec1a9fa86fffb2e40d2864b3b99af7f692ee82cd11f9bc1cbb3abe65cb1039aef922ab01b9df410d7447226a59db4c59d6f52cb6a37da736a501a30ee77509a14b6ab578f7ace56bd533838f5319d676bea1650fcc1c1c5742e1155e4210d99b944e9927d81be1f3387e43ff3f2f1f84f2a2c3fb1dec1cedd9c5e2dc90c00333fca4334b251616714627912b2876c5d5fd758f46a88fb1768a2ef1ac49c5d7c729a7d0a9cbe2700c9f4f9e27fbdcd7efa9c6159313560714e446f737da40297de6e57da22c40f36e62c04ce2332cd0dec1b1e9e8b17fd1ae4bc09957b2702d6f7eae3aa17bea307620a09592427e87a4d85170e80e4aa39d5cbcfc53aabfc40f1251171fdf1e0e53bc02812e98968d65ad6e10b105870134a3ccd089191b9b372081780ddba30575041788ae77479a64b640ffed06b15d4730a6f09f6a9c17cb862ccf9bfed2a3b961e44983c26a3a047fba6b9e7eb50d40b2f12e0efe3926d224bc7a8e6ef4f68fe1ca3f89ab5a596787c5b1e852689372e0962ba16c23b6a61428f0bd80fbcf2227dc8b57a991c306ae17dfef6207562fce5af0464dfac1665582934e9fe35aaf174d67e9fce35ea7f85629f23da83d97b6b3212d98745e6a6d800653b217d59048e1032a1c0d8fb7ba6dc774d82ae150fabb5251389d0d36e5de478feabfa2a4f7ac36420f3dab96e378803ad0fb345b33f946dd8f81df6a2c4f0bdbd89bbaf08fd657a3c432bdc4bc7149fc6ea9b7cb3c207edcb73af89cd686065b8142139c4573f95b666ded54ddd82adb7cb974fbfd5ee81acdf7171bad26d9291fc1d08e330474d86600702182fd1bc10ed7c3fdc13afae60f03b1c0d67853f5fd1acc1fa01f1b7fca2af00f8ecd7402bc02959b85634b9bde34d57eb74d02640582707e06834847b24682c9d7efa60c1217d366c3ff888448eab178e9efec05c7c71a06764ad6018f2120a503fb89ce59520a7250d94fb549db63acfe48d6a1988aec1f4875c329f59806434f50c918b817584313e469a0d3f354d40010f318e41324b21c89fdccf502c836d66c9405e67901fb09c1af11b6e115d0fca6f33bdc44b0e7881532fec8f55e072c2c3999cf5692ed0892e48d402d4a7632f68cd8f4678ce49c8e6233483cd95c5067223722043d682b3a857c1a3c309bbc1fd7bbf8066404c05fd4682104fbffb4fb58732ff92124a62f48a73627c2a2167bde8ac4533e772326f008986de981559689195765d4b4b0d1230c1edcf3771ac7cb60bca0b5b751b4c67777881d5b88bcf2baf41be490636d496ebc6c1719e440fefe82bbbb4195e894b770a405ca59347ae07356039bb53afce0aacca02f12698a72c822917b9c6ccb011810278b05e5e053874e6d59aa9796d405ec76abf48ea3f6f2d6f2f1e457887af8936e9678920b43121031099c7a909ae7836ad9eb99a1379c304bfe87be4d4af3a2316eb112ec95dab51edfcab95d79ac192bd18cef3068e110b05d77109efbe233fbce86633c013cbc3820628fd44f894f4df131b80bc7dbd4bcae68beffa1acc6a01021c45408675339b3649ceef51a03cfb5e03f7f051ec762964825bf124c9a468aba9125696138168a9cdcfecd8b77a7186a89d6581ee1fb703920162aeb55d8880f6f333add04dbaa2c65dabf6933afcca3fb7e0f207e6afa54a80a84d6886f0beb39cf1ac55c8c66a70fdcbbc1072d20ba8ab1ba2b07eb113ef7c451f432848d14fd29ec95c6c7c5afc7c6bcec3c71f2c3c6a04ecbab98499d9c602ad55346b31cc160a70c2631e48d5f1d9a32a9c98dfe5e399f0e46c59bb2af8dd1fe0fe56495e68efde2e58c26a511ad30a67c362c5fbef674cd5a13586bb635c323411700196933bede2f6f3f79d0271bd364b3f45ae01ad4c75c69291f0305111a139290dff4618e525cee521b1e6b256b7af7e0ddf82bda55a4b909f1ee06cca0f29fd62b948fbfe955ffcaa1768ed8230ee878608c105f3222e33776d3358f2b95fb5117357efcc8345c19c7e67f69003b7d762873079a011a2eb3c9fce8bbf58f3de5c9799ba904b957d04bbeb27a4d12b84c188a672be3321fc9a9a83afdb7ed1bafa58832284899aba22bb015969c2cddc11d4465e7f0b18311e0bfa18e186f8a43580c48be3c7deb548382fed4a54aef084292932c27bf81892e7abb428704fa86ebbb5ff828f4892e186ba52962a6d93986385ef13339adf1b248139597b809a093758fe2a4850c84dc1ae1368497e9911aef6efbbab7ed634786be21242299b4ea10b56114c7dadddf959f6417e649a867d35b4cd8a672400a04e72853fa4fdd3e033cb60f44d4533ae51f5bf9cb9090889aedd8da1585120d1f8e607f973e701e880077c08a645f9ce44228c0bed606ebd5d40210d0601d87ab4b32645c3c7e32d4b21c8498c4c61b02440709f0fb0126ef8da4204af4aebbb3eb63440352add18be30935b83ec88010222a9dfaf86ad94b7c8777ebc78942a51a02067fa0c41beb53dc66155dd4cfc88655ce61f9dc64077e59f0f6a80f4430119f909a5fed562c0d78dcb9f441236c55d5d5cf4d33454972f26794132fdb25ae9312fd0d71cf12b45afd1d850816bf8ce235b33997471fa305cc75bf6741708a603e5441d214b12feab04dc69baf31d4821c38d006fc33a683b3c4b3242438a6c19acd145eaa99e0bc3295f63fc02e82c15ed90635aa3a830d0b34fd8c1d5cc009155af6c196c4d74b3da2763ec757e636d2334f56d4291ba6e7f28c69c19f42561cd9953e37909940c8022fc8d3f7cd6e49716b9032859866b379929acf9f9c83f5a8b4fff0da5e905a062a1f70bc4d27d543c55ab7b3e5e010f15991ff8bfad8b06f3c8fdcef3bcf264f4a85f80031f8460ac65a60e9a2a103170bdf7a64b6eea3ad751f37549e35599d7b2181c4321542ec2515d04d3286045562d8f9fc54b784090cd6eb28eaed85c9335a4928709a4c2b5bd664d34f5312219fc2238b5735bfd6db9ca86c7651107ed0de2aec37d2da3a602b4751bb3868dfb6ef8004b87e1fdfa51253321edb2e992f3a5752acbf236c058dd36ab6adbfd619e58fb307a1aba952b489a5b611bce65abb7775497fc8d040ff3a7d0f5d08718cb0de87a4106520ae3932e27be863be5e26a5beb9d4bcc8a060b93397d9e1fc7b78e2b09cb9a495f9510856f72e540db23544f3a676576ae5521b421f856ab4d94635bd104ce2748381233d44a26b8bfa6c08955eaf4f3b213cfc598a345872cd25714a266220ec3dfa3eab9b4be305e3f8908799ac3dfe9f827ad5cdbe9ef54adc1af214b2f112f090936f1a385c24fb4c3f63b8f17e72426e2cab312c2ea990fc8378e3995439789127a891154dce820e8d2d562aa76070f7841f04f8ce931064a099ee127b3ab5345dee3243e4c5a0902e3d1206f157bbd68ad6bbda4e9e7478440aedb2293cfbdf9b90927f365b64387abac8628a1d0ec98dfaee91af3aa53c04e30f8dd77c1c980dd702ee56146403aa6310adb8edba498f6e8e547e2392044a62d34836b0ec04e0149a7f84df850195550f3e8c7aae538292850eed163088510800022dd57ebfca51716086ec37aa2e9d2af568166c26b82739669e16a97bfb54877d354d1c2aeb984f069ee983461c4702c15e51ef1505942f8d92eb86ebe0f80c989f79484281787caf571b5bb5c65b76f3e5b96d625c1723457b081a53a511e4d0c5b3a47854c2c07275bb559a8567c08255243f7430d983cd673291c27c7494fe701b07142cd1581fa49d040990b01a145ee6beb25b9b68f3a41ad3f628a72036686910ffc393f2f4543bdb484507cfa27dc7411641d1e21ce8003ddce4a36d41449a6a83fc193479902e2ca4d4027eadbb7ccd08204f11b5d205e805905ac5b3a2c989999cb5b68125dad6b4e6aa7c66fee304385c134dbc06c6115884fc246dab4dd637f47b85828ed2237a9b9e49cbe942f952ca3863cfea2176e4230ed78eb85aeef869e138666ce01a55f4bce9eb36986b735261edf1d7d459d54f47d9d9d3354b6656a22be7e682afc32bb74e443dbd88153dd4b02b3ed80749ddfdaae65a0cf4229f2e650ac8a51fa95ec2515c70543e8125441fc20e5950e8a326806ef2335931c012d6ba62bda8afd312b149c3eccf27e75859ffb73c92895c03c0dad96f8784273b69c5e4bb51a720583cb410c0c6fb00303c022c908cc7259299d1e25ee21b8015748216e84fd0edf7b688a489827fc07198e7e62e78d42a583298fdb6d76a46a84caf88519407ed903ed5ade4c705f088e53cf20d7b4f5352eacaf5fe301ec296bde8a513771d1ca9d8ac91b4251828a389b56e854b0223f98ad4994517cdcdd16c6ead6e0221f5fc8ad1827753ad38eb894682f8167b61e2cc082b389e3fcb69ae9ee7bf5a2ba71b9688b2165ca10786dc13025296504f1308757f65f9efbac6031a07fba3efd08b88d205e6103b856f065384d145d0be533b512c0061774a75bea48378b3da810033e347bb926e2c9800dbe041f186c546b3565402f42dd9051b513b8c6f6ab623b0475fc9a59ccb9a9ca1d3121f83530c6f5a22db7a67bf114c86eae1668f6a19267352d370742fbb3656a8ebf8f5061bf34663a66ac56f44de17e62d44ee8931edfbccc3d20df603d2514508ba093b43f2f51e4ba01f029a82e1f7afd08a3022a54cbc26c061c24717aabd815e223341d348db0a433e2ec0c5f769c98908b5d0b6582a3122cb63313e16e13c902cf140f35df8db538732d40a8e356373af9bbcd04a2a56a53617b1c048fbfc7f3f7c0f8499a58cd815c0870b4b1a3ed2354575042a954db84cdf058037fc13c303cca3db5a6a9d7d63274590ee19f96adb1b78ade1e3005e4c55378851721ce8f80b112393327c181fbfd3d0eab86b8e9b7cea2ec20d8c8fb564d6e033b4eca5f919de33a033cec73a60660d3d557e2517e38cdfb45251813d67fdc808ff10b9fb89c7bd953d65d71e5254481560f8aa6292ca39bd87e7a3f9f34197ea513076d1a0f3695752fce3cdcc67aa643985c02fbc23325772dcbfb59e47067e831f7865e8703b3056cfd59470af91d5e71113ec1fb8269cdea50c19f0d92b589c4f4a3516666bb12905bf70439ea141c1c8861e4415a9e9fcf2e735c8400012c0c6705e605e174905f9c71f74308ff9c0418122e06ae764b9c581e855e16c757a43b652ee46443ddc07914224859db845fcdde0f228f44ee5daa58050d0443c244d9f2330b21788003cf5bc997b4c3f01641d79a6a5e1567e6ad5de2e787ee5a19dd6eaf145714520846312bfbd34fed125022ef76258a1f76c7780a88f977f888f00d808bcf3b63e5de4783e8094e72abe009be102cf10ac457ff0c87558a78f23929079a031f4e90e98051c67b6d410aef8bc344ff19162a85668df9be4133004d84399377df4d3010db6c637e5ab8a67638ceab8559bcbe635fd27b647dbb3792a033f78925ba586ce7740fa5ad4ba6ccba490c0385082520ec6c76fd84fb68c0bec2e9cebdfc78cd10751559e755bd9dce5f90eb6c28c75182eacee7460bbcc4b4e160c8644d8320717a8953c962bf61225e39db6bf63a4102204e150405a225b444fe02bebb78994fe46ccd7e5905aff4a97fd76bc1d0c8a5a7e70dd0ea74fbc9685eba16d9a111850631fa0ae222a6b207dbda6b5957c2da17739ac06d701d570bcc3465325687374497707e5cb5107448a2ac1f92a5ddec229d8b0d964f4ff93b9f9419a9bcb5119f496eb8d6dcb76e0a026d86819a115ed37fb456a8aecc623448869eda3c7e64753edf8943aef472a2039dd92bd9c645a05ba317dbd38643c1849fb7e6e61da0c0545506d8096a005b639a888184a1eb5f28a5ac19a238206862c47f8c32fd592ef8663e4219ba1d39a2d6b109b93b30c0beca1415dc08889a9338f13b9eacd8ce7ceb3628ec93fe8f45b0160ec902cc0a633e46f4dcc582add886d78020faa97211b7e1537d6497ecc04405f0714be4466db9820cfceba3cc5dbf010b17cd4ceb90896a33fa13f12a120da923602ac119092deb93abaa27fca638f6c38ffdc65bdb8013870b0a4d5b4e700333012967964e5af3da01dcfa1a77425591d55bbf2b1b310c895767c4226056d88c3b39a94c4e7b7c7580e3dcb82af33aca22d89fc4338ea9c426ba96bb7ab1642023bcfb11b96ec17fb5a89a04d5884325480f3804e117e3b0094184aa68d36e3c2c3fa7ed76d40d02bbd9c545955e2e4a9f12814d2d48e43f4a2d05359917c09cc529f364deb4beea499879caad762275e7533c7490c09e8674700eefc36f5a90d436345ccd2d78c31e922fba92abdf0a22c81d9ac1242781530987d11c4d860a3f1c1eab02606d2de89cf69d6160f38590a9ad215a720db468a818ce28d72627a32e8c0cc984bba8832b8a57a141330572917fdb0df09a4b1ff227141cf94bd294d7f8e82aca8f8a252028d504cca0d8645956c348f6fcbc1ddaaaf6043afac2f4a070351cdd635fbd79ca604c9a6f0fa28d7404f4bc799569771360c5004a492ae52f212720cb7741cc03af10c8660a80fc540eebb0b3943ee687a2999f209c8fe2be507aa238fcf98191ee51af221ec0cdb228046520aa299e2343e8f9c7008b652e95b1db26e28dc8e86ea97fda25753a32ab7239b9e4804fb3bc63d6da854907ce6d38fb6a86faeb2657dd970f9843adc6f2d032daeed9161e786054b7673b8ed914e3f5d6bc4292bbdf4ac18ccf6eef0f628a1b2acdd7323172246b12a3c7107a04f6bb1d180fe3f8f665c99b1621981972065c764f2405bfc1949852664bc323915f1a8a91d0f89931d7883520a85e768b1cbfd7bead7f180696cbb4d1d7f32f7296b346ada5256796eec5daa996a961565bb7dad37172f8fed449c281c57cd0407f715ef83d438c6688cb41d5b55e9c8ea6dbfd032e2e0818c3beaa69a4273e378409c3a53b144f52a4ae54a5dcd0aacbb46397af9caf0d9331234c326fde59c9c3fc8226c6d716808297ab2b9b6eb3af70858d68ae77c543548e8f56a473cd9ce3f73ac4ab0baa8d4043f0e9a41c8d12f9cbb39276dce4023045313babf254d0bb562901e0c021e387020ba229e5efb0379911be08912a4353f69fc254d68f987755fd113199976a8db4c8777f99eafd09d575a8221156b66ed288eebeae4cbf0151e8daccbdaafab67dce16d027b817cd82536673fb2a7c196b068e57c9b74ef176004b592755bb29cc25cc09052a3fe541ab2218fcc40621cef16e46eeb3302f8c3a4ec59e82a3a7d89e4fe40dd0f6a1391c8bbfcbc331dd5a8c1515c0df11d83d5ded56b324bb503654359956a19fbb84ad5458699d305c574b6ede7467127f2906a1baa9efc35cacaab0a457bc6082808d37dd4cc09b8911b48f426917760e1f41a211b7cbad1354420547e5ae833a757f6982ebf5024c529ca175d15ebbe7b8d40fdd67797c05e7e738d3046b6782c8c77ec0b86ffd32db63248eedd7c8bef61c69f25f04ad86d7f0565fc51426402beb741435af609164ed294efcf1c7ea12b901bf89b34d9e915a42afbafa451bb76b047de9f834b9f203011123d5a8362af638caa89c30d5fd4adfc54d2e005d6472edd08e8893d7b3655bf95a51401a2c1164bae95ddb7ec680ba388bff0836a832b1e24491b08da92fba86a854504b200d3d0fa66e4dde006c1e67e0f24f55dbd49ba7bc600301d3b923e53b81a4b8802a2bd780814bc706a51fbc4b965bea5eb97b7e3670b0bc3dd94ab0db0003a1c0de4f37d995606edf659353be173095e1241848a4f2378b328c54805425d630b30197ea89ae4a44b9df5c6131c173c83b3820d38551c724e4ba8b0010354e016ebdca1b800e91635d9a9c0eee91cc3cd450e6207f9a731d1050b4d004aad5e3b3bdbb00eb8094d385b2a7bf282f4342be7c38b39e2739a14957be6defb0dc6382d6be399bd629905c7bedeeab7462afb34d3da9f4f66f71f2a24b38955414f8ac2b28cbde8e74cdee51157e9c818d71439a764180d6dcbb5f84029f5e0ea3bf85ebeeb6b67fe439e3b86bf080171e999ef2c7e50997c1781e4a894a758c3e68ba902aafcabb0dac0e102a8a573060b2369a8106c02cef8af2a1607e8669c58a7ee0712f731453225c7bcd9f6199d2d51feced393058588579913f70d54af7b58c81105cfc15206abe586bd56f93c80f576e45634c141810d0bbd70c4496dc5605f143d9eb14fc98a3f227229265083da14a825012ab2e56131e84420246f76c60d702a8661efde435b8d1ab076457251aab648b364b0c87acbe513b38f99da8dcc6af5baf67a030c04f560246b27b2821711eab8f0f98eeab14cacda641589054557bbb6ef9de89e2ab1ba99676a52e408b4df0f66784fe3cb009ef92a7fbc617ed6f4ec055e92c4372ad0386d3414651c7542dbc362129d499558f3983f7ccc0119759561cbc3402ed61cc7697cd216125f8c3669c3dbdddc6d426c16304e1850e10789888119faaa54c5b84a4868e5ab31108e64c57c3cbeaa5e7f1cf8f75950400990d5d5f15544237bdf0eb16edf6b1a52d9c6d1c28dd3d2df4772d117de73f74be7f60eb9dc123ab137884f77210a930657e7539c01670ae99aeb082b0afb09cabee94e0431e12f73a10e542021780786f18814c58896923cc5360a608cc5a8899d1b915dd9cc4834692b1c2b98d3c55875ed88f39705f58235c2f470d1400914f9cbc18022f4624897962d315af3d1c7cac81328e0675a2145a657f3f3b1f01ae6e825156d9cb0022c54d2fb18d282788004cf0d8b03841df6788080f306743835e18c55c0d21ed5be661c490002f1e6a60739d0071c3ed6a12d442c9fceefde0df404b4481bf848eea826dc8c0dc150093bf29a3fa33cf8fbc0c9661ee24ae7fa9fb3ee11ba86a0d7b88cc1ef0871905f1f905ec39c73ae0becc1d87f599c3514b019e2552f1e285f315c7709adddebaddeb56c17265ad2157bcb2827969b5ba4aae04a8ca3a9130a6d82a371874afbcb64d7e44f665bb35a600a79a8f072e8b2015557eb41950f2c9ce478fec84f08d47bdedfc97f043dc0f23defa7ac2d0850810b915f8b7cf70341f2bda6ede9200c3063789e0ac593ea926427d8361d8729d856b3703a211dbdc09f458022b41340243ce22fab2c27601134cf94bf08eb3f808e7e973fde82a094043d71ae92ab53929f7d6700de898ae8cb6b950677a5658cb78d0f96b81c913617fefbedd5b280ef3378bb3bca71d39b9101f362e31c143f4ae159c8761ba7532a6b300e37593bb7d2dccfa6c1108e75ab5c0aadd23088d52b32ee0c178548f8686dba22fb9ef18efc4f38a8c6d78da960f63e4c10879a9d72013f440f0ef3cd5b2cdcfd65b2e031c16fa3658cdad51b217c62556ab1cfdf9aa9ca049aba5c92ee00e3557ceaccf85deeb65831fb46e109f28ad4d232f1972c27136521527f61d748052c342da826d1201fb0e5ffa77c2a68b1dcbd4bd3618eaca9989c536afa6ce42ae55e2b7357e9b54f321f886466dcf8d3701420012533d1dbdcd570ac6c0fb6cbb99bd409731c73d2374aee0a0767e9f91318b17f8484c312777501c4c8aefe23eaaf50e6bb1d91749f4b75fd9bb2e00f112fae9ac0502ee0d572ceaa6ce63876d002fa825c5cf0a51739d88656c027dfefce8c1cc054e08b3222f0d4bf0650f4b342366e5fa0046fc1fe1dbd418bb958b1c9ccbdb0942008d49422713676e16311e554002ce236d4d1a370168c7f19d337f37f9ef1990381c0809d668757e65b9f083ca8fb31c27d19d0ce754cbec0367f3d2ebe2057902873b438c9867620ff45be962546112e0d46f4f4b869000e2d2da2164d85ada38ed46bfb596f7c6f72083c86b9d8a70a019c599ae9a45e754d1820e85160c80934475e9823a2a333c0c81565eb26ad401d3d7bd0034560590789cd424e7e7917b7864493da7d19e5f4b45845295044db1644022bbc99bd3fd7ec08627095f77ccbfaea6edafc4a49b921b84693f1f72e1bfc011758950b0e2645c12c880237262ab368f404f083ddbb57575396eb1cffdf3d2cbe908362753a69e66cd6a622f6603c83b3382bf645f11a35290375010b2c871d218ee97e78a2101ae5a000ae9fac00e286fedb98e1358ec891ecc24d79fa8fef41a79fcd1ff3837b3500013e14e41585579873a0430ef6341ed50d042f76e7219161034bf16bc49e9c6252ebfd7c5014e0e3d20e5b3461a0ec4d6d356afcd82d38576a6534f78261c3f6504f44a58e3e5c42b96c30af9add47b17c837d92338f67e4089788a173566f82532044431d29067af855499b98bdfc0efa955a068fc3c8834d3174e76aefeaaf30cce8c9cf33bb5f714060d37e1b18d3007268999fa31f4a46ad5ab5516e782892365547478886f8dc27a10a1cf7a7103ce37fcb0893ef30ccc8932d0a85a2724fb00a2fb35b423883c9a5af63ac0644c8cacaeb1ed02a42eccfb8d49c42cce068c5875122e5f1bc8831518eec6bd40c20a882f4fe3422f69bade014af53b060527bb25e976b51cef9ebabeed8779e8c946fb05cfe2a7e58dca74f0f160ebcb4581e6c19db9c159eac1c0712bcc8daad5a21fd69cb5a5961949695de93b4afe671326ce3980b584808f49acb6e5a2e62e1f3c7fc52638dfb1fbfed0202c6439d727cc36b343804516f401e39dd35bf1ea4ce4b0ac56ad006d2b066cbe17e7d076450cffcd5a8caefe0e52426d041c7fbd1830807255730e6a60467da5dd31fa465625df45dea4bbbac3e94d870e9c3f682b5a40af85d959531858182c6f5bfd32cc9bd73bb06abad652c2fa04d723b24b46002511b51e42f52a35248790b82f182e1f4042709e0f164c2f0d978a19d694496a59743278b84fc9175e79cbd017d8e9818b3c424b125fd8981806aeed064636979b0a70f81750b514235442c77ff592bbf8251ab76f3e0548dcdd95e5501d5687d9980ecd89a69f2973c26808a22106822a404d437ae973f79b50a60a1aa024b6316d311bc0b348c193287f52c1daf6fa5dc81423388998dbe51462903a8a26db331f713cb701776d7435da75679bc5b5e97f25eb226f0d4c772f61fc8654516ee61c2a61d5ba61d9b9a394a71136e16bfd73517bc239b318936bb4839c103bcc3b313ffcf7775e8edf991b6db17e597b3ad9057929ed4a2e6b1b00fb3d9f000e4512ee62ba56a60b548f45bc54803704d974795d5e1c3d8b4ab61b111020ce7bb30caa990038ac8bb694b6294ad9c061de4ec73e1e228cbc0c0e54e4e67f83fc0216364eb6562a8880455fa5c119984cf98ddf21b02cc0591b605f2ada414109d6f3c330b2e2a515b8cf8fe635365c74f702d83154d6c58de0f3325fa7564287f21e8e262e91d98035c740b8c88b7f08b24970205d8ab6f524c4ad4c18ad77f51d1f63eb63a57fc2dd52373a5320215dd1a7823de074842b390c74480e53d7070c09b3438652e1931b6b338bc07c46a0c8e00ace43220c3daa4d06f44c48effb19e30b09f3eea5bc629a54fa48ecc97f8c9cb7d6b43c90ad2f884078130a4fe34de3327c37ded370f567bddf7f269b60146a094a7a766d5655801a84a036bde10bf05cf0520a81ff7653bd1848634ef5c172df08349798bf77a8ce58637dfde8c1b4e3029738361f0c310e2ae358adb8a02743b4b09598fb940654f8139a17dfc9d4db796cef32bb1a23253d70f99413b9af3263beb69325a96e512c2bb7d1d8eeec7938b939a54f0415e1cf1b5ffd9ed7e798f26b8ef3c50f6106c541fb3c5ced880ed1deedf5ebebb48357c77e3e8b9c409ebf1a1ebbbc771c2907df0284aac4e59de91b3cbd5011b8410984c4816ce51db92bbea1db4cb59f22f3c820fe155c25edf2d3c73bf1f60d37284e76fdfbf605dc0bd312b62b217f67c1dfbd26124e7032473c39ae90848f2788009aaf7390747badb3462c60fc05a6454b40135e23bd81c1d16242d2e577d65a262b4cf52da9b4bca3a5ef6644e37b8beb5d7a38d36e4d80a1a92cf5fd7e19058c794474556e650dd58459230f918d5eb8bdc6ea62055e1d82bca5e5df8ba5f631113bbc6e4613f1ad46c62f110fb78e98f704e102592ac3b7a64a92fd199cc8d1a5444df458e8a1ba7f58a2683cb120486fd4ccf669cf292e271e2c889044d4abe26552c2e83b0edc10cae3cc9dd7f15efda6bd94d221652941781c65af0a85c2d615d79744da38faf91564bc0d56bc6c1150b24355d4fbd71537012a256335ac735a796bfa4ed41f046ebd6073ffd6953443a0d9079528bc5097725d00eb09260a9e73f8f3863f1e6d88717354590f773836ce47c8311039735a2baa2a205dc1762fc285f5ef6ea837a730a9f405e5c46b35a32bbae3f60d0a0bb74e205e143e374911cce25ecfce596630e9685074e8459d5fa435b4eee92beef5932eae9e7fefa770c9429f6a6e531aa311371ee39b3bbc7e7a56e028ac8e3d07797622224af27a42fa546d8e3a738032a4067c9993cde74bb27e4b9efca5d4136d3f1dfec189fe723bc27e6d828983a72e4eda616296d7c8aab3dec4e7c22b3fd6aab7480c58f074022c5317209a05d5cd0c2fa35e48ea96be9d450a119950c400b00f8d87ac6a72a775e0bd5842ad8f21763fef66ac35fa16763a2db310146e41f2b0667b55f34911e0cf1a8d5920e82435309884269b286f32936e8a90984d0051946c1edb7c1b442b7ee5e0dd7cb5d9f3d4710c1f71d4b96364e23c430f4608063c1f65e4fbe9cb1b01485d104785923e12bdd89b7e102c76df3907a29fa5f79840bead46553b8dbaab975b73d1ef802f9bd712f54a8fd3445a19837e35c655a882d96765db547f9bd275bd8b4336822662ec16405f3fa3c1e0d2e7f0d3fccac8c55a19f0eb488531e600e1982d22145fe4ebfd26a1c1dabff67b5b3514a1600da0dcb2c671ed823fe829f72a787d0219f0e65483970e94f204c2264cd4b6f3c89040abb15728ccf4fb4ecb37205d01568f7949f42ec878669909ef96ae7cba1530d55f4b1b04b6dcb6684e0b83709db6d61f242922ff2be865770c3587fe1201dcab3be413c27f84e3d5c1b01a52dc2d1d8039190a178acd0deef5737358a9929359b205f0aeb06b55811e85dde84fc061cc0a01169cd42fa8f2ba42f32e774f426928ef477c44e1907505e5e40bcdb900520229fb6238f956ec0e0de46839ee77ef04e691c23118484afcbdd638710bceb83e1dd11e325a0b27b6aa80760fe18033464a39f96b046292ea3ea5237edec79df17a1b9c13f7d54d72753f0d91767a0ba844fc4a465320ad4403c182e5738635084437a2272490dccfcca95e843c1ec3e697f39ccfe0d3d50c9873198dcb2a3e1755083b9a5c3077d14fb004c8c22c6affd2259bfb46e44c910e624411dce37b5c7dfe6392f0b9515e2fc27b8a314e7dde9ba0c4b58dd9f05dab6a74227e0a6619572af83bc4d75c5d688348759dd062ffc9153f6e2b58e99fca8b6c6d2a590dd9ae1894a701b5b3f9f54c1237263934a5bf2e896f77e35eb190835a7e196fbe57901a30f54ad4fc7b0f1ad73eef4510b8e82a5d756c5e99d84ba95053e81c9bc8d75c0297bbc48779d699007bc06f1b1c105ed0705729e08d5a72f06be91db02ff9121b984ce301bb9be3b0168960a085859ef86b5bf31af66e5e394bafb3ac93afc4551b6b50adaf5acf63c88d805a62ce84fda33669baf11ff17a6d7c3929611f3b3fb4848ef6fdc2e9d762f073b896bd7c2aae0760fe7a0defdd94b44c94a677da2cdc6bcc10088b48b0b147cd388357f5ed34642b3a4d26e1eba1d5ac791a61865fe36a29f2b2f718ca0da13646edcbe0764311b01acf095e83329da17e131187c55d08c85dbed929bebc41803a046a6e03d8f4740b1e02448eff86a56a3536eafd5ceabbb8efef610d323751bf0e7e0e282c8b78a10b030a869955ca86622c36f19f80e4fc0725d6e894b8c64e69547914ebe5736aebc779b204581a7835f2632c07df96004c1acb3bf095e95f5be0965773ba86065df864105eb97f82576e23f407091a1da36c539551f3e25557db77774b2620b99a64e39f254b69da03ea93da89675cf29b72edcfe643f02979d9e838a62bf88667c5549c2fd9a8a98063901434e0cbf1d2570c2f2d61a58f267ee58c97776540ebe80c50f512795e94a0581cb65780bd5e6ee2bfb31e382f0ac52126aa057d06c56613dd5d2d3be0094b946055501f58f15982c6bcf24dd4eaae1b9b20e3fb628f0aa80494d2f2220bbf18bb693e181c26405b2300582b8da2614cfe33a58d44f415ec1b6b859378369a774437d15aa954d21abe0a28d33bb7b40110d74163e8892eb94263bb59271aad4e81b58177a91cd3fce51a64605700861360896f3a1234cc5bb95c38d40ddc6fcc8e788dafe1101743d66208f4386608e816090101e2faf87c8b75b253a78e1eb19ec346f43170da31033b185e427a55b9237cd99d0c900890fa171049c95a3c2d4fb363718de2ea1440acd877ee0206628d50eaa60326620097a30467202c060013ac8d363753745dcdd738f90fa6829d3721d7f850274553af4fe7f7e648f03a03800b6f80fcb00ba2d796d9e0335805dfdc0d749fb8495b4ce5136843b9e157560136feb5eacb89ced1899b6a83ce6b27b4af088929afd446fdeabd3db81b3ce122d1ef463c16b9e10a40ceee5c213b27889762c37c383cffe60977711757fb6d54b5f32e6fc3d7f7cfc86f831bc59a50165e76c0ea898db3fa69b0bc851c42ffa8995126026eb859beb80c78883c2e07531b53c31d9ec846c79c7b8f086e1fc2210ae8f26a4c8d90d166711fe9d6398b77059637af17b41d2da85a93e84e86cd38fd4e1944421ae07e2ee15178d9d065046b144e74be8c68b9ac0bf05cb86080bec5d4e24d37de46578887e16316403c11f703b87c6733cd2521a6b818eccc4b412144e449c994d205f2252479e7d2252dc40475d835769201dd0282ab32a99a16570deca4115f909f2125646ec1102514e1008d065eaf1f2ea018e2a5f69f5d5432e042e444463d607df0d355a8b36f54efbaee7e9d427af1beb6c367adfcd585a4721402b41dfd43f4574aa8215237717acd253222e8b47d7344903f820a65f1cdcba1698aa92b32d61bb268c046506cf8c4994b3ed4185b1d5994acc190eed46d712b81b81fd15570d91212e70bd015cfc526d0e1712deefba957f53ae0f5b30b288a5ceb8a5ac1e5df911969ef5723f38f0da19f399f48f38e8fd82c9104f1da5cf0374ca198cc8e2580d55521ef8acaf0bef8586f8e56f934ac873835fd2022ddc6b463bde0eb537cb9a6112e19a150e5aa047aa55bcee5573ee4c719f71bd28202094d153f27ff465c67c85b822dc0a2ba8032b385f708ed2e1ae15232cdf8204cff8bb098bbe22d729cd7a556cb14134ee04897604a440da52acce8ecfad40f9efc6cb35e0856fc006e153db16b6f934e315f5323b81d0b9b5f76435dd1c715156845345bd13d783b46076fa369e502f2558c857d8e0e355db7e3ddb23db5345d09dc9fc649a5fe3df80a81fe604da168c3e90d13579d657e73571039afe63a602b9ae1ef61fc39963ef5cb61b7ba72b09ce5390fa1a2cbb5a892771d54cf79eb09cf25fb4ca1cabfc50bf2a7d0419972b1d377202a16501168d6fafc580109a5266b4c9a29a74c02a0adf54930016abf81d52651fbcec3937dab3d4c2e79dbc44b43c802c21d3a620e8d24ba3c675955647088b4df64270fbe9e4f92099f38bef37bb8175701b10e047744daa5657257d5045c0e9a11e31547bc06d4600da72494d6fac5d7c779cf32c0474884c17075361de0d73bbe235fc85108c5bea52d503997c3c12dac535f95e75d00bd120a6ff9cdbb38a487c8154ba9fa229d2d392565b28cba20d08a09c3468e4281b6a38872fbb230279df90966bc0a0f794466b306d3bb130332663ff51a289b26a032d0dc89ef1b3b4c0c81b9b04bc475045af7971b416c8a9daaf65ad84ceb3778275eb7435e6880a8d2f3d3221f9ae5b74f1b522fce16f1a343295769041516a490a5e2073e222b226267a9ad3cc7562b0505ca429347c9f05d05d2533dfa626dc3cf57245e5f64109c4fc2cc6c6d856609c454e034422505108e21bcd4cb410403bae47b8113ed92ae74652eb79950fc9c8d1c6f3151c70ebc0453e3a45b331c274c15276b64a82d9157682322c6b2af3aec75fe326bdeed0cf986aff789e00a147b84e60d4da9ba4163ba1b7bb6d3c32f570648c925c0ba609252ab4b6de91068abdcbab0e962c1754806797b04689ada13feeaa537c4a0b5c67c204915e42f4cd5a05e3bb5e88bf70c9f8a8d093da859169a019b209c5d538b423e5f3b451d39fbd9c38533a9dce4a96d66ded7dd322f31345721634a24855b8825812086131710c018cc5ea2c86ea439c5ad7e7700a679b3afcf5e9fd1bad7202e2042958254c6e0fd48f0cb0788ed9c2abd8299f6d8bd7746a36058daac6c578b44025a309b70f4872e0d781df7f24544f6d50e71c9cf3dc0ee96c9428d2ec38fffb4ef6ad968685d01624af54220cc4c70f4ca3ec8ce052849de1c715d772085d8e22b8a12941f46250eedaa0c063f6c40a8c803488a255fb59f7a99b1664429340654dfb073c21529f71be14bc42f573aa747f6574a05df88e44ebc61a4cb3599f234660bdfd6c95343ec3340f234306be47033df4a18fb433abcfcbde0ddd890b0cd15d87806a65d9d05ec17203288d08e1a0d87a93f8a09a528933e9bfd2ebed8c4a8df62d20bd128b0cb00f4d5da3520240ce9aef708ae2edd721d763085b65be16652d7c9edad73b700b25ea02d4ab0e18d4637d3a2d22619a12e92717ea8e3f276f8d4ebc03035cdd1a1ef30147be773eda13ee45df912756889b8ab8f567992a55d1af10278a0eb2b623b7b9f345e527f37a4582e24affcab2df8774ede45be50790d1e15b49d21bb07608d17eb315f16ca5069c4ec13b326201c03a4809bea24405f791982c318c9ecf26a26c2c54bac9d6d71546da25533f16cbe076e42c7c7f45bfa136c2363256f87268e8677f986ffbccbe86407ee40bad228ea65dcc1f795a7ef514c89042a50a25f9fe3f7db55f19827dbbfba3b79dbd5f47021c53d89c31581e764f0cda76d70c293c07d30106bbf19c00d3a366b5a109cf7fd62a575fd5dcc4fb73030e2052135f401668cc9bfebbbc61dcaaec111cc1ffe27e8a4c857be15fc72ce00cf65b758b5954caa8a795556111b9ef84d21711901ed722c649bf26ff9ccbf74f5c20a75741200d639c1e8bc79f802ca97e076324186f710b8bfcd569b936bb968a7e3f8b319216f839df4b01c94aeb580f3b7b53b65dc6396c67b6e8175917857b97856fc56ecfc3137f078a7e42a62b6d5769855b6d1ee9c534689fbd7709cd818d9135538c2f2fb5218f275eb7351c1d532d46fcae12b18d9f2fdd5bc939c08e9d64f7a6412d50598cf2da61d7ef14d98bec2edbf1b9535f63741d028d7c73bf3429eee78f120d0edefebb95bedb703cda4cce397d487e756950acf0ad92ff869b04b6f127c6583e4298c9889d2f815da2403f0801b2b979f30e22adb8b60dff13711f96367cd5ac712dcd01f28e08eca80f42ac1bd7819a2d0fc4ea02b832c638d3da2471b642d25518c04c0524f8bef229a4d980f4d21451e962509f627f98d12e1965c86f027a09772679002c58b2fe20466cd9feba13de642b9540fa8bb9137688bf591ae0400fba5a54797dd33253a34b9eae80d6a071fc1e688cc64d88500f02c51eebc253544aec566833d433733d9f19a233eac63468cb1d7ab25bc9e1c20cb985660b42c46ebbc4c0cd256728720cc40815cd5ba50048de43a00528d3b07ce8e1a1aec55d6db84b7d6f40fe18a0d701dc06ea8d25a6152e0729cd9a0422439fb27818b2ed7ec59bf93435d351963d890b92ce6debd9a1f017605ec2d37b82510caabc7e6938fd59e91f98d6f0d9a46579eb303867a757ea98f63249e80bead9e410aae04c6c09435b278876a41e0d9470e215baa2147b3a34fb1fa9a227e69f515d33a7d8b34885cd5eb385ae43bc2d9c55bb29228aad245de72a3be82fdaaab1882ad4e9bfaf971646a52ea8454777d42e2c8aefd7648aa05ee2758d21791bf0055eeab6348bc2e005ca6aa2779d43b915fc3933c000218a30f7b8267383911b3a921aa1cbd43ae2c2ba0941727dde45f80c763bf6ed8d352900065e9622cb295d48271bf65578fd8932268551774bf7b4ded08c751cc97c0ca07a5192bb541df7211781eaec0a8e5b8b36d62f63b820259a2fb483af58b31d73b5c414c165b5f1155bbd763bb0c790562a7d2f3d76bf79739c3c61d6ea287647b5fe1334256f1d5f905812449cf7e622e72f615a99d6504d67045ca12e6bf60bbf88b83feb507cd037c120c5f0ff4f5726bc8190735ad0075a4a9e71735d184cf0ff3fa341164fc0a5e3971fa143b6eb7f56307653e125cafbb07795ee0f1c9fd7da067cf6f27c3fdb0046abeb1dd4770dd6cc70ee78f5187386841040ee13c339aff3b039d0bb6b3f481e28a5bec8a2c624468e71c992623f16dff88a5fe7e54ea1f27075057ac5f94cff8f405f1cf0d137ee4c0c4b1fa6c770c9e443f14d633869415e1007138316e564b7f4db70b6af988e4f58a62e4ccb5756d77a50d9082546b4f6487fa8781f8c49e78380c7a7fb760cbf2c8e525e7fbb3d3f1669fb2402b51a1507a9457ae0469fd8a39c09823b02695eeab845375a912831f358f50bf378e294779cd695acfdecfcb57121e90618d87c5c399e088bdeeec1f7520b9e08c752f031b7c0571a3a31b9427e4f111c0d69c1ddf28de7ffb823bda0d3e60a817b93e413a82f9a70a200d089f6e712827d125b283bcbedaf276e580b5e6a3b49fab5a3845aa118d404d02d83c83b748836b0eb15a522652f02b5c4e7b545cb907b592f4e2a9f7ad3b6a18b7c62457cf3f6ea991d8aa18d7090d9aa961daec106aa3ce0df956794eeb3ec47a50b8c2096a5df131979733a738770ae822bc366e024bbf5617843317ede3f33b7b7a9f1597eeeea3148978a1121361f4ed71e814a15f550ce6961d0230a2f1ab9b7cceabdcf202145b42079ec8e7009cfd6bd655a04d8eef451ea0617d24887992661dae5c72be46d03ff1413251d6bfb3ab7075670a4fca4d0eb6c5c70f758a122689952c3a472b9e734bf231658260e54e271f1d996e46fa4633661233872089a1264976f87d99a1783b6c416f72057fe07643046bc9be3641283a284046a7ca5fdd8985b0808f4b36d60bfc405372ef453beb54a5ba76dbe388de44177298552922181a03b9e386c05a50dc354b641e2f0f4b04cccfe468efb7fa996fec7038b3f46faf6881579d5bcfd1314597fdc4101691c7fc37bd86a114d4e3f9a8a788fdd3583ef811844f3d2cbe218432f72e2099f6eca8d37d25ace94e7587cd02938a6d19e58c85aa471bef45a74021f18b68fbbf7709722d621c1630d7971e6a2eb691e5fbf56637c5bc4b500fcfa506312073e1c715a2528cc56914a05faa403bb51f92541639fbf23ee41d59d1a654318d54b39d5c673f40de741f5ba2059e0fc269d1d65bef02e69c6933a5e1f85b3d1e4828231c3e344418b06fe870e34a7610d0d1b97ff110f00f98a8fa0945b9dd7dbe117faf41cc7f3f7939bb800e821cb0a387d578b5cfe0f0a6d5a2a8237f6b21eda8c7fa514ff85181f739491d9c895e73b18564b3bbf50bc9f2a78716a99d65aaf8843583566a299c83f78e7f4486cda378343de9c4cb473d485e052f8c9363a67d81a7c0ac6e0f55cc25454e06ec7a3e5c8d1fc34b98878ba0bccbd43955ad785e3dbe9f73bbfe63b1c66812b049e2e085f8db86858e7bf50d519744c7b6c0cbbcbdcfef806bf6f4cbd38b9951c85cd569094ed98a4ec5ffe5adc17d3a40219c7dab2f2e6960422f8ef21df192b091f95e938ef8ccb7ae958f848cd7fa951c2e820e47420749c35405be088bdc41f296488d91671bdeed96af874e71795c394e930361bd2af1c8fb27110b2802af11410545d0d3c8f497abe38a497c595e7b77664ed5461f0bd9f22f59127eaa30876a05d60f9a1c064fbc1040e33eccb411c491ce7ecbcf6bd2159f6cab7ebd85b4716d4c1135e12cf6b83645651d7c6edd35b07ff1562a76b8b5cb43c153c4dcf734e9b0fa7c35597e03e95068e74afdfee30b3fea917de283add8b943cacbcee72ed73b2dfec664f246d8f6f2c285b47efcc907c37b404db59ca05aa55fb5dadf1c295a1c7324b83fa1ef9959fa90b61464508170e22b7dece44a16c573c6fee4110d5765bc7a5029e801ce2cf89cf9d024c1776bf729da8309e55ab837cf0b46d844eb12d5eba2fefddac2cb6cf4cac0204b66df26662dea648cf265e2f9fcda2a4d93c3916377aad6c1c05a877c52dc73f7ef2162c321be09034f6c1dcb231fc36811bf0b132f63d8ad2329f69457bf351590e3f7e70f0b67def5fefe29de0d0c020bd919ffe9bf73bc27cbe0d8ea683fcce702dc2bd6eb8b1281a4f0884725788d824a6170a2ea8919f4b1339ef1ae728dc08a3e9486ea35b5aa8a6c236d131c8af40872c47dc44ea7c2fb5d8d41610ee4574a7ba902ca20c5ed36a71365ec6fc41897b07e28efc4905a0d97f45cbf2452e702bd9fd18130fdefb958d491dbc1e77f1fb329740ff9f5cf565b4e9053d363f92745ccbb24dfdccef4ecd99fd43505192c5ed8c8bebca56f6ada309e00cbf1e878c7192f10dfef56afe0131134ff3442c34d98f8fee1afd741706d85389f7c4aec199bf3fc45e16c57b7d05296b7d754af2f11441c62d70d4526394629f9bec184e169ff9613dd08cf55e58e94ee3b3e388c1e283fe60da73313dd1e3efae77c4277feabc7f873991c49c798520dc2affb92a772253fbe8341fdf59e293bacc33e1fc3bdae8d8ce2069bc2a3e76000779ce34123d1ef57470bb6af763e308b23b9b031bf2ff5757bf5c88c04b9c90c1691d019e2f65e0007a97b112b6160df0c0443a017f701c2f2442a8fbf549a36fe9ed7861d7d1cfddcf7dc5f1a8e458a02aa8dd1091ae4a1008516e9482a39830b351107aea4c8adb200a1bb85c5dbce28a0074c419ea39b03e13300d283d084aefdd14bf1779b2478a74099bd46ac63171ed015be45aad85e1d1e5b1f71ecd1ee5a3232bf0b42d48a6beef76a28d5f28bca7538165007385508a1bbfba96c9ce0e58b1f12da226b23e7085a09422998f4f77b23d066204c52a96fc12589d226d872851a9c9c4c9e9e84a3da2bbdd8a3a6e58a78ccf287835f03a178a17833bd0de3f820e2428e01885774a91f4454a34e80c13e73921c7ca83c58055229ce27a60ec4207a8465becc113a3709a1ae5dc12ecdcace204c7d735c78ce0bcb7ca9c32637ff9d68fc5aebfe4a9d59d0713670460ea031238a2105c20f20796db5448f4a722d89643c0b5e2803cc1139c72007fc7c89cd824328f96bf04e7eec59f75f6cfde86346db450d2a60f448db03cfc94949e4054e21bcc9fe6da14b2c2db21f4952b4c85fcff3b72e0627898b009ae1d15cf768def56addf6a183aeee09e441986aa859a65e29f0f9a14d0deff2dbbb6cfbe69a6bd6232cf8607b4ba81c83ac1cbaa42830103a788b27bcfdae4c0702ac7204faf8eb012959c693d9e9143c165222a333f185f3aad049b80bdc2e4eccfd8eec2f8f01c039050c394a2095d13893f6a645df7dcc6a7c3954b47432dbea79cc50c858df1e32d62e3f4d5d26eac6f57b39353d3639c5a801fa7036787860548d806eef495cc48e00cccda6a4ce16b35d82da415b0ce5a0b8a40aeeade3ad52e159ec9d5546069fbb0ed05454fb4d6f83d551726551206d55eab5dd024081d426ed010a221fd9d1138448d70e8cfd0e009caa1cb3f47a220cc09e1ac1c227697a7a43aca862ea334f411bfc8e1766e024aa1e04c3b03f9242a0856b2de7c7db49dc235d820a774e69ecdfd2d2bf0f809007c1160c549ac93d39ce43c8fd64bd23c1eb20733bc4db1d40b5088cdd6eedadf3131c7c06f6f8b6090f4fdc0266970a7506316b54cca4c17ca320cd6b9081af3ce635ab1f69f0a51abe712e01254ee4535f3cf78de3252bf689ace9fd6f3b18c329468352506a23b1105213696a57d9bf1db1a466b8be33b09db1758d7d2b67334052509bd665be579ae76044bf5e4e7873d8de811cc19820bba3beb120722b3ce70a0d579335709dc9fffcdc92ea77af46d8bd62bf9bcbb2d0fcd4435040f644c15fc89ee729fa983fc825351cce39804b47e79d6b663015ce7dfec4b7b85a7f0b654e20f4f45856470707bed73e6a6072926d4d050f079550e3e25d8785c8c7f8bec638d8141262cacea4e5f36072ba892effc373ee8109cc211438a156a463ff7269cb42417e7207b5f6014179cdc60a6843054afc3a9dfbd188c4c86f02d8f7fa2098a8649dda2dc4e9a87c12a22202cfc925c38c87739d58ae607c180c24fdd64767e45ad0a465f8252134dcd17b49425d690634e3acfeaa004175c5504206dad080e2c5f396504a123bfb66caf21564a12cec8c577d835796b2f4dfbe701a9a10421d729a4bdeff2e9f777378a72bd393fe3344e27a1a97d4c10ade942ad4d0d75e1784c3798ac48a6376834989f719d03d5bac42cefc307c73d2cb7334a9115e14944d446fbe05abe51f2f9479af666bfa66e96ca87e33be7985084c24c498e406f19edfe9fcd79d7e8047ee180a9385ab2ba68e87d7f9256a97dfe4cf051bc1277de63e30ec205c4171907d6d416621be53e52e0a333609b5173451c036ac74ac50f5018e0350874af13c094c0706b15d09de8130d8c25d3e0b1b364edb137bae3ee4f719ac46dac4868770f8c198578ecf73180d3f38ae787cdbe9425df16259f681020ce783e349fe6d3576e2b9361223e43db7e48c95ca252636b221b9d4040d0fa5eea91b5658e92b6a5876e75eebef55aac3261762360124d42fd8884596908b87567c274c060b75deb1a15960d0c948a42a74287d55311199ed180b172a1a0c855aa2e43541516879fdc3bbb0bb7ecd637fb57c5dd877636025ef8bc38722f97d493e504824f882aee33a4507b13a6978f4e4b61aa2a203f56a8c1a69284f9a820df4a746bdc23663f39985c3dce18e1b7f6fd95d1b7e5ea31bc2460a12287cecdb589eecaee0d9eb2752cb1ae54024bc54f65c049fb834fe3d48f47a298e9892905c6aa0bef867ce50683586123502f9a7e5e45b854a297881481869bc035742f8432854bfdf78036ad0d76e9b85d88c93730c0d746c5e4deda03192c1d045ef01f017d9cdf696b1c651022a221a73053c2bb2c3796372c12b97e5ee62a53097046f027eebee95a836b5c1c8c1e81a47f84af8103a55c0a3d5c95430dd8e8725599e7ef1ed37f8bb69d8b494592ef49b8afbb31581e5ed7ca3faa430f272d812b91d4319752894ad7fdeeaf64defbab0e3dd18daa8ce5aab0d08087f1a885b34c1611ad80e7af24d124c04e0bde5d259ee925ce6120c7095908dd34ea2859bc4100a761042734c56289edc7e522a3389a2f6331aa337a05883110ab0e73f31f4e98d67055b2d8e0fd49adaf89f3897045f8adfc2f5a9f2223ce1f45e1b4dd225150ad032be70e0d12930e1e72061721926a85b47b8fe787700d2bf77a3b034b84c07fa7a2b4c289c6bcb8110be582f6e02f7866ab44e072beec22e34100affe6315f7a39eb4aa9801c5c63136dde859a3820707f1271e24ba5118da62ca07638141bd5485304a4d042eef450a82fa4a49c37affe4c0f4afc0d5e36fb420c2e3e56d6c05371bd64e91111d794599e6eceef29375b79e32a5c040b716b5f0e9901893d17d363f9da18904001356b0a6e9d261f42bc50caef734411589d49323f70b91c3f8bcc8ae8011a4009466e1392cb760822721c39c6c2dff7a161a0b97ac7633efda4f154f6040a0fb78912f553793171e2b4733f7403b4cfc8969b33336fdb4770101a108a94c0470df25d6f5c2198742fc68e976b58ad775bfeb2a8ce0d065c6254c330913d0e17a7b007f84d4347f3545343d4fd2ecb623c81f64a058915967b1d89dc0a1867edd077ed0ab9a06562e4859c2e5ca2514fb239d8493619ba71eef7e2d0246cd447323c84a669aec33cc4edd3061ec7ed040107c3431f0c8cc07e59526b0267c1559c79f6e36d48dafef4a4d6444e91cbb4d8aa46bca55d837cca41b9bb35f7957050edbe225abb5696d4373ffe696255490d1dc17182c685ff8678d7382a85ffa7f10ecbe3a9ed084dbe8d45bedee6b4f910cf07b999ac1a359a5dfcccb41a1cde495b4258b370f52b01bae6788a07e7accd5b99dc8a0b657f794a8231640cadf72c20e65af9f0bc20088a24499aa625aeeb36cde77f9ba3c4ef35806364abbb387d5b9a258a896e2a38bd39bffc0d30f46f9b5eb1420c2cf756e748248296bcf4681e229cf5c9b86acd53351ec3d54f37454fd96718052f62368db591003294d1b8c47bb91150a8e40e77590aa823a53f84758a7fb899e6797e454345790d2f8e717099039158836eca11e59e3a1284e03302ff7530f10785ef5e1f6d6ea78377e3bbd16ea9ae7c6cc5503c4b34bfeb44519d209a48d155a5104a51de5da05594672a58d2d8d7a8459bb4fbf5e5e5b1e647e371146d7e0cb4f06242ea94ca63cf9870dd762f4848eda03522e90fea4059a666f0dd3b8f76956acda6a80439c93fa37564f0254428ba3516cdd3ecdbd6fd2fed6c8d9db03dbcf5c3bde0ae9a2acce0222bb279f7f6951e3c154f0a5e8e77058df199455152abb7e10a2939bb2a2218cbd1638ab3dd83fe2e9977ab2d94e842c72a5f08d47f4c44363f249e0caeb9b3274a7ffb98494123bc0264c58ee3377fc5998668f365cd05703f0c45c1ecbf1fc266bac186eaf85aa6b8985e4b0b25c8943f081724a533e3a0121ebfb25058b120cda70b922e44dc91c414bc3731853a82ea0abbb94f326e6718b8aecf67f6cbb9c65b06ea9167efcbe8582cc479bfc360cbb8290cdeba3acc26b3e9739065bf43f363d8bc5df3b64bfd5511f333dc978e423fa6519426d0bab46dfd63ef1979619f1fcb597b2934c36cc25b4dab85de89cddb204a2ee8f8b2d91fe2668cbdbcfdf1b5d248eac1d7e79bc35ceb906766faebc19abcab2aa0ef4f78b87119757bea6c04ed4bdcb73dee3bfeb1310f43c1478d33115d41a4d619e3d3d4ba8f68a77619c0e4fa85dd040fff7b46eb52d120d60312765874f5479f0d90ebd6de0fa9ded1ba307d120075cd483cde4e0bde94acfc8f2bff890a691a31c8c32f116efc3e669ba2589c6bcdc2fe099cb25e4fe125328e7fd66197884a8a449fd42f6727ac174fddb0523a8299e435a33a90a3f94223577ec697fad528ec5e93dc78e242f410aa3c849c2f350e19b8d1b676d7eea69a39cc929d5be0cae8f496043fa5bbe639b34f8dfbdc95632acce18af3cfb78c561ca2b00b53f7458539743e0d513c4483f3543b8c0f2d9cd07555489190454cf5de8707d886f6562fe7360ab81248ae4ec6b0f39374f26fbdd173e163272e506099c2e55b20379bdc2d4295f57b319a5713be95e9a992e795960d917111e5ec298bcc16f2399b098a973cf12c7799ecf77ee4e0525e04d8f8a3af150c17dc7644de7743f99f64c40c524c5a105b9d1b3e22e477865639a83479a8f2daa532afc2b68da152ae378ec9683dd9ef06167223b002fb697d849b3f531537d6f3623ea7cc5ce3f499a5dacbb0772f635e1f2cf879f1dfefbddab6eb44f08048b6f40623249083534531bd797b3ffc6710c813765819eb53364f70a0d815693e570479327f8bf4b6f48b22dac58ebfd8e60382fe9d0143429a03646dd8c66176f674e096353494f45ebbebbc44f22919cad023db5314b61391cc333eb0137e4526e3737238c2a788853eb84481488313f2b981369f2ffc4e984e3cf7cb0e4abbed5e9a2c1dd7c17203fbb3dc7d80df7cbcb9b2e690515111366e04e70307f1337215f7ae3f3977bb30a105d3e8b0f50d303a6a133de15b7c40cdd7c517120927ad4d5ef3a42f37da89450048f9da3d3d65453bb368c8555c23f983d741de8f56f0bb11b6e2a526861afe9e71c8a3ea956d4fd5d6963fd6004d8971af107afc452eeca1cc798d34e37ce21a5fca57f44b088bc5956d8940280ab845fef9600c47a8e4e07f2516eafe7933a657848606499d75faa38c5714ca978a79db79a0af4b2221ab3dc438fb206533785c80385be51000ae7c04c5aa5389ff62f0956fcad57a2199277c26d27d56fd14691bbcce15f126804f5d53bd73bd2e0afc589f4e20c947c965d367a0246893cb1af06a9fbcae32745bb274558d689ccf13e8ad2189f8ce2337ea25e941d7294f07af40e019fe9a1157e93b336285241ce18230f6bf1fd6853e8786cb0928749afed782d2e4eb70614bf98f7dad425db94e073164c1cd21ee9e8d2a3873b2536ea2e5a60e23c214698ff64da834822df247638843ccd0777c4917057768c7f4d70da8956c65eb7d22db64590d3e3c514b7fb14b467ed009b58c6547c469124d2ccddc55bfdca55096183b506ebf62c163bec2981d271027e57b904e22e85048ffd5e06d903209f30993dccfdba10ed7db663d2d53105646f61daff0fe41a7bfd27076e55b49999129a0ea7e6e8d7b438ce7e678e86ba691348d70084d126ea840dee71358d7acb00906314e008cf58bc818a4e87a33c7dcabfb41036d1ce8279762aba8e577251452fef824493e08ae48c5ec47835691d8f66468e64d72f6cf806a90c205d60d14d84eed86df25be0967555f908b751c68afab6654883428e8720973e2423a74b4db78bb13483f6af8c5cc583f78430bcaeceef07735d6a75d41474ef8586b04846380ec9d5a758441d37ea1ba59f58b23c314b948f7f75966bf4db44520417a294bed69a2fcfe59e4c78581cb8f9fcfc6b4897a51dbc6f72eaf91a4c25aee8220636d8c5889ea233fce949149c763c80b2479547b7e8dc1724f67b80241356c6741bedf7da9812f84be4d0c07a28475f141e8307dc3afccaa854dbe1aa3f23f0c61bc311dd53d42caa7ba5694845f27e9faae129c8b70342401f182c34ef905a2833931753d8c3ee55324af43d33bf9272f14c89fda89247d6ce9f4186146de240206699ed59a5ff32a6a3611084b3a3483e52587d4e7316fdb4f07076e66fd8b9e780946613d5fb97bc308808932940cc5ec79d12cfe6cb29546b76654772cf00958958dc028f368dea276cddefc97e3b82516972553d43ac34a78aefc87f4509a20ff12525713a96fc1c28dbfea250bda5b7a97a29f7ef5119a1f7930f9a811c9281af9f8cb6b327640c14361727f8789f2e0eb327e870ff3a312a50652a945cf7a6dfad0c8dcf6223a87946835f45dbe14f84dfbfef9c68b9add1594f086aeed3ed7b19f8d82997d0b4e4ded0ce56d2fd083901f2cb0ab7599e26bfdc832880a5cb568eac7b1ef702f03cdb93e6585a58dc5cd4601f5a6f57aa51132281979f73b705af8f5e2d2482e841e8f1e8545a0eb257ffdfd6785c07b3e76267acd97379071c50d132e1c64caac51a644bb7f32f58427f8cc885268c5c05d47a1ae4a94920aea57f09ef84ed286e28aded8b68bdc7d35505f69eef2571a2f1a4ef908cffd403f988c42a254d1b8be4b51b8e305edd2278976a7df018df0acfe39116fd6210ca048250da715789ba29b83e62d1d3bb1231b7d408999c405ee100c837cc0be75c309c5aa1b9362a8ddf357b9ec260fb3f2cf07f4fff7c125f689db882296d83dafc166f3eb49db346192dd77b475bbaba4fc83048dec0f888037ee56a14dff3bd851423f4e53983a25f950921c5c38b4a48c3655e8e4446a0fc15ba3e98bc1725e0bb80b7e2c6d28b42589b566033ac3f2a6365593ee0de77fae90080d456f08eca42dc5572478846d1d0a6f20cdf4ede530502e9e16c9c645894159f81159df66d2d6f7b3180f3092e20d3ba81d2a756f182fdb0e2b655ae73abe404ad1fd14ca8d0745f39307281ea8607e3d1c46dd6eef9716b46d43a577d42159a4be7856f1df501b854a59507e1abee846457ad444da6da3af614bd3467303580e0a10e80681c1750d05b4ceb0def541099e150de26e816fd89d8aa84b3f51d15ea7b5b42424bdb49156675699377432b188864ce233a1a67f8d7f90fc448890a013af65f676b785dd5f2f61c7a9a678c3015e66bd5610ff660e7ad8190efd46952eb9a65e65571816abdef3276bf40fe78aa4f975b3c6e389417b35f0982a1e1f97604d2bfd1b5bf0e54281a2061d2ba482b7543782078f373f29ed3857275ecf2657d0f03218727cb0086e6f34fa205482fed2e046a9e644a529444cebb9b4c987922ff0595351786248a623d0feeb8d5fca6b77a1ac69cf2be571e146b0af210955448a97f385ed1062303ada66eba070581d388757abb31093f8d5b333e843438a48f11b3334436b9a911661b80bc2f7f7ba3f48c1c09f2b6846014b1e6fb518960e49b6c3641dbfa512bbf731ce339ff8c0e9301f4e825633c8fbbb26707c6516753a9464307a6dd861236bf01b603c5910bc297cb4a4914ca451c5de2a640b7e097357583447b366e2be5ad8fdfbae1c30847c21e405976f601f5dbcc8c5d2882797fca4cf3c466f776a383ee198722a18bb11a7a10b04c00b0577265e0153d61d9b5627f914969a96917f9485aaf48977a335047c91c226303b8f6f4cf42d6d0f4687c11b7a226ee357a0edb281da4b925141d5fa57d13dbfb07b25427b43df4d8b1c77887c68a4094ab67aca3409d7c69bb28294eb189134716294f7558a82e1c3f57712bf7b3b1b4f4ba1aa69c65e7744911562c39f76f0880576948f409e0389e34c45ec5871702b82e138f72a3ad843cdd070b1b517645ae06b823ebaabb92bf1f6101dd09c04c1de5ed1c0a4aafebb31cd4a7ef3826dae10eabf55bf03175a5061c95fc5fc3f56cee3057e1fdbf2fbfe2b7a3b98c1a1ecb23d4d2f24e76c9753b9768e728ee55430fffbf0fdf534bc65f37817b411e820740fe2b338dc4c929bf9a8c521d066f011cfc2edc25394601abcd10a642c5b7fed191e4ba942dd0d652267c1b622d9489072338fa0a83b4fb434516b6e35157ad3b3877ff5a843061242a05b02e57c67ab8ccb63362bb02da1022efd89f6188ff13aa6365bdb58da26c69518d7739b4600a91da8938756e12f0faa7267a3d97395c447704683d623a9fdf3b93df1bd9dd0db04720a8c9a213be08cbd92bea7789266e9a3f4860dc4d57c6dd0bcc16583220493a8859ef8860e04a7f5d11aa5fb4df1d7e514d9973b618369e37a4b31962a3e5ae1482de07d1e96611db3d59bc88c912a9a269e8067dc86b75904a08110640605162039704621f15492c092ddd37d078386cd910694808485ea9c76ad285e72cab7c0cff2052e2068ef87f46898eb8b5b79411be288c42b072b121c21a829db034c10a6cc0358b85ad1345b510ffcb57b5980cfcc002ac9110b450bbf407d9e3a4b98864843d59938909d6a4dbb3a78634f2002991302407aa01b1931d08f76eefcaed73dd0f0c353a78ecf8fadeec2b9bddcd6ae6106e159b372e1e999fe39d75ed7c40357cc8dba48473cda167a20427a077a0d36e42b81ba5e7ab8f0a0cb5c27dfdb24f82600c525a63f3b9f141aaf992c6b0d709caa984f24f77a8094a8f3d587a9539d42c1f07ff557f205d0027dd10b6397a4209f6fe9edd02ea6f643472c2ab7bb182cda3da828ed972ba56f0c119b2d5896845f4f7b70d7cdf93b0d084d0c98d4b0c49192b59c003d10b50e291d6810f403201a97ccdd50a8a7476b90619fdac07ba5483b4e6600239ea2a22059411ea0e1e0565f20d9d6e4cfc64b152dbb7907b973022f605264a22c917fb7eeeca83aa13d76cc31c9c8d712e8098cd062d560179e05f5e13dbedf5bb4214870255935c90e20a6d4c2c009a54af2e5e39bb93f377efeaa8eccf3c2429141309c6820c5f3ac4c7e721471fb6933eebb38d2ca41b1df24ac1da8350fa05213fcccaacaeed3d34cc54878f6fabba0d349d06d00f1c96e043a84815ea65f37abdad442048e034312b139e801d074ccbe7ad810029e776f457bc44b0ce83f4c1076110086f5c8a04dde38bbe9e14830fa00ec2cb3914689118b87e9e1da4921d0ef028fffc5b3be1226a91637fe8b35141b9f50c5938fba213476d3e3cd5163c9137939cb5e86d566428640cc2759a911cdc5a013bb0e4fb208d17d6cb07624fdf6147c3f6102c9ae19b2a0533a88bd53f99c5c935a6389f75e3c1092e26b0031ebf983ac1cbe236277f5ab7b4898557e5e5ca4e9d543a045c57bc9196b9d10bf3be8e8677aa7afc693ddc2669f5400065082c38368b6f9ba77258bcf4f0ed72d4f10070197e5ebaaf1d225ba828383a35204c652aa0b2a6912c57bebeeb400a307475a66f20584b0e3bdd76b1eaefc7838d89ada735727b0be5ca05334b86519adc0290a1dbf2d45e0acb3e266649ef9bb278bf7c860d8efdd944cc2146e5009c6924ca430a6bfd1e7469d76d441954c42202620283a8b4606aa2e11bc71728d10de6096e8fac164d080124c8178521bc87f64dfd11301b5717683d2df44da2fcdb3e22e649e71c94ae32656e0eaadde83dec70da5b04821d6965f27f7ced3faf30640d6acd3b2bef3f964f1dd98c477492e8362b5bbea0aa3d081217f228130a73f9cb85deec0e73e015cc209a22e8749160d4a97a853ddd78182dae4524f2868cf766a13593a4867df9e5c34bb01e72061bb612ed9bf53e77a20e58ef2a9445903ef9f82472680f2abe33c26d7be299352ca2381a62ff8f34e37b47fa36caf79042c50d6afc44d765a18416ee397146548b9d71087f83583437884cb48db0e40b084b171fd76632a8e96d9c4f1486ab4954110f56b3f6f9be80bc6963c25511342e88ad166e92a8a553e62354a2f183e18947b133715973ac1b288a64575d7012b446614e0d4d46b52240497d047cf23aad4f7175ac728191f3266ed5abd8348172798495e01f3cc019da7e7ba03d2e1ced2f479da5ec5e59eee19bb3a352f0cafde40b02cf3f9e13094a2ac691c5a4f3b41c3c2d136e35f551e476e263eb3b292dbf49cf40650d0991394c7dbd5531092546c288770fef19aefe9defa4a2ecf098d5c01c226fe6ab08bb9be863de1e2db3b245d8a005e9f7e3907d1928301113eadb80937c7f5450707b9b397899b8b312135846d4cada7920f979deb913d934731e4bd80dfddc3f815547e630c3f5eacc0a28fc479b968a5ed5b2f279e2ddd11c731b4e129f080d91ae022b71bb9eb905a09e4e7df4ea520f40adc00bf6752e6c0d581288a8e12b23ccb93e20431351d301beb817cc292d6b678fd6ded4f9bde8b9bebe8744d3fe7dbb376c024f4fd0bdc2982579cf80ccf9f8a7989c2864d1cade53eb2be653da833bf1394908bad50a1eac553afc4d5819709d1a3df4592bc8e953bbeea7645585cb55e50eb2eb0e98c4a8094a1dfd2a61f0ec924fd21c81ff7b3ae3c7f2191968bfdf551c392f44f71af9c0fae65edf643a13a3ab48eb57df31edd932c8bdbc10ea75302a94b4cee1d8aaeece5f022b2455cea1085a7308fd9c7a357450523987442e0c4502b156a940d18815148bb85e7c1f12b460f2902cc2595f94c794680f53c7a7bf48998d4cbbee45a20420a24fd08c422863538d878068a84e06399d49772f8a003c397d480beda1fc9149e1cdf669a278c46866342e2ee6f04c7537fa2eea87c0f89a067f573cfc95729dfdd6f1c314d1b8854cc8abfe6228f89a4e4e24d1c71a574220c767ef62ffaf4dc41504e458b3dc1a11a0f4845f4200a21a2f8a901b0529700d7123b7e17412eeea1e834a7e7f84e469d3dc39b8696389b489d692eab7350c2cc279ee8618774f5eac9aa4d8d657b2bb5e9a6a0001b324fe322c101bc8b97a487384fc883b96b3f0fe6743d158ffab72722e8a2d031499ece20f6ccf111fb3ae556b4b1fd074231fe39be217303fc791ce73e5971747594f28b5332ee2d60399f69effb4a9d782223087b1e8694911be31824ceeabf9f2023baefe95e2145f1b0a2133b392bcfd4d87eb0f2c57f81b51df3579cbe92777ceeebc43f2e7ec2c43e61f9b1faeb6ef014022d1f6d16c73235b8be3e0d4f4b2ff3848834e7fc462b167c033efefd54bcf5eb945c61e932cd053411e7abe32ecdee27bef7e55550881536a52c62efa7a42b15230d69f5f0fc71433387ad22888e58b741dacde8690ac6c38b703249dc093005c67ec9cf45f112160446f1921aee5bbd9f020f5d384abb0c9d7b1b3f75ae8bc80792c4dd6b3cc63d5e2ab3e5fbcc646996bef5afe5f6db808c0bd8d2ef933c05c27e68404407cf8554274a8642b0cfbde581ce9a4d4460f9c4bd01bc84845ab2f83d4a84f0948c646eaf345080afa45f319033d995b2b9b1c50efc044f427a9ce0a492bed353f5b8575caf834a184c33b2b2833d3d03085383a79b64a60237ed0981a33d2606599cdab4250eb25b7f14fa4d8d142a4c18225354ab1488a1a780f31744a77314c0654c01015dc58c95a9db31ed71545d39a054a8da9fefaa76faf47308cbd506afff389430143c3796ebf5959f5ad2d6d3cb0c3d239480fbeaf6b38b9620ad992a4b128b773695d864569e17666a7ffb70dc2460f176931a83e4786e2181d5bd652404eee57a907135e45ff372053c5be2b1f8caa12b7bc2bbb5a728aef5b95a12c5d3da85afd3a6dc0253cb0e4b353e9e69b804dfaf03117904b690c13456384f0827ec8dcb79ae346b0faed299c22948847556652f34c372a70b758645f829ec88f0a65e1d44844592224c1ed240e72ba8aa2e3139d8c6109c4548d36452de9cd27473f1f5c0fa34f2f271a45cc51d0e55fe861ff00025310fbf51bb659db512c7172b6605c6555224afaa2e2e2c7bdfd78f6ff310e830ffecc72953feb91f71c54d55389f22de2d7c74b5411c61923a1dc0d01ad3b58009859d56ce9733042a670c9c7b7abb4a1c9fcfd4e5d3028d330a7c9c97d184afa7307e28ef701f0b5daa4c5230b541eb2abee50d2f49ea31b192300321a9cd10b0f59c7f95066fd53cb819c29ae77bbff6d1be10a586cbb3e2c16aefc3b4df0ecbd378951d17276afdd60445beb87a457d3d102e64fe19ed5160eb94debcb1dd77228cdfaf5e1b1ce9e0b971607ebbb7adf16ce244dec6c6f0fc5675226acf83f3b892eed6402fe57664563d758c7099e417fa091916bf05ee89b7917e137429d316f1aa6d7a79b3e99d7d8f176e6be6fc0df1e9973957edca7d85d7b5ca1af1218dcb127f8c65a06632557365b815aa6c87963a6e4f60737bfcdc8497f9bd3110bcca75c5383ddd2162ab8ae776834e24927cfdb897597b537c895175dde1f2e555866666de830bcb7ee3d5fff6d62c265ffcc539f3541ecf9da4c8f649b0f70b84392645b628197354d53ab21aaceb2dce5dd073b5ecf143a239edf18497d6295d2797c295c2c274c11a24d2b8c07c6881131a2e3b7de9fca19f6dfa31a0d84f8fc8eab200efc2ba07b2c1e01148f3649d96dcfb501f0e10b1756360984a0aa0b4c25ac983bbcbf96c2cc5407db59231f1cb6f07edac3cfd650df090add94f4eb186a3e7b2c27a18a4f5e693877df9c1c531776913c23a9a882f9a24f8e429c031109f86459706ffdf2bc3b37f875fc0ed62a414bdfea3f48b5cfdf7cefda75507042dfd7f7d6ee4bf50fe1f958f528f73e0bfe4252cdadc1c85e0bf5600fce93dde76571d7773fed981fa2b64a4b5d31142fffce7fffac7dffefef72ccd3bb44efb58fcefadf995ffe7eff97494eb3ffef67fff5f000000ffff8a71c36e1edc0200")
+  mewn.AddAsset(".", "../../runtime/assets/wails.js", "1f8b08000000000000ff94398b721b3792bf32c4dd71010b1e93be6ced79b888cb962c5b5959f25a729c2c8f9502679a24ec21c000a024de70fefdaa314fea91dc55a5a221fa8146bfbb3d586c75ea95d154b3e246da0844514e9ac3c853cb0ab5a030b53366c16fad8ef03b86bb8db1de4d90c4083c12854a2ccf93c198d7c0a428cb494da4912895794e4d43cb0defbe3de326cec560d49d953e5e0bcd7d9c0ae03ece44272a076e59e163839f6cbfbf9c7f83d4c7192c94864fd66cc0fa1dc278017abb062be7392483115f824f6cc94aee63dbe3c70ab2d515754606c2ef366016d1d56e3d37f97058fd8dbdb9f256e9e5b55c0e874fddf81097173732df42423e9a6c9b0329197f8a98fcf61bb81aad211b8c2a71fdc1f38351c643180ea9169e6ac6f87f0da1b1909ea805fd01a1c484ab8868dea48743fc2fee6eea88d09656d4c2a516a407aab779ce909d8f2db54f896e39c96021b7b927f7355ebd42978cbf0c02b9a0974ec99a2d8ca578b589948e34f371462d37bc7d2eb0a211710ab3329e2b9d05b9b861acf12f8b3ad20726459e20eebdf6758bd1718d6bd9cbe41160ebc1281770220907c601af33f74c5223d62ada58e30d6a3d5e497779ab1b6555518004c8632308e19efad889112be9b4cf917bf44b0711ea2cf564823600c66b49ceb4f284d387325f978cd5c62cca496d3824b29c9cc07cbb7c942a2f598b75a617e65124d743fa2aad56fa7166590fef9db5c63e8ab5e8619d4a2ff347b16e9be798e639a6a2329c5c6e407ff97cfe28ddbc640778a72a87471177cd05aab94055848a930bb87d94e67b4323c574d6e5cbb4b15bad7eb47fa2399a7c2ed3ef672789e71bb9cb8dcc12282707d9572de8add299b98d6fa5cadddcaa6c09af1f1ec5b7307726fd0e3e7680a1c0921a09ee3c582df358e91bf31da8665cc639e8a55ffd386ae30cc468027f6f0013383a62720a33aa59497fbababc88d1ddf4522d76d43256b66fdbe2db589152929b25e1450e3790279aafc139b98404ca1e728ef5644b4956f99bee815c0d52c1c9fa90ac86dc369ed5072e6a2054eed407ddd6a045e5439a95a8fe0dbf39a865cbc636b50931850c841f0eeb8ffd9e7a31625cc36df4c99ab572403bcb5b6e2aabaa49660a25f411794e8e369495b72b9503bd99aa5995287f1c31c493c281bf566b305bdfe3c30a43434450722cf33cf2262247fa88445ead218bccd6c7d167f87d0bce47672749448e146325e39e4df00a51209ed9fa0f52673924925bc07493186ec199fc06125b4ebcdda19dd0ed082f30612abdbc906b4834cfa497c93d3b632e52ac4ca54f57a8cbd46867728883aed131cabe23ac0ed2dd9292ca3b637284e71dde9cea166b45c95b6b6e1dd8b88dd9be09774fe35671ab59b911b59fa776b7f1a69fca51e15aa0e5be28edfff3e51b6be58e8edbf27040182fc17f963a33eb9fb138a13f4e47b3c7b2ffabd1e86fe357af5efef587bffd307af56afceca3f4abd8065acaca9064d76d1588340dfe357850a90754474a3b2f758a350f18f32b6b6e2314f87ab781d621b4363e42b345324a73e95c245d24a386216125f52be5b866dc0bbfdf3f1f73fc1d1fd709a65791da47402c379b7c57954ccdf8f3f140a0db8f8410d43f17635696fc1d860a3ee74e4c493067e257b086245d49bd848cf0faf43bece646da2cc9e5ce6c3de925bfcb26c0de4df54ce0fff6fbe9acae44f8d475d0cf0421f166eb565897823477b1d219dc5d628c0f8757b4beeb16fdf1d04faebaca7ecf87a7b3d8e52a6d5e2bed72bb06ed1dab8ee918ab8128742f0aa09ca494c00d684fb8afb2c6b7839c7181d76134d5bac4579cd6404a109f1c69c607a32e762accc1b80cec4e4566d22048bc92eed4a45b4719ff244ef9078106e9ae7a83c49f84e6690ed23689e303e31f9e4a249f06429c0e87f4547ce257f4f475a3b5e0ebc902ef22c9e1e13cdf5a12f2c9a8a7d4b794157584c82c7b87fa3857ce83064b49c5e7a006bea18311c3eafa2451b8e73ecd186982564ec2dbf9fbeacf473118777af8dc865f7197dc189545a3816822dfa51640fff2faf06772f0f31c169eef9e20fdf590f4d743d26bb3293bb57ca1ac08b2a1c8bae1a3b406fb55657ec5e1e0ec03a8e5ca4f4e86c393f816e14260f77912af02400801fb3d3d114500269a57e70994bcf3f8c02fb1e0d4ff00e1983f7a76fab9cd749f299bbc8feff086f86e387c1fefc2e76ebfbfcf6a6d6e90517cc775bc63a8f28edf57ca8a569e87cfabe57bf8c692bf179fe91f98bf91bfef001ff77bfa1107bc9aca5645ee8d566b89af3bb5720df40beb3b96037fa63dd81b99d39ff9cbbff69df65fbd14b7a2e46bc5f50a43c5d705a3c5fd9d3e82fabe41ad02ff9f624ae65bef8d269ca42b48bfcfcd1d7e9a1cfb0db2086588ac549601a2a8b55ce281959932e1afae7e83034f3871dbf95a7932e3ff3870ef9fea566fa0f7fb818ebd0c75b91ec20663ec2074acdcb1d11eb47f97292fe7ed9036184deaa9a6218cbd3937b7608fa5031afa0fe2e1ce4b0b92a0c7758408527ab3f5d57970242fa8c66af8c67babe65b0f9460c74ad87e1fd810768f7fc5edf95808f1cf36677b56d6b78c3bf3fc8aeffcc77038f889e22bed123cc3516c6343be3da9462eda33d22f8142e8b2cd980f1d2b45b5dcf935e82de1bfb24eaddfb1f6d48fe256605144650c6a470a49b02eba75b1fd8a47917211965ca5955732570e32d20c1bd3593bfa618975dbb94bad9ac3418d355521d338a3072ce80deac00a2fa071e620431c22c5c5efd6ca3791ea763a4d9c371692ed26931eb2f96e61f1a53a4bb0a9ba57e93cc365c9c2d877325df52a826685a61ee3a716a6e2f6d8504c3d9bd8d8810faddf63025eea3f120f879946ba430144107623ad0bf38717facf64658cc370d8682f0cb82be53abf386e83971e37db09e99c5aeafdbecfaf9b6fc638df34f5bf3fe7d44edfc2a6309b346416d70f9efd9fa677cf2dc3d50baebd3caec6ea008874c9eafe03dfc3db8b5859ebb8569c280eb5de1492a0f1fdbe8376f8c12bcf447101b76fab863e7968d8e92c4e8d4ea5a73a769b1c7d2c262c7cb62dd021678c12a875f4e3b81d12ad184decdf1bc0f3f1c41e8971155fd5ba0fe90617d4b03ac3845eb60e2dd208613869224c4637325759f44dde488ca38d8f5406daab85021b13c6267e6a6638834dcd4c1438f1083c09b959098837665325b8c105557f78abfaffddaa665d84347a1c7599c5d7875eb41d66f081ceb64d9ac001d3e30454fff671d7b81d0421088dbb9fe523d0b64ee186a9a4ace44d6bffd0da132d32484d065f3e9f1d9bf5c668d068780b9b5ca6405ffcb73b7ab1e484b0ee683a7afe4a3e5fcc8a972582fee3df8741097657c061e4d60d6d5b28c899ae548958d1463a07190eafcd6a23895afd435caf043889a38ff5762022ac816b36a932714e7d356e577e5377e05640dc70551937e2a671b7660217a4514af497f656cbc95f422eb7b0c446241b0c06a4b9e970a055fd5b71eeed77dc263e98b019cf20070f114ac16b0eaf4d5c8dddb43e60099e84f99b428cc3052bf985f16ab14b1e4c8338ff74e9ca8749a91e5218af022f1cd589ab1f79788e7228dc3a61e4321c4e54df74d0ccf1c08a053d341b0a1639d01e2da7837871142a52847351247017c14ad3ce9354b1e1d037f9c3f2312b5104e1cb92bfc9b2ab10c8f75e8892fa6eeca916c9ef72c060a1a48a42344c8c955c68de62ce4db6c3ec093a3b5ea93c43ef001c07b1469d69d4f7f1d5d5c33878fa2ebfcb81b009c4ee618fc34387f322758e300e71c0bd5a01f8d7fd1f71eadc7590338103d9eedd89381726c39ac778075c81ccf6fbf6e7127c2d9c7bbbbbae3a384a1089b0e968c60e6ea85ead7c728daa3efb74dcb441071a905d0372a21cf68a756b153ac83bff11f436f9855fed9c87359a355995fc5c14e76699585eef5912c38317b8a4b8d43df6d85285a99e95fc527fdce65e6d72482e39b62fc915ff00d2fa39487f40d3ed3f71bc9b7c438769e1ac08f36ddbd65bc63da6392bfacd7ed728b00247fe92615ee56fd2efdadce6902da17763d54fe335bdb5caa25da7c88e28da6afcd6d1aa11bc974034277fc164888c28ee44ae42cfa378355a2445335b24ffe2cdec90fc5ef2df92b3dec6e03a74419495c707db5c7ede0e35468794d1a924a88c8705639f243e37cbb8ae6dcf9e3d7b161dcbed72e5a32f7a1532535615be086184f1a748bb047ca49fc6faf2f91c778df034c6b9d2105d18c4f24f631d9b7cbbd6359e7d1a2fa8007999aeffdc3a58288b0be3e1b0550e2bfe2c7afc3a0fd1732fc8ebcd33f1760b754fafff3c2be9d8d9549095f71b97bc78116c8751f9020b9e772fbeb917b58ccf73e521fee6fecd79693d2e2a21137819cfaa38fcbac2dd65c07d937a75036221738793698c8da520d828aa340cbf2fba068574091113c3414a78cc80f86f3598e6313d4216d5d2e1a690f1b794f1af9475fb05fc8707c8082b676cf2bf030059415189fb1d0000")
+  mewn.AddAsset(".", "../../runtime/assets/wails.css", "1f8b08000000000000ff04c04512ee088220e67d9ee2795533adce1253567478a45fcc4c3b31336bc277f707fed79fbffefc71e7b1fc93effb9f6a9bc73fcd712cfb3f2058b7fbf1efba3d9a33fb773e8f609a9543baf72948e77459a42454a0300ae31542a6388165255296180de515f6e7af3f7ff4747aff1c4d3af5fbfff3d77f817ffd05fed79fe63896fd1f10ace6e9d8ff5dcf733d94ffcee711dc97326fc772029d399b8ff9ffddcba1cc8f769efe5da5633bbcffe3ccd97cccffa010f4df1804fd370e41ff4d42d09fe63896fd1f10bceffbdfd53c1dfb7ab6db560effcee7113ce679d8c1bbccaa793afeaecba9dcd263defefc17f8d7ffa9e6e9f8bb4af3f2cffffdebcf9f6a9e8ebfab746c87f79f3fff72e66c3ee67ffde7af3f7faa793afede8f7728fff933cddb980efff9ebcf9f6a9e8ebfefb2ad9be39f3f2804fde7af3f7ff62dffe7dc86ff55a447fa4fba2c439ba7473b4f60354fc7dff75c55ffc99b74dbcbe37fcea3fa9bfa4f96ee2581fd7701d1a253332cc3300c23ba37c3ea0cc330cc51dd0c63330cc3300cc3300cc3300cc3300cc3300cc3300cc3b0a2333806c330ecca300cc3de0cc33079e2463eca17bce3c70cc3483cc330cc60330c63c691d804b103b3967f330c33dc0cc3141bc330363ecd91f53c920f073f866194976118c6b31986515fd435570ca23ded931986d928866118ff661846fcd5c6fa3631023739c3303f98611846b418866189dae4da9aed8ac9ae1986d1318661185e661886fdf1deacde48722708c4300cd4330cc3ee36c33063e01d3f604a10e1cb1986e18e9a611846661886f9310cc3b0ec9449c3c8308c89310ce3093bc3c82f156c3c938acd108736c3083bc5300ca3df0cc370b1816037998acd10db0c13a20cc3304ccd300ca330d4c166f09c450ec6306cd6330cc34c33c330e63a8543e821cf15233cc30898ce300cfbda0cc3147576738899850296330c13d135c3304ccd300c2333b2cca4c51987f0c0306c05300cc3e410c33096687aaf0b44ec95a336c38892cc300c4bd50cc39c60126a21904bca90330c139337c330dcca300c3b57b52515309a84cac8306cbf320cc33031c3308c980b7404d50cc3303cc3300cf37148fbdd0cc3301c4fb1fcc9300cc31816261b388634a885f8f32f665906ab2cb034610e50dbe0b72ca9fa23b0214d7f67aa6298b16a8532d53eeaac5c6d551183b1aeb655200ff54ec9b155e9292a82b01d1fbeb8e496098cd42b7f528450a967af04f0fbd807d472cb483a708310c00eb524e840d34dd1bbced9726b89c0622a53a779f99310afbf5e48d91e6c14874864d90e44121f1f545aa5091d893f30368113580bca2ceff9cd308cd4a5685290b9e20d429e56d957468a2b29d9a29e58a86a8ead428c0b63bf546c90930fef7038e49f1c99b4e714ed32a4c6f21c261a7f4d6d43feac41ab6a28b68afcfa493ce6b867a096818444d30275f939f522747eef62e3ac83d0b690e0f5658a22336903a2916599205a9d206d99259550d4e4c16a2a9cfba2f848654665d91c206c5bfddb55c70814284accc9e09200181966f0b06da2e64cb7e6400603d40199d4e17228be3551151282b3ed0f9ac4fca6d0305980c32b789adf03953aff766e5bec6d4c91e63d02394a911fa076d693801ab68329a059da673f588f6b17e622c618149b31c2cbaf955c91473ad0c1fb462384ce70b87085b6c34fac74b3d7208d1464a67ca2665ec72d9df902d12156b2290b46a229f5b57046a3ac07a9701c30d08b688bd0f24161717b6874b94ac4674f99a36df1575b284bc7025dcb66cdb81f738d2c42a95fed6a63b16f2c3c760e4622bff6dc4ff81797b059fb4ac402f4bd586c90fe00bf5d5263e2abb45ac73b50b139f47dac5a5c50724efe9a1104148e3ad132adbbc4283ec92db10ec70dbb9bb6878339e816244ad43eec250b8ca1ee946089af028c7f4aa403f7f1658af9d9f11033d8cc56062ba65771e7af00afd880fda431887f41cc0698a8de6208379d8ad621c86494d0ddd2b14962313046da33c7cae8a4cdc13323c3b5805e3bdb02ac95fe94ab4ad9e45acdfcc090dc78a8c9a99268284e27a584b7f738cf5fd9f5d5d77d31d3b4e383103fd79b116d4fa7345a7947daf91d0d52d38d4af577882470269913e95227c45b48d784e710590860a5690ed6dcd3df39a9b79db4642e1e75e1a6cca2bf68ca8b242ebbd0e0d6e8c0b3d348c5e5764869f0d9dc399e649a7b8143126ea1d45fea572d5a75922648919334b7c5d2ffd45df230a3a87990f3df75f84447128153c2c24c7dfcee08d3bd50c9a05d24fb844f1441a8e236d79e375629d28ff414ce14ad45a9f336cfd7aa31e5f88e913ea6ca6e29b1b97061b4b016ed02f93df50efd1c40cf95c445ce8de106c6127ba6a3d836620aa359a91faa6e6cc456e97a0b58e0ed796cee99adc7a9a947ae3e87a6be535fa04a9050b1a18ff4347482a85e467b413b482d752ee39cec63e246ac39289093dd147f818961e30f0638af47c3623b72039064a720dc58b49861887ee3993ec04812a3bf84cde36b61b4aa17fff0eb21a81bcdfd9c38864a38b488d521a372446bfaa25d11ebfda058cff4f06d1fa6fd294ae769eaecf5971d189dc2b7993f27f3f678b53f321a182b1f447c28f422f2c257384b9a98917b010da056d303387e703ca50dc0740029167b591c0b828c60bbe84ef90c9aa6213cf141dc584b1a0e46e02e0809486efc4e66652e9652c9caa005f3f3992f04c97a027503a86d00673c223ae54e7704260c8e84ae217d2dfcd3805ff5104075c0840462617521ed3861710f6469297365b5868f0a2f9309934d759305e994e46221ef818c281ac9574a8015d30234227d1e1543a29258510a075b2569ddd5e4482ab4c908ee64841c2a247594208e6a7ce79486745c10ef2022f03698766ff7a1654dfde0330273292080f7be72f2cb2d9a265387864d7a9f5069d83dec708017fba0379ff217304e0ccd097f1b29703af6322235b4448a6c81e7191b492214a511fb88cf08469adc9888e23d40e615f31313fad88a2f39c0531f2106ea1f04522f6fa772550724a2b0a20b9dbaec341d7aea6984a335183cb35c35c9132fa6b436aafdaba0be2cd1d4be7aa9ab45842239f492b0bb2150cdda6aa9fb4984aa5548cc6b973c05147e4b3086d248d091217b9592cad916f2d8594ee1c68c7ca16d346561e4909a698bc18ed6b30a6eea5f23ea653e1e8cc496b150b78ff76c80bb444e38588355441a078d4133759e4c428f85dd83bf8ea6880789ce759a28a6a41320db4ee5264f1c4b73b7d2d38aaeb7982800833c018c86f2b349a55234da8b8e0556141b0d1636ebba8fbef6aaf687f06f237ebcfce5b10def00df3a9aa89f20cdd5fd183e8d650d5ced660cdadbbd63e27717a7b6057f1a4de9d12b29eeebdaa30eca84a2c0fbbcb020f41be4567bdb96ad6f219f7c01e7f77d7e5a7a794587b3b9d78dc1d19fa0698efa7e427c42b07a09b514e88274859f4d7f9d37830115d9d917ff21f197970d0c39be3d4ebde17d9baacddf7b421e566f7478e25e871a747f7342e2ebb2b27512effd2df86fb1fc15f4951d0f9bd8859d9c7e9a1196b563d0b4ec045bde53a2674b8ebb4f960d96b961d2ad730368088cab18c6d25ea7243fa71e2456b15d60cc35b232d62e64ea7b801815812a4cb872fa5cbd7dcce2798457b64e9c7c9dd1e563f427c71f67e849778aecb78adaf7d393ae11f5e7e398a4d96ab7c05f56e9b6a07fe0558d5f38a170be4763a2276aac873e31c69d3a279c4ecfeb573c6c3981e74aea4d7d5b04aa91f3221d47fdb8493e6c34230dae0c77a7845ac236a2b71ff0c16b88bd975679a108cb776f96d84b33007dbb38609d352eadfaa9014fa3035bdc690a388d865715b56eb204b7736d79d8db7db8d26dc808227accfd5993e99a391950dacf92d014c88f69cad20eaa02f39a061acd5362a367855c4f0411a737492b25b39c1956a1337a613879d16e10d11b5fe8e58d28d64cac10bb590cd942e783e65fec784245585ecacac7ddafbf5b09f8f6891dbe855ae0d59bafe9385c5704853794c55538b617b76995a5d480bdc3ac9d7236a856e7ce48a37f59b0733945e439499324fd91b1cd27d30ae9f428e2e2c6b8685f1cf070398f0d9164991cc3bd2293c863ca6fe8db64f375d84a631b9960ae53fdefbb194844832682dc29602f2056d9b500a6093576cf9fec70dded71defbc60db8dd303b0ad556767cd10c5f8d62f02c9344cc472ce3958b7dee8b3eab515c8d3824abaad90715682947a7500d35c647544dd1c49822127433efb24c1d1cc7b022a2e0e46a0ad858660bdaad3edf91e779be896329254d72812c37094f4e7623c9e8f0c0594d8811cc43ddcf5a13fc6c326bd31d541b8eb241cac3dc5ccce15dda75ea5cd724a8bd53b6686df244f8e8db3202f38d6236d4e082a84b63841de390f3e3901d62512c9e70d36f9d9939424cb44fed758fbf7599b959eea9316bae6e958d39c45eebab3bc18b3c652604c3a28ec19a4f243dd06f7bd63bcb60f3f60937f6640646e6f89bc943e64e19a94b6384ad63316562b6179d3ab64b46ebf558c335e69e6acd2eeb5bb51fbbe8754fbaf5c37e4031d793f5a9cd3d811c4cd06cdf61dd5e306d7f603d5fa86e7f6ea25134e6455b279ada4110e03c5b5d02848d6fb6e6831ca86595a96797e1829052028a92031d946fab0309909ac01b0435f0bb000c6c4860979407cbab89abef0a93ca87dc2b8d7bee7896349bdac90600e87883c5c22d1486158d9611cb4791754c289a3565ea58148618296adcd446ac8c3a98667cbe891dc1d1235e71dd6111831e2fed4050423f08bc00e67ce8c81cb8b0508ab35fa3e361fdcbef4353913d0bbf8364a17c4a9e2232d0dcf25efa92608a02658e99cd9f0d69dcddeaed4c285d7c5a2dffb4a948cfc431bdfb87bb4f7c4519be3fe90caf747922240b60447c206472a2e4d091384e03cfa8fab4f992837faafb83870b6940dc98cdb1cbcaa9fe23b52d0fe73438f1c3a57a69bfb48d7c73f8d06fac3aa38ca6172833d0fc91b84da54caf9981fc12d5e544c3602643742170ecce030d3ccc5ef781ca1c29e326a5daf1051de6199d3154425d6d8661189b603386491936674499496c7664c49c496d7665c49dc918f663549e296ff663a49c29191667a499296f9662248ca9995fcfa83e93db6cc5083e53d82ccf3a3cc3485d1a0522e90d07cb1b90c1dd26fb18756d16b3bfe3a3fd4c67c3da130fc7709b70a780dbcd62a0aad3a23c2b413fd661d0747bc67a4e1d79bce346801acaeeb086ef8cad5565c8fcce2fa78844872eb54f1794082fcb07f2bed7b582dd2104f471f9c8748626bc8dc010fd9075291c9f91d6171800318d5d2e67e08e95317d3a7b7b4bf421b20e566801490764561100ef8afaf6f8e03c8f366ce257f60d6ba659ee9f1cf94cc6b9ef16306b0116cab7aebaf172caa745242eb8e5108e6cdaae850e94bd9cfe48f8eb398c8f1c07ab6c00fc93020ae57d17e7b465e39151695fb0b5bd3b8e944208bc23ff3064178a7db3e658d6d72fe6254437c2d2be4baea646c15051459f77ce919078bdf81f2b7a1233e378f129e0ea30805fbbcff6286e7775cde68bcf2245b7ca201e6981967218594815f7d524922ea0d73368e6d5c9cd31c77c2893713b323fdff939438437cd665dee2de282467ed0cfafcde8e950a0f869de89f036359001cc7542f8931600a1bff7c55632d826b385143423967535e4e5ab8ef2db4889c1b1f247196c4c89d9075e803b4b84f57b4f43ac4d96370803ddc0798bd6563d29e1235e8536c13d9819866118c6665810a4180eedd22f82a48af502fae272b5385db47cf6d74db3f3186d9e55df9ddf5d7b08783f5e65c1619cfe47d06caf7a4abdfeea19316bd6d6bef10e594da819c7d67ef34ac7d1c5028737f1546da2df847e2341000689822001921faa7c2736ea40999700b9e0f06be2e4f2491c9d9f33ef993f23da58f9847ec32e6806c0a9b348248619c7a2e442584089ba62dd58c6153d3ce5326fdda0626da3e081098c93261c59ea6d0c2b81155f87e63e6a66f57080d96f225c6d91d8f1ab37eba7ecd83630416604cd8fa2027ef7435f6bbcf5748f67a28360ee3f215d157a9ce136ff6d4194f83f3ff626605f70d8d564eef41f4b46b2fc4a073bf55017e7bc05fb14ab8b842b38d16c41fb1031f3520ecc5860ba95865364c28190c280f309e2be7acf58ea1ca9262c65ef22956f1b57e39f61e2edddb715791e6685f5f85c320f47d664422d5c2cb2d8ec5865d97778f0b23d8ef679a86e602239c47ac49b84f1aaf5769b6d0b5bc82828d9e354d2ed68a6a0f11abd4d36babdd27cdf1f9c23cc853507aaaca960abe2cd0fba3936bc8b7513c7a7a41d0697f0967d7a2a84f3c65318bcb8e90ee7ae38adeb546d14eba475abd0b86e9d1a6b4186b56d97307940aa8bb2e2dffc9bd50181f39a69a2a299a9c10da550f13d3b52db941ceba73a8d3e22478fba4f84d7e807717eaeeb6ab45da58ead563e00016186c2c743f69aa6c308007090a23dee75c51b2a2e10c380f0722be07dfc966fadfbb33fe160386d5ea189e33d17b311801bc2a9172ca7df04d6a474cd2425e40bad82f5348e0f407812b998ec059b81585d86663df9a97be60f6befc84fd5452cfaa4f15140a1dfd6c9c31b9eda1cdcea08ea98ea15d108fce155ac556d05aa98ba67ad05115b32fbb4861c1a30c505da551988d35254b00039591447a6bd47a0743288e6404c929b6d59cb4bcdc21ce205a18e793ced97ba9b6e8014d74b1267c176b337e9d64a2a602379c9ff421e1a34c255ed29c46cde7d85cd86042c3b76eda7ea84437c45d09c4f0e1efb316226d1d6a24c2f6126c3fe2a46ae942c881be4b0bd453c3370d7231fb9fe3b98479cc41ba73055ed0962c6ea71adaed6c0552e15af94b56c1db30821cff3a56981fad05e3aea186c10c9940f30dbb887d1033396add9bb0950790e85cdd55a001c1517525f68fb65fdd71657a5ed4015a33fb2ef030cfbe418ad0837ee54383e0064082294ec4e9aa4fdc969e7eb3b1ee4a33663a5c663044f20f4a2f6f04119aed1f526f5093d4cf8a1aeed20e1d344331d9c814ebc294a57abf65ba64e7a5a1af4a28ca2e8e40264ac750e108b8e658fdcd8055c2afa65513b5ed616fa903f5f02b1317220f0743c85fd235075585f01bfb55008b44394dea3db4d2718b741d659423a94c5ad0c22bde373405a75e40a6480341142a9a4652d2669337d1e2b732bb73fc5853c5fa832615fcdabec717089053170fc81f534aef38f9d7c72bae81ab5433a491fbc7a92bb9aac75707929bd5fd76ff69ca7efa9858f2802cbaa6e02fb0fca918f98726bf27ccf2b755142e922b442aa2f4827bf941b03a9dd4601f1d3d6a539a44edb1ee090da23c8f7cafa9ec483424bc337c885821d4bf2223ff7f6e4616912d411d4b70346604a938a022c1909dbc580bad3e2086dc2334e06ea4972972d6c921621650b26a87e5f19fd54b73ea045644c3b1ee523b479bf09dc3de8858e7585d3b70f27bc9df724913a3eb72a59a7685d7faf3389d98ba4b6cfef71b798d5802c0cedaed0627a23c4668f1b6daacd74be8cefa35e25a43d2e401ce955b204f41af87aaccc5163c8ab0b29a6fd96d7871b8d3a85eca418cf9a6b3d9d0860232c3a819dce711860541c6886f3df89f4d2714d5b57131afa010d51015776e9afb16528419d5f2606d7a7cedd1e4591769cb2c42d8bb8c43890c5cef4a51a00637e35a2da4175434ae14b0f4a202811eb455e4f511eda6071a0c2f613a559088a74a724f0e8525a615c36e0712d476b9808cc64e3a52952cb2f809aa972cadc8395a089a4648db3b8ecc93620d40ea9c9e73a53f262a2ca48f888c884631c9ae63159e65814b60d7d89ba8c9bbf42127ef9b0ed81b365ebdc4b2542e1f2db61e884bad84539dda7899eb030e098484e666adab679a798501f18735f05bce63b1d9fbdc3544dad1502b4d7955a26c4a11742f8c829dfa00380570d765f1e11195adcb64f25ceda543b8954b076f90cfda3facb05b3dfb0d9f68c80f1d70a5c5183ca28152b74355e81d4dff6b238aee357b7e8a46b72af352190556c7785be6d574848c4a0e87772d3af83d98b6eb1dc79e7c4612885fff98a3c731c79f0f176f1de1892fd990fb37e6a1160f85de382cda1010d1a5f38a1dac73be651c6177c13b401d87097be65ed7cb01261ec4abaf13ece73bf05641a5b4a66e876ed5ed63ac0869485a51228460817318d453aad155da9e730af48e21a4083e577530411c54156f08e1820fa2d4b4d1c56f89ccb5a783f8e2642737bca0fe795addd8ffd7984c345fced4a3dab5eaffd04ee87b9bee38bf5c8369ea6c6beb58ec974b8b27823b1568d3972af74f249cd82e51f43c7afaf7858b23100ea819976f90d2674e31c7984367da932193f5541c604e79c8c84c8547b5da57ce27dbc1f53b7e3b69ea14fbecba4970dff17a5c65890a68d85e34b774b324a44dbd3a306cf200da038601507101e9849525131dea64385e8ec6c20effb3a23409a8cc9114914aa9e7479964f13c8f99d7372155a5788f7162783943fcbc190677d2b84099c4ff20fd9087e19241892e2f0341902cbd661e39144c0712257aac029b668f81c08203bc4d52d623feabe0c55667e573273f7e87812299f66dde537727c38d1d37d27340ec05798a9efb1224033af29c08f4b62fd9c02c33e55c7fb89771d890f8232eafa0b6a98a142854b9da91e1af3c095bdc0dc1f37750de88d148405d77b3a08a527f5667504760380d0d320b58b2884c6e07e06ce7ea2ce561c38d8cc2de3b5c5615a2940afe0c1959000f5781ac121805b77024daa99ab0580a32d7fc7350d0da4695b515d1f9a6ad158bbd8cfccb753c00a8c6ef3f35c3bd42b188c3bac7cac451cce16511375d662a153d94f49a9504f2c9503b3100b6ac2392227b1b2bbb7c72561839bcbd19363af9b94600abecdb5c1eb231d0f67bc9b43934afe12086b23af39efd099d711b6eb1aaf994460bcfaa7a8fe36ba9a6e55be8aba5b7d36d4a1d48afe1315d71e4503ee948dc009c3bf7004749e4b73c67eea8f6fe08e15cf77b8d037ee46e8dc2bfb0c3c617f113953d1820ff5400097a609b231961209d0266f0194b752fb698e00f0a570b5e98f01ed656502e161dcf52cac4f1f4259b45fa249bc75862027773a06f17b62913afb860c85e1298b1b785f3b15efd9df472a82cebc3720e46f06377e78cf01b508b1f6b19689cec8c72f0dc453d117955b6bb5c195e0239959f9854dcc3e5cf5a227ac7937feb85eecf897957dc01b5ba47aa67a7814170442dbdaaea3a4ee1475856e9a1f216891e25dd3537f46da446f14642eb04c00694523aa1783a5594df5cb00a594ea21eec0716e711b64976cf4025c51de022842ecaf28ab1f7e74122b35fcfe5247c03877502381607552ec9640e2ba22ef8a41ecd408ef0935d5330fa77831ddea7d2df7b2cb894ca8f691290c8fbf1a8ca3ba7728f34ead494f5427171a39014c078e060e971bf4fe5436cb9a093f132ef571e473bdaf71152e66b5f49834d6c9093b2736a946d2c390d66b90ae76d7d7385d787635b9267927ee7b524e982ad0116eed19ce98f4e4240980f866a25da6c0d999b7dfd79880f62f13cab8f1329fb06f384414b9f00ed4564015dd7fc255a461472c79bb467e121e3aebae45ecbfb3db78ba1cfea4a2bb1acb880daee5b6792cb3737cb723cc8a3a8fa68abb7ec749e602c80b50306ee44329e3d57d20c8e013781b73e721141e1cf5d079d4eb474691630249c2d711b9332ad49aa99c7d3d501f7e9627be33e743371b2c853e1440e58eb81884a7f768736134cf2b95dc7d0b1312b4eb5790d607f6d98cf9838e30f886df5442c26a4261b270c9e9a4377b8c792ddd3ca4aacd9d44c2c8c04fe8b762ff1089712214bd1234f4191e874230bd7754413a9fe0d25768497d8f9ca36465cfcf897aef527b934f481fbcf07339e10aa753a00a28feea4620a000044479042dcde452410efcf96c91598ca566af53fbd36f95102a8e6d46e2ea24e6b94652c7ea1eab4391ca1f41942d0606e78c5397f271504d9bc90f8b317e4c8eb67b831b09282778d4fa77899c0890fe1bf4c5bbc3f038f3f3896e33bcf8b3fab23d477658d5ff4a01f253f1453f4a6384786e6ae0b52aa55b0b23d9a5e1cb57044a615975836f375d7c1f670fd3fce027ea1cffad435238ee4b102450c979b1c02fe2cb8538e3851581793dae2f665d0206c4f4a6c19fde6ddf98a3db84c28a490a270dc3a875913d3486e49bcbb8f684c8baac144ddb5290985bd8f8e53855378681b250ac8943965768c57e9835f54d65788ee600d3dd0fb6ab60c3524c750351fbbd4e6b34199b4053de7f011f14a97ca9fc0f1d5bc779489b9b3d44c0ccb5cfead67b18e3d3859257f4847b9dfe4ee37c520f538a644c9b08500c9b4827144b10f4c23cbdd8735aec100509044eb5d8ccc490d541fe8a529f3e1ec8290bdd44ffddd7686cf0d8b4d42ad79a12a56396fd1ed26b8ea64b8e836fdf3604ef9509a70100a9ca08fdf70349f82b9917fdec170a5ab16c86d13d37387f552b65c679c011d96a55336ed630935c819837c5643cc31315750fb169ca44ed8cbf26472388be7e142ad15f60640f535946a420e4209b095bcf42d4e6126708823e94835b2459fa13b15c1c6b7115d8efaaefec5644c389b7be7907376287d9d1fdf17c7e1ccface6d0263118694ef71c60d711018939433d16b34b3356cc7978bb2ec1c9b47d888e5947fe58a40b408d045c817bcb0747eae940a39ea16fa35fc11fb02b96d8909ffedb14dfa72dce9f917010f11f54401cf860f679f89486da5d4895a6525e523746d6b41bb442de6331669670eee0497cbc67724f0eb61d14a9cd87169fcbeeee6ab821d7876914818d47f01125402a65c0bdb6bc5c8afb66d0b8e945cce4ec6dbb30b6728d6c6839bdd14f76b45e3d37aa51bc3a5512dbe079930847bbacf886b7757d9bbc9c669d7cf458a44d93de9d0a2262175765753128bed93289565be6fc5c4dfe2428021cada2839fc2688a1ea9de2926fce44592301345146ee3892e8922c08139ea3e7a372fcb93ce331a5172811f92bae8664a9313584fa22c41183382e1578d69031912d46fa7a09e22593a3f6a8f13bfd65707ea29abfcb96d5b0bf314b847c98cb57c69b6bab606f9258e674c525bbb2772a6781c7d5730d213349db486f7ca729aead94ba5ba42874c167e6ad8a712055ad533dc0e6a6e4c848f0bfda2800369a6575192b9de9a478d748c14615082df599c30433a44dd2455df6c8ad0200cf7bed435819bbbd844dff0f90d71e88a49373ecf3ee6485f779748f6920503268ed88b2485ea8ef5c60a983b95bcebb9bb8482e5353d817cd10938016ae2ca78753fe0236d40f6af6a7cb7685cd0943b11a7a7576f239c5d5120506abc731022e0399b0fd98da0524ebef6208b16691ac921ef00fd71c2859e3a3d9444faee48c168d2e80f577f5135c19f88164d508a03e45a9e707d9f56c94c18edeec4995eb1179953e11bd52b55bf4028f780565472c2b16e88b806b472b6a206f1a8a84badca2b9b20d657a3b6bf966d7489119ea463b8cbd17f2fffd60aca64e1ccf872c69e60ac58b9b531f84e54df995fe5af2100ccb2a8a15827a853cf70bfb97df66427829f09c79efa5b77746072ec993c58f46c4971adc43690ce13ec0708ea45f31c3c4754bbc74cb5ee754787657b48025cb0d3d49e02cc72b67e53e0ac03d269766dd1fd1995deef3eebb4f5730de103d5b5a023411539e3d5c4d8c60680d235cb7359f4f0e147a522407959e099141d905ce6c89cbd90d92b9e1ce0be04d1e1f9034e159766a2961be11b88616523050782e7897b57faa82ad92f1230feac180949b819ed9973923bccbcf5d2699bcbc1ec347379cde7f72fc06c5bb1a0fdc6804c8fd59a65e65f247e75c88af77b7126cbdbd55c2b8b8dc63f415dfd7723cc7e727554606dba3a88e8a68eb3e00ef5856491af5a33225dfd24b98ee307751acc7df3c017f4f2eb025b35818835b8106b0bcb88f2ccb2c47a3f8b0fc05b935542bae9fea2f4bc73b3b22e32185426c5c88d72deddb9b5093fb679c5f938bc21749be2b7f174a75570f946dd150ac2a35cdbda9e55b5abbf16ebc35e69cbe830adb78e672b4824e84b4e48c341a76add3383c6973ef40819070549531a2c3477207d0f814aefe89d3c57309f7f678d8f935390e3d2acbd5164ec96922d3ee429775d0789d37f718fd7a427c0834e766a2ceecf053768b3f4dd67918780673ea84b577760bdc24fea6876d24c186421ecf2445d2d959437fe2580d2680c74d91f6de7a3217575350acb9781d854f02ca0b15d4c6834925927f360f8cf7b5d86c61393399e424f77369f63f85768bacc773db019f6ae374221c10fdd5725e3ccddfe3adc09e877f693ce8e28038669842716c4000b76982d976f6569da80775a952441d7e7ba48d831b0c2704c38f6be64c0eaa9ab746453041506bf92627ba610a40c6beaa3e49c68fd0b924ba29462b3ce078cc0a1333efab098d30b3398cca8b543c62a9bf86f3f37a623dabe1308b3c547a83817943716d3b20a0b218841e35ed51693a6e67f44d8fbda419c1288cbe25cf952c1f8b42f932c9e19d05bcf82ae35eaed6b4bff2d406a2fbc3b51bfcb8a721de449e407392a5546324c57075d14b13145007525acef55b4427967b9f1f6f25804c2826de85156a5b4e21e9c2b88714b8c8184d616a4fa356fb8681d17d77c9c840ed0314a42c4497f8d4c62c9ec7a3544603e780a3636880355845e488a6ac54281b831afa2285960b77fed55f9c502ab31dbe58e5de58aed0f4c16b1918e97bece3af25bbfc2c9aada9dd0896480126a390dfd6b1df7cb819fa42233103360e97e0409361f2bb5a6a058b52b8d855527e284b8b7d0d6d10f5793a61eba46aaae89d22b16d68e8d86aaa6f90570502df4a7c4f614d6c9c223e1a31a43fb73389e45d456d8637ebae73664c71390dda1be153cdb5ea556b48df128495201b27ad6e84be97ed168cb3da01464756f40a345a9b7b0fa0a1cd87897e671288fe0930c5060282f5f9d268237156b0ed972588e75ffd9b5fcfea6744ccf64de79db4934268a611b2662c6c639bb2571aa8b3891dccfb45e940f6d13a03aa18c22a5dbdca1a0fe08d5986eafccdab208f1a78e07e12157144e019861bccbd009d1b7353af4b2b40b5c3a79916330f05a95ba427d9bcf746d43ceebde90f5e1235f9e3ae3d41672e5f58a83b6bdc305f22323f10f27aa6e030127b2a2d66f58b25827f4a616323ab412ed41b1ef9c2c405b515044bed0132544a62b25df176c4027f1425df0b65f4ef4f5a740f597df5a5123595ebf5888f57e210ef65e2c4b3930ec6aa06aa143e219247eabf643a58ec2ca9f11a1c19413837694b961125dd17ebb25e424dadbd15505721f214860bdc779b59eb2d30d43ed5b37979ab11891fd25a071fd054a67e887d97197fcda2cb30fb0f11048f0685f478b5e2deed3b9abda91d882a56183aadf3941a3319d4142201b3ee6ec83ccfdbadf7bce4efec4760e145da3d1d3e729edd50b2448cf593176ce901960755e8784e20440d720104759af6ad99115255ab5083d6d1589ce1e760c66b8d1ec5bacaaa2be568f47135d2b0607acc83bfc0ae27d7502b6adf38295417dc2809597ec07d4460db6c5ca017cdeea81fca671c5dc7c569b121ba7ee16b701be6983ebac53d4ad3dfdc8a884b90a7955075e50210fe908408dd8932c2169b3899111094c3b879f0f78e56d2a84050e0bdcd46c76213876efbcc01dc503844d51e64dce1306204188e7d719897872166c77b078eac554a6fb023db75a38205a0be142f7e31e7fe800af407d2b621f82a8c399c9cc1bf13c272193962c10b7872853deb6274f5e0ab554a4975eda9eb13856158206f12cbf2c2b0b63789da02af7706222afa547710c9a8a06537a24929c7106ab6d304ff2c767421f5513676ed235d2cf463e3a8e2a1b9c023957b2ef3c6e5b43134bf678e44d637657230c19e211be2e6856df73968a729cd830050d228435de5d0cf52bcfb5a79ae35b0f4bcecec80f469b497c8f53b13f675b0aa7fb94e66309bb0c8c64679de5acc1c3cbbe56793bb868da6dec4dcc5c3175130fab1315c170c50cd8f9b1de6adee063c37575ecd462212a18fa3d280cb0fd14d4b17c5f25720e0198cc870ebbda040481e322591d58f721a76809a240178fd9542629f6f3d22edab9b9fe387e6857e1116b3a32037d3758bd9d3a193a4933ec477dd4bf0149401b428c687ad27bdfe37835290566af89cec8b6aabfeb57ac4acb1542d6d4bd4a0dcc8e423a15e870c2b3bee555484d835e3b06f4086d759ec079de20f76e569aeebe07409de2d48ff271b483d2c0ae07a46f2f9a3f8df2a8a3792957904dfa1d3b669152f7cad73091fcb03985833ed0a40077636740f50699e49218071ddb5729f8dc98c6d4781a23184e7786f070bc19ead06fb69762c85696c6bb54e331685cda44486bcd425934640911394f08a492536483c2f3a84b852aa585a35c04c76890d6c29843149ad85074da28890864b19008953865aa0acacceedcdbedc742149974e48b422e344c52e71d58c0cad33779833751dc547313ce2e4914105d411846c8de038dcb71c0d1814a0ef21fc09becc7fae9c2b489d236fa9930084dfa4ef611b8fa42b4a723cf59f77bf30dd5766613f9e411ea2ff1029c61f16c8ca08064ab74c3828b8bbc7901efd3bf2b6ef6488f90885de8a22f4f63cbedef190fd2655d1d273ad8dfd9252bcc285428d4f799972af32ea59f619b9876bb598754fec6e0591de74156cb06ab5567efb50507f6aa67fd228806d63a4e97df3e8242f9903ebc0c056fc017e59bf331bc6b1e338292a45cf2075b93c1964689556511c3fc4966f82eb776bc0d29d91180b3c4e1046444f5da44a2bc74eb515a9ddfb42adf85ce53821dcf822d51950389d83b6091ee31d2f8ded0c38075045fdd2d741d1f34a8ca7577f1c193f728e53890ded300a2354d83d3919807f62952e7868291eb8cd2b2940f3d47f4f98f5d8c12d9364088508c0a29337266ec06705d21627b95d0f54693514fd67310a4d8f9c90221ebda30c276471884c57b540f1051858d789ccbb22a18d0d07e626a50ac33034bbede49d4aa2c8581a97556a148d1da117a5144e51a5b74d81241cf87db2db9af396287c4c6b9b67d7a72b6b6caafea2601655cbddb82f695e4d65be522d6c3d37c8744e7ec2458e0f728ad9f862bce7a389c27131cb8e29a9b83ea52eae0c3fcbc336f838aeb769ce2356d8d6d4d796de21d465edf8a4e6344d7830c463aa621afae8f5823c5056f445feef802e91b0ee0ef802b8a3abcb2b8bd8a992b9f2ea3478489fd948cfc894c612156b34f1f0378cf490bfabb37be07358843dcaa87d83d9b127789140638a74268f6c087c247637fc87eafb9d79a070d03b7c5e8f1f6339090b7287f4eb6b116fa92a3acf3fa41b1635a7a97a7c9b43f5ffc8efc2077a1632fa9f0346ed226b71a23d8d9b32dfe9c45116b17811426506a360e535481ae69c27e185d4164f1dd50e19f6c1d1f67a085d65199e66a65c0761d0add1cd3dad381f7e0168ceef766f8d92ec409fa63680f907969925c4b9ebcfae3d986ab9c9085cd45991728768850cb27c913487ed47f52771abfdcdddd215019c8fad9009ca48de6f4ecf751f6538b82d83630b750b23e5d99de820abcace334812a1c04c535925d620e694c190041efe47d14d45eb9344de71721ea6f453f20fd7c84971d35d46aee683cb7942bef685c7ed3fd682d16a7228a3f1b6166d413d5cf249a06e79ca4563dcbb638588c69414dcbd7c70081d84df667db608a3af58dbda335e82354999a267c40694ea66b58d157d3a08e5c92bd90554e5d0277828d1fded052292a483b0faac1cd9260200872ea62567d7773bd8d49b745983bbb7983fc7d64cc717396b0ed26bf7d38205e00915bf269889b3ab602f218f4f637b3ca409dc7332e3a97883ea1b7c9ca7acfb71b8e0f26a6f98d283760bce6d910193a6998eb5e2abb7f445c802d392eab1762e94e14ce46552369e1b4f716d440060b82df622b841876401bb955f3fb81381815e204cac1703d6254e4fa96ea06c486eac67655fc5650428be24f8906454e426f6d7cbce579a47e70202ff894f91577e34271b1cdf38f9a18bb25d2050d1baf349bd1437f1f3f73e3e2cb65dc86bbd4ea6562c3a3867a47b8a1360d91e3ac17197e30258f6c3c87c5c7d848214b942137e044f64b875d96321530207056c85ba1af94e91cd25825cef7429d917d4e065ec60718015d6ed301ad36b8c7c54cbbc48ef6d984d25e387e21e89a4abc16fb11cc1df434c9689e96e8deea13e59fd5a85b7f96b8b748530088895e175e2bf4cd13e385b934fd30450b4a257ba5e11d08633fbc21dacaaa6658d7892e58e689311c8274ead5e7ca1c2ccb0ae58ba23a7da938c55b53bd0505cdbcc30da07b405aa6815ce40dfa62148a3cf363d3a29d8b3341567b339e31bbedd72a48cdc60c476f55d55ef1d6c5303d7f19da85237201918f5f6c8fa36f50df7e4a2035ed95ec71caf6e69ee407d82e761b3204652b556d4713706eb566da6c3dcfa8318798fba82d2219e8e0030d2dc0db0797062413aed40debb76a43a28f64cc0dcbf75362a6937ca35d5ab2c0158c1472c87c426a3a36ba5cfca8d3ba4a1f31258acf564078c8b05c78b714b176a03e18579a08931349ae479b266a074b45817d135dec6b44243270a57d1fe5767769c9e2144c9e55d7a33773b9e72546712fc77e65736dbc3bed9c2a60dfb22c01156a4da1ac06c1a9061c39b96c130195a5f00a3c99712678d25ecbeb57c4a892279d22fe222c4e4782926446eeba64639a8bb6a81ef929bf12784385ed6d2ae8f7b36fb6d8a38e7edbcaa464d7778c3d877491be88a88d149011d69b8f122d54d657ddfc68b16a74d096f98689403a107b96d874899b0883a27f5c06c7471d2159bdad2681961e33d6c7f9835ca25f6a5d3263f3c1241f69824e3906cb2d83fa60a6ad30ea5c0bd7a2f791e437da6419b3ea66f4eb2510d3cd88c7003db4f90c1862739699dcb4297887a034dc49d5d8e5b925d7b1619f5f7d11fbbb7086d682ab281ed7c580688c2753c9d61296008a17ba953fd48b010483a8fd024e1308f82b20f788f9159366e1ea55dcb1b3d50d385f05268e9048a4f25415c66a40663ce7dc4d553e7243790f1cadb1ff5c5648cc06ee74c5cd2208fec5c917d97de00d895ec2331952dd40f6f64f0add344095139a759360f70f2f285b50262b52ac92de2e38a48e11dd52cc40508d1c44933691883dcf2a33cfc23570bc746e8eaecce942b81cc88f37ad2a1c4d247ab6fbb4bc97d4383df9639d7e1018ff0e763586a5e390004a2f1779d41203056e8d05f43d597922883721452d84d3ca738b2562ecb336e28079148904a069b38f3e14436694d300b9402c30bb1b1e398e9fe43ac859c11ce97efb51d7e12b573bb31df747e25f25554f4fda4bd5d898eb0902be6a54b084eec5f994b81e8797bad01bf904c87795921d78be1fd94c1d097f6a945147a3ae809526f9f7eba9634a81e017ed680c021661c3cb2559961df7d44ec2f8a761a015d63e035095c1a29d007d3a4570a0b231429c955641d0f27acbbf7ed8f42095620af51bbc3fdc373ceb3b0ad08849690b0f218ad73197bafb69ab4255526e3c799e6bfae677d427ac62b28c63a726fc61af03983aac59642a323d00d52632c695e8728f79e8e89bca1f1f92e5fcea47aba8588d969e392baba53bf2d5e2420fa8634bd14cec46198d2d5e5e4ac152e34b0f4973a7ce5f36ec7871401b194f66e57f175a0def5316a91e7886f260086ddd10487f90d2293d8055338b7d1b3a15ca75e7c45a224061001951d506117560076c2762dd0856ba24750bd440db2139b7a036cdaa1ab3b7fab508acc51f6bf6b1a07192916e5ddbc4fe99a34857f73bcf1f676cbae2e7b6220189b58027913cad7a10a1cf9396a0fe120e73518b12f3b77d8019bd8b4d1d4ba0a64b53905f187f5bf60d59678cc8e237a31fbb764738fc62c51c7f23cfa3c40cb8411a30429bccf62285f3a832c8afb1f95c86faabe25938d9e9418042fdf53e69c1f16e6ea300faba0744b144f674bca6050059afeba5fac9a35f98caa25c473f5b4f1746d6d9f8dda1a47732d960aa0e0fe95c4e086eabc10dd720652882f434c5b681d7acc94b5305b3ac50b59ca5a402d384405795a0e94971ac696de175d172cf2ebd7bd84fc2fe349d212908707c4e499896c9f2b8f37d5fb98932c112ed74a94c775e284219a3eaea1a17dcb66098ebc59907619216d0242d1d1e080451a24fce7b4f9e47a951b12b7b66c3b8764d517e5a3ff54e3ed4c6af48b3ec6788646c4246a3861c33e83175e286d7a9141e576ab0e15e690a3fd5547e5e41d17d4e56ac4cc65fb05e10c5f7dd7bb728d7f018bc415422a3365e41768c6882f7f90472d133b3e5063383db23541a8ab5c7f172df714d87aced43b116b0841f4077a5227d97d5e79dd04a4d1ef400ad842b4df8db41199e66e577fa8bf51499dbbeb4cbbfb5e07ab2f16d17bc1233efd583cd7d2cb4627df9887833b9847d9f2327b5e3fe431768a2a9a81e6b830fe2b2204370e30e63378c182ebc48c47e114b7038ed2afbd3400e54df6006612f845257210975a713d3043417cce069cf195823eade81c079751336929f4d79194c6ef0d24f8e04e658ac786468177364106154b56715a7e7a4c1dbc2585d875791b4b2e9736197834b5e94810536beed945f1b153ff1b621311015fd6b75a3d37e69d1662859205e949de00580a57621d15ece9f25f6489fe11820950b7944197479c0495c96b59181050c906749e8ed234a4fa75dba510e877d3e1e19901f129f3d6928cf74ed2d507a770894f1e1e0c14ef4c61ecfd99562c8cfcdcd7185642bbe0073a3d3085b0e087a3d08364a87549cd5f80ac63fb6f5fdc8df86368f19da93f88dcd026fa82b19578aa1af9f9623872f61de7444292f42bfa700c0ce244192dc8402d92cda35b1082ac9e22369c9bca25304b771cc0198224c78c7a9d262513efa7d891a619bc98888f8f57bde29d9ba1ba37a4e4670de3a739ea8ed0a86da9efd9ee75a9a7e483a3bbee73fac6d2290a7a77ae22984eabe46d3b18b4468c32cd405a9e11741aba57b2785b1ba3b08748e0900587e1200b8f3ec81f39184b6e74b06850d5aa285fe6652117f6262cee440349b1b1cb65f3611fd137ef70e9db315f841b243d8423258ef3221c0281bc1d0b8b5994c4841ae7d2f14e3108fecc51270313250af5ab18f62ed5bb518c9bf72028c0f8dcf0d279106bc639fc79c7de15eaf9fdc47da52570ab4c29772fc95dff1d57754cf74ee573807a519ebfc2cae6d77a36fd7786cdff9963b2697ce31d7c2e673bea5f0ab1cdf4482cac293df7c14534eeaefd7dbd6a5c684f0036ca88cc53a7414d7452cc469f7322d9c5a366a00c24a0bb47afd89b6e88bf8b8798393823d2f04cb0895eaa82042e0999ef2237144c2dbfa21a790c307c0bd908d049f9e7f1110211bd3e2554a1628d4407613820d86262156d7455a4e777c5c76cf33e2dfe327c6af848974dfa3d954e2f2ec80c314d68cae11be82979bc69c4a4a86f08538fa516e5e4bf628fe00a55723728db59dd96547639b240517f8854f4580e325d95cb599c49131d6cf4ada5f14cc85fa42d3d70c173a7a37b89bf084632cfc915fe981c7d6251b964530e219edd717953056fb30f797b5766f1598001dc1c85975450aa09f71d362b7610a19f5a357af201f5d8b700a15a50823bf65db76c2e4e5daada47f6e7f30d467abf53ddf6d0f4b41bca13eb1fd808f21fb309e6b6b599acd4b7fd10851ae1f4b267639ea48004f0d69cb1cb8c7f9fb5561baa53c8ab3bad1973f1882bcb2fe386344c8befbe2fb1a86e950760f9fa49ff22c34db2c2fc755846a0fbfa1357ce87db3d0a3f06ed08a03b929587a1fd5b4d0aac3ee63cab2e381e8f4f4bc0f20ae732f96a7caa93cfc39095d601a1ec3e6e8440e0dd255d7191f82c467e881094d90f285160800a6f919800e593e3a00007835a8280194e064bd784673e651b0e649b3a793e85d69c07924c597ec9cc8d76d4a57dc51bc63862bbf1004328aa5289eeafda2a741de49e003f7709dd6fac1fb42c1a77158759fa9bac072717469bab4a6c674e4510b9c38e1d65d08e550aba74c4959b4b0520d429df5bab966b232d01892113b14f3bdd713e0258c9f1b83e9983e9b815e34b5510190d453ebc168ecd5b02f4248059ae231d6779a3b7f9ee7cc20a7e922310b8ec8b9aa9d5d35213faeddad5eb404f3da6504aaf70447c3e1ce3b00e649bfdc9460da300203db702a5d0baccd914765bb13c316fecb69b12404e5de6fa59e54148771184ff2335b1d5f60a717f43b99812b000e493fc4162c53f9f6738f6b656ff36f7bbb98f094551ecb5f9bbd914c604c5500e3e61621203cfaa80aba8b3e1f816f9acc322f90cdc1050ef8cb1dc966f6b52d3e7da2074f919fdf80808e7142892ea17b17eb32486351f2eea65569b30e43257a7d6a0e42db4353d461661ce5d248f4add7f39a1787af50698e9e8521596e8f20964e430feefa356df22af85d7380dd8fb8639aeab485a673b0720a1fdc4df2800843dc86d694b42a8443cf68805542714195fab315698428b285c6b08ccf48e9481644cc37bcd2026814efca7be2c077c97e517eeb31845bd6729aa674c71e7d8f9d5c46185fbeaa6317e7249fdaff8845e01d022a63bb4556c9a4d521b1dd2a0ef7a8517bcf3582810f05a4da368a290e18c4a26be6e02316afc94e68a22ba952d569d71cdb2274a09ef909cd19dc63c9fac3f7d87a938b96882e0a48608519c2bf63456c65a8a4730515235610d925a07d8f6b2473a2187635f6d21fa595485b565b21dd570fd5d36da845f36e5502c0f84fa3dc12d27ee3b0d2d4cb189b3a9a5e431b1819b613fb6b83f74e279ed887dc817ad23aca4a0665570523e420ae804d9b7a078dd3821aa2b7c31ea744f9b82a390ed1eb6a4ea4cf9ec28426f36bd074f9f60afec8d4de3ed24df7e240a1bbd8452a0f2d092593a2048b05dc8d2e313967a65733c64a382aa95c01114b3de6acf7182588032544c38abe5289014b8aa63a2e425e1a07d201a132b394ea8db8abf815aaa7a215e27ab23c9371d8523a66076511febdb6b08d6cab3299810e90ffa63f75f3bcb23eecc61b74e3db9a1a715705fa41f371c63d268d9396e726b2cc212287c1064d2731ee5c6c3e50ef014be6b0e0ee1fe7f70097e3054f225fa673a0d900035e06b03342567be499e909f28a6e1805845796846b6fe1ee26890541ffc668358ab7879c86fce487b82ec5d4d95e1c367961481f70f807733d9be99dc28b39c6ac5989e96324abb30c0c83abebe1316957ad78ea5a94b1ca37284b902e9f2b06401d6ef91b6b4d80fcdac462549ad996d02ab2554d60d7550c530e85398c41028d145190461828e5c70928e512d3cd50808b2e407c92bbd8a3a9df7580d036d5c32391d3403b5fd39aaf1c14bd97f166441f179b200ffb83b9984bc4df16ffdc4f06a196078d752a1b73d7c422564d8353f83a061fdb67a674af025fb51f313f9a89cd05d9da2e752ea50751c8b993f69679a0dbb4a58f43821751c4b4852bfce3a216d6d218a0b02d8094f7119b2f871b748e1c48e526f880660479a7e4704e611796a8978133d13f054ad221e050aa37a9eacd126dc32cf2d0d09433eed3a4a4e6c4b7deda960000bd272ad6c9a41aec5ec1a4d08180f4d1b1cf9292c1c1024140dd0b2d14368978a6d713239b6edd6c345042bf6123575995f5212cb2618920c78b1c6b5030974acb8a2ab856c8d46b541cbce46c3be5c5c2de00a8e771dd76d5d4e0dd265633a29dc2194d3a713e2dc335826b5035697a9904e52f665163624eeaf7267e6cc34b73ffce6e394c7dfbdd70c80fde26943ef3714a30dad8f62ac570639bf513e5472b7c4fd044ff760254dee92d59e9440854acb45416b9013dce4e18227ced3b362fd89c192e81bc18c0eaa88a6b62eaa0f5b88f6c2dcaab6c339e72af92ca9ed7e18c0f3dc0a88ea84bb11729e98d9ea9c4ce8ea869634a8213c3e0f33547524245fcadd52b94ea91b48225758215eb6cd99cbb1bf33236208adcbb62d06d28964753abb62a128880829c0f0c6cdfbf991ac22849fbd9172a45a5cdf6713aa8516fa98ebd404af99b770d11dddfc465927e61f2573e5ac1cdf5eb0db42599e1cfb1c69b85e56d21336249ccd4da0d9d4546e17e8f01acc63bd534ecc9ccb0ea5b631848faf35bd48d73218aacda3ca171878024a2f299213bf8e29ac082e1180dfc37e7506d84288d3683c0281a5c1be789ad192dcdced87c981eab2ec6ca2c161eb246c9953bb26f069a204f4b15a10ec77551e5a3e646651388e5bc77ff7b067757f64509b6ae83d6638917c74418235b18c30b76588a200f15bb6d0296e12acf7313abad2a1115447b8ecf92178fd1ea54b7640fd14d3046231d22803e55021c36e0f397b0b2b8de1a03f9d9adaf1de064757220fe467700dc83a80f5b4b54d34cb727acfdafe54d17cce907a51abfdc9e631ec4cb3a6eae7fc0397750f257081db7c4e126f0abcc1273d6541ae3ef992e41d00cf918e654de21a2cc372fdd04019a9d6f683bf8594195e5a597517e444be1e80586911a4a1b084f99db8ce523b71a320f5688574cdb4078e502ef1c4d1d06317a3240ff0aa75f82f0e213e12cfc105aef148a78be427e7a0e60b7f6286b86a07cb1bbb2c81360c4e75714c1ae5e82bf4fcce58944f9e81b506e2452fa019610e037d7a16a71fa46099e6ff938b751c4ae6ecd94c329ea26d05d9008410f9f1786b10eebf0f05e283ee76982af6da1f024b82701f4a5b257351aebd40d9987e74e52c1cd33712591d575d1d3f0c5d6e3a38de9335cd3e322ddddc685056ca393bd4bc386bb89722e0581e5803092df3611998660745843271f72aad21d008e4be7b149ad771bd7f64d9c453954dd5df82efeba7347b5a3aca0614c6fd7917febfc6493186d5995a5df18932eed4504f28c763988fd2b321740fb01413b43116cf7a98f1d891fc4e6440bb622777854a5287998165916599cefa10942569851a6ece66c0cea560427ffd8a301db4d4f582395a198e8af79d883dd2fc291b9ba9e1440fa3deab311617d78772f8a235b89a9bc655ec8582243ae46076c03d6c6ecf896ab489787c3d4c329d14e47a83161ed4c1467d1d69ee6f11f348d6fd4ee866e1e9d5baced6cb94dc20794d37b7da32fd355764e6fce5894c4f634e4c9ac99ecc81df94a806e08e80bbd4ff2a6c5a66bd1f31bf694d44ff3f4e118e8283d555b1df3430a358fa0468af6aff1092505315d02705db0f31be3ea9232ce0603b22ed81455329735610a5fac5bc4039634f9454bbd384224f3b2922783b8dbbc6585ef04bb01d22b5c63fc560afa3ec6ae08e25497059359c113b23bf88d88d67e8feb0e917e86743f8810629831efe012e2bfe960661c5f1979734d52e706645579ca0c416ab9881913d9980d04944a10bc0a1400becbcdc0e0462bbdb0227a96d53836327b76008b6a8d0bb99eb82a9a46f7362177d7aecc0445f5dd3a31659e987f7677f4ce82225272a8e6fbca640d4027ec919aa752a5edb0375793c1af9c3acb8b693ff9ccec417619c41a8f5a36d4240def4be1c35f5aa2daafbe40f04c1fe38d29248636f893592dde073a195e0db44d433abc8a993f308ac8e6770ae0c56c97d5196c3c7549c6b7b3f4139dd6fc18b1821bc3269304f3b322582e23528cb85fd8ee08a249d484b3212effc5a9569b48e9d1183ca40048a0a013fee9bfd81939e78a12ab9ce3ab79c2caac02e6ac2b0aa4d55311bdaeb313d4b266a9f8490f1154f44310c49d3711a50b935521a1b6b438dfe296c5e2c014b9f59d93e1304f1279de0f7869aa98d363370cd083cd0184e6793544f9ef235e0e7d064406209282e25982af6303f78ab1809dab3cc0cedeaf1c2ec442c63468c180c8856eaabc7023c1392cdd4a2ce4d4ea7472644c58427aa74538642c9125a721f0f4bfc896428e66faa98dccdeb08eefcb11fbcaa609ddc01c50cf29f22baf517794098cfdfbe8c2fa70a95b4845393314e4f03193a6f983d7df3a20f8204fae7a5b9064affd2ee8407d23b23e099e34ef73de3026672eca2f57ae5f997957f1acac7634af9ae835a1dcd7aa76920cdfe935e9803e6311a9e112df956703dc1034c3e6d157624775214214c092fbd2b7d4fa14d11b7159c60f5e44fc9a0961f7f9c05cf1e7246d985b7a70862e921ae96efd59aa81e58753b625e779ea1ac66b672571e18fc83d930f8b5c6990f36b1067976cc8699bcc5c626f2795683fea676f6fde065cb07f03e5066ee692b1eb60044a4c67dcf99e1dae50b150301fa8eb896196c1dcd753899967095ffda619285498132809dbd61baf498bddf51214dfbd8e9eaa111b7cc225ddb782680ddc42a240a751c77533f621d78a0e0f2c9d3bdb71d1495c134f5e33d780018ee935e823a974f6029f7756e51b3342d78a550332eea3a7b52349364f155b85562341615e26c9f6c9d0fd347abef1939bf40b36b15e9c914799fdaef09df1839c3c0b923d2aeda89f631df5458129e60cff69f3003d33be288761be66c4a1f799f1a34a49d663d912a96fc155171f57c6bb05d1ae9edf7a755976210991cc011ccd8c56cf874a7d707307d19502ce104c0240b275f9e52a19d4b3191868efdecdf86b762154da4a300f806fcd9e24248b144525ec6b6719eee113f86a922fc6310e6b638b723311691305645019cd1aacaf2fa75a72add11ee0ea31287b6ddd7df32e628585651c405ca03fc829592936b19bece4a794623d756df415434881abc47105151d1d6e4d06e2811a05498695f0599251910b39272e515b15cd9f817509e7c00dce80cc2b8bae85aa883931b49071389debd773454531c31ebe538a10ec7804c7c4fae4d37c6c769cc9de91739b3415d5ae88e90ef47230138952a0977a54ab183e777057955fd8d679e483f80802bf76e6dfa6707be8972f75d03dfdf4c9aa7c2d5855ebd1a9c831ae6b6460999caff8a8be8ce96d6e37a67e79179c4dab78f1e48b52428ad4e0491b34e875174aa04e68f94fb2a17d9b8edbf188495494cdf563a8dda2ad0de59d59a674bf797419f2464c20502a0718279b8ea22c5ca2a1bd9b6d6f6fbb5b9c38b1ef3781276592626d1810d84e4b7982b0553a483243dbbc06f849178f81c3945ba2b15983d3aa10c2362c76ec6ba7226744030f495ae4af71c006ecd0591c2d21eca931cd8d1099f2c01714aef8d58a30a4a301bd25c1a2aea82d2a7da8ade12156dc83e2e058ebc6b23fdf2b2f6c7e0f682b03982a4335c38884ca9b4c4ee44c1130485273809302d69972ad5a202d3beb8fa665d6c5fa89476e68bf71f6c2ed11a9dc8b1dc38d11fd3517ef295a507a726e08f97c2f331a56bf4c5452bff64bedbd77eea94a8809787583ae3b0edc10f81cdf0956947e232304781413808ca841950d8351043456da1cb01b452132911eabc2484454466d3ec9051624cd89a99057579bd03c217ddc4b4eca8c14e1daeaac784f62613e668534d0378b8e8324537ab882ae30f84ded6b5a0e7a735b07af3dcf7e5e803e5c8ce515a77346702f58c63d240bf1b992448ac8e239e3847d0339732b986e9d6d81acf55d53c2ec8c345d3b98a0a3014af83759932d295210092c0d2175fc3a089a12103038e92b55a3f6f4c0a8681ccfb32d13efc5fd142ec6a5e440b5f8d8a4f538a737f6caa86564afd0a99049a3051a6fefd421d8c9bf79d0837c520b54554ea509901d7b0530dec130fc9dbfc5ccd86bec3d5f42b08cde02c7bdbda25f6532716dc93b569940e3134e6157a9981412e4e2bc3d25ada204810d32cdc29451c577082a11ed0c2da36631d9523347bc4454bbabf2d055b1bc5c311573865c3586effebca065adf9f681d4d5c33276705a1e863735f5c67ae4ac233d8f5cbab7484b97c586cd420f00def7f700de9438cd2311780363f2c4b5dc34169bdcb5477194304c9e5ac9d29669446803f083d42d3c7b7c9c0a28e29ad66242821bf56e5740a9d39f71ef8f447591f305f0bef8fc803e0ce7ca02789cb615f82135013ac72157c8b20289f220d78b5953e3596e00baaa370b88029b298ad2babb4c8a9e9220acbaa9e1a7ded4becac623d7e39a46950daf4f654a310cae4f7e735eec49667ca0c9f66a8f43facf0c13d5446d8652fcebcaeb2fc57a988751a0be5ed44a2dafd37f729b7abf29d71a6039cd530310aaa273d6ad27874af65186a2ea58f1cf08a887c2aa00b4268b44a6ca8c74f38a668b0f49897cb00e41ddb84769e690006cba32ef4b31ff6b71f685b2f35a709b3a132dd1377b429e9a89a8cf28c27755bf135f537cef6822907cfc3d2dc7e11888dc61c18501d07c96c6c06efcb8f1c8772e1a64eb4245eda4c076e0b78890de1a6e2896f3131af34f338831f687edb7645038b4ee48188a276f210bb516f9d9acbf14c4d1ad5c7b9b98cf247a5adf09b3c4d53d61dde3943a95f6a6d224d482365d0f2aedde32a2650625fbc0ee1c7a2a22b8e10b2f16cad5b8f699a831088180c3233d1d34a9ac72cdb92c911e066222104c1fb16da26dac1b67869c14429f63819e9b254274567c9766331a6c25d4483468705ca856dd7e8896f53ed503a2b221a64b1bb17f44fbf3d29529fd564099ac4b90e7f95598ec7803d2a39be23ba25f65f240b7ecf22292361c85d486567d8faf98f63bc4fbf4f65028ad07b8a50424440260ea220dea55a5d92d0d694f8a1f9025e07c835d0282164c6f494a6ebe75f2bea20dc2d1dbe7c6e74fdbfd35b3a12ad30dde84fd958bfa4dc07b3e34d3f5ceed4617007276c7c47e91718c4d45371ac2fee40a16462531a894d9fd461bf6df65340fcfc70fa1fdf431c92ce6477c2e1fbda37d4331ee3814361300d6452599ea504cba9f6f7ea20a240aa20972ff32004d195d2ad00ab108247acf2c7ac000b82181d9a261f4650408d22687ef080914406b9a5bc0a1558586922c1525927a348107301d40559ac8a09c6a451d9a95e202e0ae03ae462040b266300c0e448a523c9095038aac55310e17757c2bdc8200e79d0883ae232d7c271c3e11fd81c93a7f2f956a20a2b3ae23005322d495901915416bdd043e3d4d0f022168e958d38423f511d8630ecb44e8e35cc93e187d34c7cf7d3af8da16d6c8416b26eb18093627f4ab20e91087a8fbd203a19c797e62e9bd680971d3516f71753e0f488be4471ee0cc23f2db9ce01f51b841090617c4f45610d6813f1b758be66acdf046a39dbd9babece44ccbbb7ff756a372286f029ff02e3d068a2d0ecd4644fbe277c9d6b59bd04f993934001521f3701af1bd92437a1e66a17a7d0579f5d27f44fbc8e4960843058b0487d0352cef0469835bf219f9dbc09b6ba1e16a7eaa8dccb2206d516c183a424038b2173b28ad7c41af817735db52b145ea7307fba3e13402926a53b97ec615b85d3caf51cc4d6053d3f0dead298694f461656c34138f401c74ae7b6e318255a09452cfd3a8d3cad0e22c6e8f9a10fdadd749b2517362b29ec86154c758bf8f3f235b7561693fc1c3180d5f575fdd0d104f72246463d12c593ded406be85162f6f327a97aefee524a1f5600ddf0880c7879ed449b8d5e21a005a359224c9c50b45d331ae82cdb1fc80a18e4dab701df985d719925acdbc26ccc3342de4771cf43bb0cc22509a702017d3770e8ee41374b3280d06cc6c884392203ddf46a9b80edf2ccb45b2b06bbf71072dbdd9ad92ed109b3b28db7328b921a324ea12ffaa2c3bf90e1d4341adc813195c7e721933672a2352da93e06ca3a3d8e2ada12b77714c198ec89031d1e0050b1d1e54a61824ab5cb0a962fdb6029fd0027ddf74c2f8930a8b89d841294866ea2b8f8e3a24afb403ff52b0db8389072c4d394a65e3ff7b80e7e6269aaafc3d23a345b692b7ad11cd4c3cedbf0d93c349445ad501a39f01774c4934a3ffe308658019daabad09f4e17a44c86a6e481e4d420fa175dc7faf488f6dc54618c24daaea2c70ef0af1d534bf690171e42ba9eb2335611d2eacda1954ec60b44e530d82f889169791ba1f6dbf13b8083005319c07ece0f747ea89c7841bc2cbcdd8dab230f09bc8c5983d14c9f18dafa13170f1f1d1fe2804fcd478c0e4464697f648328d3a512f72da5d146b9b3b90799e5857a671481127a3e3eb639b14d04f68b56eff3a8df785ffd548de4fdb8698d73f1c7a4a160d5fc201f438e78c34e580268d5ba90e52938cac6ab04abf6e5f00ff3415b34253000db7aad8a2887213d356db87918fc555f52e471d0579e346adea91e933b1dfc68a13fb09ce1963d964a16d273443263be92554a0e1db678d2b3046eccda869b13c060fa9f9cf98c27ab6e6ce8f281008ccee0cfcb18c6f454d2e52eb3dbf9c1da47771c080c17addbeadbfbbb1b6c9fc5ba40cb6d10c49c923679ddcd0b31b0029deb40309fbf0adb81afecd0eb65a3f78da19234f66a84b921c72b9341cd7cb816098cbe9c70cc6a271d9da326c21a014fffd0c0ef4048dd2c1690bf28eb8c5c032a90b0c8a752e8f193aae015811abfb3f33540a6acf6f45013e0ea01bfad2a9c6ce66b05e0e904369e03b43d2771494a402c57344a612122fe9524701e38b8f3a06de39932a85c9c1eb42c1a3a4a98e0b775f01b96547a626a42c4520a5d2fd9733f0582d623bbb1ad97b4ebae9366487d0f82a292f23f4f0820eaedd3ce64cf22525344ffbaf5d2a3655fb6997999bcd1936e1f085cd4859555e97a16571a5d9c1237ba13ed836ec0a859674b2355d16c1f4fca5450fae89d303da4b519a84b3580d0c784b947bc6e4b7457c014c63592c91e81c4f524b0c3098b994c324be9309ab7ec2adf8b8671e86c281e10ddbc1ab5e841bd3d623ed73acb78b3d0cf7a7a7779b1e66809d44a8000d53678bee94d710282888b22e2737a9b7fc97ea18285c399e204ae654edb8b80dadc284f73414eff407b99f35fd3a49ee1c51b7bbe4ee0429494bef6394b6d5fd66302e3837f2a48d9ae4d4bafc59ce8fd1c86bcea64426634b60868460b077662f1cd59cf3610f815844dab58475650437a957d0a93cc6e7fada5d48fe5237a83d8859ed7026ef636a88d70653d4f3ce2dd6870e6dd6a5cc4b418da4048eba1ee638663e3b85fcf62df9fb3f690b136a3f5eec6c01ab9aa9c2689a3981aa4f93ec6ae8f8fbf6717860e88b51be9738964311fbd9e010bbf58c820be282d18ecc8141e37569945d0e631cfa86138e2f2a401f1ee87d6d8f6b01bdb9de2be29bf94fbce2ef339e34e60c76ff284549dfd0113c10452094e4854e44d48d02922632a97b9bf4012f707752e6164933d20c8f150432908a09c4e53c4f98cfe15db5c10df526ec0107d89d65b2c6ef8a5c729c8d4ecd2e6e0bc3ea5fbcde106322065ac591f393c33635a5f2b0d9b0dc4c6274227eefc2194b0654c129563988c20e2c87d2a4a19b5d14ae3264195983c61e03cb58238181507f691b94af65ebc8918bb62aee955c8f0b3f95cbbb32c00a07844fa742b1f5d83b1dfb67b9fd06162e159a1fa9b5edd2182d28b9f24fa1d341f85a5a9a21bf196c60c659e89ecac1dfdf06adedcef7e183e5ae621c12e13eab61d0e56ce6d8b07d70b5237f6a4db0222e13a7b857ea517075cd012322ae6d6e2e17bdfd0573b4766252427172ce488d98c2b60f8f92a59d01931862e0beced76c468a660aa11f7641b09861104fcd454979da095c2086728e6eca9a4baa21a8b0de903f1fec91ab6b2abca98bedfc71251ff867436913da24fa7f1916fb89567a5ca966be0e3fb1cd1344c1d02ffbecdfc39307a51b4f5e3f16fd7d05dfb36e4a2f391119c13e5f678d9d18693adee9ab069864d5492d1fce3e7d0a1b1818b95ee581af8fa7cacb0dda3cc2ccd56ac041221acecb7f60b0192920568eb81c674d85592a09ab977370cae4ec4c0fa87f917bfaf4856535dcc05f86c06786014d30441251cc3d61835f2a960d2626f87567458720f87bbc861794b751243943885c76c874da90ee76b1373740c4eb9b319516b178b75b32589dfeef97ad9bd23f58c633b1c89ecf8165ce253d5b421b27ab9ca35a30f9b046506ebac01a4fe8166606047a937ea2891db3da27229ae1a689b2c27eff420990d05eb152a4b29030f28f580764bb2cb99d6bad32988c8a97ed79ea048403a9af28620279439c668d1c41d743c505641eea802974d3e30ad5f88e515c0615db8994d11819ea146285f03d0b49e6c5e62b43500ea08a16413b51b13152d347888519b59176c4262ef638f055de5f139bdd932b1252f8892d7be751113cf64776e52fa351b7a248723488b8f12a762468faf4628189acc269fba5a8ef2362886195a89140a71dc0c264d5fec89e9c7c71096fb4aa49d247903c5d0dfc53a077ab13fbeb4a171b1e2d95adffab823d6654f18d00f235126b6319cf285eee339e45da321d5ddb8ced67b8a7347d75fe93e4426846de9dae428ee858d4c011a762379347ac0ef7d264103e9f6567e1ad42a9102f76e3992684323fee73574aa8fa8d83725204bf742540c9f7bdf0cf5c78056b99702f6467a6506a887018086161a172ba0d89dcc43947fa4bda461ec11eed70e0a97d63b370db6ed1dbf09cd7cee26f65b64a6787f6406c798261ba59ed131bc189652590b5fc5f89a1e460872fc2a6ce203722ead3f3b4a2d42df1b4ffdaefbb31355ce25929696698f746ac5b3d23e86dd3bf2396bcaf3aebe9c49331a5b219a34c5be3e71ae5cabdc9938d944a2019a5af473f249c3049f23e0de97dc3dbfe80f200293a4a1b0aa7ed197c4e62e24f3dc5ae7c26c35087baa3ddcf0dba2eb4a44ce500ba83703a3a4c38a5eb29a03df0214fd8e092be0ba753e00c3c06e5a2a10b4cc23fc9448fa3a91148c7442188c4509aaa52e713d4242b0176ce285f1c780840f6bf1276837a99814ac5de1873aecf49c647d985426b2cb8d4b70da2a2933d57342c36d33a6882d22ec4d1606ec5711dc9db28bafeb54cced740a150bc303566f8b982fee8f01d963b4ec01cd70a7b5afe49641a891333dcd6322dd66608c35ff93da3198b56962bb270078d6ec7b448c69935b7abdf7a4d2ab7f6bcb096f9bb7d998da273165623ff468bb85deebd44dd630df3b3b92c677f372be3b952345778029c00ef0025f771166d12580140d8fd54c6303f0fb91dfb32773fa8c35fa6d38027028f0865ec7914f8ff7573c92b884449587e2d074d005552935362c762c6d7c2f92411ed181a584c50e371d070fe39ee3a5fe8806ca95034ce03ecb4adcb240a25ed89f196a314b16ac40f2c5c85c8192482c6cb76de185c09a7b3f547a9e69c1bf0711dd4e0f7f923991410fc50d1a1a3475c781bfcd09b90364024fd185a9358d90f3767bbb1f8979abc385ef252f9eed5e46213c89fe62138f759fc387cd23274d325487c974aab8180953cd38d54b4addaf1d6380efe7e7897bf1653a2fbd2a258f4805486e9b3946765f24958f80d34cee83bf312b44a36e72f601759edef63b6c6b577c7fa3108c0f2c24bb26458f4cd61d2b8e9e041e5ded107b4b04c81eca8f6d1ea4e964645d119f95c85a8528f8985684d8510e0f70ca13ebbc989e889154abd03d991915c89300255f92487e3d6c918c46029a2e2523dfbe51d2eb6ccc82071e0cc8f16a909fe90e122947927138086e9d71a17474de6d5b832a1df99dc02251790056986f918779a309dba9270b4560e34ed06609bbb39df905f910e1fabc09a53e6be477f82b7a2af3e25a6779eec8dde48c8c3d264731948d4881bfcf39a0b269d9ef0807f5f322bc0a4c20f2230010a833c6b2b65c660f7dac994080ce70869c333f8f5b16a25fe5516fccd19822e985bc1edfe79a6c9a49c9de148449c2baed0a0e8f2a6f68fe09e149070f7930cb2f8d98e88158bab6b4ac254fed674dadb3423b781d85d4141ff10d6e0c998e2461bddff7115973eae8b62cb64e21e097d03670b9588fcbcc83b2e07b6b7820ea60ea2ce0d6809023bb5aa8944c763b29e185034dd83ba5ee99737ccc8946e54f42a6ef2149faf2082aa237c5bb41a1d5c61cc24cbf753fc00f441472d34f1b7cb461e4023febea1134d5aaeeba5fcb73e075f4bc71abdce89e6d29631f3953f3392541f021302963a9b60297e52bdf4c91ef1849c005c15cf19914acc175cd6b91deebbdfe11e4d9581bafe5e1ebe644c4f36c4cac691bd5f1ef177aea32cac64e4c7693fc6a2f8548e1d346cd4b80674f71d19c2011f8eca3cdd69d3244eec0fadc5d4d69ae07c0ce8802d5265c3db4066fe18c1d483cd74e6929f6eb82ef4d3ee9d589deb568d1840711333344b157d094d15671949b452353490ab8dfbcc99896dbb370a4743783c722e830b18361ea5e64a27d2c109489178f5f48e631caff0241798ea78c8f9d6bafb4dfcddd917ec514534c11c7cb1dfb0a029f77215af0c8c5764ef5b9d13da4d44895f1d3eba7b74b7346bd1d864cd05ea0aea3a0f06d1f6a029fa217f66ef765dcca954bdb31e888ce31b3003487c25d401f40d7948ec4edbd70946215a8cac3ea650dcb5ee5bf730ee89a39a511fb091039a666bfe4f7303473fead4207c74fe21272134c33d41c76c591a717137a1637ccc4df046a65b897dc01861db8104ef0ac98080171970d81b5c0c01f4dd6defb643b998c556e899fbf1457f7438bb322a5f75c32fbdd4112ff7dcd04702523857967e9ab1002dcf9e984e5258be58e54128f67939c319f7b910daaa171f43c716dbcf0b861243993539fa42ccc0da3f5888461df2f63c70ca3b4c4baeed37c4ce02533cf0edd4312bf707ea9a881b51fb6763812fd026257be038982fe7932ed08f5dda13851ef14dc870a831630264209f988e91a508c73c13f1c7c320849d6d9de9e6d9a27ff6c3691848b883e45023a9640ff1d782e1fbd2f97178435863b680b065c6c6a58610bd3611dfac36203407c035a652d1d77f6de4714053641547e42cb6e586959a177540100e380e2c2e95f0c3a0ae55b2b00a8fe1bc11b31b982a84396d2755ea5f1c8364ceadb563f61f67f8119a9537121b05925a80b001e70f730cb315297c608dbc7225bc7232bf3610f8a3a7d5178c04eb3ceb23f12f4b55a9a41d0027e95aced74f9339db736ec576718c6cf203dad590c198d3a11b95ab508729845f6aa34b0b3e80d31fda4fec933c6facc38da223075699628862b388ea447d6555520da78b35c37bbff25ac1332b713d6da82f5ee67c8b5f1db8c58bcc7dc79141f7c6658557f72e338d0ef357817256555e553360be4da0681a3c55a950dc49fb03ac981786549eb75f6dc31f85d600994004de2e0073397c63820c40f834b3f105cb43f026f08e315ae2894c4a5670201e67b84e01f986004cc7ba615af7b6b15270db6cbc7ce811a41489125bfd2d3034550f706c8173a977bbfb7776dd55d62d64e4765cf62857e670685abe89732de7189422defe50a359917d32bbf6281ff34e92b5b446a7fcd95b48e7b3a599a7c969d2b25662c1d7f4aa51314d9a60598d2d24ff44bef975ea5b81a4d8fa742d09205d3538dc25305bf0b2d3ed303ab5df45fbce80c6e9fb6eafbb2ee5c92c2ea93a27cad3e8f83b938acea8685dd72440d13b0ffbe28f592b46f58ec35f71d2f2a73e11530d6ced5be3ce6ae12ec33e4c497577b1e944dfc4b9a1ca06e285bf50892ca70ce63da0be069804e9ab066c852a19f4dab5a3912d6ceef87ad3da6cf7d4b4d14c6d004cab59d227aca6d36a452481daf91b72fdb6662363ad8583aaea4c0e8e5a83514e3132a104d21961226e0f46568c10ddbd4076be57618b2b7679c9b3aa0a7521124584c106388d7e00bf3cba17a82e835037928dc3c051b5025eeae0eb320d2d004e5d9d156c0419937e0af0a28a64c1e8a08bd9f14184e4f6d66ea283761c3c417a1178893bf9c6bde5a78cb5c1394675f58e46151f8f871ebafe2cb6a319727f92950a1f3983a7415f87852688813d6811ad888596a84790c279a280c32824f73000bbe2f21ebef87100e6b12efd613aeb90334db959eb49246c0d2fd6edb6591d33df080fc0f26c5a4f8b13429879cc4fb5ccd91f084d036c3485d1a236c0dc912a7c9f1d071b0eef72f394a48fce26da4077403859212034317e6c6db94beb1def0c69c3b6b3425624db149771fe23acf4f62fd3cfc5a2067965b9c24222fc96e3d85f4795136ca53ba0677ad7b328b5330cd3269ec88baae140ecc03c587e2a3e72b8d098bc1ee7a39461699aeff69b71db08aceb7b71dfd0cec27b11e24ffec61f959bd32354ac813987db54eab18f240f19def698e56c8e0a2743cb0dbe35b99328db5577d5236e5e68ebc2902ae507d7ff6dadd3f6e32c887df1e7eb7d6a4edf1462257b8a4f468df892f9b296d3e0daf7cc906d55ed0074cd69d3591af0a8f3d0882ee736f8dabbabf6cc18f98a9b7ce543b5fb28701e256adf33984dd2c9090454cbb614d692780d562cec5ae4fabcf3d0c6187b877485233988fb03bfc32f3c8430630c41c284c8d1cb228a3592c7b8158400fa7790b129e4616193bd638d6d785a749c4047ab78e7bf4d27f40570e132ed1263da0de3e549af8115a280cdf1bd7b4732fd5d7dda42471e1fada0ff17633038fd5a30b1181fc5a92d9f6eaec234406ed50e2e4e2e33a2a27239691a87c7eee4f62f73ce9b63d27bf0ec833e5562725c5234f0bed5d069347abad9fe7e28d36e5bb17bc55a422f8c3c85fd5b93bc3300cc3d80cc3983be3d48cc0300cc330cc8f617886a9999061189661848261188691bab40804c5ab155690d0029399168aed182396e8a97dc8e4e34777b45634e55f2b12facfd73f55b6dc5a922ea4d6630e60144be6b5e631303628cfc83ad7b3a9b9b57a571a056910c4f85ba6c053fde196cd9caa4d863bcf19b7b750e96fdae70b6663af0ca9f4bb4546c17cb4cdad8d523d84b546d88b198246168b814061516317080f55fbe964674b4d84c86944abdcad6d24b151ec3bd2ac29a09f781d680ff0467a0916c17b28ed02f2853db95469edb76e58db34cd95cf18e6fedab6656c73fb89a6f0504c53212734208263427110260def079b5ab166a10ac72a0c8016fdd0d28ce6515490c6f8d522d6c017160c4cff3c4b3983883862287b6a56c21319f805a16ace28124bde7d27c3425b5ed9d0532eba677fde93178eb699c3a52638fd365e69bad7d6a7673b042ee0bedbc1b7f57382bcdeeebbe37a3e3f9c75e1161c15a4f7e93058d77cc670a073959c56245850fd126bde1899083358404ffe8558a5db9117c7a22fca388495fdf714da6201451117f119d213171f798881cd84e4f2de932bd7900b9d16b83774faf23aef8f400e57a139c403d9533ff0cec18e7668c8d6f9752f5aa6e4978df4ea4765918c74fbaec6b5bca972f6c4654cb97d686e04c0d51081757e540afb310cc394c672405cb1fb286f3fa074293151981a8ada2778a39b59d85be959d2764d3ee3222fdfdfb9c0a48ce4ac102b673f2e65e6a6646d59b42d7b6ce71f3bba981833fe046ec6f34215da033864b6cabc7d341c582608d4b22b27422b3bb2e607edc3fb905e8caa22e318cff77d3b3a8eebba66c1719a222f7b9eef7d37ddb2aaa6322795039f909b3d8438b4dc394df8c1051cbfa81abb59e49a1b5bd598efe765fe284ed4eb9aed4c7ecebac7e73161486a3dfeea4ed4fbd9e94c718f9fce62cdf8fdd9a38e858b27ea848e778a26c65f434e7afce101bf078eb1a98a087c938c5fc9676366ae3c5d3cfa24494f8cb5098aa1ed7db3a2393e5bb199d74a75891efa7c8dc000d883f4e1ae30f41c50f43c67a465da49874e3fffc39d173a4b0a93bfd29ad8e820eff6a830bb01a9a7cb564a33e5a7f0bcfbc7a7a12f7b4c322bfbe20c9a997f2adc283fe2886f643df3a509e14d35e9e81b4c3ac7de6f3c293c41f9f678f89652fab651faf65efa7659fa164bfa76482633ff0de4f4935cfc6db363c752a7cf7123eb5b1327159b7a93fca72676bb9225b0e4e38460b251f5a8a72e8e186ae5186883843b8404adfe9ed2baccb90bbca402a42ab03a904a1ca6f21e546486dfc4b6920891ad205c62263b9eeb9a0c14a6efefad1d862bda377474f9b9938f013d2620136e53fcdaa2baf92b58f98c61580ce923f30ac99fce724c9e50855d37f675c9accb370d11aa0bdf19db4d296e11afc3d7be908a9c64f1fbacfc1cfc3bbf6e417deee1cd10fc002b1cb740cc4a0dea6722a276fb4ace39037b5402b4e7630b8b1bea617a7d4174b037bee8e3ea85e87692568da04472140d46b733290e16cd0c8a9bfb81744ec116af69b0d9f823f904fa33be26aa39a7be9b58063f1d0d09250d4c4906d39d5dfc2a10b36e5ea291b8ad38ee613d6885cde097e4f2d4f7efd1698d159f9ed6ea75b6bccb0fe93022ccb308a18058e45be239507bc0138db9137fd1fcfe1a3aaba50de1fd2efd3bb3ec635b83661180c8548a6118860d68e58e6a86f9df7faa791bd3e37ffdeb9eabea5ffffb3f7ffd7f7ffd9f6a9e8ebfab342ffffcdfbffefca9e6e9f8bb4ac77678fff9f32f67cee663fed77ffefaf3a79aa7e3effd7887f29f3fd3bc8de9f09fbffefca9e6e9f8fb2edbba39fef98341d07ffefaf367dff27ffe9cdbf0bf8af448ff49976568f3f468e709ace6e9f8fb9eabea3f79936e7b79fccf79547f53ffc9d2bd24b0ff2e205a746a8665188661c47467589d61188639719b616c866118866118866118866118866118866118866118567406c76018865d198661d89b6118264fdc28b7fd8277fc98611889671886d16c866138996319697760d6f26f86612a9b619866641826dc0e54bd7d41f2e1e0c7308c1a310cc33836c330f2d9a76c28f6b4a77d32c330c7cd300c13d80cc388bf7a5c3f2846e0266718e647d50cc38816c3302c519b5c5bb35d31d935c33046c8300cc3610cc33000fff23a26267782400cc32031c330ec6e330c3306def103a60411be9c61180eba198661648661981fc3300ccb4e99348c0cc35801c3305e9f338c4c32a528b024d20c7168338c80e40cc330facd300c179b06be0fa9d80cb1cd3051c1300cc3d40cc3300a03286c89cf59e4600cc31614c330cc54330c63ae5bf15800f25c31c2338cc8ce0cc3b0efcd304cd130a2a83259286039c3307167330cc3d40cc3303223cb4c4a9f71080f0cc3b601c3304c66330c63209a4e08e7c45e396a338c68cb0cc3b054cd30cc0926a11602b9a40c39c3304975330cf39b1986610002cfa1ab4193501919861d1786611826661886110bca89a09a611886671886613e0e69bf9b611886e329963f19866118c3c254dec090d1a81391ad31c668353bb66351a9e390ed57466bd396ed93b5694a26e92da4c0d824665f4f67180103588b671886c59005894b940d6027122fd4f446ccee51d31b1d5bee9156fe090bf3b36b5671ee1c92bd66661e894554614560498334af83b5a49b3c91af597b17d41d19f686835c07c58e96721ab281838cb4aff9e64353ec4745f6c6656dcaa10a2b14395b119fcc5bc652122723ebac4efada481435eddcaca59a6a7fb97395ee89bbfb039ee2e42e20038b40645d338677c843d6d5b6db9ef331ba0348cde35c9245833f91446806b304c9a5f3ce0bcd5a0e74bbc638a0c07fcd999a8f796e8cc8550d9f67fc8d0159af8358a97b4ead4f720cbe68ca5ab7542cbc25ef6a22ea5ab4cb7da0ca6a8efef2613ebd8389e6e587f0d8770e56be7066086d45c7563f0d64e3324f96e76e12e2faeac19a26292626ff04c20cc7afe350102dc3d509093e8eee03f78e8ee367efc54db5a4b8e7f7345c1d9a6eb309bbe22f56bbbcfd2d566f0a99b6c0f52655371aec64c75d7d4e3c5d1773565709f50822c96a2071137aaa6db789a68719026240e752860b380deadee0ebdafca2f0412fb8c398d9c08db3db62d7033e9c91faf289eb75a9f3dbd4a828ef0ea0860d9782b7d63ec55c17854bfb410e144beceb2e753503ff7199c9db66ff9a7d134285fc9631dd4a240261c48fcf4fe78bf207150f7c7eaee1407220f870f34954e54995968adcae5dde7eb79b7098f1765a43804543faab9a349954fdaa6aae18c5027082ef4ce27462a47aff608f78b8758f7bdd4d1ddd43f45fc1b56398bd6c695f1e3f36f301eb591a9d68b08c57ecc4144ec7f9136cd6bea621f87b402120811610b34093a04e90f1ed1e38d28ded232457698e8212688901f62ffed84adc8ad241e1d27e9087b2f9a9d489f49ed5cb8f2a24e5b7a4fb3b5ab797bc9d164e32379c2cbfbd1ad2bb0ac982954a01fd4e93cf21cb48df7eb9db28547e6b49b4c5f63ac6cd72de290af5df18b7f4354bf6f9fce433593016d6a88206b855f5e51b564d61557343044e79dc96f928657cc3c22a6e16346e9cea76c94f0e0257480fb70e8e42b29ccd6e7f3134df91742a786ac6d827c9baa7c357e4bff7dbc726ee27656b406ee4c0e51391830f532002d12d2758715383c1b08bb6081cae76a54eac0be4f9442d2d90ba7a294d80e5e1ceb7202ed4f32e08f3bc6f9c5a0fa310edef4a8b4ab826b9fb43e2a07cc76f3784df190786d0cefb28a0bff7c334e045a8e414e0df9defa380ca2e190a627dc2422f87440b41a1d84290e83648ac254a06e5fbc4c577c36bc952c1f9fefe9038a447014d5cf214b87ac7cf8126f5ea4405d2acca4f224f3c5f7f6f7e00c6e04ae17526e9e07d52a8ee69893114d9ffb2910914257a5550c92e55268fa1e4db37b60a44434a931e39b472494260ea53108624d1c5521650732cd511f145b3817b4dbeeb5ec3f7ef85c6ace9e4d0d2b89a520905370ef047217d79edc9f7467c097d16ef51d1854bc6a4e640cb9adbeaf765746e93ffa6fea9edd59ecff7f900cecbfd4892d4544b3b4ba815793a757fc622f710ff81d6d0a9a5124610f96944fe4cf4017df49386e069d9d100922d34d039309f1a106d44390c0d16601abe7948dce39706c4108d2866f15286d0cc87210cf371b276f704143ab45a867266650944b117675b5efc00763dc778c347aa453a20be47043f6b29505c2d5e3e9edee67c9de21357090752cf6886771fc0eb653bdfd245195a4b916c90fca298007eee86cdccee604703ccdb1c401b343f5e2c59881220d207f5016c2322db592b08ab26a28142ea2e967e0165e89bc7f77750647f8601e0f301e4becff60859172fd496f46f4f2e3d80bc41528f26e1f87c1b28dc38024af271e16ff4f395e84219551870011341ebf70abfdee6c12322d306b90dc3bb3cc9b9e9f6d59157e0a5374b64b6a6dad3bed306777ae31bb15ee9a28bec0136928ec03ddb2a472d1fda764a903f8d5884b8eacef0ce7a17e53bacb79b1a589b479ccb53bcfdc89696cfaff3c7e5360118e6d30ec1eba47626765267e41a36edb328c5bcb71b1199f8f82d084cbcdf08a43033015361235703f4bdfc51d185715dc309d95d57804dd1fcd88146d61f5503ca86e67506a83d13a35af9c51752173ed728a37d461046cb461cca85d3647ddc83320bdcd1b9e8d7a9736760e5012496798b64509dc64d30556ee033ed4ac29d3a5e95122e0e30059ca86270a0dfc907709f4c0b02e74b94e0d5aa96f909b43a151f9577e6f9e1e6f90c17ce68d088509e00c34fd089fbeae9531b6506e5c172415cd525c155a9af44a85b1ab12db127c954293d81af473c5d664b3f850672477a1411a8e4c6bb06502cf994c41878d4a3472639519ce12075856e442162850f6826f3b9f667618c931e0ba698d95ce1263b8ebb81026f4fad44e5ce576b5a183cdd92a7c7da981cbc0b5005a0ead1b74d5880556cf8891e200973881f8c0b7065f9927508391a616980934690a33d27023106a80ba8557da92eec891bb6db3113599b0d73fc0bebb4aa93a49b248c16e5bc0ddb24840ec5f1d8d332327bb7b69c6e997e9ec3a8918b85cf5441c0be618f11008def823c123d6c02d0ae8c5618d0a66a0b740f1cfff845c77373df041d8add395c661460f8ac7cf985efd324a930930e24a9c6404425ad5890dbc790957a13e1b8724666765e18ec8be83815784938c470900496d1ed07d165e47852430f7616015c7c300e143ca5b50a5ca35e36170764f60e7b4259c4af0249a24e2c6d6bd689ff7c5e907b1fac226cf543a6651d90316dca0919835670a182a6f5116384b43eb7d97fa02fe0cb8a585a99cb9c0798fc6f553622f076b0eaa36fc5e4671d5946701e0bf4d51d09db682d3601737fe62e11ddcc0330058c91efd1266428c17373406d83713bf05f70047ae0055d90c37ee09901ee298197b669b76e8bff20dd6acad077255079dc31b178d2a906beccef74aa2a84cf2541a8f7b81e905fe2a0872293b05cbc7d8c9f6d84e013a154d9e3adae89fa3de03c920e900e09e10272142dbda9c5b9f4c050dd557e54185e334c692c5d2c3a756cb26da86b77274bc2934353c3f3eb99c97303f1dbe5d6f048f1ab42f27d47895a6d8773734a607527a3acfb32ce4a6dfafd6ed34f71b5fbc5a65d0a0c3f54d543d54499c76ceb72a084b009dfcc3da3723c5c5b3fb8b678ac11f59a6feefbedc3c4f4f27b8f054355f9454f60497ea1474f68fdd78701be648dc871415dfcb81f1bc4c6dccbbd9e2b6a50c708db413c4bfc308ead77917d7f1613b78728cbf1ded4a9a8c6cf264be9610baa74ffb6587431864ba3bee495c1c2f8c2a12a5e3e9930bef56b64704fe24143e29bf5772ef2bdb5baa7dcb56ead66f18bae9121af80e3a39b1318826b3f9e7599f13699cfa11cb6e9e7307ef48a61f0708fdd66526f933fde961365192f0486f1032e814a63e962317692e5636e7d63ee6ed5e0a465eb802fedcea812bee9e291ad1991974b9d63680f60ec8c2f5fc1e6a902a5c1024a24b68dabcdbcf5eae6a86e54c7580e44b69b6ac08937a146995f2fb06dcc78f7ae4336c3305297c6085bc762cac46c2f3a756c978cd6ebb1866bcc3dd59a5dd6b76a3f76d1eb9e74eb87fd8062ae27eb539b7b023998a0d9bec3babd60dafec07abe50ddfedc44a368cc8bb64e34b58320c079b6ba04081bdf6ccd073950cb2a53cf2ec30521a5041425073a28df56071220358137086ae0770118d890c02e290f96571357df1526950fb9571af7dcf12c6936b5930d00d0f1068b855b280c2b1a2d23968f22eb9850346bcad4b1280c3152d4b8a98d58197530cdf87c133b82a347bce2bac322063d5eda81a0847e107801ccf9d091397061a11467bf46c7c3fa97df87a6227b167e07c942f9943c4564a0b9e5bdf425c11405ca1c339b3f1bd2b8bbd5db9950baf8b45afe6953919e89637af70f779ff88a327c7fd2195ee9f2444816c088f840c8e444c9a123719c069e51f569f32507ff54f7070f17d280b8319b63979553fd476a5b1ece6970e2874bf5d27e691bf9e6f0a1df587546194d2f5066a0f923719b4a995e3303f925aacb8986c14c86e842e0d89d071a7898bdee03953952c64d4ab5e30b3acc333a63a884bada0cc33036c1660c33d93f8e912966b87f0da3facc7e733ea333cc5aff4e46eb999be144c6d8998ef9b98cb63363fd0b182967aafa7733aace34f56f62785be06d86298da5f0b3c30c21de466dc8623089477e0c8e34ee37269c5812bcf0cbbda00b95dec4a2e127f668be885a6ef3952d0b3c57ac9798fe9a856f33b6e523577a7e56cbbb3a72ca73679326697cdfd27f2eb53aeb542d29457bf8ebdaf701899f97d15edfc2fbcda18b796d5dfc42f55da4999841ef149160bd8b232dfa263233b8075faa4d259e77b2e1e64ee02151bf39234116fccca7850cc382606e560897e7f1e5785cccbac41c21de1b24ea236ae28eb917a51db116039741038ecf9bd2fdbc8f44722e59fff86a520c9ef55204e2c78bf4e522fa72c7d17afd1c772f8d474d0314d6629b7c8082c0843fab4bef007977a0a9eb77336abb8a6f38c1391633b937f6a12defdff636f82a6957ca96f944cf7fdd34a0fa042c3c87bbac265efca09a80291e7626f645c5cc5c62ed20458d107c640ffa03843ebfa31214fd9d00f456449ada89bba35563467178450caa51147780d698acf7afcbb21597c12bd1aa70483e4c3276b8373a22dfd483582e2732432d4d6bb7233c7342b3a7e25ac77586dac90060d2bcf69c9b1a4cbb21a1bb93cb02405cadab99d2540c4a198cb7d1192d2fa03dca555ec93bb236798248e0096b86611886651809046fc62a8d95aeeca2168c28a48c7b41d0f2db3f9f46c25b95138ec97a255ee4be109f98776c9bf76db75f235bbbeccd75d2d5884761dc5f461956b6beabd89620caf1ddc79a89c6aa3c4cd87703fc36e41b214826ae132441b0d2a96957f42ff70172c1e1d7c45fb23725228695dc337f46b435ea398f97af9a0925c1b2011de2e9c7a2e442584069297f108668237807bf1d0b203c2eb3b980eb3fa1d2f0d03592b9801adafb607aae6a93c5c0de58507cf0df40d0491ec91aed545b07a316ff2e64dba88c5c0ca45db01734e44429e087f356a974a4dce41ddc6df30598b7eee54466bec6f98be6730c763f59dbfd94287ecfb929de6be68fc0bae40d29b70f86f3b7c052e01c551ede09a2e1d9815fa609d1046639286da09fc3e69521fdf25719f168f97e81dbb8261fef93d0effe7d37f350af822463cad1629ac6efca31630a7d4793bab2831ee8a49a26e8e01e8bfc6d10276a8d06ff1006adf045104ee76a5cd47524205952a0474b83e64bc36f6a2ed4ded7e2ceb01e6f7683814efb6111e7ff4c56f482388bf41cebc7111ae8e8cea7c49c3d60cf4785ebf2b29a8668e628bfef2b5ccdcd6cc452aa5f53498cb52cc682827f53dad66b50d2cfee3730ef44ccc6509efd54cae3b0a9e90423d0b97e025ea5e93b2a123d64c28f7d9dd530854ae4836f2c1558eeee470411416cd9c15d2bfd3c31a9a227adccf7bd28d00a18fd2e6adbe46b6600025af129b88bbd6e7323a027c1bbfa3ecb7dafda3d9b9eb42be0adfee81b3aa9172c4996046bb4ba6694524197c8805f82c4c3b49845c96326d8c34676ca1705fe00c8b094dfdde23e94f98959f5f812a28002bafa63ccd88aa92763dd8293dd2a701bd00ad4e6cd5670f5dbdadca1fa347526f18c22fed0e197a0e913b9b46f01ce1d74ad63bbaa2483103fd10564047b32fade14d25e373974093b602ed3612cf58eaa16684cb47e52158d36d16642270d119f9d62bdf882bf70ebd9422c73088eb82d21aa27013ff142f33474a09f26aa3fc66122f69da32bfedb1d3acc9fdbf82d05180ab112993616b8b97debf00c33c9f9405b7c14e722ce7c092e4cda68e0d7141eb233538a0b3f2480ce7c6f8f95f7f4ae8f9bb5d04dba326a6f74b59dd7c9f347c92613bebc95e1f72a184112098a998e2b2938855065233f02f7de35716cb144f553288a6d75746403331bb7e218f331ddcbcaf4ae8d24a8a48c422bbba64ea13114f95caa1c36745a00a7801aeafc7e41551ad7350294d54bc6073ed75975338879e4fb00e9b914f79b5b53d635df885ee740036145a4d8eb9c8f325d8b32b9d54e9a49a4e291709e3a598a985f1fc273b6f2c80a9e9367f110b1fe2194cb90b14bf80e03a9c5b662cbe405c435245db9ba96b891781e8fc1dc4704bb4f0fdee677d56f193d631720537918d30975778f642127877d3ca7a2176e2f1dccc0f10325d2b4cedf84ef1768bb11143dc6efb61effe2823273a7fbb1b11fc0865fb866f0c44c6a80a2275f0d6c5df051edf3c5477c77ec6f0e966ec822c51ed13f907e233a1b9ae786398076a4e7c78e5fe3b44876f02955118301ba24a9c8d913eed7b44d34118dd167ac6f5224c141a3294daa5b5303e0d1099964801bcdeba4b62078565e3f17494ee4d348e23845fa84693afb35f06d9075eed2647e2b94532c12f0bb1ae6f8287298cd7e3533de69eb5854dc2dec98820ddab271a9e7ae854ab1bd2d66b57df8d63364b8e53cec8cc703af68d9d599044f31a5eea7f082a4e2498d9f0427ff92c6c97ec4a0ab2a960491d9f2b6df4117bcf83913ddd142a979c2a7617729fa87488f90393dc9d88563d5eace1440cf17eaa29ef703ccfac035f2aeaa8ebcb68958d357b701985961957c9e48a96015846c050c6758f660772fc00496945bd5d5e80256db51d12584f4c5a8e140bbd9f43e46dd7539a5d80cf04386cc5b60cefb91b0a6a1d3da38c8071000458fcb01ccaf9190693919dc36eff446a2f0ac1963682d7e6548dd523828516460fd747c8ec627dadefce98d0161fa0b1425b7e7a4ea3b89457be283eca063d91b04dae341a9fb0c20a5fad18495011fdbabde506726c39743cee3976e2ad988ecb0f63124638f8c60cda69647567ad642178624164887dcb377f0b86fdd65e5e1a7e060bd81449efdb428fa9e6113d2a52a84e8844032bfae3acf904aa6cbef70328e220e6f7aa93c3861e303c09e9909a85794357a93ddbdf74758c1e2068baa422e6563a909bd2c4f3351a7a4066bf96960e5a93fdc8a93021f91c8b91034ad52b258bc8112bf1dec1b7b006dc3c6ce571608670cd36095ee06d15de495eb38709505973914f68f5f2836ef0fc0bb2071b11d3b15201a9df7ed0fe1217132588a4c5a326c2e028c1ac02c924878fe6ceb5a8445bd2a0f614af627ae6433c46b88b5a4f1c2b06ca4f5bb3dc65bbb2a3f3b30a7e64e6c57b3c7b9af5adf9a11a84ac6fd1afae7fd96561557043341ddb9eaaf7dfaab0ed9615d9066fecd750d463597372ce0aeef3a133b74123b8680899d250b1825f3503a47b3e739e9b40bce61a248a8b20fdd81ee8bd3e9f46cd046ccfaf3d9cd1e1b3ab05816934ffc491e9ce6b48e7af2d38c1ae4c6a199a256ec323093a4b46280d87bd55dfb3600de0f779f1b6d5d56f50f4e87c75a29a7b4336b04e1f47a519712c333c9c99f91f0aa93c38afb260f13c20af6e30ac1108d679db7521162245812ebc1710cb13873b277a494a8932cb0683cf54f17149ab5190663fd7b597eea516f60eae992f4d45fc51b10851e1c8db7c6fcf1106b863c6b22baf4237445d8aaa645929fbdd70d6f0c08cb7c9cd5a8c3ca9c5e4f188a7bc4b3f3d64fe00d39864b0a3945ef5d4b85e707f901d1f03649dc0e0080f9cb0bd3905e2407c91f909942e8efe5b84b1f0cd2e2d796c5e15b690a822e3d76a4cb868b294954fdd2ca1355a029bd3a14d6a466311d114de62d45d3dc68aaf6e061901bcac54c8c3e6b4b11d48164c3b5e5ca96cb38d8cd781bdffab558536e6b56f5ddff8642e06344e449925a17fe050be05a97977239ca7ad9dd8c38463b2b911a84cc6e71d165a567c8e9dce41d4ae34baeebefc9d5580eea6e13f693685419fa597a903139a1686ce3fc0d99ac0f89ce5e8907d44ef7eb44cee5ae748a6d9754d75e1cd5d57c7b7d06f49b1f375756e4322f5cd9b5d06e6dc09435308f3e2fa5a3ac2dbbeabad7a446140c14044f70b648074cbbf498a8073d51ba41414d83dba701b26ef8ecae5688171c934973e4e1055bc5deee3564aa600e3f9fb52389b3865842230efb10b10be7fab1072fee33bb87879c1f6dd6fa89b02c1f4ee43357d73bfade8a6f57342727b2b03f879df5fee6735637f7d7a48c5f9b6e3464af8c4df282e490d1846c20eaec2b0f1edba8363a2f508d3755e96fd57a75bdb50527909b921e84f9a8a5a37181db79c0d63571dec7a9b9015384cdbef11e72de074e13954ea459ea9a2181d51e4045293ace11105f7e0140f5606cb562ec58c53f50737f0330194e8ec95bbc7cc1675c9ac08a7a04e99c91076cb1f4964ac6b6bede6da3caa3da2e3adbfff43159cf64b153d9b019d9b47d7e1030d16f0590d77e7ad3d03767d50ddb6e001f91dcf3ce108575008af6c821fd3c0c71e062f970630b4099475cb98477b94a6f7c794f36c88ddfa134c4dd4b853c4bfe6de82935a83762939fcf5293beb88f40dab77610317758624cd07087022fe8c73db6156f6b96fd00233e4508524e2fdf96e365d83f8b34e6e72eaa9800fea03447935d7096d7d5d6c3148bc9645ac725cbd1c772156f587e0c1546ec9b2df6ea5f7b6a7789a89790a295ec02349d5b931826fa456c6d6b80f471a959f14ae3558197d66728a85c8575c1345e28430aa7696c812f64aca2545cea87c11ba5ebab11daec77acce2bb5045348ece309f77d86bc2f8e2a12b1659a70316bff907a96569653621ba8978411a1d4664f7e828732d4e03e87821bce8659271ce7d914dbec5a5c5e7f9cedd9a3403d8142d77a92ef3b34d3b9f07618c57cd35871840b831470e2ec9649e79e4fdef0dd545afc464b230f25b413c44d0f01a540abbf48faaea4230115a000f9eec4cb335d109081210e861450b0f9833319528c5f76f647aa615551b127496a4fd86c9a7dd9f937897e0dc84f11205c83a145a824ac07ee9af491c4b75c72ef223388657b675a477aa206ba16a89c8d3cf3cc451c5b2f775fa2f3d3298c7cc7b96a355d6da1ea53dd58ac69fcf64ccced365b80bbb27620a31d5cc184d55d4900369bdaf0eeb3477cf481df7213e19f880e383b016de759d9bae40fb910d7a5f4365ee3a0f143e516e2ed6d86c228046045f5ed3a4a93164c909599ea420c94e758217bd16b6473d303f58234119a52965ac885360d79481900da541c2a1a8a2393e4d160ca0f3e51ee8a7f499f42839921e46b6f8a93d777208f371ae09cf02f5ca282c5679bc5eec4186c98bc65d3110449ab0b372c98f5930bd95c1f9b59fb06dc33fc94ee651a98ccd3f8346082ae5643b4a863bcda8b4876058f0b524e0c792ab6e0f86e9a19c64b9a90942f104a32bd71f50243edd8a5e9aba6532487fe4e4f0dbf6fc05e54cc45071422bde87052a0ede9421f5de0e237882e0a0b8130ea08c90606ab922e22806c54eef9ec5f69092dc54fe537caed90223e7e8b8411d203c7a629ffb06d6834ce4aa53ffa3ecda35f1b353b349b2cbd5022c2cb8d44481b1f8b188142bd5d72f9011492bba45f7609b5c4ef55fc729d8782171e1c99ab8b59455bfcf415f8d677fadc78578787c36b266740d4f7a74fe8006b9d9237e544b2cd63517d548324166867108a2ac25e0843d796654a6a7f9c4cdbfb579fbdd16f95301b7cb0ebda5f7d0cab658f503927c3fe17fdf892a7c5f3521221b5f7584ca53e892f75f8196e7948b752a59853762ea55c79eb7ac9253f3b928d1e91dd00e475f31aed68a97d055d44a12ff2144f36309548ef94ef2a508a7277f75d32891949529d09feaefc071211876ea7d402717765f271b6192a36671bad9b7205ae2710de3bdd28a6bd661fce1298d76212acd51a6f6bd1b6b12ee4216f8834225d2bb70d6fbd15bd8250503d43c85c6e56d3ec824a3fc8abac0cd6c59c484038c10b10501688b21bda00b22519751f84989d965e933d78e67521174f893dbab7ad679abf07a669b740eeaac891b4b5cf73fa8e99965b65be63886abfd3b1a6273a21eabe85c5285b203a3ef2e3136f127998c59f265d696161e22150c5b0b561f5bbb780fb809f90aef2753102cc56a17cf383d78515906bf31dbc738a6fc5f27abec110c30a5b4bb4d2e3e953eb5845f79c26190c90e7de36d40703f6f5b23323ead7bdfbef4e2e0801e19de27ac3b8ae0d7f3a34546777ff5260f604c59da9aacf4544f3264c78ff8612ee65637204479354771a78d2199ba6e16b0d3719c775099631e50298456128d07ce565eade45d4984f6d606aaa4f399772acea9c67aaf745d48ae996bd9e4a050f5199100cea5f921b3332378b929809adfc68885350e4742307fe3e5ba1551a549ef67ece2d48b56b1d693eb3d26151ddfaab0e52d87c30819c85b461a3ba8f6ebc88e2813d579f499afc263e52f5539f3e0b25463475683ab390af48011845bf6f31f850904219253182a2db844b128a9c11f203c094bad0a33e4c95e90b053e4d2b7346e0c77c808b4eec1b83b77e8679ff9b445d50d3bc10ccd48d7835559530be73dbe1675b0ae6cdff890e07b5777fd7d57c724c42f84c2d93f3cffeb22a7bb7aababe1f1057c486eb55a594812f7d8a3152c1441de75cdd60f544931f80c653168f4d3731cca5f60907342e4b116d5df36a91d83aa10a3d36d10ee33ff7991e42453fd5eea12e74f63b598b71f184cb01c3c1770b8b72cd4e45fbd3c935e18d057f09649f91a3569323e3539c2766771cb810abb801b22049a4ab6282f0b2c92fe16f4b301fd50a0272b4c385a624f868aa0ac69a9e057a22e0ca94fd18a631343d9419b174cf0e69e027ede8489eace315814171f572f0e7f1d0bb724f2ce3ee6dba58f7b423faf8b5527dea3ca3dc502a4f4dd4d1943e873377f978ed84836740df1ad96c894a40cc4d3797d09cf523fb796359ce4c3efea1c6a141ab5ef24074be8f158dabea87104439ed5730a59443415e747a1129cd5aa0e10b9b81c436c0c8469b1a889700903588514e6fd4f67aa98146fe0387ca78a728da91478be95753abc8358cf634e694db32c686005a4c8f36c853de5d084be522574f5df8870641f42ab970e08618b9a620ee8344a606d0305401a45f478da9b3c3364199155b9039eb81d272cf3b0881098295899b9c32a111de7c4241d178ebf36359c216b543397f4cf6839278b40f030df7ef34cafa59ea6bf32efb938631a24f1eaf2404d43dacb230cf2dc6fa08f62a833f2be142e0b8b532051262bf72e42af41e8acab4513db23c6292768a34249f7bfc98099dfd4a1c004b43cdbd83e889c4e79f25c97ef9732d91121f5e49f3fd87edb92836e4020aac4e181f0e18cd9c5e6de917f0ec55edfe3a4c02e873da4a67af97b99ccf286c48c1fe887c197a69f153c704672409b0b9c7bbeb257f3ae79d7f7bc7983f16587636a42358a9a4099e32a72b616a9a488822367ecdecea2255945e7a17e6890b4faad27ba9b6e0e242b1ad43313feb0900d4e31ae17db30d3bd0d24478792d9c4755fa9594b6d5d73979a9bd5eb87df55139c7e1ae7c93207960ddd9a1f83e517bfef394b6eeee43deccd2220b28d370c6870d7502ae5f86617a2c2d80943dc692fd7eb6dde93a6f721d1bd569d54f8af2e15587a81258ec2bc54555330159339e708e7dbe5f88252d093a2d08267e38710f11069b1032d8c984ea72c799ba55c840d7ac254b50a194e898514f22138792900ef7485588eb996a03ad8f9c826103c0bfae2a63c4a1ff1c51244c519ed5743dbc0f3f595b5746dd688d56717194722abc04a984bc9fdfd22db7451dd6863ebc45f75b9928d4a423175fa8aef8f1978c55f62d837e2cbcfbef97f029d3631b9ad19776438255190064dc64e01b57e54d48783782114e49567b46302ef4abb49cccf04c3aa55be28d18a9feb047a4084d7057b8617ff73a8a902379f667472ac9782b7a99309a2f766e05d305d56b984222ba282f1046f74f154083fb0e7a24ebf1ea2420695770cc46ad08eed41fb2e01022c31b825aa5e4625d665cec698715fac769e4d838274dd61bd86162bdb2b6179d6a3bba10b9b4cec53ad563f9f21b0122fd97f77a0997225a9c42fbcd18eaba5ddca1d960bc9336b5d74b8c23f1be1cf26905ebd2afab0e6e24b92c68660bbb7ea2568eebb6caac0298f48b75f0e8a0fc39b90bbd5d6f67a515ee52e5bac2640876d93440121b91456b15ca469468ea822058661c72100702753d465b699ec8a104f93c7684008051e7071c4ba4f34630a4aac318b0c019191160d0ef9e3c892ee79037d5271dc7b90c8ea58cc1855c8f7c315f41834adde964997fd8a17611cae9c1bef38e8306a0acf4c4c3a7a8dd7732e59dc0ea32b90d502faaa2b1d222f4c256f718b49316b1994a9a588984559ad83ffeea541bbd05c256a89d612a8a701e2d26ec70ae5394c977f91b8a6273498d5331687953ccdfe230ed3183df447936cd07bc7bb1cf3bb109180cff144779972b321689f206f7ae1434a434927c211d9b34b2d8caeba6a84583d19b3e3d10807f9542bad66a618aee5994d60794d58fa86d00d9dc9999938959d83a8ff92c821a208e3810fea04e4dc490a714fa71eb195da625ecf18e2e27af5e119aa8d89aaa38f9c6c5a33659b1aef6b0fdacbd5be97de0c37dd432f5cd54c255b120fea6f40810c348232840f9dd176336c06fce2a24a49a8b469eccc5dd7bca4e146660ec9df42c7222ce8d07c962f18dcfeb1fb77a9441565d95caaf1fb427728fe36024a657cb8b35a0dedd180e059cbe0ef562a6b332fd3b546f60cdb1a447d37e96b84ae3b524821e910d2331bdac074e1928619aae74b85bc10c2c9617eb08922077910e1f1a4f4d63d52fb5988b55d9e062e9e24bf149bd1ca30c9bf67a200918c7afaec820a03ca28d18d973e2169078d80e261fab8c3916618d755fae7821f986f3a987bb392631c753c53cc6957f9bb3aa31ffeb8e7536d288718dd14bb0fc396ab2dd4db868696daba1ccbba0fa2cbb4fb879f3a0ac2f0a6e58400317482bcd1f1996d8fb378f5725f7ac714c3fbd4e23a5fc5505b9d679aad73fad7e72b19d6a614321d4cca48986b58333a4fba3d70857c15f42c1c208c654718b586989f554c23e849551cfd9749375868279902875a5b80006167c26630609d67cfab3e5885d29eca62d60d546bb997901b6af2bf7fd0a5b4fc00efdd9ccb30b507214cdf891578b898ca4d7426332abbe59ccf9adeb08331919a07b01863dd095cae3e5854f623fcabf36e24440b9124ea170134995e225debe9556de40d8569ac493b9aa5605cce68a4cab70fd9554469c2781829367e1ad964cfc9a34ac1bac455b7fc0055ac2788a90b0054267885c6b52708241ed57d3ba28e1677eb45d6ed3e0517acceb85893476a643ba312d65f72c96bff1b30934298ae48d98df2bc9433cd0cd415229648657515ba62b8f0eab5d01f899e460daa0d369292e2140409f980d5e0c495725fae3972e98e0ef5e41035ffd2a57a39979d567be71d093bacbee6c1c46d60f3be0356454e8664e847be70fefc3876a697558e7aee33d7a006defa7f5f60b531373b7cc0746eade83d7e185e9786131f8915455e1d4d4e097cc5d924471e8c28b24dd7004a413bbb9da4a83ddc1db8937ec7e720ae94e077b373b66bbedde45b138fa1ce826a1248c013ef245764f273d187a9735c08ba585f96b5f6945059f9ed1764c67106b186ee230f8a2ebcad39ccb01ce336ccffcd0e6e4513dae9a824aad2732a37733118dc368d62b8aa11daf5cc8381b64d0f56d7dc7536c559f0d05eee783a0fc32dd311de16e57c7c546ad1a816d434cc6f9c5e3d031e8a97dbf52848a0b82c2cdb0372cad32c664f8e19053b6e2590a90fa3b0609bbe3b59fb89baf902b445be6239c95c8c966d355e60c44d61e09922dd9a6143ef316304ba9f79e633a8d1b8356d71de9a062e360d8e4bbb507d5f0b1fd8aa11fb56aa4a13d993814ef6edb42d34ab184c09b98015d920852c408edc9c4bac0db051f09ecd07a01824f4b2a3ab438e317f2268de92fbf85c93cb3f962cb016bc9d3afdd13677da6aa750057c66511246283a5d8ba3bf24619bf4afcd19fd0c1dcf2a8bdb0e798d57438ad8446bfc6c59c657aaafdd8eb092f6cd21a313ed3a47058dbdb1f7f2c7f45d2e6860ed70c5af0aa3e20738d5873d2b98e82177b2aaabb2643e5237e3807c5ddae6598eeae59cc7f2df5b35b6efd7e70a347d4e453c0b872f8932ebe135ac1dd330d166e8667f8fd36298522dbce3cd2d530743495693f7c51fb73deea6decdeb497a30c3d55e123da6cbfd0ca6211ed512152abbf3043d7912ea11f58e4a27484857c223009d444a29baf9bc2198a82268f0f5d82e20348266fe1812949a789d6bdbce96bd954c30f9cb3532e1bb0b9b749aecffd8ddadd6e2b8e2e4ccaed1b571e8717b3f37a5be5b5cbe8133f8a8e8042d7483070409d7bcb62e8e65dfd39e619ed62df99185f75aa0da209c30dea175150690a819b98ac746c5c206ed5f1099ecb8fcc98b00089538a16ccac87ab024b5aa0c182db5c502c1b8439dbf0a6911d47aadcfe156830983a531487fe3a1df7cb7cc2bd410cb5a1f693e234be1bed19ba57de6c551e9c03e613621cfbdb6643a10f4844169216bf2e70ec846da175137a34a77d80c244dcd93d749370489a06e1364ad302280e40072899028cd13ac22ae2acdaef51aeb8894eb1ebadaed8f3a28493c7386b038c169e6d184cf896f1ba199909cc98e553c7d5c83de2795e593cdfd87ecb19bce7eb2437dd2b6b7ac8219aa595527fc434f5d65d083715a85feff58267e8b5264abd731bca4471f25a3ca94458c4d00e9d8ea6a437987a9c6d9bce311a3640f22437909f9aa0a8a780e80ef680c33a2e916aac8ebcadf4a1e414a484dcc48750aabc4966d904e7771bb2f4041534429db37eedef1f015bf672a51ba92bd8315c77f5da680c9177e2e9d92c88335e281b6346acd8a62bbd5c3fd6b9c99e09945c63d684a24ab3cb4ae1b78711b75dd60f2b8b15ecc6798acee4f32aeb94b6539c7e532c09405ba597b0d12f7441c005d68552f3cfad49249497bf64eb7f0c73c7d7a651a00006b6780fe1b6ba42e0e3ab6fda367b527ab974928dec1eaad40d074585b42fa1befd82078765f967f0d2af16926d73014e3c2dfefe8c08eff4d08f8689db81c92be805a33536080c1a25e8a284efc3d09eee43fbed532d530b297f4f817bb16498d2e111b3ab3acb93e927a08c91c332807db618ea3aa3ceb7d2b84e91cfc6ebe7fa00f98aee6b894e0d0d0f9b81cf5172dad46b52ea83d499dd2fc11561638d69756ef0571b313caac38b4d39be9faaa132fe2c3dbcdae673892bcd8a26fdb58689e0b5489c803fb484a92ca67184ba60d2ea8e8bd29d095fb1d20741b740c30d3f09ec3841e40c2f0cebea25fcbc8516ab0bfcde1dc5bb217d62bd927f7c354d00c667ef4d58d7fc41ca7ce11ec2e46f018bdee733c99b929a3824930c1bc94c75fadab9cfda7481a6ef3aa78fa53a543f20241963f4dd997ce7e25c00a80766c5b54494732ff1e7291471c244c5afe009934f4d006bc79ba640e9ee699b1473def8e5505649ebd47d882e64f9f6a20a0b35aefe671ee6eef7d29016c87e2f419a431e514423a5b8c2a653b6b01e856891e4015fc4b1c13053b4036d5e50d0bd6352c22f351a94806538609eb8d828b3b9c2bab075d7993a2c884002a32a443d375109bf0cf18ae6a18fbfa854852f373af22a9c7a94881e9611a3326d5e544622d6733f9a70b8402c920e0162c992088603b788c35f7528b42ec0d8407a03305e25ff1a5ab6d5fed99b4d70cbc7ca685bd2677c3afd085fb1b1c2184050063f9b5486dadd9369752476cc4bd8ce77f8c96c0607151ea1118c83bf66969b712c62e89235da84c7f89932cabc2e1f9e75d28268402069a60b30b607c5558d546433a2df446874f693324af96da4038c487d20d2ee74b93ab98b302cd52f6eb456e5f3a7f7718ff970c9f02ca7d952fe9197e6537dac37f1edabef42071d30d6ab36796adda3cfcc618d41a7e3a3992f5bba941f5ac8fc4d5379884c09400df7fdc20544805542c0e4791402d6144a0b13e4bab1c8f1606204d6643d7824d64a80054dd28852c6c64b0c83e17c5908d1af6c628984227a1614d18f00e1daeb0611d3908232db7c5ef281bf2866df0563678bda62075e368194a7e33716ac9f7855f0f3a2d51b367050b870b412b555bfc1ee97ce4c93cc973e8e86deb0e2a5e9a5c2403a1c703bda27050d17712a13a59609e98af94706a8719d1224378cb5bb6675b1248d332dec661e1bb778e1bf59aa701ed809fee5950d6be5fd40bb5eaae884fd7666f6a8fbc57b412feea02a92c89bee16d303db2fd4a06e5e34a8612ffea7ecb7db29723b88efe77de7179420207d17384991e5c124982d78488011db1f92d2a26e37b6136e00104d5452059855844a7346d1e0ae686dbe304868354e0416879ad510b6ad49d5953535120d4a099f9f3d0a1812d06c0f4c80d00a31951d67ce39ad9d0511155d8fe22871c884aa553e4983e7f6d01d3260e431e2950da004e283080182f1c4c054b340a40dcd952421ebc8e6531f93e0761db558d22935958882f113508477d51dd118b731efa4f5e2b7e828f399247943d1c602b43674164e62fd134101e7270d9b15864ca07724b3bca8f078a916ee3b4bdd235449ae2edb3b668ebc27c54dba944dbd394b510920f129875b7d4a471a5702a8ed1fd1afb451be3fc34dba0fa14b5330634d5d66bf2a75d66eb9740d050caa720b2249601fb70e006330888a9aac4ae3c24d6c42c773a4d784bc9f6eb8f15cf22af725c0edbce0022c93ab3a0721a872b802dcdf4beed56ef5550d5ea735288d4e5378ed80356d7b998cb091e098c922cb45bc2e57c8eb4a39378e6d1f69a4f744cbd36db4c807cf50f8998c2c5560233be7fa7a6cf3e8a1d0d99299815725c0320b687d264c2350e691b781d5ec89fafdd5da1405a05ba5eee1ce5e09e7c6ec6763ea9435d3af0121939de6e05ef680f14ca378ed66e9622677321b697cbff8883e65b55af56b2558650f156bfa9d44fc122864ab00936bc3decf8b8a4ba6d056a30700098eca58ab5a60282bc137c9ec9bed6316d96f9b038687cce47c8ab926fbb527431fced6feb24746750f99bbe4d88b76c853d066487f0305d3ec6f92a5902fbfb189b0de432cd53a519ced8745ecaf85f968409367e5d311f244007a51d2516e31939db7a1b4687db265519d98008e12a81e723a3074208eb085c607e60eff1751135c1ff9a0e4bb287dc9639f6da225f148c8072535b59598c62f6f6ea1bd732bb2ec3e0c499026b07ef98170b89101d24f1ffadb1879e3ece312c15cc11798f2d4e682c723716f9d626bb5c03339a2f2f01e5a6469d4b71f66d2371a120461ac4ad94e232875f02a854353b0b49b4a14815fd12f5eec4e27e8694dee9712961ad8b3d91be2cd05ae50df2b0f8351c141f9964a94ac4ecd6819e1d56c84f9a20e891f43245eda0facc77871ccf7dbdbf66e9e0e3307022223ac95d22d078dd65c9d46b4795e263c53aa666baeaeba35af554fa57a67b158f78504a51c1c3424a4c06c996acb1a69c627f54becaf1d83c3b22f1d58190fe6a9a761518b35fd9cd1fac48fca34bfdce95f4839bb8e660d444e8b9907151ffde488d78f79b3afdd0f0ce1e39b8bff859d7d094d2f3b6ac61ff297bbfb8651c32d36bce7a39f93b647ac5603efc89bbcf66ae15ac5ee61f9205fce00197935ea38e1c76b89920c49d48bb535430bb2e71e6c1cfea1144503a5f510652e4bc8771b152a755e00d02b0a96296f6d6b531460ed45272461a340cb98778bd178e01aac029f844d0c07bcb4e49ef07443d754de0248c70625c3d6ad14255fea71f5c36fe5e7f9f6276c3cfa51b1c3aa87ea5977c97dd4b6b258aa84d2d0e6ed7c97f5a83c7782b380f516b67944029a77dfa2ad91a9a1e5ae95ccf81abab655fa49e665c6c65d225bd6d1e218e8e18fa44104705e59d46a8980dd72e452f4055512480e377356cd5b1bc9a20cd84cff98b29ab2d3f50f660b12f195c7437679a7127c82579f21d10a04810aa1bafc760ec3043987225b96551c69bcbdc7bd1c025a68400eb16af3bd964e2d1dbc0773b0faa94fbc5891798a96274fd71859dbef28e873dbdaf2f14a1041c5af211f9c37a6c73f52e5c67f480d3fc59a52cd4df9dbaac29faac7a0de70415e8cf73c1b041bb85a2d952d6066e94844fecfe153a9e9c2352cd841571668a0c4403565171525d4be9868edf30021abb4292b561cc1eabaaec532840fa8bd0e7a8469d315654d9ad0c60447048d34153ad377444f531ec36313364ec7a67da2a67585957ed6194d2ed3914a71f2e42be8b5213611efa6ce9fefa57b95cb19120df7fd0a9256f609a1c09a2ca4fad95f673cc2bf040b7e3f2e56854aefb8b58557603634767b09536954e82639953ab614c66b88d2aa9e7d998506898bae0707b3c7e8ebaa901e8de9c96c4d768e5aaa7382a984931d89ca6f435b0e0fdf362ccee4762732e31fede11c6ba45ac31949a2d7d8c44a2aab429831c88430d7bfcd60ac9fcc4fcc5a867557aa90e18774399fb441653ff93a26f5dd790ab1aeb623ea504315f59e31e347413a43bc271d9d3a139accd036e1607f716291709794e6157de0c8f2dfa7ba12bdac8b09528a59a1e410c2bf4b1ec1f4839c08041b068dfa438bbc23cf6087cecd50034b4b326fc231210e2a73c09f53d9c2f8614ab2ac533583d8ca4d62e3cfcbe15a1abbb0638a90cf84ec4b6e7f4b3d6d301183e80e4ccc11578b53b9193f48d1adb7f3c7f1a53c0d104f2b478f254f4cab49f2b2eb90f69113b0fb0c2f30a5ad59b9b74c6bc9db699713760457d6743d02004399864f41de0b90b6908ed7f59b4d6425bdbbe4560ed04e8080181cc5002c8e881b363a40b9817ad30394eaf2b9a688131a14a8c86746330bcdf2ab1e3b81a048ca24bb15288fb173f0af7ae18e04e00cb0dcd23ea62fe9240f9f883b719786484cd37370a82ef50e341978077e9a653c0569e9b9ab9a126aa95c8dcf19436c5ec37bbb275a038d3139d577f336f055a84971c46d01771e3f6c4b25bf894e9c0f2fde2e0e8963b158b03066ec7d803a7bfbba8d4d2f6a052f87b3ffd131077edfa13a8be248c889f63a993280cd92b14823cdab678b292fba73610ea0157807e09c772885b2218be5851e1ae70ba704b09a64aa133c83bd42080390ddc992ddb5decaeaf9c3c86c400017515823d76b70b99c8112d80ad7ba8f9f934ccc4745d450bc3f74983bb2079acfceb9118a3c7d626e6e9de56ad50839c678651582f992f3d0d06442ccf0c023fd245ef0dc21c9506a11dc541cf3d211261128fd5ae91b12883ca59020f2b063a64bdf2fe606228a5e535f91acf3703f98c0c7e3df71297a475e49510e424805394adbdb7b730ea10e861eee08eee8df5047205489c9e37ac2db9bede439ccf0b8be990f46911908b53c1871aa0780a978393cdcb687801c6a078cf9666652d450169758a4234908ea41e5e793c104b56ba497295a41aedb263c044e54323080559f102f90803f43261754b4ac79d436402a73a30456e3a381043c5b3e7cc1354789cac572aa344dad2aa5113e72d2a1626572c913028e1489accca9608986362b3f28b164a1139c2fd02f664b1f2eb16d639d74bb50e71374d01c5b3f548fd2c487148a96eb921045b44f28eb6263689860569e0d4c956f2a2e8c5fc9d20bc0adcdfbd28c0dfee49062245394b8d104c6ca167269a00ce1546142c130122a8158f5327eed8e78cfda453c7178e593b5206a68a07cd53da782d99a2cecd9ccc10e9b40c3c6ed00c3707f93eff2554a81ab6dec24ecb132602af6a66885cacccb907e284c35ab4d51dca96b4752545fbd9f45ea42c72c86f990c92db3c39a8971b2ae66b8a921c50787b1862843b5cab34ddd5721040135472cb348a8819a69f1836d214d9dbb16c8d78c7aa650480ffa32a05880ac2ccf5f7231ce492b91b223a9415fe8ea1688d4b5b89fa35666ec90cc66ce8c107899b22f4e16bb850be0aa73b89a45115d96e6c65608badd56a1817458421d4e136d63d33b0ef99934b826b82d51be360a1c9b6476d4c4d6283f8200c944321a9403292ff8892783b4efd53e33dfcfb9cdb76fdad93b323c99fcd85eb1b7e738b206225187edda0b9fc6c6e6b0de4ab006e3deaa52b15f4c9c3d5b01e2e4d97596533b33b8c2900e10504c6927a87d01253bd7739602fe7c53222d7ed23f7dd74bbd7ec99cd2912d7582e2aac60c20b530f1e8590fbefbcef3ac83a88b48308026fba481934619229d6c4204fde0fa75e81dc595f58735e7d865efe01509b73095da98529e8e62ec3306d02768b74bb9c0491bfda0acbb0f9df7d3286cd4ba7aa79ffee8127384d891700a1f3e704ba9e7eb578df92305722052d37989f38b8cb3cf7b2b0205825c0a28f1053622f1d160525179780f8d8021d0481e48dc93773a67bda1b75040dae972d33909b016159c70be62ce4603f071f90fae9a765770d9bad22654d9ecb223c67f8922049e1fc991015211eabe417cca9e00fcdb7d13c8b83d4af7f4f88917ac91725eda56a35e950ecb7d5584410a15f7c6a2ed20b275db6de2edf9e9d68f9c647c4fd465e7287a997890337dafe2c8f142e2a5a0cb1af1b79cfd6ad739aff36bf6eef297c3c65c22999b94467ac110695bb6acbe97251f7539f2d6d034c02f9fd5973c2c3ab89f14ba7a04e963659ada98457fd9139e623a16e63435748a0088188f45b3794f0c2b584782266d0e68d89553e9060a43f0011cb4c4346085cce5a3069bf60389985b418caa09e3efe21a6e35cb8929917dd602324767c53a98998be17aa7da1fec339e4998ca1464f973dffa47b4529474a96e9f728050a9382c39d47ae81ea6a1d723cfddf41b493e1d50c9a4b3be69350df04213b409871a1d957b897afc941e61e66c01802bf2409dcbd7dc3feb142fac05eea5736f098c008137b8f98a7c8a2f8f85c27b82980e0e5e2c3c33528f9494d86ef09cd9932545e3b9839648ff8197b2ee30969ef0880d22a04d1b25e6d50f04fc0a5f529baed3f935d5d2b90d1aaeb2cdd1ee30b8ec655a775ab00d13fd1d5ec02bceb6f51b4742fce40d3db95bfd17453e1218a499aaae38b0347facb8e239480e1ced751f8cff40e967a7f7fe6c0e8c5fbe1a3e5ae914ab41161b4af19334016c84a26824bdea5e5523e3bfce03f8797106903d57a8370d20bb2dde7a8ad2a58509d08cc632adace70ab33b874a6b0b6b6f2682d5cd2e32500e43a6b632bf72da3ef5afd06cc98f399ee8e4a717188567c21b4f4cf870e71fc26f9e5bc7b5d1cac39cf7b334ef18887a455ee5923010e856a1e8e5a2472e690305969687af00791a954a56c668b9819e1e009ca2755ea125ca9d08d9f0c735791bde9d332ba681462e0f20510c1554affd1131cc5aa3625d5298decd9c7c6d725ef9471660db106e3cd4743d110c125a0d4bea2aaeff4c35621b63a602a75cebf413fa7491e269f274a0b1b084de9cf68cb9e0f5b65785eb144d827dc8cdb9dec5b178b00778786ea026399c47ea58b2bcbaaa0d2d4f64648a334231c359a32e7fe8ea8032d902d1b4d1c6280d83bd722ac63a07257d7f1b5dd5697aaba87f35fce859bfae090b84a5393ae6bf671348493c7a5b23db7bef68d9dc4f5949407eba0aba02fdaa314d7081c89c98ad8cd6e212cf3e991a7accd3cc4edbd84016e9a02cce62c4d769d84c64c9b08e648b3555240e5cf793f4b7378a4de2110842316a4ee55c299e6ad64510c126a58d870a5248581295ef5cfdb4dbadb7a4b7331d7eb71b7b2c72238888a2330aa932e91821c7388ab010cf2d1acca4485fb9346f6981c1ce7a6400d7345358c9417cc463413ed19b92867b054e0a0f01a6714c214512acb331a2ee26a654c5f3a401eb7121dd5a90d50c8c5b1c78d3e6eb81e70ca984de8d33172eea8a05778e13dd57edfb6410a1914b9ac9d27e485f54886ad260a175364180d9f1b309024b3e794f2eafa4ceab5cd0e6a8ca30ea768bf7b2ed5809ab259b8d8339b30a7f8ac94ff95bae7c3cbdfcb1ed2627e5bab221bdd93bd6355fa51788976c87a96214e1e1f54f85498ab4bda8e4deb4fa51ecade180ff9ed6114b31d76e398728092d53800f6880a62590d18c74af0a8bd4a0e2cbb4f6fef0f24a4481c3d4e7af2b5c57c6dce63a54562d66334bc90c5f45702b0af314777f5aa198be868c77d78c5ee40ea6d1950b6269ddc56f6f3f7bc10700941fac81714e19a1d28c54d0884073d14c7c4cb88d361704a1ece682a4fb3b4624b36d2d19e56967c01fbde67a8429a4a383d25c9b4918ffcc97019c4a9b7c328705d544d5e5a3a62d0a6bb74b7f05e7be61a4ea3ea7716bd456549d98e1da35ecc6a7df6a7816555bb7edc0d86057311cff827d0b5dc5af0ea9d3db9bea7f6443e87930b9989f9e12e713fb2b2f27fb9351e849613ba0f95fc8cfa553abe8da986126f304b2feca1fabc7bf8e842449888ce374b73bb348c3c9f5390c1647ccc4d53b793d3735edfe3acf297b26590639236d6dc52223e0273ddd854a3102652081d36b7e0761c17e43db36a8e2b4cacea65f9ecfa77f94742cc6140831bf4aaab3c3dbef03b96d6cb362416faf6f4277e2434f69889b9fa7d0859699486ba81bde6150d564cb97cef7807394df89429298228e956a6c67415f418e0365a1bd8a0ad183d2384b2a9b62a36e377b5df72e124b10af420d974bbc2184d16af09a579873bafdc4720aec110599f1b54e5e1baed9f127763a1644dab0bea8ffd3a0102650e8276ec2c64c836edcea0ea719b133e6d13edd0f730cdd06fb651f999b6c34976fa03663f8c1a506004b23f70a079928b532b8d00bdacd11062d69a21f680c3c5b46868128f6125bf1ac282b802eb13cec12ecce766e3c68ca87c96a43b6a02881677e22580540511f92dbd1784e421c183fd6d4bc345b356a805104657c6382dad9035c246ff82a141a62e45a226f14dd13963108a986f3a3e1212d716019aab2406d617e4a476a5f920969b175454860ad92099b5229d0f4577c334ed3d335285749a4437dd90b0fafbbd403c0ceb42d7d3b4a9743bf081cab3dc3803dec7a8a0d126e9921ec70a81d2173349f66509e0e90c96e90fb9a25dd2f147fab9223e35dddc23453f3aa2115a5d304bd410cfcfb49f24ccb003ef22693298e54a33a0e5b8bd41a213a000cb8f8d228e7c8902894315f3f8d38c78baf579373d5efd54120397f800e372712bdb7c84d772952fbde42ab57464503bbc380a64f41e990226a9600e70299a25db27544485e502639aec90762f68ad0b702fb279e3943e8f31dba62901ea712fc0e29720aa509088d255ca3a60e7172bc53f453db0f2d590f2fd167861fa62aae19a67d18bd8ae5303d410f6d3798752412eed7179cc8183e61596d58977823cc3e79313d4e84372d2821f359fd87c3b7054d126b727bbc87da2697317b9b8effb024d24a1e82dc5f2371f5a43e1dca4ff25b3311dade10f9431e283480638ff7f7c25797c3b5289d1441a6e0248e969c8bf56eb51c8579d998c468ce470373e2b21a0763d5945c829a45730e48c3d8af305103e324a4ca421e3fa2b70d9ee723898ac892c46a77c02b898c1b4a66688b94daac45d1e8e4541c7563b1bfba48ceb75b94d2ada3a83d81ae160b2e6c97d14c37d6dbe967cfb036cdec09e4353c83e2e2533b8dea42d95adf982c97452e6432695c8c31e4da49b60a60ed9f579b8cfdd49bf5102044099550801776c5880601ca7541c60f9721ca0dc66e4809b32281943378841a274aa19d72249a62b52a7b98442d0c623ef855eebc45679886bbbca49d4ae1c81a2110c27ea55303fc61beebe3f33ee093095c187b4fbf89994b3facd3f22684fbc56eb50955997c975aa36bc46f886518212aeb5115909f3e2d1ccedca21bedc5f18dea730b29aaec7ad3803b0b3a728acc7c14b0223690b849f81a263413deb9894c619c1cc53f183375a0b7cb809a8fba364a9218a26c4847a37c9fc06758d2ad1621279f53aca5be20e5af7be1149a775a29836bc921cfb1a3b306ac2cf894bcdc96495992c3a0d15685f5e1eb130668736f6cb64728ba52ed8b53a258ded318c08bdbf266181a23477e4846f36441e003bc743aa2d34bfb79733bee40befaa1d7576c5ff80d7e8d2bed43ea887ab714d85732ee3ec70bf366fd8075acaba26f1f85e2f308fd428e06f1ceb82396e2a39da57c66c24aa43995c4f861a394c2d8b9b0ae19ca8dc8c643c230b79d03d3576f994224eaea8188579cde3bdd5ab1d166095bd5818f8cb292091fcf142ba97bbd72b4ff497d4f0961d033e8c3453b4f15eb5052c8a27c420d4334892492b005e6cff81828e0274f3265b782b273bde6c95630e036ee66ca15ce965506b50e408372ad17e95f55ccf9a332ac58a96a60b5d1328f95463af535b0473c3a9671d1cdc20cb4aca5b441ec2ed2719c4400e82ef762dc43c763c789c4835ed3ce8c75112e21dbd5468d6dd3c3968d6343cfb0b9fbb52213e19a51cf65d259506ef0afbc77762748817b5040d285584517752e32c2a7cf88f50ba3de92a90f718c62f06655fefdc834dc37ae3618b83d084df4db9a4b097087bb0b17c51b071ccf442ad0d802d0828dfd96b0235998e8974bd864335a48c10800b8bff27e75b49d730a5c81ddf75be6dcf7e089d8f1364fdccde1d44599e06014db8da51ab423c7fa761f0096266b62c1d47666cb58b166f0b95f01b1a6337e2575cbe7981dcd9ae1442147331e2d0937cdf2d9d3fc36ce8a99ccab44f3a9492e37f3034e49af3e3315ed4ebebb4c157f5cab17ca2542a6cf2e66d8ba1d0c950c8a2dc1ca1a6352641ebb0241022be8dcafb604f167c1c3283afaba78c29251a3a7722210fce9c0343cb68c3ede6bd6c0573b79581b3b8c891fd5b326486f464559556690e438ecc6ed4405aff5db7a85b9f2cdde2e0e7611f89d22452cb72a2e830d23ef14789baf5b5bd04df40c330ec24e4814c4cca2a1e75bcf989ade8d1f7ffb7875bd0730b592a2de0236328a6ce13b5d989a0ecba9031de53b588a79e769a20a72c4e9d8db6b2faf906863a35fcb907ee8aa1dd63e73e5eb3ae4426f0e40609cccc47db4dc92628426dd3e27b33cd4cae4521b208f4eb2023370e77df01d1514f19202e6286c9eec891758603daa501f287bea448fc43067a4bf18ed0f3225e7a9d7272d714408253cd84188e968789a7e8dc0339200807077c1d9e56001137f083f276c6fc241858531d226b01ff70bbf1133f0e58592282ca7591dbf74b7cdf59db86b963f38e68766cf3e4fd5f6411c0d87fc9141c20ba432d78625625556fe94b053b430f3c3d6cc7bfd82e7263b9271d37851bdcf62baa845695a882a20df1e04ece483e3054b53a1258bc3e89c6a3817a609204881fc6abc5aaf0ae68d39b7bb3ea8dced8a362b4b3324f3937d3dea8e1906a31e92c0a8fd74f1b6e216009736eb7223b02ea578ce8db1ad4f2f0b185ea7530360ef12a02bd0296272483ae750a4405b5559b242be2b769cf2f428312c54e90bb4402c5a6c54cbf6d8007efc9605823fe1e7c01a9bda7bdb6fdc682f180e321d273586811c3066cc96b34cbc3d7cf233091ec840db8b6c466e64248e5e390163dc6e8c05eca083438fb547b3e121bc54cbf881c64c7e974efee9338c2937cb903b29364339d53139bcb9f3de10d39eb4eff69a8e54cc29a323013cf02991963422cf727be025d70e685b2b7c22fe702e186a633f3e2b7e7b3c526377e981c8c2638906ccc930396424d1f771f046b47754f5dfec2738f93b3092371fcb04b90677c7cf156584050320935371d47f62498b693201ed036983948af63498d3e4814bca76a4c4d9dfcc00db2f93d215b71bd10703be02dff931619404edaae5cae64b8e712d68c59dd2e735cab304828d8ca23797829e484fe56834b6720108607e126286573b23d3ee30eb232c5fd02791bf640e66b9703f7605949036860a21d3a47acda0cc13422f6dc9a98c75b626b87ac1848a86ce149ed6d06ff561efde3752a84bde315e3add96ceb2efcb69fb50f35b3df204e5e11903ddee747ee109980fe4a941a7d70eb1b49b120c9e2a0673abd70cf285097c2d9ed02f70383524a3229f08d348a0011df3430f805e8d1e070942bb6735fab6a38eb3a4a253892043823824eda16ecf47afa9a235d7843b8802bb4a4de809d7c4a5d284883b4e235cd00214706003b4f15ac111b72847d1c90a33fd1d46832b47b901b3e2abc635420dc39da772319bd87b99bd782a7f0291678c7d012f95dd61ef031090dd5ec9cdd68778cff65b6229edf394a455f2c6af6661c026010a1a79c80a7c69f4c1a305b5ccdf306bce71be4e29c8b4a65647952e86f4818d1502c1b6561d30a3380eaed088e05dfb509c535677718685470506a25d0fe49a8c44f7e8af1257af5d65a4e04930749b1f41f486d3452f554b54de73a13616c8acfee6e5770721b2de3d830f9ea96e366a50abcd4f782e8338b1f4e8c95a61b893fe0b69e0ec920f73ba401c641fa03e3cc671def058e401fb9214e3c28d938a24c4d12a86b5de975adb4b71e032ca75e1826a05abd31e45bb1694f5d31d2e6de5235ed23c41530c5f97108af71899dc90edcf27afaf609f6ccd5744cdec2ffe529b13711d49b484b63e1f8ca02f9e702e8cebe14d4f2a6c7bdc148ac1b2a2d56ac9920e1609c1b60b67db6170c47e6001bb1076da4c908841aad9e5807217a4c48f355c9a899d6d7a717eb6fece30044df8518f08335e31e9baf3585a9ce3e9027d8ad53466ceb239614ccc602e7adef18a91c00c782805ff5cf1009ae5582ba01fbc09090c9e7ae10b8c7edd43007ed14bb0e2a4f0a10be5cad78731bc43b1da1f4ebc28fb8413ac2a79862d1efaa2c7f656f086b06043c328212d573fb2031f141aaae5277c66df7553907b29e18f39b9c26a62717e8c88884ca90bb58d232a18bd3a1f02a252ad90096f3184beddfef6797ef93c2e7a0177e11ccd78ff52dedcd280525b95b2c1f7756c160454645ca31c2c25c3be4f40f5d0af2c1144a0b71e6db37195a0fa5c280b33146365c085f541883c3bb1943df59389b8b41b62d7eb9bb02e97523764ae0c295f7dbc64d9a112efdddf7a160a97d02d20d29cc116f8e0ee40cbdedf0f4f0817a2f63a79ba0939265f8ba1603d3a0c97682985e279a5bda88f171f18376da9d9b70bb6b54615a2b11a0a98dcc27a8df571e5c034f36bc45cd8f1147ca172220c35364761f72389f835245e9932cbaeab5ebf231cf447ea87d538c3006f115595a42c6855e8a1e6900b887a02fbb3122ca32a0ca5bc4cc4e1f41c85cfc929fd212b97a23ebabacc5038bb538ab6b9a14d9f6ec1cea8cc345f1d23ec6b729b809dcccfa580c93622b5ef3cd78b4cc33c55dafa8a701ecd506d6f86b38c2272b80da2b8ceea925be7822f751a1398dad1b4b18e7290aa5c1e81db5569ed8bc6c22a04290d72e7a5b327026807206aee2e7e9356e2213259ee0feee3baaf2af56da15d5df9d021c65de0735f22a02b3e8d0a569fdc16d9c67527a3767cf8c3c4a27c1fbd7dbef84c2bbe97b0e597b1a142653c4c04e385331161dfe1ae8f37ddae4c5f7ea30f77ef2da3722218b15ea3cafb03531c1fa05f7f8664efd4b551fef02db756ec40dc2945ad0fe624e0a302aa7c204b55aca753ea2e9b06202fdf57031616513222ecc2646307dbb8b96afc084f1670bc3e79da9dde97e5cfa887e251756708c32d50a9baa4616390b71eead997396bcf30337a0b21fab4e3582f6d85d7c1f53c2e46ca087e1237e859cb14fc4234012eb990a4cd043710c40f86fc71946b6a052af964389e96bf33f2535454c36284d226e5fb1a8e5299b7daf2f632bfa9205640b3372b42fcf7bb683994b2a788df7cd6a8d8aaaa2d178adb230aa69b82d61d87f8cb243b5ee190e7459f6b8778b5840492369939cdfae75030e97f78ae19fc32e3ab49f6ec92b6299b3911849af3bb8ecff986baf5bbf06dacd9a6e93bb7bbea26cb0ea2a81b4b8e6b21e813336203e8c4ac52351765361b15e9f5439e51d451e69ee232349b1b485fc4539e13a612363ebaa8271f73b94b21f4258511c87dd75f4c2892ce49942344fa0e8270b1e2742035d9a91b35374c386170bb91e4032cbc713c691e06bc8792278864cabfb647a2eaf42dabb28c69c0484cb3b73a3509958c05579bc4da3150a04981dd443636426f53d8705a874a76ccdb9ef676ac29271d16c8e50426980246fa0fb89e7c287bcffc58d12763f8f44d2232c871cca32d0c1571ab406d3b3039b592050d4aaba2499eb0fcd53731651d4079bdf95383ac0fdb9f83b196204e8b2e7335c366c873b1453f62df0e32e4a911dd160e104dc95a08a2f8370c3968e7c42ac0a0907dcce24dcd1f93c08b3e435500a5b3d5b88e72ffe6a329f4c44a8854a1271b4b4c6e780639054fdd89fd19ea46f277f06f992e2c314331e2fb3a4167c043721f069cb9d5ca78157ce80c50a7690a2f9895975a7240a55bde8a17fbce410fd830f7715557fde46383dfd8f76c2f5eea9137d63296992f4ce546cd935175d0339f61fd5e81e267ce1352912431e464a5457b82293bf1300e6de2fd8ab3651927b2d1ccfd73c3f1fc3124bb5d81fcd20a9467a9e9cf8b35c65d3aaeb7161830f18c5e5127a7387680ff3ef7cde166e28efd79efb4c255b66b8a3bbbb7c40e41e77c4baa1034c425b4caed6c5113697935b602504e3ee320ec5d40a2b04ccaa5612fe92f2488f1a40c3cf82feff42797e8a5eb10632574f6edba64aaaf3eec119744f0401fbd305b0bdd27c361d876e2dbcd02b4f262972afe83b4d479e45f016bc178072b79781bac9000bc7a145b958bd585ee749e85c55e400d880d0de81430cecf5ed9b29dfa9f6f1b0a0c4d034e0477136c955d84f1040f3d535247d0db0d97904c57801ab4841a2386a61ee8cc56c66c3c7c4831f956d7b72d1242c24d51aca4c25df9849eff4e7a1d4ef5b1da68a60079a2542ccd9c1cb33fd70501fcec529cc3979cbcde3f030d586f5430c9a4ec43c9e987e54be29927407f48020bdd20327dfd51963dffa6fc4334747f9716091f10d3443374839a9d79f5fb5d9bcb4be60c5b9cdbaa075341521d37a1cefdb6c1815fca9b1c2854a7e7777a21cf9375290c174456f4f172aae32033625fbfd8601b6a12155d54fc85a88b19fa9817e236fc51697c4592ddae3fdf0957116691a1aa35e6da09c9c3097bd08c285e4638cdf16df28dd19fa3038e7d01f4e9e6935d1be274e9ee8b88fa1eee3361d9066bfb6cc59b3f684446d1f202ed3e17ac0f4d74f45668ec8ba3f5e5d4ef9a1e52df6effe0bf383b8631494f1eb6b87e9d7ec7eecc672e9cbe8e013fe5e17be0bd87c58c06a805391368a328252c0801a3ea82afcc953185a0594684ca38dbd447b416a80de6955c1db8ecd8a14676c29ff016ed179f8a5bec715c543697712ecbad5ba5f362cd68ea42357d814f998d7419aa68c445b358815799f5c28981c84dab272bd45ecc0947a9082952048b5f8878e74282a688a0bf0daf730182c03365b961a0a08fc9351172b6423436c9807335f01c5e323ed7928029f900abd6ed2a51c88292dae1744309cee0089c4adf70a9465fbfde0b74b51bc00023c509ec21f5679a2488a7765029b366fbbe897ea5d324fc7ce698d094c26018c43dd7badc5381bc6c64f767a27e0cf59e1e7171d5fe9c62397309048aed46b2419cab9d3aeb0fa361f0e4123de1e20c96ec850e897483305db9cca890a0bac7d1baa6f1a7bbfc853c5d0cd1cc6854c3aea367a4d67f3103b4f91d27fe2ae0d5228ef0ba1cb6a6952214bbbd37a00483aee54e45a98a2faa1d739218912804fa516e1e997db2ec9ee84570180d0284d2d830a5c7b39e7cef73113def8f6c56aa7d44fe857facde059632c21e53437e0cd066472447e24c7babdb8cf44b41c85e06c5ac7e9b2d314899948910de1eb6e9c9ac82401d86051f9652b76ed23d4f15d023efdbf96dc29489eccdda07b68f5923623d06841bb8c12f763f78131bc758130ce6fb853adff13a0739d3946ca6508f5cf65bf1d8de71d54255d69c5fb7fb13c41997711651fc1381cd91f1f705b2ad3e876e2182679c5ccae0b64d73046421d97c48122427d76128c2c4195fcedf3bca9af6fbfce622d85fee1063b8a35a7aa90cd785f83c322b441f2f25dadfa07d608979ac992b5838a6e266c9981024f71a4cf8a1017a577bae113aa2361e74da8bc40e77afc62b00b4dcd7f7aa4b21f9dad77b293a49a34998c9d4f3f163be908af2e9d0160fa7ea4f1edcf696e6ace2849fd9ddb1bd31ea9bc90418d8d8a690cc4ccafb1c362937c716443d90573f39a2c3c361cc66f9043e8790bac10e78c0a31ab37cbd6338142d049fea1e3ed60c23b8662f66b3e75bb84e4bc5a430ae9657ec67a4bcbb1ca42df6809e7084c03adf99356dfe4e8037288d3dd1f4ed0666f3917b65e03a8659b9858dde33995ee82add697ea67cef981776a97871b2de06a4ed5936ce12e78f2bcba7b6c04f5a235b560a9dca3a1b493dc2689c5dba12ad6e98d0a558e63131849ea73fb67bd38e216b1ac231b0f5c2c28cdb95c713a39418025522585e7ebf0b5622db8ef04dc364eb56a4de5d0fa95c188fa9862c55fcbc2038c50160ca92e18848770fd4b884834c4bb55f87020d0b34b2b3ba44f02a0f50b4a9b8c735e85bad0a8cce1284088b2426d54a4932cc849dac0e696251bde423037b409a68568a7d91d5332ea48a29664a0bb4b8d00f5caf61e89a1b2a9c6fa380eb9540b44161a8940024302328ea1ad23d6ff4977ed3a63ccaa8ad895f84d26d119ac51a97c9afbffb96a8b8d1403797c6eb9d5862df2d4ef37e722ce988c507ece11d30e0a92868cad733120b6b8d43621eb82fbcaa9951d3de18fb58b57d6ccb4472720a516db1df5d7ca8f510782d33c9f158738c4147a738f0e3d48de6371ffb4c5a41c96431dbfb00a16ece2e882bdf4a1adf539cea481af4d46505d66d3e87bff40f2584a55bb66fe386b52d874573107e4d61fc5d0098961f0fc792fb4bd35f6e011cfaa1a6f6d8d1ef8e6e13a4931d6ad366a8296dfa340d73407b6b25208edc110509faa6b5a90a41e97d1045d1f56c4a4353c81d1ebf4cfeace6508c09677ff98665bcaed81890cf2eec27446837d83c779556f51ad35295177a97967a72c4cf8c7c63038ec852d0cab5bd3b7f8aa69e2d1e10a39f54eaa3b540cdc44527257eefe65fad36e5de05029f65feee5c3e7cfdaa401048aadc8c9132afd13b30418554e5fb85b1e39dbc7167882db6b11177ad25cfe35b7dafad8e1d787ffc5762bc38b8cc7dfefdf2935c7fd868f43f199a748c5d89b6c3016ef78d2836177b913873f8a95d0a35a56faf596a208478d976ee89116716c8ef466d1886b155de6e406485b5a50c597043de6c8ab9ae9e0b9d362e8cc1130c7bdec18bc8caafef6156f678e1a0fa2462a0e3c36da6a56a3f9c97b4fb976a274757b2740b534361269da8299d3c9c46371f7e1a48dace6f0749a8b7669bb5303f1616b0172f730e3b112c31e5180c8ee722cd96752af4147b0b7ee3f9bbb0ed822e6b464b2e8852b7aadaab83b93e8710ee6b7c7959686b5ae6c4ec93c9c54d1be79e71bf882c71b346d882f780749047d309448d8eca8def153a8a65091373c0863217aabe0dc9f9415f1f1635aefe325a32483e5a8dafe7e3e179c04151e3322ed0533c4807e47a55a2e50aaeecdceec12fa9742b1b9f81e885d6d9c498ab1289172b053f8fef574cc97763c4c07155a6964a04917cb386b162c819b9316b1bb525c3056c3d1701da306a1cf797a9010c11d904f6d545f348847d08b539616a7bfd941f29b620c1954922061e05994d62393f4990334325c598c663263758f5fa692dfec033eb48c81ac1ba9aebbf3aed8245fe5a1afdae50bfce5d817dee4c28eb788cd62b6e10f5940d3ac154a8732031268ad0931f75e9e52f23a1bbaa0828a0a90b511cad9d5b03097fe88fb884ef7272d5a65037a1d2444b85fb6bb51b32951e6fdf1a29eff1795e9575db6bed59822f03b7dc27af15be31518cdba97536494d33e908dbc9912fd63beef2d833f0d972c67e7d24429abb95f38fabbc31de25e84774014214e663b4ef1cb9b1799e7eb67906699b979e205dfb2a1606872957b840b2f16f7fe845e787e5ad9f84a83a69f340f53ec386b7e14213ace3064be48210bf5a4aa48f21bc3c8e2ea58e4998fb704dc6d4f4865edb97b0035f9109c835bd5332ce3441f3927e63730a636ef816da4768bc2331bc7358b6dfa3b16f3484d60e829d360d168416e3a0397ef889cd8d65ad53a711bb370df84b51188716a9e0cc04d5381809156d940cd97c3acd8fa1b81603f036c15f4e1ad973c32d16b39786d9cd5a82ae3a7174548a5c759a4b13b87e18c3109782f26d8e6aaad21e51e042a5b9fc221f40f82e2c94d083d3ee5177b1c8aa42b8c535282e19096188c0b11a47a2bc04be933c8bb4d50d3abb41bfa39e1a98cdae33f9d8808698d3e9d70fb5342706af94fb5a7373a8fb75353e47dc8f1f06f9beb3881545922d9a40d131b769e95f7ddebb1f6180aa4de2ccce9ede43316972bdcf154c52c9326d8c3bcb04f8581e6e3fdf177ecaf297b22dba720cb5619647f2abe37e114e9c9ab3527d90ca41590ba52c148c3a4aecf21b3a25ed160ac38ef6094778dfb27647dcbeed541cedfacd3ebce15063328db940f8e5b024c8b65729eb86bd25d89bfcb4de3db83881facb034bcf0dcbf5a47666bcc7875eae9aebca32654079ec6366d543666920a038c2aa6e13ae195fe3c95a2121463569256ea3ae2325f737e8fcb8009dbdc408f6488ceb2ebff052b5afb34016146e31aa99d258ba5874ea5864db58fa69a87a271f027e832f96f5cdf3da05dab99c392058513c625b094ec9b8a4323027df0cc3bc0f73908cd4a531c2f6b1c9cce07686752cd05845d12059becda7334ec031eafd1b33bb944a63397a88960381f79f8d464972b8f89fbadc72adff3ed5ddc5fb99b9df88081ce2d1b74f8cf64aa8cd40ef95ec6f9a2de8a7029be325b2eecf1009489565fb635a58d842e7276ecddc07ae5fcc2d346a04bad86fcbd0406595654e81036a590fcc16d89d03295b26fe53aae7392f21102f69eaff6020665520f6dff5fe86b4e18cb4be339a9ff8f78dfa5081e3997ee440bd9ced2a5a3307a39ec35d97c11c03e85b81ea817c79fdf0009db88399be7db863f1da617cc4f62105e9c8c3894b974bda43b934c42d1f189751fbf6da535819a49f3af4962271397b34169337c0577c9db26b9b00b9506aed6086e9725088bb11f6b2c886f241c0bf73dcddc48a5a25884c95712175fcf6844f67d0b4d76c6f88f6fbd8f4061becd7632a03cd7ee7fa692362a1b887bfd9ded5e47e9259e7a8c1251a97889ce5207f794d56ac40d4f2eccfc0921203caffcc20357c2f148b2d3364b04f9d4a9e89f4f9cc04ae41c887cb98479a37d9697a7c9c0fddd09bf29180aa31051e731e77741551ad0aadc38a6bd5b901c4285d1e3419c12b59312ba1edcad06773509bebfd6959f0b3d6c5098ab2d1b2c0797f9745f9181a12cdf77213499e078dca4761dc1d07e353d65fcdba9f9609637cc537bfc5cfa7958918da51a823f6e5492a9b3b940570748ed2100496bfa65589a6cb2abee38e6e0717bdddd680e667188e5eee6172d7e02a72facc886742877c2d15931c5a7ba9a5ec7d2dec15495154d858a4b58f3a2920f4933de457769078722b630691419b75f756b3b4ac8301ff2cddac40b682eeb13c6fbd105ad4f71f77538e78a312672e486ec652bc9a3b6da9b96812b6c17922a500c8e182855e531c4d3daac05518a4349fa73439fc072d0172e118321a756cfcd8fb7d45277e688f5374006fb8513de3e093aad8141a2ad4973899f87dbe067edd7fe9cd6d6c171f47a32205f0b0a418edd1d7b4a6983ca8a9614f3bb169aee28d7a8ae983a0bc9497f49b9dc577af166b60dec550181cb55c691e9887b20f65474f576ae443838245256047fd825a79eae1fd19b38cd79bce77f754f2a5ec418df0f2d46c976db4cbdbc3000a2d4f7a9dfa4ee4188620cc0dc8c3ba9b3f4aaa5c8e8cf2095583bdf07342557d3843e1f29e791dc4d508775f38b52ffaa9e88dd4dd6ae967794aaad7472f844e960895d2e483d1a3a583be0ae8554455cfdfcf8a83aa6d09957fbc4a0dfdb970bbc23713c71834d34f88b6e24bc14e0366f027b85f562d9cd1d4d8828b98a6b5f83ccad092d91c5b244d48b73fb8152ad696f6d9cce60e11c07fe69063fae400849e6d17cd6fd072f61ac157b3466b60acb0b5ae5775138a29e6959d31ea0606e76308bddbc77c3ab774dba80337af4971324956bf5beeb76952bb9feb07acca1147b0c4eec6784388843c5ec655895ef4039221374dfe2a1ce1c65d9f9c07150decf327d579ec9b9af437f797679df834c93c262da6afd9cc3027b649047082619b9adf13dec2ef2e128e3c4207fc4c90fb83584610af3e9b1986e11986e13686f719d66618866118a66658869119a16618c666d8a466188661316482dd17227f366bd35a5a37727d7411db7467279fb3ccb23cca777286b958adead9fc28731936be661f56979e20cb800ecd57b969a51fc8e905c36a9d32e5df3291a6648d68adc40f5bb490292b8c5242bc78c854e3b4923be4aaf376202435a6903d9bc5e8d0d438a592011c164dbb5c5c8d63c869b3ab1f6228eadbe68fb6208bc5fcb4ce8930c7318f54fa6d7017c28f03d81056c136e1402322a0b8e45f0e0b3edbafb50851b746f2fe31547118acc83066f6fe242b297f36e49174e4d594941b02f3264e9b60270dacd8bbe453431edcd61d40162661182013ebd94e164a8c39ef0994d7bc081b78826b16fec134da12d018d811d158159a49a53ddb1345d1347b8c7c34efa12e26ce8a99e5a79de38681236c5cc6fbebd3d4e150442f115215aca72692c8eadd3cad6c0d9c3b5ac8e394e6bab723938fed6de453a4549c346bc9313e3e70cc1dd29ec826122764d57677eda769850b446e82290335501db97e81456410514ef739867b4c79b0d519aa8e7fce79edcdd648203be08487d8da17c644c21598b136b8d7b2f29662021d553c850f21495cae13b442af76364b1a0a6dca679c6465b74125f59d60fd93e5d9c44b633920aed87d94b71f50ba9498284c0d45ed13bcd1cd2cecadf42c69bb269f719197efef5c60524672568895b31f97327353b2b62cda963db6f38f1d5d4c8c197f0237e379a10aed011c325b65de3e1a0e2c13046ad99513a1951d59f383f6e17d482f465591718ce7fbbe1d1dc7755db3e0384d91973dcff7be9b6e5955539993ca814fc8cd1e421c5aee9c26fce0028e5f548ddd2c72cd8dad6accf7f3327f1427ea75cd76263f67dde3f3983024b51e7f7527eafdec74a6b8c74f67b166fcfeec51c7c2c5137542c73b4513e3af21273dfef080df03c7d8544504be49c6afe4b33133579e2e1e7d92a427c6da04c5d0f6be59d11c9fadd8cc6ba5ba440f7dbe466000ec41fa7057187a0e287a9e33d232eda443a79fffe1ce0b9d2585c95f694d6c7490777b5498dd80d4d3652ba599f25378defde3d3d0973d2699957d7106cdcc3f156e941f71c437b29ef9d284f0a69a74f40d269d63ef379e149ea07c7b3c7c4b297ddb287d7b2f7dbb2c7d8b257d3b249399ff0672fa492efeb6d9b163a9d3e7b891f5ad89938a4dbd49fe5313bb5dc91258f2714230d9a87ad45317470cb5720cb441c21d4282567f4f695de6dc055e52015215581d48250e53790f2a32c36f625b4984c856102e31931dcf754d060ad3f7f7d60ec315ed1b3abafcdcc9c7801e139009b7297e6d51ddfc15ac7cc6302c8674a8b1dbdb4f671911139e0ea2b3d4e8656980d48201b63dd31488f785f9368b1bce0327af787aebc9969cacc0474c040049109368a682646cbfb3ae287636129fa911d74915110e8f537326607b1550ad37f2f40cd666b5c222267ec08f195ae263ea8633a5d548ac67947e93a8af337e70f6dc465c6ffcfc0b62466d4d264383c4b535c70f7a64201373c8d385851492f7a669fd9efa865007a08c55b300c3f60b44304a1c426698a0720b4a6718e67ffee77fffa9e66d4c8ffff5af7baeaa7ffdeffffcf5fffdf57faa793afeaed2bcfcf37ffffaf3a79aa7e3ef2a1ddbe1fde7cfbf9c399b8ff95ffff9ebcf9f6a9e8ebff7e31dca7ffe4cf336a6c37ffefaf3a79aa7e3efbb6cebe6f8e70f0e41fff9ebcf9f7dcbfff9736ec3ff2ad223fd275d96a1cdd3a39d27b09aa7e3ef7baeaaffe44dbaede5f13fe751fd4dfd274bf792c0febb8068d1a9199661188611538a6175866118e6386f86b119866118866118866118866118866118866118866158d1191c83611876651886616f8661983c71a33ce00bdef1638661249e611866b0198631e3486c82d88159cbbf1986196e86614a996118471609de0314c987831fc3300ace300ce3e90cc3a82feaaa2bc6d39ef6c90cc3ec31c3304c60330c23fe6a70857e31023739c3303f94611846b418866189dae4da9aed8ac9ae19863118866118be66188695f9fe0ea331b9130462180696198661779b619831f08e1f302588f0e50cc370d7cd300ca3330cc3700c2333ac3165d230320c63fd1886f1269e6164341f0e0a67916688439b610498621886d16f8661b8d85496a34bc566886d868932866118a666188651181a63ab60ce22076318b680188661a69d6118733d1756f2c6e78a119e614456671886fd6c86618a7a4dfa4fce4201cb1986899b9b6118a66618869119596652f88c43786018b6b51886610a9d61184b3753c2d723f6ca519b6144db661886a56a86614e3009b510c82565c81986496a9b6198dfcd300cab0198efe0349a84cac830ec78300cc33031c3308c58d04504d50cc3303cc3300cf37148fbdd0cc3301c4fb1fcc9300cc31816a60a098634a885f8f32f665906ab2cb034610e50dbe0b72ca9fa23b0214d7f67aa6298b16a8532d53eeaac5c6d551183b1aeb655200ff54ec9b155e9292a82b01d1fbeb8e496098cd42b7f528450a967af04f0fbd807d472cb483a708310c00eb524e840d34dd1bbced9726b89c0622a53a779f99310afbf5e48d91e6c14874864d90e44121f1f545aa5091d893f30368113580bca2ceff9cd308cd4a568526cb91005425e81388d51da349596340f35ff64b2cc0a0b6f3f2c6f6c82df32c7a18b72efd9fae06df62f1d0ec30ac718af58de8588953df63c75851682b4ef0c187610981a299055114268971bbe558dd8dd1cf5a97670264d3ca7889fef032b3c7d1150e560f26d159896380ce3fca042f64647cc807e034039a2115a640bf883091c15011cc9129ce54206d9e2fd2ad7196d2de7e7942a73ab6476abacbb7bda061245a388387ed7177993317856846e25e0ce0144972eae9f9d5affaec1ede3cdcbfa74c1c654380fd8069e52cc76f439093a1b3d66c487f88677557ecbb5d166bf71d8a53f13b7faa837a8a91a03dddb380f222bc46f5cd9154e1be68272b570da64f30c19aa40d2d305ef1bae8f0e2c22308f0859aba8e0bda31c723ada50d4240312201f0eeda7cb6e19b467a999536f0be47eb7913107c45a3317e3925227d7cfc0c28d6900067dab3d36de869b882582ed5114b68b6c1f81f0db90e5e7408a6e352d850552b6257bb2b56892c60227550085766405d684bac30ce2d682de3b205dbd3baf9340bc03f5c80521d00ae90323c1cac525eaa68a9bcd43d239e2aa869ac51e78b571c4bef8a74414f0fac251e343ccba3bd3fa751ad69331863aa9fdcecd772dfdc5b890e910c62e99e462b933c198ec96ab1a46ea01a9876e5a6d0e9e1919a97fe5cd96140f40cc5326314a635c70339a7bc3ad64bbc34e0253ca78355315c72f7048761b3d0d4a0dea51722158b06defcc240ef4289c9de5167f9e4bb5f121c12c39186b07b3f8b0bd1e93657d112b041a886150eeed8a99b309e6d94ac71bdd5f88cd7526aa62471905eb2009a5e7fa506a94e5fcfaac9b5a1699144d95eaa3883f788a80acd83d8797c4a80e32f1077fdc6787c909b913b9d21668656ed90df45823db6d59b580880430e4d532faa7a51b98f5a09ef2f45751aa7da6a151b78418059b68b89641c9020bcb200df449e1d6659274dbc3cd5fdd978d96c866accff41b2b6f8c0fcb32e133546aff8c99ad88f84b71ea5763e1bcf7ef173a51b28d494ff74b14b5d00fa311ace04fcb5805873c662947e6a66ccb55630f9f4b66f6416a1eec4287e481f60a9c4da6dbcaf1b6996e3fe6076bf66f88597dd9256f39211842900591c285dc6c0e9e1919a95b0f276f81b8b9cfe58273a6bfc9ffad44d49ecedd3ea2634081d7e2109237b5566cc20eb5b0613f66bf2ad342d969345f4253c145051549b848e2be859239906d1866062b6685dc3d8a7d94fd6b115441d83db829946b4c489fe9692216e91231b4801df80a39e8a9283abc42ae64a6cfa94f3aa3d5894359f4dc67f708139ec52b47af20c87321a3a821f385d42bd912d7296d97f49b41149d215b45bc1e3245ea4250218b71fa8e0ed54f52b0db73128cd70cb5b90203138de8080aa3da15c229dba8d29db3ca5f8158d7522789018fc6042216c48268fd36c86c42742f8293daadf9a1a719f89ef2f292555991787f3924cde1e53520164c2b3f6287dca0a528925df090335fbea0a7ac26e16cd37f44966607c11d9b807c9d5064e4af23415325257d9d68e3e816786d2103b8fbec0db9936a43c4c7fbe644c4ce6383384f793266e070bde8d1c12c24a662a4ac449a9e7d820c2334afc792be80e7420facbc52b13afa51c4bd281b08578748f8ec3f1ed1a79868ad0643a9ae3d320ce5b468d350162a3f0002a54ae9041860db0fa14c318b446e543c11ac39e0fd69a9821b20f2992eeec8065d5f1d524f25c5aebb66800291497077451798d6c72616f93756130b34646a6c561061488cc03f501a259da482128d7faf09754e2d915a155d667af64efb46537575ce21216a15e2a1f67bdd33073219c301be2be0f8246e9dc4f76dc88bb39d4d24dd4cc4482828709e28beb2d83f0b3044260280a30701d879cadf25e2dd11855acb83ba36d9e90f9774900cc94cec1e20fa34bd00591ee20c9792de7c3d5f8a41c95f9471e0547dd4049a3733912209da23852d8071350046d3c323ca4b092f04861a23277f9c5d68c35797188be64b4969669be97667e00d1f7998abf067fc0905f15b14f9e87e01288a6936bb34e1b7326af263e72ae0a88bd503544ab3688ac26c73e999aedb25cecb1690d1c8edf6d62dbb3fac3ed959df8fa19fbf698ef3fbe79befeb8323f0f556df5f889f2e561f51bbb281a88e33b2e9b7c976e8ae2217fba2bcfb5a6b0af6d17093a7f6407c3de0e0fd665dd12ff925192f7d0fd5e8fde5f6c7aa6510d4b6532fba6f10967836a4082d1e6c3d6c0d9a36f68bb82e98b9afb992bddde1ee676d47cbe2e6dcf5bdeee7d83181f9bd92e0d35852dc4edeee7cd92f6ea4faedfd6bd39d8d79da8f072e9c8faf8ff8e408327f5e8f3c5f77daa7d31c3057b6f3037332fe726a8cb1835ed4c868dee59ed7c068de8568de2ea70fa1f6c9adc7ee493687fcf16ebf605513b53e71b6d7fcfc956433e976e1e976417f103626f01f257baf28095463c5252f89fbd26d01f4c0b3fd356b8294f9065562b008055c5b030d777211e1d3597d661fb41e9b27e579017bbc821d3e9b05d6692db52ba0d18afa775f6b27257b7a8f1943392dffa4936ec4086dd46b890f32caddd98b1a588283994967bfdb31ada2bac67d47e3df71901c4a2a178278a885be1df05067b124d41b0c6edda006b0695a409df9760633620bd7d5631b78c3da0af26969ac709fd32a33f0fe33011d84d8ae962b6abdff0247de43de910ff9ba3a7bb99f661f85166ac5be5f84a67e7f41070b1cfd2316a47364d01601e7c74ed149d5c588b06746e2d3374d27a89b4e2ebd96b6279268a95b28c3550d1019e7782d7e22260265d3e1e7a5afcf8fb0c87199ac5183a853923cef8e76f7e973bb21432a18b1b2f1dadf6f0730cd4983aeb6c523dffca9f3bc06e3f1d177941e23af2c39fb27d5bdb358d154abf240cd68d6f8b176f7a4597b0669aabca1897ed8286e169ed087847f621cc758ee78f1b2fba55033c954ee72872b8b344d5d03672b1d46042af5d681830c9e1cd3385a66d8e2294ac6b6469d19f45252848a143d1a7244060e38e39bec89e8494026828d9745d434ad5aab0217e6afac2236eb3469bc025577419cbdb39c08a073cf3fb522866aa103ceb7590c198d3a19841ed435e6ddb99f395fc1288bacefe03f1da67b5734da586498d86eb05be7600b63d9dd21e580f11eb44269d4e9735169809d641e90b15e335e93baf163d12c395c68f790cb19d5f645a566d1cd8639866198d258ba5864eb64666bd14e44b66131e6b49ffaea77ee5732074b70ccc2092c623f7ac5bc302751c9fd8c9bc810cfe448b57fb00e2fa8b68fb3ae2f187600b26e2b94718cb4fdb956e8fb81206618cc3a44b1d03122e3041fc1d4f3efd7c88c1164f7107d77e37f54c70016b8816f0572207581264876807411773e49cd03e426c78275414ae743ed96c4b6757e70ef774a2409b7979fc00ad4337c8cc84c42f27dfdd501222ef38fade330e81284ebb1336bf0d28a20d67685860ff827b707450dfb24f4069cf5fd217182c0887cc373e1430a20b0ba0d4e4674cfff8cef07ed5bfa1c9989ee92f3169300e76871d396865055f47d54adff6a4ce1ecddfac9afdacda3d16280ec89ca9c21dd8a54ea670d9ad0d2c5357ec2da851b4c90d92e91ddd2a2d93192d5195ad734a1a4182f1e446a67e062eb270de9b4a3b6999c4d23f3cd7c6bfb793c69b384e4086baf5dbed4795dca17421fd7007871a1c8e4ec68f1f1b9b96f376e8a1dfe52f13a9245127647564edbd4b18f7f0110613f1e4e11d98d727bc90ab6fff9367333d036af0bc3300ccbcdc2ce703a63cbccc1fc08469799e7e65cc69c99d1e668c6891994e13ac68418eae668c68e59fee66246f5198cf93d8c76331dc3998c22338fcdc18c46311bf33b19de16f8a84ba34024bde160790332b8db641fa3aecd62f6777cb49fe96c587be2e1186e13ee1470bb590c54755a946725e8c73a0c9a6ecf58cfa9238f77dc085043d91dd6f09db1b5aa0c99dff9e51491e8d0a5f6e98212e165f940def7ba56b03b84803e2e1f99ced084b71118a21fb22e85e333d2fa020320a6b1cbe50cdcb132a64f676f6f893e44d6c10a2d20e980cc2a02e05d51df1e1f9ce7d1864dfccabe61cd34cbfd93239fc938f7dd02662dc042f9d655375e4ef9b488c405b71cc2914ddbb5d081b297d31f097f3d87f191e360950d807f5240a1bcefe29cb66c3c322aed0bb6b677c79152088177e41f86ec42b16fd61ccbfafac5bc84e84658da77c9d5d428182aaae8f3ce391212af17ff63454f62661c2f3e055c1d06f06bf7d91ec5edaeaed97cf159a4e85619c4232dd0520e230ba9e2be9a44d205f47a06cdbc3ab939e6980f65326e47e6e73b3f6788f0a6d9accbbd455cd0c80ffaf9b5193d1d0a143fcd3b11dea6063280b94e087fd20220f4f7bed84a06db64b6908266c4b2ae86bc7cd5517e1b29313856fe28838d2931fbc00b706789b07eef6988b5c9f20661a01b386fd1daaa27257cc4abd026b80733c3300cc3d8cc8f616a86b9409061680cd9609a45181f2eb21b55a6241a0bcde8d044e34786610fc487dabea6d5edf7db5c561dda252a0799a8ebb82f2b556ed56fbcc3dfbbb6f34fb67e1fc902d7b0af82f8f0ec694b15fe910b9a64ccf65e2748822088df3b792a9d354dd275126591776741e4cf0dbe11a32828336eb3f13b256e1af97e3759c1e9e4c432a99c975a0c77f29fc555cdf389b60e47a5c95737a5d6e170af8c4feae8b412a88c968b7fbfe13401f571ed11ab6553f62d588fdcf79b007c1e49d4999c29c6f7be9d224638b57bb377fa9e53459f42f60388dbe390899036b7f80d6f8b5808dcce17b342d60b33f5a8d3d4eaebfe12f4d2fb5ae90acd449038a16368a0150a5ccfd96b8380f345d034ea04948715aa850a351cf36c905420d00cc3c45f1cb5625e0598b8d8353a5b2f44fec9c1607f8ee9e8d83eec9df853c4234efabcbd0fe06b81f0e17d10c7388bcd8a56f9058f0e5eb6ddd17ea6babc0aa0f3902a801c6f6064053e618a933db128002fb05e2112d6eda574f92da65c029e484af4bb3d4a4671e8535b28ec74f6d7491f1793919ee3fd38bc83e1ddfe94982bc4fa58f8c2468633e5906ef6cf39797388ea6e4739927a6fa1105bda67a387c7adf799a4f9987c9deb39ad74d9912308efc05a2dec97edaad2775cf4eaf2be5ff0f0e07ef870c32962fc6208103bfca99052de59134f82beacf48d8acb9361c1380d885484bbc4439e134986df50beca748a1de9e14c50131a2d826c5e8688a5e8ba218cdb258e63f66107bc4a124682b29a81ede554288a69206ed6166682824f80ee892578024cc8475bdd84995f880323386265f12bbefc92cde4fcf9a33725660613920202de3966f588fbc2f59388d66e1c10fc49040f6aad8744bef82c166704b0c3ae540c32116559a7474760116675378b15873e3b669e5580d22679c0eada603d474c1d55162c1d2307313aaf06920c4efe7ac9e4c729842ea1992e8c5e1e1a559f63fbb5f924f8bc8fc07b16b7fee61c2274281f83682a7a76ee9ae2c699998f0c167ded639704c745f378f9eaa463be834adbf3daa4e56d73ca24126dd01d03dd568c304ca0f1acc23fdef5838b2553471c18e0658c6e62283a5ecf2cec5b65088278537e00f8c59ed1b71b039b7c91aa749dda72386b2f94ae6e7d11d04f6da94d53db4d9b413cc76a2010625fed24bc1a974ebb51ec017c6b90f2b63fd7f0cb96862c2a5838e202b12acf47172bf29e06a4edef97258b4fe5e4c791383068ef9d18103cbf004e6f6afb64f46501190918a68eded48e780d7cafcd126385194611fe5d2505c0b401dcc11203cc385da8b31bd92d9edfe9fd884805c4f5d411dde9b7386876af7617f55c21246a8455fd167560566c103fc8dfb480dffa9446c1fccd58f46c822a3f2e25d94a9705c0748faa03e5671e6dfcafd920e635958fb9624477f76817f2962c59a3589b835b7c5708c5da17d2a06f71456a7d72b9d785795cc53994b7d5a9586ddb7551bdd5de57b5e69c926f7f021780d382bedaa05c18a06db089966708a210869944e14058aa8f8c74dd59fb1841a23bde4a573bb20a004d799d4d2e08f995984b172cb631808a6667641c28109ad6b66d77df5f049d1d37aed6005d4d2d17a1166a2dd00547d98180730e5aa6e41e44472ed82dff3467fe660eb4bd5311188b1aee5abfc6df369a283b9fc38cb9f2bd2b2343e549cfff9a1e66d6ba75db4e71bb613fed017bcf711962b85ef2410cd3be9c21c53bb23dd47046c2c5388ae4d6b26dbd5dd745cb5c2f0c4185c9d49f97595ee03a63cc2a4102980db3cdd06cbae48ecf1f74c0caf2f01f6bb3943a33e3d7c9abdb5ae68cb0b1263cd7130efc2f78440f026aadc098435d1529b2a447d9d06a60411a7f325751544bbbf038f7492aa9368a0878d447bde7c3ec0f86b3078788241fe9024e740a90a92e5dd1cc1c376998dbaa2c45a920a45da487a8a300dc26705cf79486ae0c3a70b1d43bc3b1034abe1b6a525fd92731c29b42638b6ba2a59d519562a0e5f162db437c067715abe242393f732f963551b2706a812dab8d271f40c73341160144b8d6cc51a3ecf6da55d24f08d3034358c04f124dad153ef244dcba05a757f23d0433752d74cb4b2e8dbfd270ab0654e64024b4f88ea0b5076e8ec5b23cdcb55239d486ade841bbe3801f1d5226dd50226da50cdb9ab7f152f2f394e3eae47838f10f8979fa6a092a3ede80f76e8edef705158cca7a95d17222a0a1c228f27ef217565c945e211e962ee8fe1c06dadb8c20b5a8107c6bb5ac4aaadec41ca402b6cdaaa77c8b1f53769a841776de3981dd519bdeb8995ec3761c8605597daea4e69485457718aa9d7949176fbfe3578e677d95b9d3ebf553f50ac66091cdede9a334505b891b09fdf9525a26b3d44c8e7ced700ac3cd689d685d0187950cbd76392f67a4f7fe68c20a0bbf3c5797c00b44211497c17e3d914f1c6062435e3ea5bbafebf967c20d122d3ade9aea13405b8b8bf1136f55e87f770ac75a34ccb88b4d6dcc1fb6b478cc7a31d29270fef87e78ef3e6e007e320dae3aaa4e26c0df85ddd8e3405a014ed531595736298779c64c921343d3a678ab33348e2fa92a9f0ea71dd95c640fb29398dea8a99b4bd553c3bf24607933ec94346d79d0fc542d586db730613cf864fb5b6bbceb8a6378ddcdde34a1a930405d5bd7f6349068c174c9149719310c8866305280ca8d79dd26dc993666cee67c74bdd7c8c1e9fe4c982178748be66e10e9f86b682060008000185d28c1251ba472eb5af3ba05927b3e1082e1c4586b1bde8fce6359142d0e6766acea4fedad10ed4b110bf4af743a74133cbb90f9bd0f23522cfc34375668ca00a9f2ab2583511632efc6bb10e9fe8ab83334788f9b847e8acd057ab67c0c462490706cf646ee6ea86d88bf106957d585e22c204a114a52409967c2fe02ded69c77f16dac84f356da8f70b9654477c1c479ad2ae1ba380b0180f56c8b41671a00960fcae21cc350e9cd38371ebcba6da9a682b509a372a452b7ee16d4c12b4722d3dcb5d3d77c8ddc19f772cbc7a89dc5c4e83b5d0bdb6004d52d4ca988e54e373243100c892528739f78efec99b72269f4739c11889f56429340e3f59b7bb85f5c7915cbf7789e3a0c5edb29a568246c96521d56e66530922a1bed4f8a6b4b7d556c357de8f7f947e00643742f99e1c8acf0424374b76e5dd11da501380682a540d01109f8d58ae05607bd44664592f9e5caa562a069565bfb29e0152c2578ce669474d49d98eb8b3c3907f2467d5537533dc7293ff9e65c9db3b23f8d77dc3b807843fe2d25a0bf9993f35aa8b3e3fe04535dad1086aac852c6dfc89cfc58efef5cc59ce1e0e9590679c02ae729a5d2103c31fea83fe0a1fc25ec34b58fe158d17a5994e562f6f2df66ffae25a4a5ae47e1e49ed5561a20d755673bebefacbbdb54fc05bdb566cb828339bc05078200d936e80440e6fd54a0aabe591d0197a64a3de1562800b05cb8106040659c80243ad5257341615d954440454e1b40e8814175a647214613579f14e064d4514b2e25585bf933b4d307ecd6474b231cf11ebb59c25287d87b9b78c5a84f4aa49d876f806f43d21367364eb6954f1686544d9fd1dcb37e82487114b9685996dd3f88ef5877e7f875d427321c0b84241e1505793f2ca956bae70a9ec174d370ad04c0e901f2263328290d184337c3ee4bdc2852da13375a3c173160304e024db6a1b6ab1793b5d1ffec463645688166e92cf39722a76c8aee155e07a89834e59b20e961790c4f4a0af026813051bb367c227a883d396c3bd7190660f17cc6af1f47af840b7dc83decad1d0cf5f92482197a62ead2758b15d84ed732f96b59d792b08abd8f0fba41d349cc33249fb3daa94d1db042ee6a4f2353203d4712c5c9019c60dbf2a3d0db40608efc8dbc0fc105270b1df46a0d321030e58f2e9ce864898fa90af526cdbca376ae7ab3114b3a2ce62e2d51090321757ade090e709cc17a5fc98815c071939ab4d5fd4e075bbf105741d2b77c584078ea9d9d6dd0c4e14315195425231d156e5ba8d33e6f14886b5fdd95c32ceec2ecfb259d8eca16f8a43198df1b0867d665010f305ec5580391a6ec4021faa54422b1f60b1f030b2deec5fd0a9c6a1df479a29a82fba5404fff32eda199c4b6e562e37ff0c1cbfa822a5269ef8d6fd95e28fa067b77c7c00909372bbd7d0ad16390df25476d5b21db47d27554ffc8cc5dc6f136725ebcf3111ce389e8dd2539e7cd87e13cd628100a677e6ed06d2e167bddaff1e3a3a066046038d5b1a77361d4277a645d136dea5beeaa6cc935134112311bf0ea4c1a3bee9953df7f7496deb784ffda485072760b31ba35e0183ee89f8be0a2bb35f71122e09b9e6b4b5099099ad11d9274ae0384d8841bdd3cc09200735891dbbd5c65c48ed539469331ad97d21c0329af3e999c1f0e983aada3a93f74149ad2541481f8f76c8a00c3049dd77e3571cef4adcef343f22ec36ebc8437886151ca96f3ed4cea622d993b256f5618af7c440409bd98b4b66761609665675117e5672463f4c5ee02f367b02643685226c512d5a788c05a852cdc2c767f50b6daab1900bf2bddb51022dff31bb89f19d88adb68d330cd83e3a27a669a59d5fa94b9d1c4ace3b30d00b94bc6c7096e119ee80499ef87a9d24faeb108098061049b562164536a946a762f9735955eee3d78b149ce6557344671e7fdab4648b8ac9731197aea707a4653914c0438cd57adf27c11d48eba5d33dbae8ea8acd72a1bc517bfe0c6c36f3d56a3acbed6a2c1b7da8ab5f0aa47278be3c2cf45795499071c600a0a91b23d55cf25a694b33055447653151b225996fed3b1f5e7bb5d30f90b59d959b197e3ce283f28a772c3470839a3b1f6b69b5b076e65ffe1d81df82fca2018874db95d7d5573f7ae2e51cf74f1ca0847285d3a8850b966a258f72ea9c633f7cfa824c3b6067dcb30dbb88b8a12f6395f75b11a037b25eca9eb8600e03a1859c8355d3e13ba5a3f1c1cdf87caf4eda577afb4eeb79a96b6fd38004a00100096a9a8a04d926004274df017e51c49049f1c62a6f05ecc91cfa27a5f4644f9176c24371b72d0bbc7731fec03fdd0bb49fe104139b763cde4a9e6a12fd7effe398d2af7e54ba8e50d1d67b2f641d3c811136630136e99c81c1d41deb532d134e7b4641b2d8be5c2f3476c7e51d02ebf00000318f8d5ab6682f2446728fed0945a82424ed34889489385bfe28883d90193348d3a0ef8d86774dc147d22df02de26b41d24e99ffd59ed8e3e45c253953d0f8a9bf2937ee5263c5da51f9f19440c7da63924be9864893d1a0d5e96eebc15f05bbd4447fccb594d659ef1d7fc00010db951da77813c83ca4ce4999aaa10d6410dff4cbb8aba413b313c25c941fd201fd5466bc5469d38b400b649451a0421609e8c078443257f53d5ee5ee5f2eeae3a5edb261f4b0afcea8abe3ded3083076e17890ad18a9feb1e732339f2d3b159fb20fce442eaf7c8c3ccd68f85ae582c0bf248bd65ef5002c555a910e481d8f6233d6a89f2ec20f6e5b49e2f7a1b8c070a20055a4684b9012340bea7529d1792f15e6aa9d76f9fb4af5089f65aafda5afba413aafa524aa4e2771ad1ceedfebcc047bb87d450c09b2de2692b91245dab4be9d2e6b056243d9468a335024a61a36912e258907cf12e692f153e3ecac936147c056d2c376eacb3ae7b56723bc6221652f8404193bb2f14af7644ca9fd2e5e9bbdd99b7a66d08b4c537b53913c144b970a457523f56ed46dbfc5829ee8ef25c741b206b3e59dcee106f8fb17ebdc48a8f4d558610559a44c0f4c7635e7ff5250aea7b3b35b8aac1014c491325108b67ccca89f80e75729e773856ecf39609c35c1252b39796bf9f810182c384860b91db7e84dcf3baf8f81c5b1d39c9ca6366b10637f7f6d4a7f140daa20b9ef7bfdf81aaa5f3c5752ec8b7dc25734bc9b53a776dac0d2711426311512e78ae4b8ee0ae072267d5532fbe928437a1d7f6f59b40935403090098fffa86de0615bb4ae0f2ec491c2ab1caf4d3d2562a6b2b05334e909190c4c6d0bef729c1056b4c47fdac4863a6109f1b291006c5c78360956fbc07e3e5ea651674c4511ce6e24c8917b55f668bbaca343e68c86ff731e170d7b02cd65ec94e5447af0e674a13f92a2e1782fdaa41e54e15c747ac1477a2683609010e8cd704ae6a15590838c0298d8d700c6c0b4a03989254290212544a07345daec9830d8bff8e18bb522c72d40c3854eb09a12789fdaa59581899f3b2b56c84caadbc2829d8807dcb4995bba7e09e6411ed5606687b5ecad271e8048d8e0e249e410248db54ed0877ade887a1dfb512185599834f92bab3a875f520b6b5ece031a708c02ab0453ab20b1b1f83e878b3ad55a9dbf64ce638b28408c41ecfb26b67c2a518f6e5f8967c48c2baf6d73b6f5ce23075bf7215a437b1a25e84b71478b8a2f5a2c5f08938be18073b8d8df0a4d465c12c3ea7a0c67cb9ccb595408194fd011ad3348f82ee6161b03489b151dd092804a57da1d5637c84a6f47327085a9f3e17513a1dd62e83a249f7c8c0b39e089394be950a22913be132477fb3b09fdde1587a8ac53dbccf6a59f580590384a6addecabc2d11dece5cf8be38f6ce418b68281246a4f6f7a673777a0378048165a5989cbdedc50d5585c3bbe5678066a50790df3488df486f91d333bc804254b90783c83c6da38f9b8f7671c5004c89fb9dcde4aad506f788c7fde251f47ea82cd40b6bfbcd19ae9803f632d13c76eeb3dadb7344394a2fabc1992cfe7e171102562765f22f2ff1bd2ca57dbdbcfd990ce316c1ef91b19b63072bfac50c5746f38fc462aa29f3e2a0dd66410c00b1c0b3a3b888daeca32922761f43b39581491acdf140f5e7d9ddbc10de2bf77d65546097c86fa894bd9be172a4a52f1ffd969bba608cce816fbba896db91f49adacb87ef4302af46c43f4195bfeb54fbb9cb714574a4b8a71fbbf29397e0524c86d7796d7a79f8058bdc2b2da39e9782bdae0bc8dbb569c4454f94bf4a96a2adf2281c71858739b805d9060a37ff7ddadc37ebb2d192bc1afaac0c8c29ec6ce0300e8c5d274b4a798a7366c24517672aa88a0b527ac55fb832292eaabea99a86fe94448bca13af1f9b93d4c6360729d228a37e9b35a4c1cb61e8a9faea3edf92883ac7e57c270a7917d1ed2e871281b28cf819018be57ca3e5262039a3cb801c60bf11505e10c89f30f795764dd6aad1217bb62e449e91f1693c59dcd2ed492199fbcd29a319b7a1c8ed0f57f6f59097ac88672fe1c89f18023a36f191d46053596356e04293357a6f5fda649ce488469f882e16864dbe61b826ae0ebc8a55aeac0e04e8af8e78db250cdbbc454417a1400a64ddfa1c1f643a5a768d911fc87eb10458157d37f370ed9407e86ed116ee77746d9d41004ff5029a12b1c8884476bf2c7170929a871a040026ec09c5098ded88fe248b453a251069a736cba5dbb0ea3300e9ba30ba8db6e2bb2977e57117769de7ad139cabecd9aaec3b4cd6480616aff081a1d7eed49e02f3558a942e7007519e475e9cfbf65eb9911c119016a9c3b97860055d735a4384aee60d5f7ac3ef1e14fbe1d77312d98d8b68a783381470c9e45bf7f2d89f5799be39051fe9b6f838a65156a8a97a9b3fcbe775d8647015b7ec18b208c4c5566e4cb04432c85f2c8e36643081dee48958fc9e9f396392d92ecf625aaf99e6956fd3f0e93762d811600c5427fe9019bcb421493492769c2010e5127419126d033dfe006b792564e4d28073156cad9c759e9c419eec2ea3c844a4b83dd73a4b3928e9f9aae9adc6fd5770fd8ec852b6e8065fa7856017d24d8a6f4bcf43ed26c8afe225d735dd1c8cf81de11522b5807522d6aab7f8242baf4895806cf98196962d47a5f1d6f3500f659395051b300597cfa1d35003429fb7d6d5c7a33d76c2f3259b5761846e344dcb2ed83d032ed975ef685c22ae78a64b7ebe04dc1387bac4da8e557eacdd5f5338c8f943d889a2b4612bce149daf9e98e6a4a983d4c042f7d02f8a7f0d9f00d202c7fd0ae465b0dc436d48abb05bc5d7bb000302b77404b3326c8fe4525aad9256d2073338022f59a70ea4333afe923c1c2d6350cca8a38aaeccea9be51cbb67273062cd8ae1433fa6fc50284db871ef7cfa84c6794f44444eed15da1d83517a0c95724668d95637d65873bb665cf030e245e9e41a2c71570dc12215026414a701eb976496b0b9bb25d8ba1961167e6e83aaadcf0c97ee92cdd3f663797b55f6cdf8987620173b9101d4549704ea63a6049c9e8f3ab1dec906a000a40f3294b813608263508129ad01f6762ca131a1a485a6b90b0e10b5fba03033b14a4cb7652e259e32b26319d90eee316b551ee794b78e07c4979d95615e4422edf3192fb92d905cdea212637b19a8d72a3ed3f537e14ad5087ebd0bf16d4e795de03927fc933381ede4527a62cf1175683c1c7bec65281dd2ca874e7f44c9c56e0ddcaecbe933696f63c789d39403c5f1c8bbeeb747f19348b97813b3e4dc2965d60514eb3076125f4e28903d411bd610dafd9863df38ee32e7b86c4c5abddc4596e213e5e595df129680224e170f8053027bf862ad5cb9dd2665d45d3479a399f1d76b76adfed04e65cc8c453b6e49b8b35d54efb91270438ccf5c31330d8563ecc2fe5568e0f14a24ec11bd104f4c45a0fe753a01ed5a30570530d859c0953b0aba4c73ffeb6ae8d12a0c35744a793a8abd3f6272bc190c9f468c3c3d5d9e4d6d70eff7201bfa1b9d7eff9162dc8aa12d834a792eeb59bf77f5edd5bab4258815da0d6e32814de78a72cb761ae588cc3ba3fbe302ab6deccba9ad6aec8019ae0ce0ae2b337159576f07a6943ce16d98d797efecd5d53414b9439547fcae40b08e035301d6fe4611bac35fefdb57e9aa7a48706f2629779023bef3b85c10afa4e4f6074f9bd06fe915b9bd96a8d7f6aa0a1c1aaabc121d7432c23412d8b15378b3c574d6338f3131a025f4f781808019143180f78966b093a9c57dfc827b67588e91318a9df56534efaa344432174ef1b0a75da90ab3fbfda401526a1d13e32f995192e7dc270845a83ac074e76ed2cc41a89e17749f47cf585b7367122cd817e5f52d5e4aafc1f7b00f6c2da885e41058fc2a45efa32fef0382541c5910581cc36975eac1f66beafb4d95ff456b0242304fddc3a9035c854bcc4f2e83715a8d73cc0b920d9df94d7ae15a7fd6437e748f1b76b78afac9d217d47175acc93b8272f741e188d1bd08f390b31229ad7178fc3714c66892b56c4f222222f036e224e95b2ae34cc3092bf01c5a27a4f179e93ce691afbcbf93e3e7271361f133012caa5a6b7c2ec19f9af41302d54db7de679a70f9af6fc19a7bfcc0c06d3550320ec6738fbacc22a68acad2e0f3eba888046137b5cc08f9c86d0fe10bc6a004958a05bcb420851a0c060dda191e38af2a8ddc2e080fe0155813c73f70810273aa32244180e1bbfc7d9ff1946c2643cc153cb9d015c20e9bf3cf5299b193ebaf19b03e95a6fb72f329b946f371a39edcb4aa73bfc3700b040a87edfc2499f9b58429cd2c3637f67d6c33216a7be42b9f9cd87626292b5458f61d8e4e1479f14a83401e6c255165cc079597c83b17d299c1c0786d9587ab036cd5376d70686c096e9ce283837a0f843029e643c5909b3020d051a1b7a8e0ab0a5eae6d0835c853e35c7e7e5199492fe7f9d0cdccc4de7ec93913747cb3074c3823ab5553aacbea5e1fc0a78624c193cde006e6c6174a0cdfd4e49374099318f444f63914e575d8f2703b49bb20c0d16204b8c640512692953c44052f798e1c49ae443ef6adc1e71472ff20e35392888bf6a7b9180a121aae64845b5aa5a7cea0d94f2f8e606088e160b8f5e34970e507f81a0ee3c3fb97be4fa627363768e9e32b18c7a6f0501f338272e6fb31ca963cf9141769c8835cd69f206c792b48c21cf98d1904c23fa4a8ca52b84a22dfb1fbb278f447812db290d3162c9cfcfa65154153795dbdd0ee9550cbb9919ae0941f3f8436b4f884866e7c1f2ff33e83e82206eceb6f171c1f539ab6382a91e91c49e8b9a0c3f820bf7f75b10d8bba8f4de469597f09322f19aac66d52195b43c1f667747d835681704aa81a198bb849c407094a0e31828106114e41dd68c4058becd3af046426ba2ec04c2cd1b62d205dca8a4efdaeb310bfd81288eee8579013bb09349a64a7b5cc0999604a4421c7988dfd5846d99c4fd5aa6898ab9ae95de7f5c6391f92ee39bdfd2157aad57fa9e77e0e5e2ba96f7b7c2f5a50e7c837e3f09fcbef6f5101506c3de484185b7e56c0e579e6280495a851b5585e6798509744b03bb3cc6670176ab1b24333e42a8239c27dd9e6efb8eb44acf998e1358ff310ede4a9b8f9bd359030423f625849291c6897121cabb90209c8122ff7257481ddb95acc3cfd69bc8c9f1771d20778e0322c21ded7b8a9966d9082b89845240ce987accc38711cfd60d6b41c3de85b241003b42f183089bed60743385902c0a50c91751292d54895115273042f166ce8144d95a06bb5be8e6ec10b1c06bd43a768652eb5cb0975a2fad103e86d4311c84a13fbd5cfb2b3fa8c8d1af498ecd67789dd663017869afd3299776b22a1cca1711b8a7c0001569b893f41d74b9c3960bf370cf3547212f5f1e23a431451dafe48703a3b1e85718a3d5b4a8c450c95d4089e0d041b591fbfe7388f6de2b3eb21e5d72daae9244e3d71b6114d98197c733b7ef66269715286794a1950c10d890a490ac2123b050b04176805409f04b320aeca04566bcb248758ea95e88eb8337a6ab456cd64f6c0dffd4af88d54925267cc2eada692f8346f6c7dd0dc54aca76f3f83a2d46ca4d6a1a7787c5a118f8ca2364621c3351d6a52cbce8c28289129007359656c04e3791c0a7416cc5df6f55f096e4e0f0a3ad7d1533a2a005f644247b7031c250acbaf7de38a32e6e06c9f1730e3d75a887c51af54414697e1d6051f7d4e423f1af3b027295512282173b7640086bbaee577a68ebaa03105b10928ee8a4400a78e8a239f3952fc5646d301ce0d231352ff74237e45cc716b56cbbc92cfdbc1418d4f34aa7ee75966ec1d3afa158d8a753f052b8770107ee2baf5864cefeba216fda83db23828caaa4cd0c16898928e886d1a07768f19d4b69696cda7398af047a5e659c45f61506819781a4e8e0a1a8a577212ad11451bfd02601544fc1051573b751a7066af695b57f7a2605316e02d46cef5560f63a377417fd7b073e84f71c0365f40969e3f0b94bcca427a4012cffd3ccfd5bc420dc66672c6fc9c000d316adf690920282818068b10a12b8ea85503bc6a0e3e9ed47b10da55934c59ac9cdd2e3c038969a5567db9d3e40f5bb72bca35600d9580bec0d9918d4dbb0125eb8879248a08d8518f05c969065e10ab0b343d801e3e60df8209a89350b4a40e13c41d30a51ff1f68aec89d79d47bccbd47d6d47fb5f5f04cbae11dfafad2647120c625841bf5f7f44e9dbc7ce7787014cd7fefde28688d67572913b5b8cbee75cbad7458890acad338b1204b4adcaf8c4ca2a3d9c48efe20e7235754382e94f47eb7181e10956cd8f472afc868e12b1ae1b9d03fdbaaddcd27f0751e53d2aa5d634c2325feeec22f11d68935a6711b4b68374a0105db5e53d9d9dcee66d82bc4e55979022d3ddbd5158d706002579bd77766ddd86e2d5817e21e91445619efb10bd8e7bcce71489446b389543296026d18b5c0dcfd7e02682d09464b1772e06042b9f37c6c9c37584a92053e6e7ac7d1f189b0c277dda70c82b55a20b5097fafe06c84183f88a282e27ae2fdf5da4b27186fa89e6ed596a2ec76edc892ef3d509e6a771ebfabec526b5b1958c0bae4d28a7323540c4c7bb061c8a4a9f5295d44d9ddb1e7e908c7bd328dedc892e2343cea255abf0082a2c898d38753cd38fbb3e32d28d7eca4c5486695e2d0ee2543244154164e6a4cd416828e41637ca0dec95948767e8f5a38445b4d914f0b5b9527954a854594e68110580fa4771ce6caa23a44382a21e59959548609d701cc9e0ca243f6d98409ffc6ab72b8ec59c2ff3918feddca4d01533e01a29dc4a97e35b93f2275e4b26e17158ae9119f798d8ac55dfee87dc9e9741c5ef4ed5f8d015ef4ba3a49122b8a9b0c454d455f3f3ca762d4bd5d9962a1298df346d84727f73000382b9279dace810b523a266d79e8fb2c2de8be3c3c6c7deb643e8b96e1c66b878f0431aa8759ac1cdc3ce2fad6b8198ed4873a28ea1c2e4d63dcd78bdd9a5c417313c7c70698fbf2730515da773343fdd484c4b62f84245651feab62b1601f9a437403921f78374435f41f004d80126339027aecdab177e8d75593073fc1615d231cce437ee458e32d023a0f98b1fca70cbfdd185f2c936c9575621ce9339d492630fa245a7d52c2a4ca66953bce7a12042b285350d0c685e5d01df9540f4b609c2d1ef03e91b9f2200816cf1717d8bb0fc0233640ced35c03a08d74942b82b5da72fe7ef91c8392b15bfa02c387b68e066abb7ba02406fa7c706642f9f0d42858a8eb54d3e06195da08328e326bb278b46e63d0d9eea2cd8236def7c080a7f6f684883e03aa93dda9f1eeac9a9f8425c3376ddaaa8c5fe1682cff647ea28ff55e7fb2d4bd5044b2c9e9fd0159833e640927f5960b3262795a29e93f4aab94dd3a4b852505a1dae1d67686d8c03d4cffea6df63498d2b2a33faa12cd85bdcf166aad40668767a65578250d10a3ff4376caac6dfb2a656f52bd712a02da10f8de687ec88edaf74d3ca95200bb41c1064c4fa9f3be0c90faad531f00532249ca039e09778c95a030760b5c6ae5d8a3622a91c6c89ef2aac8dd3a0a4a28ac8817008f17e5ed7a104fba1b46af9fd397b62ceb4187c1429b609af15efbfb31c57a93c548c861d6bd3751111738e347bf9bcab9c8d6d5a7ebfefa17f389d1d73cad21b76bd54d22d9ad4a212afd60c18c0092c47f79384b82257d87c613f9267ee249f5a9d02e4fcecbf3d28aaad9868d70950fa802f072329b041cfcc8808c292260ec51c00506101c325b584c71e88da0592e354ca0785bc7ea3361a630041f1287354e96b10fcc61ec55a9566fb439f6dd82d846aa3cc30d3f2869636e5dbd98fac27f27d966a1fdd6978282dfcbc65e235bebb6e65e2153b0e26a8040ecf400a979f52a75585e07c5d6410f9e9c325406e4f0271cfa748f0a0499b2966780eccd097bf159e89392c250279c4d3a69a9a5aaa991fdc05a9ed7e128af701e18969ea86170ea6188f8118424de67a87b3bf7ee015b6cbabc59781075574218471a20e0500f46577442e83ea9debab7943b1ce24f2a54c818658cf9ba49c1fe4d6941f0a50f2d84738cd11e658d6b251c3e3afa424fc0e0f78f6abfd2e4e5996c7477a855489f4445f0f630d1bd90f02a447d31abc48ce20983f2addebc8cbeb3ab6995f569c486c98ee7bca4907ed4cc676cbdd5687d624377d560149219d33d15c71ee93072495a947d87965de8691a254f628e2e36c4151d1843460694d045492bfeb1abb05fa822b4151bab22d398e468b6de0a64718fafd85cec69ea4e131d2568796981890e0d95eace7a8d9db6f37430e52bf9aef5915b52b8f742460e475f0cb1421f9fbcc7190291e63577dafa69a87a912774daba9e295a8e2075e508c356c29cd6fec08cd849718dd08c4ee09fd00f00741ba3ba2bec54a0d6b155ab716db2474f4014f2b40da1ad13d09f47d11141aa6dda2c2881c88122c8f47450227f1f5adaa02bffc147bd4fb50bcd93ea2ef4d49334ab35af0ae883b89f32d3e22fb7deb4274d07d5388e8d050b75e44ae61ded8f649d955a467a7cfe15872e29b55ef530f92ab2576493772bb7e7b0dac5c3b104d6441a25689be359726faadd2586c40e96a5b17338a22da5ea81c573dd94ecfd80f484638236093c83fcf4d1f99dfd2d982a736780bc165f4124af10b6aaab1b137e9821ec9caeb9442004ce9f200c5f0abacd352c310ce2940a7b910a1d27c474b0facccc99adc043da66f184810d92589443b7a86d0ef20282ac5248e845f104b8d158fcf30e7afd1aa10921b2e107530fafcb8d34cefcf94815e1816f4f786332d745424a2b869e730577cc8c7296255e1845dd4c0d5eca9b5d81e5de09c919479c11223de716d7eadba21fefaa5f5d8daccada996e00e21ae9b98a41d440d6c9b6e78234227561cee0306887f5f26ba23722686aff3b3d0d5cf152e517f3d1b85d26bfc896f975603e3a5c9968423c2a3a3f23a9f29c81952419525a495616d5cc2bfb8bcebd0c6bc9f780414ddba7d7f64a62f92ba878ebc7b49e8bc146af0ae7eee35b4f915c89348633613b1dd35162d1136c75b31f0bc366422e2a3cdd827ca857a61e02ae133c3c3abb95f979087c83b38dd68b884c4c41f6d7fdbf396e7c345893ffd1733c5e02c21729b774219ba51b57af523d03c54a50275b5149a941f999f9bf91ad36145f2160bbe19722879a6f281c07842cd7dedcfb41d74796d17c85d2c8567c6c81eff9cd2631dc20a07bc92a0033bd52e29ab4b020d9041b6686a1e126f0b2b9a3df22b415eba8a67eab50c1fa461a309c0313738b12a90100070af7813d90954ebc626e4b90d23ec14480df153e6428ca0713a266b2af04447fae7a6c13cea887d9238077bd3f282e8e0a35124f5a6824481de614aa35372b2262da94b9bfe0348a380236c0384dee4ef0488fbad5e3f4193b7b56ec2e4e75072ca75a9748ba25e310ac385f6d438b4bd8f828e9b9b57e3fd9c3c3dafd171ff5e6606931f3008dc9589304d887d2295975bc1e4813857c440c4c7ada40c881a190183bd9da61317ed7430515c0f0603f91fc14554259bc1c1b31af066dc1962170bb5b2f1bd2dd04740f96ad8f45173d7f2e10ab86907450456bc50ee9097ecd22aca6f922fbe07b6f132f0723eef9abe448525d23e8d267bb3313e8ea54007c6f1afc684385e0618b4488a30f2834b9eccef380c6e6af061bba41f0ce59afeb21898c9408a528b1b5129fc635315ed8b9d433adcf0890c7752a394194bd60f0d6c9ccac7619c76221479ec08e5e3015268823c881acca2c306abe93790c589a011282445a987d14ae8fb7981f45214b406f2a5f49ac175a9c2191074789034de0b8f99ac87b52ee0552a5f4fd28d992f38e698e15901db3ca3a579a19d3119c317d408499d84d4916a0c3029e0ec3fe086de538872cf65d914a9c156f6101d4f93d7f6ead3ff29eb9ed398d0982d3cb7391e9319c21e99a8593d1038c6d3c1fa36caf920a487491bc92f85402e0b3aaf5bc7286068816fa1598108ed13372fdd5c80093d5dabadb6195945a0eae517d4a1450aae1753c778bd1b4bd24587d0c989d0633c43a921e6b80f5a5907b529b8317dae1430f55ada8c84e65a9587240ae5c8fd7683e4048aa00e2112c3434e72161536c7087fddc55ea8704f5a993b4c70b9d29eb0b1475014555445ad9160ea06e5021357d00f51c2d2e5e1ea7d81056ed7730c8aac053938072cc93794ff26e26eeea868e864edb49fa7b9f621447381b33935db58875dc0f941cf7ef039911f513e47d6d51fb28cdaef40b207c612bf2099753b634d0587338f4455d8c888ba2950a7cf10cd315d335bfd9fb00d87c2e2fd4486e6ab0ba248d85fd670fac68cf734a982201b455d273624e85d53afdafa8b0f3a652facddf8ef35dc4f07044add52fed389fa993d5047803e5666021fde382fea6cc6c2704ce6cf0585b9b2ce29f37b0cc7261cf4671d9eae1cb4f3c30a911f554162663597764e60833780b9093b790d91761e05c51694c131d1b979b86c9e825314b6d85913c5779d1a122a5e796ec7ee473e6a306fdf9b29ac606d72097084a874684d5ae121c2185af246222512c6e35fa01a724bf71c477e6fc621d7bb0c9a0ac93ce771c438d93abc0371d988eb0ade39b2905229bb7b0cacb93698dba4ffd9834fb2505d8c9e06a2e47a4ce7750643406358e43e205164078a1312d0bf470854d4a6b2c12fd531acb1153af100f485d0e368d623ca4c0a06619a5cbc2d41810b18afc058e183503c5beb5ec1affa415abf1a0c702c6840f9b87a6b5a89aef29dd011b05ea9cb5d81f113ec622c8e6ebdc483550f454e5eac1a267e429265911539a3c6df272f39f3145e74cd4a739fdeb8c6ddcd5daa2923c0410f708cbe5a7dffb84310701e21b6445954d3fa544059ac084056e01a10d468b4d5a17c971f24854f3f6931a299b53fcde8393002961eff78e6141cdb33b195222b11955db479b384b767e16f90cfdbbd7fa4230f99c2914c04311b300a6deddef8bd94261218fd06bcc6734fdc4b783080099148d1cd142662dc26dba08c0fadd2ca6a2c11c41c4d51266a3e93a1fe4643b0d768f56e366ead41bb7a8d2fcd50af038c1e27d6180ad17966ec77bcc4b6352d32afca96c614ddd13885905950bc2bba45f82f14163f3a26449d34af11583c0fbb76be260fefb30ce516fa0d3406f0b6e767d0d1764f35b8c52323eb0e0c405375f91265d74a0cf70c68416391bdb102279f34621846a971fbc44d9a0ab32ec20b6f2c9ae6f0d68f2ec84390fb360bd2f18376672cbd54fb9854cf6b4538dc984352ac70bb988adc65b1a8a4d0ab93e04e94883772a9c19f6819ade367d9825da54e50d6a4884bb104c4ab7bcea111773f1fce9ea6db08f90a2db163c82c0e07539c503cdd848a4d169c11422502149a2c202072260036a65d5c058b606061f4af41e9cbb1d1f5c357ad7a055c2dee89c5b8b31687c6d08ce6d7a165382f1e4868ee0171601782eb2e722659c62f0baed27663e6ac0157299514ec973883c64e984762490978c29e4dc3b352a9a9ef97613159878779e63fd7b90d9399e6405435f6f3d75f98e2ee637f62a070d6271467a800d447979f9750d0210463b6ec7022226466cd0e6d550f6789c01c47021f4e406f610b0284eb4194c54a8b012b39cb183308f8522d8c9e95f07c95b179201b100635ee97d448716d00d05e40b256938cf2348b184095e6ee7691093b96b2486bd4a1a0b4816deb31dfe9cd723ac6ed3ebbd1a89d6fec523e409370357985681b4a7802c762d41863b12eb5c6484bf00c1257067c0406525ca812005eee9afd2b2a7de2bf23c998833c84a385d68bff05003ffa574cfd5ad99a561ca1b70db8e4ac74902216a228f95137e74767be63dfc6e514e3e50c79d9fac859107fe3cd1ab19e0f7cb17d2fc3994e687ed737e7aef3698e6ec8962b3ccc6d9ec9838011016f7f90f7c3b731f2b36025f517673bae70861209e2939120109b902ebd000dda899837202ec346cbe21d4637b287b17b3c5d9b3aecfacf894702020396d83b25cdb45d873551f6746a533ea512bbe860afaa522c9f7ce22cdd1394a8b14f407ac158b100cd8e0386f51ac50ab713db1ab6fc68f673119cd10ea927b608a71fb353440b8e63a141abecc8be22ff2cf60b895868b65d157fbc3aaa1227d7f2a326979a984ff4a6992a777bf11a862a63e08f4183996e482dd8cc61348c2bf6202935865427dabb4bfa3e1cd19efed5221f1145339a46d21f41fc2a89ab1f6be23643c9a7bb001fb61ed3ab619d26343f7be64f9dccd106536d414de2fb9345e3dcfe2e7e7877195a77e1702c4bd842bd801d792b357510eb0c2be4edf7aa281ec24d49a98e759b3d8f071e2a6682471bebda98200c054dc6f7b456a93228b8f414288a913e82069e0813cfd11a2cbbd0104ce607d46b0f2c78d9e6b4b44e886a9462b8ae8b89ef520875a50a59da587250f84661bcee903c7004acf8fb196154a9676057671c1fbef713262c1870f827b56999ea5e0b1fd1baba5820a515acb4c2392674494361bd37745ab65af032aa9bf6ea1d3dd85db59ccdf175ae2df40d3b694c25d8e812e9294c3e90893d9ae26c79ac9ee82d69cb565dd87e3102abf2ac88dc707e39a6ec077fb1d9c6744902cb4f54b886a344ba37c3e8e3223b2bf58d6f582540c6db2f00b1f922bf6aa0dafd3a5187902af05b111a74f38ddccece4ba0a872c8d220a2a30352d5b089c41a391cf954571ba2aa12846296836e45680330075d486e6d838e919a29b2840469fa6d1650e58afbac7d5114840d69ed46d6f2aec06f050dcbb40e3f0b568bae84912e8197ac5c53bc09738404d48f0f01db30d17e840e63381cc0da94528701f723101e07547e0c336b70d692b8cfefaacd9fea07173159ace54680af89cb2f578ab6dc11f944ab7cac2c41758dd4265b9f045034a2c12b0edb69761b29ffd90c9cc5f51adfb839dfb1e56bade1a69e5a559c197bebc1d7dfd0caf8f78b64bc08738269c85028592ca573eaf5f3d051da46cf480ae462b99512af90b505369b6400751ca106dd95c9aeeb379ee58895557ac473a04908c0b7ded6adf988aa6bd7b11f463f1aaadf9a8e45b19fd9bd661a3df6b4974447d8a0bb700b5e9097315a2241cde50ca1ce14e28941b007f56b41a4c2531f9fb25278e76e9dd0c392f666cdb0a2ce57360bb1233be0cc63f74c6c0d5597c66d8f680c78279ec3f673485ebf295a9d460cd96e177a763b9b58814821fb20a54960b2db00ae0887467f10e8c37e9953da9f369edae896dac8ed9537c66184002c8bcbc5f07e62cec7a546446577ee051ede1842f7e813d2ecc26a5fafa9a35880e71ddd932f108c54aab241a6879b773c9f4d31a6792eb1a4568a89babaf3a59badf12314c7346058b623df0330c3c714506279275fa22eb90fbb8b227b1591dd4d4513bcd952519d7c89fa3b499b9b40851588719da71aeb85ef2261691e90b8c41af20f11132e6e9c38843cc2b4dc01a998a822f5d3cd7e0f3430ef5c16a3de079712c5bb52f0b94fbcbfa7398c76c3e04431a0af894027c95541787d649c87647e4846c73c274b418401eb1f34a4cd00b2783c4bfc012d14595ad73a80adbfa2561f257db5246cd52305b02c691eff745f017b17ace14d2a5432fde027cb3a78135624aacb964a2188e537018bbaa758d7c3078f2e0f7cc7eef276e03c631873eea8422d1acbc339a9311b7027f7f78ee0790a170c1d3bd9063539510c1c521a6ed287b44008822905b2a17311fa0280334929b864f449b31ca311b4c231217455d9a5c5a0afe3fa4a412ef232e0ab5f88f6398b4d8952c63f983875a0bb48c1a6985bc43e3662d7fa223430e0faaa5acd17496559ff64654b1516ee86ec193757ae41ccadfe49dd732056d75138a6775e6a6a75a72363aff62b5ef8d4f66e041e8bafa4404fae2d6039739e13abd14da08049358f89c44210e4c1f84430b073df8d9c9f360c268d7c9bcc3b357c26c51043292f45b0391ef61c7627f7197b388699df0804f4f33e5e774f6f4f9797c3f6bb629988920186fdfc10652fc8cdcc64b4ef43b1e1955e21fbf8b1bec2f20573f6a9e22a887ba51cfac2e9e86d8c193d345e5493c459814914cfe946529aa281cc47bf97737dfd5267e9b1b32c98202215bbb59a2c6842259da5e43eb144e140961af1e890851a924cc7c9590ae4c7b5f415d7c435ab14eef49c4a67be0973f94eade3456b7883959f46c96fb01b19c67160e8bb6155ef043500211fb1522aed541986e61d523f1ed37e8a36dfcadccc1298731c72fa89762f62cf27b1b396586aa4393edf79a1b9626fb845ab5dc05217d995821b444f2f911e08d10b6adf4306e05a00a980cc44d2369af05cd58ddb16799be4dbc9ad403ccd6298490f0e674ada18dfc7e4397b9bc988fc289f4f81ee64c3e6ac58e1d5f58f7c16233c8b02e59a43126e5b05736f5aeb9d7b3704afc491d8c6cc2aea2e02e2386d42dc7b491d686d1260478a697354b9051bb6949ab73c61f38126bd610d63a5291b799496234449f41f2324e9d19d8ce38808ced932a34ee26f3dfc7ac5c2f56a45f4a0d2e9112446da129cc66fd4286bfa32f7ed17c5ea3b9a8c88586971a71a93f74fb245271fc4a93e3210788ac8e77ea820936d72c459db68a9403d8899c501fc3145883cfbd7f9fe583c2a817ca688d95234b09bc351247a6a5024612788b73a42e6d417272d11125505823c1aebbd8515a441db54c4961813fc923837cb3714aeaace77ad387f558cacd94f023b93201e6d229fdf4d24da0c722dd6d38bfc107a8109123f84bcf0d3c36e8b71c981f68314119d3b529ed98671ecf83aef0661633ba4195cbd2452998ce1d67850be89f6e5a5e5d2dae97e424a512f11a524e2df11880e2e0da261d5f91942a47fe5b840193f7f90dc859f0747beca6efa95dd55562382b4460446cc0b22a9795b56e1620a4cfaa96e739da7d49b7fe28db306f964af47a6d4f7509dbc1050b28e6675f7ed2fbb143daf26a1040859b926865e686f61957d4d55c71c5b215606f4ce04bcfb7d1fe475feeedab3225bfcea89d8af5becdfd4df7413136036cd0b3392cde128e9a48db835756a26f79fdd64dfaf8058e54897a65d3500d2edf3c80c266ad88b971c03108492b57203d752cfad171eef315f97173460f04b6153ef4b2e9f01fd82ee82c72817b50c6997a6591a269fccb55128497211da41ea16d806c06aad4eb2aad90e6ded7c9d68227d7f83449804c025d758112f064b13ba35fb9cd6b4414646c4bde4781798f1ad29f4fa95d21db7f4f8497aaf64bab1bef11706ba5eb26b447f0a212cc76ff0398551058ed138967b87d412218dca83f24450bfebf6d2e6345182fba73c2b118c7b00976967c544a6bb81f2980cd404493cea0b8524caeb5cd153882290f97975fd8340d2ad3a14eec740a07e0e7f51a1ccfba8847148674206fea956039a61d89552895fabb7539e675cb9a66661ba3ce576035e2c4399e3486181cc6175c3828e1d857c67272c664044991c9a6ef0570f1ca4fe74dfb85af0579305819511940d597dd8a273a7684cd5c4e892dbc16ab9e8238ea287d9aca8d23017c896ffca33b163e9db7fab3aecedd0d28da8b3a49f848be69b31bc9f7db2f18f28fcbc695513a6bde576ba479994db13be6d9f97e55366577bd5c76fcc513f570bd6f5d12149d306f4f1ed4907fed53f8f4745230f87f1e98a1fb14762313dda6aa0c9a1b672f2a1dbcdd2f303c708192c28a4265baeb6c6b8877a57ee0f3aae539e90e3579e7327e819c223695690f6d392d4646a9a96f21f3b7d7186a71000e8080b8676a666e969b2dd8c29ba1be56602696fd7d7bc03c14ac6cf07f14d77ec71d16205b4530e33bfa20093ef95715434d05ccfca81c1543d1d9567f00f76b94d225188e2d16ac82ae5032bd0e85569f40a71e826007b80ad81d000b3c4e546eec1386623e277c260cd750f16020a775023c5ac5d7fac0d2b8561e7b5507aee07d80cc7e816e6dc94b6c6d2d8700d9ca87a4087bd219fbf2f272bc316f3575b90e3ceb4323b710b02ccf92de9ee5a3557a0ca640ac1d73ccc8a447e0fdd474917ca5fb6cf74295983e4ef15eab6f51845a0e7efbf20e224d814e4bc0080519802e4ba345ccab3dd19e9dc2c4d0dc7e68f4662bf2b513fbfc2cc80f8ab923867bf177a927aa95c2e7470663c4d4b0417d2bec0fb68223ae682bc4bc7b3fbeebdc3ee076e47c39a257c748c7db7008e8c7967aecf969d34715a849d9df8fc9381433d3f9625daf33e062111356df89948b0ab98487d98ca82d0e43c859bf63b009dab9c1a1cfc180fad035914cdfbd19576242377d3184fc2114758433a637d0cf9436031542dac827ee6003c248a4e3d49a9f4b05fc305806c83d1867cf99865b768f3e3b3593c1b276f7162f8650b6f3b84f3ebe9b0d6e9703b1e0be82095318843cffae9832e09b1642975a6fa97b1f5aeb4cee2a6b47edbe22b78400736fde8bc98f5eefbb0446c52e60d8cf2c468bb71b8baa967a7628531e2123c68d6a1fe38f9447043c4a2f67e76de7e5d8fa55efd8a314d9dd7790b687df2958dcf603f6f52bf0c3d87b5e7be2062cde54da4f465ab9fd0414fe7b44a9a9143da128b5b8a710281f5cdb92f06f93e74672e979afbef25d1a2179a12bebdd61f2efc36059374275d514de41dbedfb901353da399dc54304144f4a50f4b651e375b88f8f505c8b9c9e2b2e542d741f7893d20914dc2ec0febd9bab530539266630a0c4f2baaa418945e4a48ffaa4ab5b62afff9c6e357f5280e315147da5e8f9640810cc6b937dbf2dbbdb04a4ff20471587b454522f7c37f7d426b80f5148df0c95f2c10eed8eb70093079a0f8b043fc82e33a2b3886b82e25c53db04b67e7a3ea4440d5e13b2caa07976ca05f5e65292191d9dab7fcfc50537a697e1d8c5e3a5ebcd562e30407529ee8a6a53cc5cb7ea1dd67a8d01fa4974c025977814c84c4e49c763caf91bbb0bf2c37063c99f1bc0257bf395c93f70dc0b9bef6e8a460711fc21f0a1debb212e8d2844069e6b6dec505129482b0f36b8c96f5ccc92411f3214ff7b71e5c15c62bc2b4e4ef2bf4a79e0447c2ce68795c5c6a8bfb59173b436477d3164fcc6249cc82fe600206466374ef1c3c6413a80cd7037a2a575e169b703b315be1369b5f029efb70759b347cd5001f8c9b5ac48f8c175b01849008746b385a585043f97c68112cba159494374ad4e0f533c53703c669a5e6fd29480ac5a8bd3b534a0a6701f1f8af3eef0bb8701f0055cc85aaaae4b407d9802b8ac81b4c2817741f74836772864eb0c5f07c82bb0aa3eb152839454f8a704306303667aaaa775cb7ccb5f50f6521608b6e7a1acfd412ed516b833f63c5fa0a3e88f7c896ba24aa137c6ac8de08f2c119cd9dc91bc36a9c066781201d5c419629f313cdf5b46b50af80fdcaea6be5a8390d41bc4cc40510ed991babcea8f045ecb4433e36e6fb6c001c3cd81c5c9f8c378da00b9ea2c9ff9676fab9618f51ddc00699363c24248d0b705d8ad623af37511f2187bad7f7f8e1759093a0b66a50fdaef54b6c15751684f6497e0baed894aefb1f52babe27feee83638a482185662d7aa8e4973068a976b0b42f988c86c8e734ce7ac410c386686a34577196e877273c4e8aa675ff11c020a67a814b9cc5e30133e62c21a2c05f9ca5bbed1f5cac2b696ed4718ba3d510749d25db33fd7ddd1cb023f05dce6ee49fac7af873db364d7a6d9b4013d3d583be0771e502ba4f1c8c9a53cd60964c6c84074bd11320fa1e2aa90efede4c81e41ca0d9784eea715f894a22a80ee1b9df0cfb3635096751adc981e4c445a1c4ebe7908f2c8f6eecb0ef8a12d0dccd00c425c850a299f27895388a6d4045b3dba51a34668c1a0a621b0284dadb384ccfd6e47af4549290a575868973daf498c72a22963d54f463c5b98aea5f3a4d26a00899b7f87c6035f28a235b26a99f89e8173451189312cd1e7789b69e6dd07ea1b896db20aa833a36e591c6170f783f687a5a8d051449998437c7a886b8df31b5de2bcc51b957dc7c8213ec93bdd2564e6fe5d4ca97917c257e753c6dbbc19a364446f8e113f1be9859b748d68421c406e041a05256f1f0466a9d78729a457f03930878addbcf80f72da745e095fed0a12201539e13493cc1543749d06f9a441af3fc4342cb806edcddce63514bf4e079e99983504f023750690db44d5267458de557f84da609668545b658671bdd4496678b2589e03b0bab2babfafee4c421ccc8e673da8d988d7516698521fcfca541943e3e1933f9985145fa047237a8448d45f697d4bdb1a71d8efa7c7601965c60d19c8fe97d213955151579be4b1a68efdb4221f86ac133bd8c62b528c12bd43cda41228925295c5125167cfb87a9aaf5c5f06b228b01ba5663783e3258dd0be5ee7eb864002d8013eda0af0eb784c6eecb4481d75c709d28e78ad822ee00cee958215cdf61f7c1c8533cc385f4bc18fa2ae8e85fec6734daa825669a6987f2335423962eb7a17cdb96be9119d463e5fcae1980fe3196660a3d9ee60f23933c7678d76713f5df882bcaa8a65c6a2f7b4845a9be1836055170987e2ae45c08115e84f165669d9b2f2d1ffc097dbcc20c489628eb234173cee944f92b86ab6ed8da8f39c23c7c7a742591c32d96c8a5fed0986b81fbe31e67028552b9c39fe6c9bfeaa497e5fbb4deb96e39405a1d277f7150752f9a0bb5d6a35d4ffe3cca8bb307172fae3465d18113449b83dcef6f9c9698aca6f1222f49c7526bf8fbc432f9066f34f318da758df3429193b01f651a8b28143325561b65ea08857930364807aa1e3917113bb7c38cb2cb5c533a799a552c06c15a413243ab0c5435ce46c93c50132c71b378f7fd4d21d6e1c5c443e483ce3280ebab7ec877dd77d20f4cddf6bd7a77e2513ffb52426a605d15d647d9b071562189b38e9156bfa0f75db2e88f47fdfd4600ea8baf295f955057ce1059f2e9b11f700b2c684488efbb0b3ed9dc351e22c941438c8f46f309700a37d68edfb534cdbaf526ddb951c2cd97a9e508fde12b6adfa69d8d6f2a0c9bc437661a99d619971beb82d90eef3b95136beb4376dece4dd10b5a89cfa5c712e75d645d7010a7a131edc1d3a7255bf45aff34b7c49d929c75f86b4c6114cb45b87ede34f791d714cedc67b13c3e5a6ba831ae8875242b3a94a7da12ac9f6b816e5990413e6160ff83b193b489dbe72833f8111fce351f522d03bf9e8cfc85f11a2dbc51b2aa1557270107a2a6e3ef27f6c4e03a7d86f918716b088f3eaa86c83d7e55cbb6818f4092c0750d3b9d8b2c1c542060ef79c6d60415c79c38a396a09db2065bd889abc9b771d012e180d4cf114bff64044f0ec3668e65f22701d906a96d0a7f735eb7680ea17bf76e00026c353fedc117f7cdab550fe1bc6c05404137c8aa0fb55236b47f3bdaa70dc059e9109ff17e42f165c2108fbbb9e281bfb200acfe004ea2c7951b2e3ed884d6f6471721450532256b19bac1126ae7fd47a2dde4223d76611b600f8bf606c050c5f485f63a841c90d58885e8d7939af9d86cb77ee069123c3eb21f6ee9873b3a400da107ac94dcfb6db9b53351dcad60b5cb8e9d23143c35760b62331ff3d5fa013c49537d049f3434c6ea0eec4a78f115f84b2ecdf293da82c9090a2276a2df50618360faea7476deeb7ad02b5f7658169beee8a4df9e319b709e6ef6a0c8eb212d6d712ea7817187e556b1eb8be5efba82b7781a1e6835f1f40dd5c6ea1eee4aca4cbeb8105aaae1a41561293fdf0ba365c690865f6922bdb8d69c9b9d531f1ea7d45b1f3eca0c681e9754c6afbe87f7fafc682f9c39c4bbc7af201248b25b9e86f86b4945fd1a332ef47f1fecbdfb00194e4f7880ffbedba4669e38fc60c2c2975f52e6909f7ec8ee729fbed56571067ba90dc96ae4d0a446daebfb11f6ba093a2e2077baaab7681c454c429969ea63509ed97b96e87d9d2490404a1095b245280aabcdd51ee86d7217ff598987c52a49ecd196826941380ad81e286ad92733b9036d3c77f850dbae34be48e39e040f4dd850f687ea81b31ab977394d25bd9fcd5c56eb3d6aa946fb08efa0351296c3f3373e7d093b9f08dfdd9d5e0cb890c18ef68c9fe052443867f9637a45c5ebeabd5810440084b9581e6ce9b29b98320d508b590e69383d85dee5f547bcd18c2c37d0315ff26589f57d11a31a2ca62531ca475c1ff3289b10e61d9e3a33269f8722d62d30d8d1c9c7895b96cbb81593d37e6b3df2a28afbb2d6f4f6f0fb80376ba288d874ecb14f36bfd37d32df229516b441f8a308e0a9a0372f776baebec70098c5055defc92c6109b21430b1ba03f7d969e84e017242db75a2fb407cb6f861aab66a90c7ae8a33b6f066babd0424940797c3ce3785af1f62b0f295875937fbcc600afe36364bb2504571c2e3b80550f9b4aae14f2bb2933f5e49e5407ad0309c99ef57bd49f1ab3de158fd52b1a9dddcfd3d0a5fd871ba7e16cfe527ca2b0eeb4da7f22e85ef4c791b61c3a28624d0d5b8041d524cd2b52f7aa12279950c07e827d0591e65049c11184901b0293d718d1f8cc0e541ee86ad48c5408154a2e0b141524eaea9ad26f05ef562f8921e996b64271860443bb9e0e175254e56078a5ed38625f08bc72ee2af2b1cd544f9069ee6bdfeba07bbc5e4afe8a2d8c8fb850ebc75b5c3de6a2991ae99953d75ba12a71c08d5b1586530e6b02ca2a0b1d7853790612ac3776cabde451a361f23ce6d5818ecbab8bf874877145f81dc9a3cf7dc4460ae1f6132f06db7b3cdc2ce82d168054b8aae53a55e87bef9c8c53a2d99c8ddacceb5e0aeef8bc218230750a62f9f655d3e788e729c6600d8e7dc45d2673b2fe151510a97ddf26cf270918164c618cfc67cb0e487063ae9c6fc33950d4f5a84cf41db8c5eb1da9cbe4baac51e5d0083a68a2f077dfaee0bd9bb981d1eebcfd67bb2c94c1db5164d77cc8bffb8f43201f02c38d984519c9af1453f9efe896b484bc717501119b638f4c77c6184ecb79e7f5aadcbedf5c06ab679aee335ed9d6a718713545c115e32e3258cea68dbc97d79320dcc91486e3e77747022a675fbaffbca2004f3e6d1bf0b97ccb206e27724cce8078523f7f0926620706100be4c66a2b5ea95ac98b84d6cd0ae56c56b6c70541ba4e6faafe362aef5966e33bd6451e995aa9720e218307a249d0542df33686d3918fbeedb70823892f33c357b4dee6862044b19fae374db9a19bf6be23eca6d0b2ed0006c3c842e0ecc57d1272ea761a13b6829f58d46978b414d67c7d5e24f645b23576c773abc1e1639dbd7ddd5608ee3c1e5b44f71f7021a33ad9a328666c467bd8517840a77c0e99be4f09bf59bf9f5b4ebb4363710c551d79cb4784bbec9caafb1c5164df40d78db675ecc7cd517d66e54505f4db02a2a75c2a8b2a8aff4a1b19c020303fa56bdea42ada2a9e454403a9752992665fa8901ccf9e68d1ea0a93f5fa98117f1ab3cfd2520e1db92e18ae69b26fdf1f2a24fa1a0a42073d5b1268aaad20edf4190da7e7b645c8ba47c6831555c8a2e487900da02903e77097092475da13dbf9cd0dd9f3728565a578d4c4e9bc88afc6926aaf01dab1e8acf9d4d0099ae7ba415c586afa3ab549df851897881ce49cb125d7d29dacda813da0ae739eb2572919db23b813fac4cd8d40acca0a96c23f68e72779e8e704fc427d2b884b752ba35d76e5f3285f423cbef4fcf9b5c7593ccf8c01a53690a1744bfb7121b7c24bda75b6026c3a116786cc9d5b1cdcb2416a879b197d0b68b25a0dbd76474cd36be9bbda3a51ac8859785ce01ea269660f8dbf65e3b9c577494cbb7e58d3e0980be31745b5dcd4d89fb548608d6da5ac41ae64a9d1fdc14116337c4bec387dabc9b4ec531f7f13e027f212955d2f4fa149c8203c80aa9869f14644547302fae1cc6486dd2bc4b5b1d202b8b524c9c13db5f7b2309e4a73c004b1065c9b1a429573dff016daa8677e8d0d2d7a337a6277ceb999f931a8f4e43918e2976fb724abcad05eda8143e9c9fc46976f5c1ad2a128d985663ac148fb5b34e0adfa168c190b125d59c690b65722d831c7e7e2c574a3093ec0964f7b313fe5e4668951daeee5c6f132874049daca42d7528f293d8365917bfdcb4821f356b694bd1e1805b429b824a66f9a1de9f43f2e2b58fdcd3ee587b27c96197c78af68b19c3f409b3547daf9d9ca8730d28e055776a39fd2e138e1f25e00ef5ea60ee306c838513b5ae5ab076b19dc43583cbe1d37617081cf9f46014bc2ea1cc9f0ce24475d3d753cd33843cb6b532c19e25f530a5ba9b2dab22174ab3e1bef840920c703b3fcb3d8abb26d3545267c94a17535aba79aebf8003ff80458eda2616fbdb7635483f481d9d3d3ec8d90d886b1339b6b2651950dd7be5617c12304729d307c59c8426c52c5d768a53e91bc02ce05b48881d56b536068c3f83750eeedeba4910e28e00addafff0ab706096a94251dcf02e6ea221460ccd675bdcf3d48ecde2849b75eb14a97793d93b0f7b03877b70afe25f117a528354c0b468bc8ee2a8d921291f143f88c94ebd60a462db1a1455d533f7992d25040b9fb2e198919b1eac27e4bd2767e8b360ec61d5c9d87a29dfef5e0579f8e4e113c46a2826825c2690f9d252774079986dd65a523e68e611835a8578ec02dcf7d7786a7a27cfee635c7960f4c82f11c470902fb2ba334c36a10fd0a323e64b557c7e2759a8abed1153e622856e78ac8a902244af12ebb93d56e575a83283536bb4f7ed16ea326aa7d43e7e3314ea4f5c7c3fc17071261f5b7e165c263d74893b77553867556b9b087ebcf15ba019c119b1f6874eacf87ab267a763acabde47ef7beac4310573867e763cc0fe578e9d460f6fe74d2df2eb76e865d475c899ce5164bff19a51d0299462b48afead35c3305297c608dbc7225bc7231b072616883b7d5178c04eb3ceb23f12849896832a3a0261a3e390b2624ee7ad0dfbd51986b1634ac36d164346a34e44ae562d821c6691adf20b842b3adae7fc63f8c0be7f3bb3e4be54455d9a2546e1b28123c851446a96d5a9bda534ea30fa359ff6ef2c24b3dc04d832584c6494663a8be96837bdeb9eb205e36728e60963d701dbf369ad41b3f5ab20aaf195d8615ca0155d00964f9b76b892eeef4540a53ac6213ca180c40a2f632ec1db76f18ea11bdf9c5dd2901331eae382230c56238452213477e5f9e44bc5c86a15817345d604980f1d65d0ca93e5dd0363b053171893c66eeba09d83ce5e405b766c79b86d5049a52ad47d079d110f9503c7ebcd0b98975cbdfda8f086b6f0ecbcf235d18c184ce53cb5f2f693787b6c90f3a96243a45b34fcd7b5a11c9e9e3d702bff210776c3098039bf6871094d7236911442c550604e50f09f938036330cb2fa5b79374fb760b9230ee1e53a4ff6313768801717e802999497f1ca4e8c1a683c83ccdc409a9556876e32990539338a74a49b1e2bfb6043cf629f973a7212d67374c8b94d82d826baed609cd39545f37fab22bc48ad22be1b0b3c6a2f65775f1cc0c852d9d56161af0fc86a247aea205ef712d03935ca64e0622f7ef2382fd2b5c76563e8767d2ea282b83463be92cf52d4ff860962840a534a6291dedafea0786647e845894b5c127a002cfff72da3e4874a38fb23f5810685dec2266bde999cd2c033d9d014041ec69df92368a828bb28a8c92c664193a87fe9e57e3f4a53e31e8443dc390a14ad984ad6bfa8495dfbab7d095fc7d21a220cd2afc6cca96e4d0f498bbf76ba4b41f1f7fb12df53a68180801743a871d304914523593f00eda58c99f7e69121565e67487950a26bcfb36ba45536b29bb00e22855119cdccd461628613898b4a19b68439f0074c7155fb971931cc808f8ba261b848ea1776f701475f9f1ee8ec23df923dac95bd9af86db2f8c5d5cda3718e858cd4a531c2d6902c71921c0f9d04eb7aff902363c62fde46ba41d77df853e27168c39c7ddbd377d65bd804733f8dee44b82946e8e6437ecedd4a9c9f235f03e4f2728f132fe60ed91027973e2e2a45b949b7e09e753769190afe59228d1951d795c28169a0f450bfe8d64a69c278b0b9de8c514ea6f77fc9edc1ac0cf1e36d4b3f13fb65ac0229badd29bfa057b2860f790ab3afb66ce5406e289ef31dcde10a195cd88e3f775b7c355331b0c9aa1b2aa4dcd45149c5c215b16fccfebb1bc5650705f627c3afd66eb31d9e4d141437955eeb9bf4353345cf5bfcf550fbc3adaba78111bc8326e855e47113c1827cf86d1735eb172f6088f972d4d0ef71571a4daac302030fa6b5bb7cce705f5849972bf859fe077458aa3eee61e83b447ebd92dac16285e20a6f48f3a56e314bc5ef819673a0a018b5ab348816af9022c8069d0948c006e024590fd886d2f7fb06c5c04fa8a7ad74a27f0dcfa09325998a1dcdefe8e59a9816dadca7dafb0cc325fbb69fbbabeb7386da114fa4aeecc930be4898c5da6578bb72d607b49577454a363672739e77cedd4962d5d4bf3d3fbebeccb3af03f44079f649517363190a3d4b3e7432cdc2b0a5ce0092ead3f0fc1d90f2ac542cd8862ad78faf646cd65c95bc62188661189e61186e63789d616d86611886616a86651899116a86616c864d6a866118164326c4fd7cccf39d56449fc1f657c85066480b48e38d43360df896ef6ca479185b516d1b7d541b6470d49680466742907151ceb8dbe3266d5f8e6a11bd7315febee10b87b3c54d372f7310bdca8a06fbc4f5330a96110a5180de3329b39c264bdb55e0a7356f7957a48005230dab11c7916e9d62797537c3f29d636100f4ed4dc3f50092a44eb3c49d157c77c703c8bd79a9942990de847a33b5914f5554f5729ad9ed7de8e7a043b996efc40dc08649f40728790914d457f11600d6c0b11294571509b3c7a41fac0bb3c15ec8b797ad1c1840cf589b9d60e0c37ab3caea709339522d511c52366a9fa7bedfe1f79cbb6822be048cbf638fb3353c3167557502b40e0c0475bef83c242b6d3ec329ca73fca608dd40938c4c842b24e917984a706c4f91edeb4967db4d15539af9a13bc2dc3e6f46b9d538e1a5de2ee84460724a9978a6ddaf48da58992ecfc12d5ad8b07158c823b92f9337de57520c0da188ee3ebd95d2b48c567641b3c0b35586f0dd8f6008baa998a319347c15b341f984bcb1c767656851a09e8223154e72920f332795ada751ea2792b6e25815280cda3020b06437762bd7e96e10ef4b3dc3e08f8119d05fb7a6707cee80c2ed1f5178e33004be545e27d1385727e6cc01ea128c99ae64b31832a2b6813c5eee7012555243b740d855153f12e4ca1d877f07ae53e5091436ab65b2ebb1b7cfce4c216e36dfecb231ff5a0113983a61e85faad6b298687552b36e05eca8a43960e991934dc8637b5968e0532448308ddcf4a1dcf0f5e1841aef78f683c473df4db5edbaae9a0882a2c838a4eb7bdf8ecff3beae5931f5bcccb55e10be339dc72fc82677623f7471429080940248cd3a3625ae9d97da75dbb1b36e3d7e7f8ca8636e735a82edd56130324f67fef4f8751b51c7d2a713758ac73b59f9c55f930e7afccddfa8f77bd299d2993f9d35f8ee13f0c83ef71969e6dd484f0657e3f7241a6de25d179233c0eef7d0f9bae2567c634dd7f8cb0c0095589564f23e477652eaa477cf214df4216d73ed14ce8e56992b9e35489a8b935fdd1918900b257afd54186c7e12f889c6b9dd3bd609b06131b2b32476a3b3633dbe83e8eff8a76f21df6b2bdc71e98abfa39085fb4cc295e993f0d769664a7ec394bb2acabbda3dba2a3fbada6f74d57174b56674d560ccf15b8dce5c2eb449554ee46b16d1f97af8746572da07ee53865b5e869fba1d2431bc6995d5350c7885be0e7c121f44e29f7e354873e52eeeb341776da85338cc913d4ffe1aed4393cced1380c73571ebb95dd9726e6040d6989812eb32465ffd7e1a7342b09c709c8b182ff15d3ceaf124d62daa00a90a0c322e1dcecece3052975a39b270bbc2888c57673378d82575fe6cd595b7f6753957850fdbe61719c19cf04e99db9d4eff6abbaade43f6374cd1d7250dbee7808118f0fc1a276a85a6b5ad009ea9a55fd488c2502a3c3ebf68a1e37a253eb9efcef70596a5f33fbcbead38be7dd3142941005889b5e9fbb86700fb413eb3732077bf8ccd43dfef089a86cbf2515351a2dee39a1674fd96ba1d895565bc0c8e7f87a4c64795827e9f52a64cfb8d636fd8a914f7385bfeaec03d184688a0a90a1986f99ffffda79ab7313dfed7bfeeb9aafef5bffff3d7fff7d7ffa9e6e9f8bb4af3f2cffffdebcf9f6a9e8ebfab746c87f79f3fff72e66c3ee67ffde7af3f7faa793afede8f7728fff933cddb980efff9ebcf9f6a9e8ebfefb2ad9be39f3f2404fde7af3f7ff62dffe7cfb90dffab488ff49f745986364f8f769ec06a9e8ebfefb9aafe9337e9b697c7ff9c47f537f59f2cdd4b02fbef02a245a76658866118462c6f86d519866198f3b519c6661886611886611886611886611886611886611886614567700c8661d895611886bd198661f2c48df2802f78c78f19869178866118cd661886933996917607662dff6618a6b2198669298661c276863e36a7251f0e7e0cc3a83bc3308c63330c239f7dca86624f7bda27330c73f10cc33001c3308cf86b9ef53b62046e72866138b6661846b418866189dae4da9aed8ac9ae19863156866118be6618869579908f0824b91304621806bd198661779b619831f08e1f302588f0e50cc37084cd300ca3330cc3700c2333ac3165d230320c63ed0cc37847cc30322663cae4dc6133c4a1cd300221330cc3180cc3305c6cf2f8b1a46233c436c344dbcd300c53330cc3280cddb3253d679183310c5ba20cc33093cd308cb93ebc0c82e373c508cf30a260330cc37e35c330456d4b9cea66a180e50cc3c42fc3300c53330cc3c88c2c33e973c6213c300cdbd90cc330e9ce308cb1ab67cb67127be5a8cd30a2b7330cc35235c3302798845a08e49232e40cc32427c3300c27310cc3a6c21a59cd8126a132320c3bad0cc3304ccc300c231674114135c3300ccf300cc37c1cd27e37c3300cc7532c7f320cc3308685a9428221a35127225b638cd16a766cc7a252c721dbaf8cd6a62ddb276bd3944cd25b4881b149ccbe9ece300206b016cf300c8b210b1297281b3c0e2a554055e2acecbea63532250f43af7a058b283637afa6ed99321222c652b7e882b7fd787d2133aff3f41c54675786bd2d85ba76504abf19b8a70f2f397d6c3b9d8c25fc1d8e5aea9ecfb0db2fc8b8b593e801a087cadeb444e1bf19cce189fc0692bea6020588d27254d955d57d58681995505483a131a6637a8f46ceb35000efabf0c56f234501ca2f09cf34545977b456235be1a47f84e781a9a24f9b448f94efc990d3797debdb7d5852dc5b687e1ec8be4259a59badd2c8bbca40ef7d0736bc9bb3bcb20f3fac0185675f3a846b70b75ffa4b7ca301715b52b1dfd11dc03bd206934b226d943e85692cb8c90aaf98ddd2c70125bb86de70e418e4be99b74d36a7842517ab7a2b391ab32cfb1b1bd4e07e90653652d1999049da58c12d9038bcb4b19cf994257380750942a04874a39df6b1382cbbe991a60cdc0f6eec2d4a2ffdf443a4e43e1ac7f57eb7e2350c13d4ae32f142ffd0659ef2045c13f0d62c8bbdd6dd12a55c55bedfe2ee200ad281256f5aea25a9f987abd36873d05933ccd2986978c866a1422fbe61bfe9cd62f1f0992f9b190ff1f5a44498fcba510c63987c999f7b9d59759dd9659dd96c9d59bc9c31aca3b819b27f062cc5fa382d4877b9a8fdbbbc5c32a25b02ddade504f0bc743dc669cd02cac4fdb4ea115d364df8d76430851fcb94e07dcef449c5d90c76eaeccc2fa38dea0b4f4e81d4fa7ea54c4b75d4f75d909cfb10b986eb0a23cb0951eb4f223f0d23f4865905ca7dbbca8525c2227d93c64e0bbe5f29d9203ce2ebe7cb1948a55181ee3060a3a51f347bc27a523cee899cfa3ccde4ba955dd99deb4118d88927486fd1ef2ab3ea2ac803b286c925568d25dc4c88104bd3faa9d58399001b485f7ab51c8e69d1341d1267631676657326476a10223e4294e423e793d9e394a43eb4286e3828aa0b6dca8e4de1a0af5cb27904d28be74bf03f75e5d5b51f3c860c6c168cecc544f011c746bf9cfbd155bc245928b724791ac9544d198d87423c20531920292cd4bf0613540a35e186431a874de4c36c110fbffd32cad20e6afe287bc1876983e60b8f0baad997244a43371b7c570dfc371497e4247d77417d97d0f318c71088a83e95357994667171e40ff197e358303552c9abc8126a1ae578d51d1f237f677b5b1f2dfa24b8967ff71bad7158ea2d0ed1da148f67d12478a84c237f960e494afc4cfd3a48b64cd82848f58177cf8a931891a6a23fc3ef45cac621334b9fa98cd91afd283b142a35f4dae9afabe3204f0754b57e33ad76ef6aa5339576f06a99334d70e4f53b9def290da4bb73f36c7fa464998f4a844ae42579d8c1a90565ab781e1d0cebe1b9b9383245fe427dddc9fbc0a2bcdd62c36aa5e88112f7d4eb10e567528830550c0970e8ae4fe9d17d3fcd2159e253627cfb0d6c698646e8403e9b47fba08fcd5a2947140445df7d7b7a1e6d0063d179af242e4d7df97e8f6ab9b74ab65a94c3c5db41abf59be9b57b53cb7c4f4cd2428cfc2436a6fab241bae513088130243d9a9d99f09fb0293bc713523eb546518b8490078fea545c32903abf3c1c1c96aeb8ca87016d1423d8fbdf59d66cb0b1400fe9173635c62751ca9c558ff5cb44e954d11ccea9ee7bad24c11003b59e92e0b529890a8d2717f92ab400552d77a6d40e592df3310958ebfcf2707058bae2a85884eff284fd4411d988dedc98a57ed377cf38102be55d5016d07915c2a419c31d99f0d9c5d30bdfb765f4f049f0ea091ed1ce9946dfe08053dc8ce9f6b4abe2ab48f53871a409cf3b94ac8be91cccba80bd909681afa2f8e0546a2e42cacb880c0aa5de96a995c90c9b6df04d387ac0d180123340a2a1a943bd32bbf0b1189b756c2362ca49326fac836646512d1535e397f3da0deb72c0899b8ac82a04655c1eb6cc04ea9629a249528cbca7b61c21397e3f33754ac5f87dfc679879eeeb03b9996f19fb40d22c4016cb1a3c5f539fee54911b3b428be3950f11b104ccf89d0348058731e7eb7d1502b9803290a415beb9b42311b1b502b5050f8acd4e002265f88a7e726472195a36623572e1c49b2349d09c3754a646c656111c71e67dc6455c1431929794f968179740cc7935617ea84f134465d3e876229fb3eb1c4f69e3a68a72390f5386381df999dc198fe2e7469c708d7a7537aa999bd68257557e9d451591cc5d83a2108907c1350e5a4e361001e87a6284df01a45779958e8693d43f47f331f3b64ee643d0edb6fb0048ae698c6765ec5e873c815465d25e845b2838eeaf38005b0312078e67117c63528cef3156d13c0cba04441baae3c37761133207161afb1045c94dd3d07d97936f1137b6c3a5351eb195e5cc244e8e45878f50eafca41add1ac075f8acf7c457892bc9f555091114165535244c14a17a43dd7ea1978c8143c4b947fa46d5036232c0a3654490af52a45086828ec90de66b265037ee5d2121b2e9ed96a83bc4a2c7b2db368af9d4ac850856b6ae411bf7600ca14b720edf251b0f94ddd9447acd6422d1292afa122972858d411bf760bdbffb25c8877d0fb8f7ed8a98b740e1dd70f9243a29fdc05fde046daae99a8b47d8ee3f24a3772d8da3c619cdab017516a5f2e4c366efa1e5fa6be6d4357c624e08857aac9ff84c2a1251166cd335e65d599656fa37995f8727d5cbac30c1dbd71690bc9d8048741ad872224d2026715b022e53c5a0a8771d1dc0d21587b78f8334918e1fbf9ffe3cb493b48b1f9d2c651bdd96f87ec35e4f5820fea803619ce57113fb8391351354340cfd8f08fb14481160f464a5255680affa571804e321c61f9f63fd7e4fd7bda773d71afe737b419e74eba8cac4b29e669b2e5d73ce9870217bd19fb76604e729ced142cfc600c9bbfb90bcb3ef6aa67aaba8524437c805064792cd250d526d83beaea933b8646bdd614bb4bf5fbad4733b63c2059f2957e4477c7a30e1b2d48afca874292e89843bbef686d527947d26f33949376551d6311dd765ecd3315dc7659ccd600b04c37f7d2ee0d6518f85b4702000c6508985c2becbe5c693839a4a970783a4260c75370b539298af0496a3077011d2b97cd0dd481625822865e9d3d99045a78081bf509abdac3b4ae13384f63a2a32e50a410604cc874f4b63e962d1a963936d435dbb3b59129e1c9a1a9e5fcf4c9e1b88df2eb786478a5f1592ef3b4ad46a3b9c9b5302ab3b1965dd9771566ad3ef779b7e8aabdd2f36ed5260f8a1aa1eaa26ca3c665b970325844df866ee1995e3e1dafac1b5c5638da8d77c73df6f1f26a697df7b2c18aaca2f7a024bf20b3d7a42ebbf3e0cf0256b448e0beae2c7fdd82036e65eeef55c51833a46d80ee259e287716cbd8becfbb398b83d44598ef7a64e45357e36594a0f5b50a5fbb7c5a28b315c1af525af0c16c6170e55f1f2c984f1ad5f23837b120f1a12dfacbf7391efadd53de5ae756b358b5f748d0c79051c1fdd9cc0105cfbf1accb8cb7c97c0ee5b04d3f87f1a3570c83877bec36937a9bfcf1b69c28cb7821308c1f7009541a4b178b9913171b77eb1b7377ab06272d5b077c69774695f04d178f6ccd88bc5cea1c437b0063677cf90a364f15280d165022b06d5c6de6ad57374775a33ac67220b2dd54034ebc0935cafcc65a63d89e613eb434962e16d93a99d95ab413916d588c39eda7befa9dfb95ccc1121cb370028bd88f5e312fcc4954723fe32632c4333952ed1facc30baaede3aceb0b861d80acdb0a651c236d7fae15fa7e20881906b30e512c748cc838c14730f5fcfb35326304d93d44dfddf81fd53180056ee05b811c485da009921d205dc49d4f52f300b9c9b1605d90d2f950bb25b16d9d1fdcfb9d1249c2ede527b002f50c1f233293907c5f7f758088cbfc63eb380cba04e17aeccc1abcb42288b55da1e103fec9ed4151c33e09bd01677d7f489c203022dff05cf8900208ac6e839311ddf33fe3fb41fb963e4766a2bbe4bcc524c0395adcb4a52154157d1f55ebbf1a53387bb77ef2ab76f368b418207ba2326748b72295fa598326b474718d9fb076e1061364b64b64b7b468768c647586d6354d2829c68b0791da19b8d8fa49433aeda86d2667d3c87c33dfda7e1e4fda2c2139c2da6b972f755e97f285d0c735005e5c2832393b5a7c7c6eeedb8d9b6287bf54bc8e649184dd9195d33675ece35f0044d88f87534476a3dc5eb282ed7fbecddc0cb4cdebc2300cc372b3b033dcc7b83bf3d89cc1183683da5cc3983b43de1cc6383bb3da7cc3f818cbdd7cc438372bdbfcce780c6bdc02cfd836b3df9cc22814d3303f86117206623897e16d818fba340a44d21b0e96372083bb4df631eada2c667fc747fb99ce86b5271e8ee136e14e01b79bc54055a7457956827eacc3a0e9f68cf59c3af278c78d003594dd610ddf195babca90f99d5f4e11890e5d6a9f2e28115e960fe47daf6b05bb4308e8e3f291e90c4d781b8121fa21eb52383e23ad2f3000621abb5ccec01d2b63fa74f6f696e843641dacd002920ec8ac2200de15f5edf1c1791e6dd8c4afec1bd64cb3dc3f39f2998c73df2d60d6022c946f5d75e3e5944f8b485c70cb211cd9b45d0b1d287b39fd91f0d773181f390e56d900f8270514cafb2ece69cbc623a3d2be606b7b771c2985107847fe61c82e14fb66cdb1acaf5fcc4b886e84a57d975c4d8d82a1a28a3eef9c2321f17af13f56f42466c6f1e253c0d56100bf769fed51dceeea9acd179f458a6e95413cd2022de530b2902aeeab49245d40af67d0ccab939b638ef95026e376647ebef37386086f9acdbadc5bc4058dfca09f5f9bd1d3a140f1d3bc13e16d6a200398eb84f0272d00427fef8bad64b04d660b2968462ceb6ac8cb571de5b791128363e58f32d89812b30fbc00779608ebf79e86589b2c6f1006ba81f316adad7a52c247bc0a6d827b30330cc3308ccdfc18a666980b041986c6902da459f4f1832a664013db20faccc605d7cdbaef755e779b8118dc12a05da658fcc1b655caffad2a330bab3d07d373e97bbbae9492fac203078f94b50d62c4b3001776f4520bfc91e8e9b9768b5d208856e53e52483c5e52659e6559563b39942a863e20ff2d8298dfca88ff96e01e12b44b3a097cb066ed2f32305df2cef57161266cc7f2e2d989a98ecc1cbb73a0cb87fafced57834e6f19f4dd0995893c5318050507d5624696af7b56b5fce5dacb84c14f1ebdf88967cd1b38dc864d69a9bea57ac272f33c3669fdc2839b28df21bc3071d5303fc2245a0be2a7bd44a2d2ab10ca8fb7f9da3e14cf73acc88ae09514433c83e29e9d61b6502f61075c5145bf187821620f66f8053f2f8477ce15ade65013654e5b53f1e4001697b1c993fd263c9677f2abe08ea9fc53263edf031fb36763b66b3975be68e329394dbee26a724aad477f21ee9d010e9ab7026f5ff1e384a9bcb36bc71222fa358792c6e63adb2334d58c4503630a601dfa368131b8cf318f04fde6aaf2f78ba51916312461cc90784c8021ee84d777d2a486a5fcf6fae0fc24f8e022226c644c9a5490ed465b024c9e94a704fb93d97a3a1a1b1590fba15c12f6d1164ebfa10f5e799dd121a9ba79e1c0b3aa643e7829d3bbe7b5d2577470dff2d76feb896e8e93ad463ed84e47bb650e5358483f3c7d0d88f99bce6100c024d606e32c0924a369a7a47c4ea68ee81511bb6220831a4106b1b62c1c438069097584d912022ee13c74e0c7582067a39f46937955015cde005d756fb4365f984226cd86be9e76f6180cfaf8d181a27f562d0ed120f38b4fc2e93d2741bc111772145850cfbab970f95dbb8afdf2d5b1f8c9e759f0377d98ff58b010d99362c9855e15e0728115d08033488dc96b16e9755b34b74b1680e56df55ad9f43a0940351c32781492163498da488b7c9204d6f9b0d81daabb6230aa46ea5c51b39d854fcd2b4f683212ffde31f1158d845ab70f07ac5b477ceb36e1475ea37abba7de3d2ba7da884e87b37d03dee6b4304a58699daa0b14f9f2d5923eef1e2c875def2d8dc71efac126afc99eb4ebd15b24653174282799ccb9d4d0e86fe26c6650ce2bc7f83ef994419549f1efc2ee0baf0d4129e00cff6de25245811e70a56bdb5a43e7be08a82567f1a1688d8c2e044ed0cb757f8d5e81c0b36b49f0e792b99536b4171d39758a9f4081465b1d14b8e5968b4b214ddb051e9725d2cbb939710e8514b4053d1f0949d73e831e7545e4f04217ab291056181538fe62aaa21d18d0aa9a8478ab5d3a371f44228ace308f9834bac968342716cd2ffe458de188a2e0e18b26e7b269e50f5f4852f58b544474e9c1d490209202a11dec05d54cb11da1e32903a7cad77d116620fd0160ba4bc56189338f2e915d208b242bb2d5f0e211e59fce38ebdc7bd9adac67cd71b1ddab23a115cca613a998489de1ec8befbba3e305ac2e181556e3d2aacdb6f3f4c56f513b1ca679b9aad1ca3b4634af4ca07276d48a90fb03d4d2f2ae90164941a2f1587a757a2876c4aad01e3f2e542a9fa88aa2ebc950218ed0888c602c2641b87cf0122627b05631ceacc119058cbccf3a08dacc8caee11804b9f3abd0d0aa3e1959369678c103d568d0c07f7b150be752c85bc426d1a345033c490fcfcf92c00aebb44267d647bfa06819c7446c479c919c2f11e71dc4ae5307f81d4777f331c9a3ddf0d60f49e2164a6d4a85b96477fdf9e178ff50398ca1613143ce7a7fc727ab367ea18a194a90858f4ef9db68a375e7909a3e1d67ea70a038d4fe15ed580fde221f81511941e0c7142efc30f66cec500ee0b535a69ec28af5ecb4970e9077cb4b86c3c904f4e9812a06f3a2516cafd2a8f62e104805a43bed4bec8aa9bf122136be7e8f75e270267b2f0f661fa5b1472d09e5ae96aa2ab0d84be0c84da87a11d2ab8e7475289a2476f419beb20fbef55aec4a97d8e5d0baaa63042e4e6898ffb0d66ad74d85599740d45465b5411460d178a4405573632dbe23d5b29b0f80d7094ea6445358382d749c93573fabae35d581d57c768abe5d825df87b195876f48bfdbeb2d7a6d819920a2ccc432ac97c478eadb9217033e36f25b186c395332ae96a96a210ddd1005c0738929e4b8015e13855da05a41282b54b73de0ee857b020a32800a2c645d69fe8da3e659cf219799e344ae8e440c824cc1b6a71db56ac24458d9aa201eaa058d1409399eb9ded57b5d097546962c2754236a872d009c268c47d07284b46daf073567b7bbece75f826556d9cb33237eeaffd31172a035b050f8b43b62e72aac7bd34383db2402374dde20256063021144f6dfb4479e6541abdfd44d49dc676a7ef62c43038d6c6959ff3229efd36d279d856a61dcfa45f5c468ec6fdfc0709da1389efbef057f89d5a45c4e038abc21cbd932fdea263c654a299a68a6c70897af419c45ee1394630ee2228eef78120ddeedc3e574275ae568f1fda9b2786427a224ca23aec87aba7c8db058c8d3854d88f03178488ee27b8b3264dfe088f8760133524a63093f95e06300803e5a95dcdcdd0f0217f974c0229dd1480df45edfac0256ff9b9a30eb6b5c8516b37b0a3446072c73fbe52c26a239a5f68d82828a7801bf43f2f68c7b08d04d6b5d97cc6f7d15c67b8cb12894a166500ddc2d0840ac5c60c98ef124d2b7415f83cad1af4c6506a20598059b16a2e77a9d738698446dfb446880356f0a4321f9f4174d520661fdc9d49a69a11a674c4ae8c32f9727c05814f04fd37fb5e238bdeafbf0252c5bd9d6620ec68109a1a67537f4a27b5f9fe434beb0752787dbda5afc23606eb0681e36e87e2f037359f3a56df22e669654d5e195fe3776ce3ce580a43badde485dc7856a946af24324bae5fb56d07c22f6bf4a0454812dd69700d978dc6f83874d76729bb6f8b94f2988b35b0c6eab66df70fe7b7859173d91766561f6a999878c10fe678c4061cb057141a41ebe31a24175bc07aa6a5628293b24eb5f82872c47e9f888a782d61ae22674fd7f7ecfea9ace4d676b7b8aec67f1ebe425ccb2a113c5e968b63a698043ff50735de018b333fa52216438b1dec10b0765a56bcc1fecba15e629aa84fb11a4c9bf234bd9424138acaab962b16a03a479cb334f6e0274f819e4a5e9e1820e8cd9a1ef82e0b34cdae2d6a4df08bce9b241d8178f21f68c00d99ca4cf58e683def910cdeed3d00fa2cffe85f2a177e25fd121ad341fdce0e259e482d6b39e12714edbe67ee153754e5333ba776be5cb212994f310c127156ac4acccac730d61c6641da4fc905def681fbbd9888fe50379f2b54739d805ec2965763c5a731fdc1d882eda67cc282a84b5e10c40bf2f8019531794dbb5759b2f66054f5871ec00042b4db3192ab7538a08c9fc2a735204825b9a6606f61ad12085dc8675d68d6f1557608e9091a17aae0ac9522bf63391b04fc3167d6f3a66d252662de2156bb99996e69f66bac55102ed34e434bc59c9ff2c39a9430d8dc17de4e8813fe27b4efc02f474a7598c3566c59530c73ddc3c779af28701fb0574106b27aeb4c1dfeba53b7a09a4c9041e4ae3139dd548de698745ab6184622a60b14ad421db4b9518e2ed9610f7717efc7c390f26fe88ecdb4cd1b42b8a7268965b1852e90a90282f6b4d83ac7eefb8509a6d7dfb98997d53a737ee8ddda1c8936d77d420d5bd561e2a2ea3e6040619de0fa642699e050ac66ee5111c24d6f0a38090f5e198711d1b84c2ef39d0316bc22c0facddd13e1a0cb1dc58c4c7966c13b0c2234f33c3d033cdca2b8a42c443fefccea6ace2986f8f93c12aa97fb751fc8aa0ca9bb4704b5b8553bfdc1977ecf5706bb3374cd334e5e27734efb8ab3726d1f845aeb6e18f7fbed0a1163491e99da8fbd621bb185d9996490cdfb1cfb829fa7afbf80b34624a3f3cd4af3cb173ebaa2f370e4e91955247021f921d29403780b45bfc6ccec100f882bd5a394f5b5af6b00d4d88371e99c68e229a9eb1da20a21b16783ec089beb726feca9d7705d7b597f6d805fa6c2bc9b9bace1f79280ff28a57f8936b48090aec92a9abe0380e1c99bcfabce6c0817199b3c4cf1c4458dbd3fd5d7cc1915d248d5312d94f9f789baad6d9dc1d39a2f67ae85b6a45fe20e005eb3bf390c0a46481db576195f0d5eeb6759ed7eed94ba9c17c4bf707eb10e15be441da3fe3ba16b8d7b24f771ae416b651c2eb8653641de9f0bf19626329d154d81c05e526e6584290806e6b8827df5fbad893569f80de5a154021cda3d3555190bbe5fedeca45931a1935955971695d1953ae17901c71e450f911d92d71cbf411bc05f120399217ec64ad7ef2a40313ab149c67cd0cf96a924325e059618e59c6c3efda4c645cbd62d610e6e891a66ea1fdef3d65a2702bbf906c1e63fa0e74d20b785510cd91410b8c51075a7c395e1f1f1e5f49aa47f5527a466cce4fb1c0e8cd9a67d4907ee2de4b16e23478a3e4e10bd61a824437e42c89e38de01b9499a4595137c5f1749464dc1a86413eed4b2ac140123ef04b20d418365db64d555bd383e65ba3c031a4cb5ed78dff4aeeac2466754a8f836653f9be44c370c37f6b70406b0e5066f0b8d5d880c79efbac202d399063e415c7ae6dc3902ed1be7f97a77a3ec94489af745bfc63bdedf08b847a874f79e2673d1325be5e19218ac5f82ed0cac6b5373bb5c2160433a71103a0792c1bb0cdf244b3deaf71d8b23e4afaeacb02511a5273ec6f18eb156ac214c4ec5eeea678d954bbd83f0db908e82078a07e08052ea462a4ed3749c2153ce8077ecf210fad39db57a3d6697c225c5a4f40ed42d5ce480c3c492dbcd2ce77239e5bb03efd77af248658aa8e1e039cc6349636a21a48a5c13ad0b5f8df4095d23ba1c35846d0c1ce86f17d63caa268b19027f1b5f3b9557b3f9945ffcbd0d7d18f2cb3cad338ba8325be771b9aab118f5b7147cad51eebf79f521420652c63bf04b809f5766d0c2c04dcbdcde5cdceb1fdbf93891c49fa199d96dd16c070a0119082a93a2cb034e425635e0855e10009d13a75dbed625f75515e835818c2910e52e4ebf93bba436844be0fa1d38541ce3d7ce20689fc787bc39924acf1beb96570e305e3f57b49a520d639dc113634144c6c40611039eae8b9fcef7bcb768c1181fe9415af0ea11bca539846d856dae40078e5cc20aea83ee3278e1b9b0742a4a81e194e5e9f598d23f22c73e6a02d1ee5272e9988d12b489768a2132697c02f678bde906508770ab1a628adf6f88a23440cc16349b860194ac90307ea5106c5617a4efcc6382c70f0e9ce4ae92eb7e5da7a87bc5b6cc4403fcb38e62d640a5d058d62a66013ba71ff8260ba6ddf86ebfd648d90b716fe88b15798e5d6d8c8228117efc1593050058d69a5e6c4f92e8279324fad061982568aa21e34c78f073f45b101d5e6ea52578eee82127b4b23c9c4eb7e449e94fd1232124014cb55f2e7d185f4a6eaa170845e916585b5996f5b1f4d292552e7ad371d90a9855ddf5ea3f11a57fef55a192fc3220d2fb438fbb577a74db6197483a1fdfb19468a97c27d62cc72975885e990ed5a4d6fbe5cf03f59e2d22e9ad0acb9757b59ed9cde82ff52e29b5bf5b260358890afb02dccae1ebd207e2074c1dbbfc0ee8ea6fcca9c6f6885d4a96a44237b1dab4ba56dd5cf49734852a3ce2189f353111e26170c970bfd70f05599ab35795f924663cfefdf8bac54aa9f1df9b30ded57160f663c699ac138ade9ac84e56fe1dec05c858f619ce5ad703ff0dfae8def7c061c05d2ffd9e79aec1792fb915edbc6b2fc8663e010b1c2f71f99550672ddf14ceb7bbe0540a53421fba582ff00e0cbb4c8f8aaf48aa6aa280788000d6ca4a8c00f254f7072e69e015c0f44c31180bbb79da12d178c11912a4698d9e9aeaf77ded0886cdabc385693963c995959adcf004c951007ce3da667fc7541a7f45bc4a6c7d0729641b4cb086adb272c0ce73d40e8d49caa62cfbb9a3ee9b43af43f71cfd04db43238c5deaeca7fc80e299c9dc453f6b72ad1d22d386065d1845a9e0422772fbbccc0d8fd864be825a4894279f0bd5ce0968a48c803134aa2e6eb817bd8ac80b6101083ae3f53409525148662c168e932a9b263836547eccdddbdf6dd63274c97c134ba6ed86aa55c36a3a03ae8026ac016acfac1d12ccc31a8cf1820b544d493ad495650757c624d8924be02fa4cb6d684240e83144680fcb4357633858a30e2fca89275af3fb0de6c130221c425efd8bc7477ac2fd6342885d6acd33e04e253ba96dd15f254a857abb0c334a347e670d596d4a6555440ddea55b8d287d31167284d3687d020e675c4ec0790166f93316a9622dfaefd118f8923fdaaed2f1436fb6159ceaf501ae01102cf2681f7892fe394cb7fb6eb721df2acb58157bd846b04537f6dcfd906a4ee51bde2787c1e6bac1fe5415e49924d3995d19530edb1d5b4b1ed7e8a1fdf4b46689149dffcc5421e34612d6755782b818f9aaaec1d2de158bcf797f6917303f400a8f50abf710ab5a620b80a76bdf6f32b7f81fbd4434c893da75c68574ed346e451a773d70ea5cd749a3f4611138511915de408658cf42f1830922d138cff4c4a68b04e4f5db59b5236eca2ff4e1f7e8b03f9ba783c8acb74932fa0b2c6c61fbc040786159d7d718fc6a8ad271a78ad09bb4ce7b2fcbb9232840f40ddf9ca491ba6e03bd1d682b299774c5d5b79b2c84b0d4bb59f0c9384a5193189085e5de88b4880915eecb76fff5ac9c8a4172e47ca91378af0c5e90cf842e71042b5622bfac4e0d6695bd43d316e0cf09c29efb6620b10b49ae5df8f7a1d606ec0da59b4dc4ee0f3a6fb04e848902c9f3fc9d7985b5e6fc669213ec7a18538ab2b946add647aa0fdaa844bc18c20a3880c542232d8a32281492bfb282031a266b1340092b0d14e47a0121e95b49c9ed0032975e37775f78f7f197f011dfd7bb6d1b4a25bfc354e2f70b1f25d4ecdbae572bed69e9cc6d7dbce4d880ea9420489a5c3835e0cc6ac28c2d08f96d70249ac2c9b74f119ae1cd16d70a4fbe734b6b4e2b23765fe6dcabcc3d5c6e0ba99d94764866509e9551862c3ecee8df1437f8dcf728052145257457c4d165a84cfd350164e5a26e3a0e729dd22f12ccd4aaabcdaceb970af9ad2cefad6a86898dac2a672f8cec8815938f1c73419d7a96e4eff60cae712a8b2e1d28027e7dc00824621be9a659e70fac0b6024cde2e704c3fd91c195d861d81bfa140a58f5c3146e81892744131c3bcd88278d4ebabed4ba647ce8997ca4c8873cc6db228f14bc143c208f11cc9c2acb7704b2b0043ce95b5a00d0f390cd92cae9adc1204a1c74a14163b82c940c68fcacaaab555d6a433c602a6949a7e902fee1d95c29ca67dca9381ea554723f0f5d66ebf482fbe121267778129af11ad5cb855f2d7c770a5981534e504a3e03189c048776aa522d4851ca7ea86583dbcfad16d7573fb028cd0f05ea0c80a9e0c30f9097dfbb3b22dd057a1ad88551ca273e499364847e5fd4476ecaf4bfaf9673b18bd9b1ed8c5f56443aa3fd2c455b86435f778c3f095f7f873824593e3c5407b5e448b489098f3b2ed8cac3fa20975e9df5e804c21a50328314ed239106f47f868508e49cdb06844ee670fabde7f38914126a3016f5c9935881db28cbd33ba41dc364d385adde2aaeeee1d8e41d1f5b9b27095e89843e67928dedfd961f5b7498c8a467f8fd7cd0dd36c0290ca5842512002ad785f3f3325b7f51688226d0df04ac455ea91cc052c2fbe45fa2a2bf8a667832712afb9a9deca67c6df56e4681f879b1f60a4f3069f6810fa622cfb29a646cc0633014f589785d1580299166bfeee288a5ab99307e966eb817fb5356d120711999b7441974ea57f3eb9b1fcbc345aa7b6f67d6a898f65bbaed574aa141f970e3467c4423b8b7871cd3b3c3006d5a6da5bef5e2038d2f554634167082a50900285d6c0f5ceca8d7c0c97129c587224b39497460a2bd684eecfc9586cb8f251a5659d422dea0a11bfe3caba20c605a845d36135451012217e8ee76c52962faca89c84f8e1a79f26bb84568d59fb72cad89c51aaf38ba31c48adbe1e72c774993ab9f5343d612fcb6139a96d5a1fc08bcd06b27515e0039e0c57e274870c4c26791f226c5d76ee1aa0a926d382d735cbf4e2857e77d7efe0cd44ccd234b0ad6fac469ae79661b3147ae731d558a00b677a85edd48df1465d5cf7c7e219013a0f8d11dfce8d1fe23ab083174d273f2d2c08849f17a8b59358a0a24a0c1589d2e2a0f67cefa0a52b551739c4f5ce6fc956bb02bbd2e5fa8cc704adc931e274bbf4aa8ce166a9d9cc93bf023baf29c4dcd249a23a92bbcbb01ed47c88bd8c3ab06c3cd38702943c8273e2fb0141d2b43c1c5eff1cfc60366a47debba84a4e93b2180b6395a32b6265cd1d0f1323bc9572caa5adbe6f33c1fc5ec98bfebc7aac172d27a06e9c79de71a838dd66e268ab22a987128a7fcf32f70d5e84bdf64cda92083a4293f3734abfc2e6c44761250b9862b331cd07c6d16f45050935079e6871c390fdd57d5135b7dedbce697d05be0c2fe054aaf0b2343fd72cdf38df1cbadf072184f17a3a02401510e8be0785cab0c4c489d7614c046806651ecad11d3f1f560f8403b33bd439e65cdfcb5d4b94ddaa4f4352b51aa0d66b2a3423da8c134c65a19105f209ac3d652950c985f1f988390b802ef025de18dcc03ffd3e17549d235f5d3d716859a681af792473ea5eb0bc241da4121728f1cb0e9deb3a568da9a2ed839b0f38215b27521c862668a8d6823a39698e07858169a2db3ad2a23551e2f3e3652a4b2a07c4216955de4e4fe673a2bd6b76d668c8335fa344def4536d10299df10fa3b4fa362bec86029aeed0addbdda05994bd73ef487b20a9374a79799c3c1a44e707555ae298c467700ee79139d6398dbecb8c843d8a9bcdffae3e35d69dce0477137e7ed68f6a6aa31b9895a6d4945a3586764944329f2b994ae996aa2c8fe94be96c9d44755f48d06f47be90e046490f8014234702b22dd7d1ce7589462fc56eb379dbef0216a82eeb632afcabb35e57d0b2e17d25fd2296e9ed55c7b37088cccaff0d98b99ac5c7d8369c0bda53d445c60d8b5c7cf60561aab33e86b638d21c24988f28b87f0df9c663b80c2ba4fb38c15e2db8a35f972b7b791ff52be7f310b31a0c478cf7afcec68c5a5f98e65543c68ba58f098e380e4f5d83e78239154ee9df6483af2f283b15da136f4da1ab045e0a810d5885731f6616719e3d5595bdebf569e235fa376843ee3c44befd22038fa07205f0225470962a25fc7743355d7dc4d804ba93e7dbea01c5a3fa7251d9a6a819ea0b86740dd04347ebc8193cebbe556efef847681da772e5a3c22d5895f0bca874c0197f5805f1a2e665b51df8193746aef7057a13db2012a85a30f6dd55846417467a15bc1d20bb1b0b913dfb49ecf6a2a7f441a118a7cc14150b7c7377b4e03f3cb4e77bf814279e2cc18a956762652228f1630a5219b4c525db20cd98b91b3c8e18ac7ed223abc582764a268a49b0e080ca25ec3b50c18f16a071025f28c12d32eca39f7c889d170a602438739a8aaab2fab9234d98c6c1c7521ab1f398f280b3a782e56822c4c9f7a66b22770c5d83fde9d4d89052f8fe4d9386dfe950434ec2889e2128c914f4ec5d664211b377009c8f15e9052473593649003387770ef86d1a6a49dc35c7cf481e1c705fe98cf507ea94fdebf4fb5a53c1aa8f88833a971b6596b7e018f41aa8c34fcd870392d041878ff0e21604e6ac798afe789a2b66f06c1e8fa07b1a86e06f36b085b92fb88ec4c9fcfa85f157dc75508f24f479f7ef4fa138a45e0aa28e2f0a5043728327db6bbd4afcabf7e14929e2b3194000400ab4240d12e46d3299e210fa6a0445a5b1bf5adebfb1d4a30ce4dda56cd91996d47f4cbabde6cc0fa08c36dca19cd8ba3beacd0fd602e8d4c2cc2401716ef6d3ba4ccbfd36de13de63bfae032cfaf7e7497257a788c38c8a8f89dcf62bf8a27a8e110a7436b341f40966d798937d7dc6c33a4d13d59c2a685010b00d150bf9c8959e99104550229233d2a6f292b8a43a69f00b14a8e31f590fa6a9c03939d3c46a4ccd6a539324667c4cfd4e7b662ab043613cc5113772de2fc8d24bb45a612c428c39bf7591b29a3494bfd2796e3c0ff1ab11136a4d005cb6bd478ac1b600893d016e336f382eed4d06bb240302de3c7462412651f7a7eb02071de1ca0b00ccc81938e31a2445e528faff3edbe24fd98924866f98cf24f8070f13c7d36d2214e06b4c96637d65f3b5cdf96c446f7fa47684a8cfe2ebd6fd5c136f6dddb41e08e895d8850b310b769a6b41696ecfe681eef87a3f7af01b224dda4deac991c43cfda2923f8c771c52b18651ef93895a3d892e3ad3911e443c7401849c493ec17a012627654561ffd5424ab04f3c0c0e40d2a6deb385a438861982e1a84c85d5e183cdc82165d4ed98500b00cafa4746e3ca7bb71bf59d43d443065d9cf3c9a1d66c6b878fbcc70c8bd5ef2ba18ba26c29f1dd9adf5909c3d1b037d4a1c3debe74a90f078a61accbd10be1cdd6399ecb728c4db64a97d3e90f323eaeaf17a2a312cba60d208c319d551515aa5b2870a376c27fb9f516734dffea262ed7df7782927b1d5a77e36c52db221e1d24ae37a6266e31d5b4be6e6db0a7fec4ec1435de49a9a2477fcd1f7316aae3c363345fa70befbc0bb2dba8f143b028cd31ce9b95bf9d09251bcd09e61870931138512d7615f5e0edcadd59365a7fbd8dfa1025e067e74798bf3759b1674867e8c5d67b1763ca9f78e3b3767ce23c2fa6b148814d2c8d1c1353a36490ec53c01ed6ef18e36ad54578c2a91eb888b091a6fac40dd528b6554bc9f19d7e53338fb4cc1e495d4b3ba8ddc1fc6cc2acddca3fd2b9600e7f8638e20f0a1dca224e68a480d78cd80e4318bf92319fab413c0f23db878d228b0221faef31f06d11d20f6c6eb154ff275666d88107b259ab1deedfe843913bf0326a54da339a29eeb6aa21a6dab84f99a3d7a4abdbfc0c03d9c6e85c7cd6ece5f874cc1defe5918364c4dd5c593235a9dabb6bf355692e71379003fcf9d12766bb616a111513a5e22b01b3022bad49ede9eaa31ce96e58efd96bae00865290553c0e2b88c840b0b6b2def79e1b3238ae02dad49f02f5ff8466cc1984874ce9dc7aff49ec7b53e047ebdde7ef2f38401e00295b4f9d5779d629de65447592545cfe759ce0dd027f8daddc549201fa4b2b8c300cd1d13c6bf07e751e2baee36ee3bfc70b47321573663b033727556e003c5b9dea9c8f65d92034ca2a11b8408816dbadc1cc3ee011a461ebbf14444a38fbc66df87aa252e375e011c580932a1ec9d5921928cc2b081140ddeef820a55a03243ca99cca92c4e28c268bd642cd2435d60ff10d4bb822ca6a8ce4a35638876e6fae14c862991aaab996b470c339687cd4f444f5b8e50366ec015e5343a111c17fc162492fe8cb2cb77534ecbec976a12828405a3d82fb909d8c61955f9c1184c1548677ee9ae9314521cadb8784b0c4e5bc103b04ee01fd25d5a1160e065e189ee225664c6994929babe33fb7dd4d302fd76cad85875eb94daa9523ee9a2aae430dd9c6b3e94d064f301364550d643163d460967abecd727c8e518fded9b02023c4600f50e53ff8a0622ec5c8d223245966b1d45fbc2f9956007152ff2358de11fc5f60780a60f8ea7457c5629f890b05812a2c6b776af7983f943f09bd9f373c6790d080c045b9fc663ba2b1d37a5730d0e6dc73bd949f8188b76f91262462c8938f12fa5f144a01334014f8c310e82a4f4bbcd6a3efa88ea491c0b4bacd1283c0e237e2c254c9ee7b77345cc4f2b8e8542d38aabb60f4ff7911ffd68e35872c63507464dfc490f1cea5748e3b7af935cd8b53b2852b63e4aee3fca1c60659fde98d28a21bf52b51437d1c415e7a3b6f84bdab0f5d0048056b1a75a0487a33716b3d7c1e5c825e583e81d73a915739094509c81557536178dfa05dc59e9311838be37e8d11031dd747532eed81b027e39eb85de8f16da4d7b275b6c9ea648cb0d2dfc3bd556c85ca9968025eebd0916af55abdb3c01e0d59fda5e1c02910bc444484047c8c1f8b7c93ce265f5eb51a4a6075631879db35e60aef370dd3e6946155b0510397a7dcad3f7ef550eb44271f9ce1c456dd396cf451d67cf3230649ce7fcf4b6eb969e4fc4e38be0cc4cd830b0a56eb0dde234909fbc2aa5c3a10e73b06d4857fe6e200a0086660aee26c5843901860738edc14815570a375e4979131cfa0c3c3136e6e16d136772dcc79fb9b3bf7c007b991379dca5f2385b782d9b17c837e934a258da29ec0be4ac9b241c2e7f29de48c8dda69dadf49a4057333eca106b7ffca3d3884f7e30419cc6157e909a96d744324366e254908f37d16798053b99205134ef39220038e7913f147342d16d1374e619846a6d406e458a0a7b804dd2a5fa275bb950abc502ca4e6e4984eb3ae12e05cec601d0e13730d80cce5261d52663b949ad2115a0274bab1bb85638a70f0ac92f54933286601fb953d87b2725896f8a37c42203f7c1b405cd765ac37773cc187420bf0729444d08dd1b6a2653cd6d64c606478817bf78f5df1a4d5d11a807e7af193d8ca8b42212775f03432f1ea0c0538e28529500b6ab170f65ddecaee0208b366edcc2310a25b16c0560bbf9ba6c856940b6fb358b9150110d8903b3048312610ce18995e05dd882a5bec8266f7cc057c5fafb894660e3927f19aba1f72c3cd9fd0fda375f9ddbc3a9fb5bc4442065d0e7d3955db4cfe077a6078d4c7823175a03e9029da908ba310c65a3dd40d1c678cddff965030a934bee29130ed3d6859c48eab271058bfae12163fff679d253dbe417ec1616c791b45949eb6edd3840384d47628e3049cd6f4521e88b819eca589423980287ade3d3d6210888dcba3031570929cf92f7cfbaf3bc565b32513f939528edae78f8116e2d34b176b539266598921f40ede26d2ae74d70f195bdc6360273a26b3ecad573482a37c12cabe84c28fa1400f65d6a6810c7a12addf6ab062a4b762f21981debc6ed8ea884d60e37ab3eca7afcf1701943bb09cf33bf32a1bb6bbad8349c41aa4239ef3daf7d319287bfb43e783ea01ae61c517d114fb90d787f052d04fd50ec3c10daac218a3ede90297220ef27a91a3d298769e41ac172295d9c446432a96f118cc6e258464aaec956d3d8af440ddd0b36db9c3257688fcd5122c6518ab8c583e26ee22dfce6a3d0d469bf113d4946f8129967269f0ba1f00dd1dd9f06ac24d77e4d9b95c64fa1d41f74859d43162b5dcd3bb5df08d58514298b275e8f426c4161beb272d2cbb5a5b1e84caa8deaa10fe079686c96f35172bd6ddb903cec4eeb78ac0a1bba913a54a1b1d966083023438664326a8baf675ddd7a7d401cdc667970a4e688c1bf2a260d2f7334c4a0350ad98484357ec4aa02f95556a504a339f8222d4875a2f8258b94ec1f9f63256fa155455050815720dbf50b88024e953c68694d131ad98020914f97811889ee1b70bdaf800b5c695b5fa169a889a39c4c778bbcc4b220a3b2fbd869610b68e5c61ae9252d2a6d2de1edf0ebcc7007fea4351016f48d1559244e95ea2450acefc42d65c0adc1d1b035e694f646394618e608274b3d3354cfc286712b6d0ad155da19111882b751ec135a5c5a8943d58ee3042fa4988912d6a85827be49fc8ddab9aafd7681d2be3c16e9461fdac354015484561396e8e12001e74316803e58e42b49a10474c4315cfd2c98ef0156825133caafb61ce75a7f9680226c62c91cf708857cee82f069c337d477b7347ec2adf72f82370724ebe218189a22e963b91342bea2029f7f8c0b763e51c5c2aa23f6706717c87ee43cb8a28556246568e3e60589f423d3ea500d4137434d1d1ba7a78d97b9f7e42612bf12835182019459e2698634e2d538780730d04a8d11c0b81290102fb8268fa4610882c86efb70cc0a38817ca1d414b4df9352a7d83ded7d84a8f4812b0a566719212681c7d7159a99779a92b680d8836edb007f3c0ac26d57461a4a7f4f3ea3430b9335281e121d87d43bb0917044ee7e53582214936211ce8345eeeef216c4808c617124a47935ba16db68cc06372455ec7acb1516c930e7fc54380a8d0e0d5a963f2910f644749e02e2bc523b219fc16e4e06307723269cd26509b7a30a8147128cb8453891563a5635b5e78ae9dc7ea3b5097fab2af202e0f3aeb662ab20a7be42a42b7ccb1d335cc6206bfc79506f208e0b36bc4f45040c26d312fb4323a8e049e7a326367e3f14ea2e9d048391ee8eabe1b7ccc02ed0564b2d7eb24e8f6850df3336b2e89fe18ee1c804523fc0c3ed2da13960dd3be6ac0833bb55f37e205e7b0c9cf2d2f7f0be6491e2b91dacca1199a043391d2aedff50184edb72ff2d8e0368e3726643458b65e87c8b9d5de28a855a0ee0435d00e4d40a8f6addb9335daef1db78ecb8538c85ae4edc0c425e53fdf3dc6f800b19d485bc9ee8dcf56fcfad5a227df2eb84c98c3a5f3e720e19d80190c740044937fc47d0b9a3a480254d5179a21204af1c5dfb8ba8490460318a4a6269c873a2e74cf19657f7685fd7b494b1615d8942933435430f755728d9d135b02ba4f0dd5f76249ff75080327b24f7fb8ec01b1702e7dc6b0cf56ab1f8989231c26d26e6851c0c65864a906e676d56bef914be2dbd018e8f581e1952f5076fb9d75066f2a21b971fa642d7e6ce7a68bf69f08bb6a528912df2784b3b7a1e2b9a1b4eabb487d66d036faee78023f1b9b2a9d088ac6d191f59921d31fe88eda7880b2eca9930ad94e9639e50c6bf8ceff5b381e76d73b56e6b9a08f6c218d622b61762273e9700e011a71ce2ea6d0f9635481bf7df688c538bb3f7f3e053303d8d124d7c6c6e5bb72367eed73ac258a9e72105a1c964ab0b93267c0ad90b70d2b19cea9c0adfbdc6e0a986c3bc948f311e6f251339bc183a5542fa1e5c2c718d42356fe166094fd6b7e82a45c48f498a37415440057a187f52b3526e4b2942afa65e7a65618c58581423e808e5930152489c248f0440369803c8c9726ff0acce0d68437dfa5af753662cab40d043763e0264c0ec2d51e290d392ac91a42f76db70eb49b40959ac0c47f742410f5c72add82b3fe77c331295487c23e60e76e1f8883e7a073a8444d76aab3ca352bf968597a96e53487263cd3702f3adc33078452162135fa903dce1b0476b872463557b43ea0e7b064299bb4c175437e2edb7d83b767482b70658fb5a65c8ceea7b5fe088ced5de7f35f73745b39f3bfbcb0166e9cd4ef8dd09c4ba1a69df0141a66db5f84f8e9b8934e12bbcf115b8e064b95b24c019c1286d0ef1c4b0a5bd54317ad3051dd2ef9883c84901e39822e3ac48e428e60dc292e1685680b8d4c729fbcc152b11c74c2cc55ca9b12e96bc19eb110a619ed5cb96a8c431d41200c20807d25780c9bb8fa2341e21254c8b6ad7ea3074f99c9f67ac8df737a49d2041ddce4f2ab39179fa2af94bab573124ce8803c7f59e54f6e2b399f6169076ac54ff696e0992154049bc32ede33d452d37d0184cba1ab4a34e37a19e36aff791efe21dd4a15b3ac87c8003b15ec51947053f4ad7dcf00336085bac4d9a8c0d143d8c5bab6855a21bd06d235e17a59df0a4a43393dcd6f39a6ad099faa4bf1f46a4c2e812011832b7ecd081b89bc2a99dbeb7e0415400244fa3bdf38850a8994cd027bfc6f62174b66650ed1693b50a5a331409cdae3628906538e86f20b969b96f8c592ab73840c33c0a132fe3aa33d22741bf008116b4927b10b4970c8116aa97d0e2a8a0b9bfd1ec09f6bad714270af5b8f2deb7c98b7cd406e19eb65308c1d1528e29bfa8d34e3157004ce26db8cc237d2563bdaa4bb8cdf195e123c683f9291a4a4b014491868de891d2a182258a722b700d7a9fe48f79774f18e7a94f7bddc05330392aee95bcfd8c4876724110092294f8583358b20399a6aeae33f803aef048276ab9191b98aa5fa7c8bc4540a488e137c4f455a6cba1768402d18f484c38c572e3ae676272d034998c30f6ddd5efa2800874d1ce96dd8d073885d97b521a1325b4850e5b955455d54c0a831c4a55e780536224aed674ee22d656d7e25c663797331fd29c00feae4b776d3beb6dafcbdb44627eec80af40d4164ccd41e9baa34adc2c809838994c09b31d012f6ce382bf431e415627a915b58a0fee85477d31d6a852284a257e494d97d7257ac9f8bb109457e413179b3e8036280007e336f50f15055480ebd555757d67e6051039dce6989107365a30dbe8e43cc5fc08b3291cf9ad6dd7e1c6ccaabb728686d55478eddcfb6f74e6d13316fde511b489b623299e3e4a4c151fdf7e4420cc8c5c617b764372034fb7efb5337eae2f0486aa4dab7d40870e1315d465077aa224fcc074ee352a621d6fb7d274d11ac5f73ee08971e197d36afa69195c04ba22eca270d8c3b2d3291da980bb779b975957141fa8ad7f8f6b51decfb83e18c2328c669b65bcfa9e4c394076fc152aee8826d72f09a4df83e0da234abfea4cf6d02fc74f15579f8d05d0d54bbd2394cbd95b01956c880fc977cf69d5aca2354f77c5c042776c035c1c98c71df216edafdffd02e46aeb5a7a416867ecdfda0052097457b8299f367d28199ef493650e90090057ba9abe4808efcc909fa22ae2930ecfa131a7128a947ce9ca74b7d24da8be071893fcf6b3ed6f131aef7a4fa03a4928dd4a255abbbead4ac9e613b872de852f3b8def8dae9fab136ab9b37a0efb7b4445aa210513a2746b9d6167944e993212a9618d6ed55858029eba34a1dacaf13e7e0e6923ddc6b1851e417d4ed01c9114c2168c4f7a904e3116938ba6ba5aed3d01264e4e8f47a294710eef9be49ccc738cd52eae58cbe98029f991772b0dcf7623998964e559fd882bb7d4bc190df8d22d8a2597b4f86d42f0ace30b21976b6ed1f67947cf961e9c61604b9614c006384ab63f48023693ebec6d2a63f4565692551aa5d11b0d6da142acab3c5614dc5436238a7d5e8ab3f2f9e21a3a9ea3832a3e80a3c55a7e7e36c40827b884476002559516272c6f6e48decb7aecfc968a1aa327d00c2088b81a556824f862962bfc88d898b2ecce3b6432b125d3d2ee57e5afb85abce2572c359fcf00ff00d0e241f730c1121ee8a8a7d0be38e175421398aab54b8ee4cff4f154e52093867e9a8923e41886a7100103368fabede4297af17511103f6e43b2f317acccba37f0f93b66131148b3d4457dd84f1a4f3add352924ee6b40f5308043b0258c8af5dcaa23f31ad12f8b0ec26ca7e4b9e5ae4d2e1d6581b5859450826598b06619f6bd7789d01b11c492aed5a7a9a528b489d74232f9f806eae744ad8e28210be08bcbcf93496b756af3dc0e2993d9e5a34753a44fb89658a72ed2a036c7faba7262e0c6f18a4652ee39e50777928ee400fafcf01191a82bf614c5609e2cc14cd4e987868f4c9fcdde20f386762f9aeaa36c632134a04992797b28e8a56b7dd6566a6d2b4e7dc9307f436518a6d7e587e46552ffe6fbe9a7e3da9ef9028beafb9c9329ebcf598e602ac06b932289c55bf3d886f31bbbae37bb7e5cc9ad81fe23e68e0fa74aab911c3812c7b065ca6f44e5c0c0ba00500de3f64722e96d1966b7680e601cec4baab08ba8a36d2e3676821d811fd1c47a4feb314e7871925e7d8faf1738129635e40ef9eb1016f57331a1de520fa382814c243ecb60f517fcc0ca768174087978fded815e993e2cac493fed151cb8f0652685a50a349be25d0b7bba1c7c4de5479018c11e4e4aefd069798ade05cadf03950aa77b62259b892a65b452d6896b73408372983aaa905b3ed5b387318c6f796638c0479ae61c73d9bfd839ed7c9e1be683e421fcdc2a0662549774d27aefb19671aaab63d560b8d1487bd52a2e2f3ace5beae0d8a3b556aa61ca146cc6335b6943cea84817d99e22232ff6f23bea37bb15fdadaaaba27bc2559d5e314c80286031234e2d208f2bce206b65a95c37abeb3786fafc9b3f5bbda1defb8ae84eeba29a37052ff08b23448c5b92322757de6d1e7c849f2b277fdeae3ebfd4c87a255420544f23efb3bf156c1ee44405631a8f8dc8c6705868b21acd62aaba1fae7de37945fedd37c7eeead298450e6df4e53715808383396248d34679aa3ed5f772b2d46ab71c01d53fd68d467c6849d71cbbc50a15b2383eff63d0d243f90d45a7d334cd9cc24680af1ac3297a0cdf46ddb407caa603686bcb87b062dd75033cd40e4649ba531c1cb0f45f3a2f4503969e013b59313315f742e947272451bcf0cb65e2b41a3869fc79dd3d8cb0af7e0b3fee29df56d9fd2d146af3bea7fba457986f3a127babd2b2b6a14b3b095399f8099b422e7409c65839604998dd1407741ba16f83e47dbc7c45efa4b0242277ba47854095e41a8ea175baa1ac6fbd6304648c118a07ff4212da1fd88d063c9e67c3f4f014e9a6003a7f50827c6e9cae35aabaed6f4c64fec65a1f248880959c525ea3d8e27f6e5c38c4fac9d80f14b4b552d65a6d76673372e6ba0391d72f8682e3f0e3365269c0917de32eacd37204a4e0b97df6038d10c641de2748ff8aece1a0796f36ebd11e1fdc261d4d1a0b1babe4166a853c3ad529aa94c70cbb08761a8bba293e4179c176d8ae8d2f8defaf42fb0d05a6350f2df30c90c6a36ad0c04db88a01636852aca9dcb378b1e789e41d06897b3c20025df06295896c49bbe9ea2c3d1c8190453814100125878c93dd67c12c4ceed916b5424b2ce1488b7baa1edac9dde6635cc3ade182432a1714e7e5f86ec4f8f3af7213120e8151e9d78af8aa4698c3c800685737e3db581d5e9401120f8df606855e585916279d0e74f168bf7e30c6d5cd6116c3d614c56afb73d36b2a2bbeda5d5a4b890a9993b22de228e13b348561b957bfa5493bee3c2c2d5278f57aa85d84bf102c476cfeb67e659f1bc31481168b1e77f44ce9aef51b63079b7f5c22c9c678af63ec3328999465bbaf31969f562cd8a410933c95867eef95e06321f0a658b81c02984f445c70b5ed649553298e88e5930ff82638324e1db8194a68049d226dc224a9e1aa0e2ae9e528cd6a89b45b5e248df273fe7ab0025fb04e6d4c5a65c837cdb982b950e4e64699cdb9156c0c44340364faaaf6008e01f9c5563074cdf5cc9431a2090279a115b7f19d95fd3dddda98fdc7aeba1733efaf89b4e24905de7b7574dd16f610139e93cd4149986085aa073af95e96f26248b7c8257741f7c29c0cc3b163a322631ff36e536ad697a51b44ad8d059f6631d08246fb910c8f426e1605999cdeba5fb932a2665b206fa243c2ca8afb1ec9447481aad63d33078acd1802b79f91ac5d822b1efdc1816b5ae55b87221fc890f4b42269a1e369bf205fc9ebecfb16fcebdc1fa4b84e0b60bf37aaa93404e78eb736db931adaf67201b472e070cf5346a3f7258e432d8364d38b6072a2b242807ae77951ebe3a47d01579851edda2b0b68e813d2b81efce90e221d5966a7a4a77644137cf5cd52ad19bcbb58bf35ba015bfd8be7172347d5cc872fddc7ec818f9024ec9cd38843da9d9d9a4a71c6f25f6a1cdd12d3a6e2d73f5ac86f95b74ff3ed3a37dc6702da0e2682e08eb31ea2838dc91fadae1121ec8782385bf83102e8e11ed04e57986bcfd0b9cb9d95a80a8333f8ad1e45c6ac347a88b00603bddc39c744a7dc0765b3d541466c13e84817dcb9183ac88c42a6b4075aba6c5977838b80a49e90cfead970a08328e155b8e2416235483f054cbc654571798e0939629cd56720ad565d2d4683c27518bec409fd80c7fa9160515150078e66896a9e7721c0b1a7f2449afb2edd1f0f7278f196bc83d528e39b019f18d832d678967c70e479643fa328633131c2c43b0215baf14209e0300b85d848cb28ac7f8b941dbf164d0ab701af9d4df4f3f04af432f473f4caadd6f694018fc844a60d6157fc9de5114fb380180bf73cde247e127229f88db62ef57e61c41ab5cb237f4f76801ff003c0f36da59c734d8901b9ce453635fb4db8feab9015d5876660afcf109758a3e364a88d3719f1ca2a47faf9996b55b478c75a6e8be6fb2ab87144867c58fe92c19f041987e6bb85d8e7e5925bd56d3f6b07416674fbb538db1b0f9e510e57c7591c32609f3a9d4931a4897ba682cdd85c8b8a06c055f3a3fc08170b2e371182505539f0278b30453f1b656e7bbb3ac89720a7abadd47c8492b71f723ac17af6e7c63c01a8b5573bb1151cb07e78475b7a06e1a9d22aedbb41839111b33ddb4e2001a50d8e0aabcae221d8e3ad7500f3eab5d53db0c0f8969abbca789d2b298b66c4ae1c6cb98fd2bfa1d00f52b370e12dec0ec84cd8c47c73ee89297c9805fa6cbd44f0150d54dc597db58fab75501547119fc18b6b9b38e2fd9e5c95d94999574a644added31e7cdf32bab7d398eda57384b6b415de060a8f85190c70747f0275a7c163b0330e9522d3cc8d8d196719595c86fbbaf6b2de7971b4aa16ebd8bf71d811b61abd9d69ad4df808627b723e2b08a39876007e6558f0ed722ca032a313e71ff59d9cfa75dc12109547287cd2123e9d41c168cd04302ec24f544b9a7d70d66d3860cb8f194a42f4210d515a7400d68568f98013885705ec3d3c86281c1105cd865ffe5ac241b67bf4f129c8339226d7acbea3e2da393a39f92408950bd57bdfabc67dd8e1649508d540fd4c7ff1058a518679506b8470eddc97dc1d0c19ff04bcbcefb05f3fb733bec334c949b1a20725137d701630609020822f768607cad686a36607a80bef458769f7a41b197342e404178bc125f21bc4289cabc5a06bd446505c4a9d342df017057b634aed19f60b1240bf82cc80934f1433d6c4ef3829b45fdee17878493e27019c14094da280904625f10d404eec960f28721859939981cb1834ddf52a2f0a5218c296cfdb0cd6748913c3c33a4a0518262a090d787fd4b9342d42f214b2052b6890bd0e9f98d187d0a7fba2becbda39fbd2ef8acb1674ec5e20767f0b96430d3c7f6597fd64b17c1a2d3529e88f90a50184b207b0d1f37fcb3e27c6a705cfebb063be052ce6efde5b80eb8994326e1d765bb60ef8aa0df7043094ea86dfa5283eee5338a1d66dd197c75429545354a7a5fa64501ad5ad2c9c5212b53a0abb03ce4d06ea9179e060e91879a7de381af14cc0639874f65395ff8014de8016124f0b0308a29791fa5809470d8cf2008273865047072ad991b22b9e134e219651784dfab2a151c6575cbc7775b823e60663675f11812cc6b8a6f0edb47318f5b0c76c69771ebecafd5fcbe12b5e8a38bc5029f17d8acc1805f12100e05aa704ed81098dcdbabbf622183a7e75d7c0a3f09868531b4f17f239a3a1cbefae2a422e657cdba799e7ebcc158b78f68706204ac0673872f74116fc94c37365da6f8eaa5c8591eab4e41c2cd34e715f5c4f644233bcbacd8fc9e3712aeb0260c82f0f030fc9d26d3961d3d16ab0d907a10572c4cceb5bb85e7dc0d521e0464e597313ad11549534471e1519e9c2628b13b53682b515446e8f41320a0daf3452596f7dcb5451e5656a1c65ebe21da777922eb0a8e4b847ad704a06dc7d31bf990c4853503f66020707783452f17d92a339e5422e5827b6852002f17181088cf57c33f7a883dfc1bc39265536a99c5b5f82c6ea41928589412404b527bef6b20582581bba59c9802cb8f999410ddd54042202dc357afb1968040f5e7cf0237f9c8c7ba1ba9df4a0fde1b482b9242806599401b50ad4d7f23d06ec8e73a32e8abbfe3ba89daf1a863f6aab1c12d9ae970e72c8fd705a143aa3cdfc3d9ea5a9f3214a315ecdd7888189b3ea1341d04405646c433989ef352d557862608cfc5519f65811a8c6b9ac6c84d6f9c3d21903d8a2b9869821e7991f9e708fb84e5131fcc2aea9a0c2d77366a3ea91b39f86c994851477ec2b2e9f431f1620d41935bf364108b003df5ebd43565eb93793fb9f30a3b0aa656fc0cb88d36effd338cb3f12ad62a765ed7058e94a46a1a1501b61b89ae20c5716694060a62524d8f90a4326556824b06aad17f371d56fd407a0c18faa9320d097ba66406644ea07e6cd00b7b013cc08c4aea8b4b5403647788321c3ac12ae8a186150bc9cbfb0ecdc5a40b16674920498fd0fd7a6893adf6aebbde1c71f987ffd9c581de22545585aa54651cd6de7ae90df7dd86637ab921e0214eb9269c251ae2360180dc3d54d5405d0cfce9739ee61d26107832b5d67059781a1f9d1e78efdf643e02833cb685ed4a3f8d62ccfda83e2d2e7567a1d3119d807e13b51025bb57b428ae945a327e58a7ee3fb1a1f525928426ac73af50b183939d981acb6d09958a43131b1a8f1d0d8e32f2cfe68ce32dbf9a25d906140dd28252639f15baac7550c33550c10ddf49e3fa014e305f667cd176ad63e79a28b418cc0f71f036a59e7679dfb58e2a9f78c80ffa6d74fb3e937564a6c733b535ffc2482b2ba06f17640d8e517cc97952d491ccb1251f20cf4e89008437d7817796dd030f0581b842cf47bf7dea83eed641261c4708d2eb38926d73b575f0dd11b982c69e43dc945f0e0df69ac488c33dcb3435b6555cf19342cf618f745051a163f0a1ad6c821c559d518870577ff3bba96138f0c9e37c09a8281a8acc0dbb499a3e0266b3b159076fe089541be1f5a13401fdb0c57ff7b445b4048260d9de766e4ea9423984035e5a707dc02179b467edc78b558569c6760581a1b5915b8156e799e2f16b5399e092891b904bfbb5d95b6ccf7cc7d5372df4fef314295b088d3caaa8a477897936107d50dbd2b61ddf284895c8e122381903215588464c8e5ebe6b5f65a6887b0b0ffa3127d2709f115ec7dad8e25c51f7e7895dcc08d3cbe498928ba0c94bdbc5aca23d7fe310f3cf00ebb827b5532ad451ee5dbf40f52234d1ab6972de78282a5ed120fa8b9e32857b7134d2940a1db298757c0bb77bd8c17a9a4bf5137ecdf139ec3ccda6fb2b13925556b67785eeb17d131dc35b76dbb8a81755715278e683fded21c34e257131895854a255ffe2489c859f478e6ae78b69a77a552b9f86fd08002483910525fcbc664dcfbc64a77da4d114eb0db89051fc269b25371e4d082afccdc81937c6b75066e10b1b69aa5c14cbfb3d4d2ce5927d4cc3a0bbfb0b0b7efe2f871fb576f3bc19cc3366bdb08de0c0d2636feafde5fbf85f013bee836132825b4e9b0c8e6d2e06df5b034cd7ad336b964bbc5ea31be2bb607de6bbe048c41e185a446fec8e1c578488ec0dc5acbb8e1aba7b8b9caeebf7c7d6a3602a587b17b4e46cbc581dbb33866781fa2ccc6f58a2a67edc80d6ee8312285ef69453ad195c71b9fa30b7d0fc7eb8251ec89e3afa18408664dad414ad22b1021102e1a8e9e5f33374aace48388ea9eb49f59a5eabb446ac105a25c012914a0492374bd5266b855337a1e7ce43785e17194ca2fa92fa23531bb7651e7e6bd1787c38f476c9514d5445381c2defc9da303dce4400482679b591e51bb3fee6d6a5163cbeff6dadd190b6bda55846b639958113c83120b7455b73f5cf5dbb79cf9e1705b1689bdcc133f24d56e9533767825b99af0e657231a13f845ecf9c498019b7d52708b6b7fe3d08c4d20e2b11cbc414286821319b3cbd56252adf62884fb6c16ee6406eafbfc1c5be01d746e7b640ce33c3ba87565cadd67ce1eeacf3765998b2ddb3144c77d784e999bb3c663dbc94d053952ea005e076551c42601f45bb4e6aa94d7a2733264af427692aac7e2d3f190a97c7c12e871e75479425c26532aff8ef46ceeefa0ed28634a485c1b462e8974c4ce037018fca66a79dbef49a1f070e60d3ec5e134792c48167c3f0b0dd846ba452953be0ad4b611143f495f49a6903467adfd844dcd4b80032654740efa1f6e020666acec8ae638e704c45cb6c4dc6852c2abac99c6bd39f9fc1d91f0b7a971818cdee426ddb520e0040f7d53f9d6f74e9661ec6f463f2a5194203c36c3f85632f3406561c71858b25b6873b496faf146e359ff0536f8810f133bfdf7e981c67243b20176539714a5f2860fc2b4b8c4f47b7bb56bf5066de97d2105367c8b1d0d92b961920ece03b032c0d73beb30fad0fb7bc2f41871212d898c9b937a77707e2377d0e56695163355685394decb7231b4f421c4777ba08771e277b90d0d0ee62f6cbc8cfc558a3c13bde5fb826feab6f7ac24ee267c5e7e9af996faa1cc54c06e2eb46991b9999ab491ee20476d3e792f09bc0f8c033a65fd78b525d0b97dd530fdd34abb571a299d683e3d0dbd06d7c9b62e0ce41b3de07eb87fafb77a68be4f678976a72eae5baa7b9a4c2c5994ea0219b3842e9ba9b47ec990055e2ef2ab3bedd1a64e4cabed4fc975267fed2e42d4d601ad275a2b68c6781fc79daa319f5533f9ee9329bab83dfa3fdc79b39aca82639e42282380ea0de30d4b32fc46d2fc77dd24f2fdf3890011134c56dd562206d2a459c62b941c363e31c89cddbd7033232eb8895e7133fad343f653571a8e42052b7425f7a31f1948310f0957495b593e74e906fed58c749b4447f7d2166335c3a33704beb8930deee15d56c11406e78570349c997a410cd4592c33d8f13295c1cc00ee6fedc6a1d527b84b58c54528f39641131b6ebca7ebcf9984a3255488aa45252a2d1f9e59b9a29c0ad68349069cf5c5a4636cee9444c9d2f1291f8487c3ae423af895321688926ad0ef667625cfc79e98bdf1f4fa17efa56a62a54283475ec7de729f9bd934fe410479e56a4f0138c754e3d6bb47d893bb4b3dd6ce612e9d4b257481f15f5e264f87ac701d1eeaaaad1da3bfad214d1634b5cb7118d5f7c879c87f88656aa50a37cae086076a4306c38a440b4e6cd818f86cf6e0da19c39df17691ab841f2f4f660fe3803f0e660b621605578cd156566b517fc880b8faebd657e2238ee995120f1c3cd29fb5782055e2112cbd0317039263449e29d7dd55171e1821f0bb940a68e70903570054fd4137e10b53df0ab3d524b6fca7e636af8e8b47e26e03e903bab273f76bf19f8a336cc58232352a79f172352c0f491bf6ce0a034535437a10645351de6e3f8426fba72d3180cb7d90f7b832129834de3a219c9dbc1892f6dcbf253ed0c02764708cdf9dc93a0a1df4a58be903dc6898216c0ae32972bd6102c7f5a76d5233329d1fc217c3ef1b866cc3fda70fd58e598d2f6dc3b3aabe4176a6edcab1ea6fe78dfcee4882046e3535d735e1421c9deb24f9d81fb61fba427e581de1757ca507cd4944bf7fcf3a4dfa043b6340940bfc909de5d960b736fa6a6de37829ef671811a47d558c3c86fc150449dc21935cbd6d81bb334142a5f762027329a717298522185d0481d82276b1e2e253233977497b8cb89f26b0b91f261f1bbf4e78a17450ade895a48f9ac0c72d5bd0c0c941bdc7cab1d86740173ea7001c575c0672523eb025748c112b8bccad103b7655c8ef51a1ba007a294a5b78aecccd4a40ec8f600407873a4cbb532fe5c531f3a1826e4f7ac9e517a757df064d1d2abf89c1b8781daca9e4cfc93026c9506207ce55f6b803c8011d93da4b88a85c2e983382ac4d41dbacf96fa0c0ea6b92153feb40a3f94174603fdc4b5d020dfbe9e817b1e29a7a8580b0ef3b754511c5905eaa0534bc4f89a0d5b594b71237974df3e8d5807c396716c15473d6c55ba139a24e5cf488e94322f672d4513f00c23d81df7fbad46ecb235b96938b079bf7136aec521dbc20807702d8a52ba5994775953a5a1eb85a46e7529de4092384625a0149f606e5bfd4a587cd49f204c40190bfe481e9cc12f509307b2aadddfe94232941b027d766ac9f837a24cd1d2f336063c8424c1ad704375305e19f4b19ba418f46887ee1eab6096af5e48ee2e6918915ed7307399ca9c8768b5bf04cf1c3d40f8c4a494942e7480637b85d7db3a277e37a856fa8a687da8042af1fbdc85957c7fe562d8f0d201b4312a6eb78bbe00324437abeb901084dd0f433e8780326bf5c0e7bdb66c3b84f5e6685e20ded820dda4b246ed0cacbe0f3da77ed36ecb6c24b82e644f3664efa501bebdec32a8e7c151d48fd48f26d012a928b483c9b899bca505065a9460decbccfb1a3149bdeb14961b39285a262538a79166c245f660d5c2cc53b2982d48a26cd6832c4fb8a7c0d7fabd10fce9a2c1d4dce4f184934952c23677cb4a2d8bf28b508490258a85bc10ee0c0b2f7d366e1109f08bfa9d5784b1dc6edfb1f38db00f607fc4bf4a5399a16d33456dd59912a59dbdf437fb88f0ec08d208de4adbaad78785530c5b9cd276c216117eefccf23176b2b9db2d6f67d6637aff8af73eb30c685ab04cf4f20911191cc592bd72455d305cc4ca0dbe61ebf7d8d751d5bc5d7b87e13b49f8baf7c09ada89359639a32e70387f1feda23f016a00fa8753d09a3acfb9fb0144fd0c6b9a5d9003f56e890210b88a264d3380462ddeb0545975c07059dc7279c91527864c75a083086ec267484e7212d3e8096ba92dc0333366d246ce98eff0d15c5221c12aa65a1101e3f66211c107ecf53cd20161ca8a67b7eb7968cd9551b4471bc464b1b299890f897f9a8961a1b7dfb11c2555051e1d8dc1e0f100999f02b44d90d3354ba490c0a0452f360a3aa35c5afb97105c9350f2197ed8809c0839d13d6a5c741cec8770e87c5bca6b0e42b302af9db9abaa4e68167b24cf725a4d98539f7d3edbc270a1081dd0319bdfcf0bdb670f5a7749872f6e3d85d52cda314c2aa05d71e0314de58eb95bda76b6d83e7503b9e3cba28b7eb36b6a4fe48424cf70d8ab066d17898e47abdf55a6fc14c8fdd6c603ba9620d6c6aa73d33ec0dc0ba5b28691b2be80ee8cb56bb6c2f4958f7a465253b4c1cea5194cdc051f688c591c04928b05d85b54009499f91d78b49ae3e143429d1583027ad5b2c5be80c126e4152d49981befd2353725c52bbf6e2bf498132d0d840d0a4e720e0631f2c55370b07e67ba871ef255560cafb0246e238a75a49b4f07608a5b6b55cbb2cbec6b40136f91fb232ba60fd190d2f95c6fa2915223bffd10ed4973535352bb9c4cb26c668e62eb02a37cfd4a59510ab250042948239af5fc47362ba0e24824981914a3bacbef7e9620748b2480bf37d222a9bef0d9f10c45d037089721d0bfe4974fa42a3dfc05475d6577a5f3008b6eb994682ff784f28685adf492947825629d4001c3eeae647510af4b345afef322a27d22d0e0f9e7d3763b6a4597033fa7d15d80babdd9160f50d4d70cdb6cbc83ef6e964eb99821e95e52cae3da5222e557596a4801c5f3529fd31df42188c5a0da77c8ec6ceeaee7555ec21c1108a7ff95bc187fd4d0be8fe0da0f1eea25f03e0ae2b94dd28de8506365ac219e1b278b3af4ad9aa821406426b1dcb48fa612bd64c2405009e8d33854cf344de3c858dc67186ce439ecfdd49b29740a26e56f443018d5890b5d2b17ca974806bb18a1d99c9dbf9fd96d273f66d7e8189c0f1fd33fe99628de9e50fd4140eb0cb929faa161a0148dbc5d73d7d2efcf134964b2a36485f28b7aab6b217caffc0dd22345ee421f37a8e801e29bac7f12405befce51ac34202b52f6c13313e484dbe9578b79b980a983a4dfbd193a8cca4a7d824419ad741a7197c1466ecbe967b4e09f94f0239d0617874357800ef5b1104669651967ab0fa7949b31336265b265e1da81c3c795d69fcf8b4eb94039e42fd25ed6d779240b4bc7027a723b41dde7b38d6a0f41ec7801f4b8c0cf563b4926e07043287ba5e9f96dc7588f190675651edbbad588912b65088ee461896a4ea812e70e10b459be6d27563b0fce86252cc38639c49abe681d28e9e3ca0df333ba695e30fcd86bc0547d5744dc60c025b45de41d4902975456518f3e0d7c4832fa81072d9948649ce9d40d4fd61d1a7bf09f9e3b49cd03764875a6e2e52fe735252b498fd371ba1383c24ff057269e357849311915c0faa7d56c70ffaad3f413d1fec55f4cb70d90454832795fad98d08b8876ecdec6d76fcc079c8495bd4c770066cd6e402725f14711f88127c0e34e237c5520ca732cd9181724e76cf125c5e345da2be3b3a076acce44c238f8591ca63d069c0a138f9355af7cdb1b5260bfed19c8f3a2c9ded5ce585b6242caff58f755a3e8fca9b0f7c51f4f010d756b770efc48cb46aa70c88684f0558cd769f4599fc875f07c09ad79e39fb0a6ddde7b73c8279e4d7c28ac8ca518f04bbe118ef8217fc03043528333c9ae6e5bd41259d50d5950c2009975fdf23b36e997b995ec67013c5409b45efc7ecf4261ac68c69a5a11065ba1afc7fbfb350433a78190e7ec5b669b14aa312f6776bf2ea7bdc6a7bd6abdea0a3f136fc786e2f33e782e56515862c43a31ffd18010da860fe928d180aac649aa70771ef40c0823e9482c1faf2e9cae4423d8846182c00e7268876acf8b5aec4268d264920ede3ae8d1b6c3302c868c469d886c1d8b4a0d580dd8f83c755170c44ebdc9363f0ce4f58ee333700223abe710fe4a00b68f796e515296b1d9fbb5bfb934962e169d1afb75c089a76c1da120084f6042fe249d61055f673646353f1631306444bc08aa7ddff1c4eb9b50941d67f22785f3160f5083f982e2b6457572c7da2642eb12cdc98070edc29faa80fd1625c8b8247e123b3f5e60179b16b40f16045eb3639ae530790f6c3bdc3824b26b3a80c8a353e59aa40093cc4b8a28ce82426d5a9f9be00ba3f7370c32f019f2ed562d9adbb105346352d4071c0af1b66ce94bdb1841b01f85caa1473b5a9236afe1d74f47e761395c431ff3d42c400fe59bb3cbdd3b0912a8ef3fdd90b6cfefba87b8bcddc8b46720b549c26b6f9df8f515c91739e9ab392a53386d0fded91186d2bd82c917df289dce3c518fc44b850c3798c2c06a4520e3419479ff4e4d48f4cb638f801493970c2f43fc793463c93d2d28ca74ec0d6e538d8de3e294ed6adbd0dd6f70bbe10774a325dbb876b875bdc636264a09797f696a9bc46b56258e2ad0fb35e30735c7863f8f2d2e46742b8d3bcfa2cf7bd6ae7f1025293dc1d3b106544622c7ee96da92fa63320f72bf82e109ab0439c2349cc392f9f0f2edf5eab54af766e8c65298d921e40c679f2eee309be2e74164b1040d8935c481a51e743e88b1376c55659ec3e2f56dadfb75adb900e9bdb993c1554f1ef79a3ba08d52920be7c3073a37bb2f0a7d2aa6cd597d1830337558be2af1d7d7a0b562e380bcbcdfd7ef757fde2e587650beb79c0b68aaeb41bffa21ae16602d5704f01d9d7a060bced3f05b46724e4a9d0121257bdd68b3f5176bd89a6a86fc84f6d78c823409c19acbe2a69bc4501142e899ea54323a0ecb6ae8f712d8efcfaf64695a0020df7263b8ef7df57a7e68d66168c8924e46929201ccc8adee8913c4b12a24da85bdba5838b20241fb23a989497215f96eb4b37d163fbc72e25192143dc27d362c83b1606ae7a6501a4b178b9c5de77adeb4a15ef94feb29673c25f537aaf9232112708bda8fdf0df7c9543eb1818d51f780786f84c9e2fb68d15d3f6f93fce598c7d5aaa925776472d8a34f52983bc39d4442114f59a39d7af958e80fc7eca921cac0bbafd20cb028aa272a213b1fd0348cbbb88d4ac1d602dc5afe312dc5a4b55dfe985941ea6efc69b196b12a255f8dd5f2e3ec95adb42bdaa337bcdfbba61bab32307740ad4391d96e94fd7bd454b11c03e505aaaefaeb67236af870b022f47df57a9b12e34ab5e86f6fafeee872885db6cca1d05007fac8e89e69a929d7ae1f7d945f56bf94ee810b563f3e362508b20fb713e55f8e63a91166ef03affae6e201630c75c720023e86bbfbaaa673b8ee872f11fdb2fec2050d78cbe780414ec3c73d4d6f5986b1016250b3918357216473ab543a16a9101c08996c80c8b2a52d878410511b20ac6bc940d9d6e5fa137fca9c688ddaeadefc5862e4c688b2d13a8eb7cb588571e519d68efc6e4893e51d68ef528c93389aac7717d6de0b2bdabd3321d9f5113e11764b8ea30577fd7c4d84bf920f55ed5ce81ad5b4668cab5916ffa6534f9e01d087072e4cbbc743af1e4c177f4a53dc71e71b2e6657b66f03f28a1cfcd37abe20b28e1c4507f8f44ca666c66c4936866118c66618c6dc19bb660486611886617e0cc3334ccd840cc3b00c238c0cc3308cd4a54da069deae3002071d00ff731a8fdf23291a7ef586b9bb6db8ba1bf36c5117bca197acbb08eb7240552d55b35f61d0f6fce83bb628ff836e7181924e21931ba72922d1d15c8d59bf6a7951536e36d7797597f32168257fc7faad110ded1664ccb3de996aeeb714146cb2e00463dc80529787f13bdd8ff6341d061e6b6de4582c461d87c5c21cc73cc25288702e84ee159457daa267c281965dbd1041093eb28ae12e6260afc6ead82ac4afe32788d7b59f623173cece445ce43db96b35f1344c31f2cea0d7e4044c6ea4c1799860475a800f1206d344767117b3f72d69ea3759bc55be595029644b8d5ff3a92e0a19908983c88200886c5fb11505179171fc8fd8d2f8f8b89a34c57492afb2fbceac0f7c087af97297681af1ceaaedd2a0d9640bebbf26b21cc154a9077ef96fb0d0651f131e557cf8ee8f8ade2a7d40da471b1e81463c19343003aa10c6c42e392f3e2d0eb429a48efef2abcaf4d60aae3c05b6da5570be9665606087b66f327ae47c25938f68dece94a00c4c6e70b2808b31da59085aa3b64815550da5468dd437922e9bf691748e7005285ad0d598386dd311fd81b7e98706772dfb4c692c07c415bb8ff2f6034a97121385a9a1a87d8237ba9985bd959e256dd7e4332ef2f2fd9d0b4cca48ce0ab172f6e352666e4ad69645dbb2c776feb1a38b8931e34fe0663c2f54a13d804366abccdb47c3816582402dbb7222b4b2236b7ed03ebc0fe9c5a82a328ef17cdfb7a3e3b8ae6b161ca729f2b2e7f9de77d32dab6a2a735239f009b9d9438843cb9dd3841f5cc0f18baab19b45aeb9b1558df97e5ee68fe244bdaed9cee4e7ac7b7c1e1386a4d6e3afee44bd9f9dce14f7f8e92cd68cdf9f3dea58b878a24ee878a76862fc35e4a4c71f1ef07be0189baa88c037c9f8957c3666e6cad3c5a34f92f4c4589ba018dade372b9ae3b3159b79ad5497e8a1cfd7080c803d481fee0a43cf0145cf73465aa69d74e8f4f33fdc79a1b3a430f92bad898d0ef26e8f0ab31b907aba6ca534537e0acfbb7f7c1afab2c724b3b22fcea099f9a7c28df2238ef846d6335f9a10de54938ebec1a473ecfdc693c213946f8f876f29a56f1ba56fefa56f97a56fb1a46f876432f3df404e3fc9c5df363b762c75fa1c37b2be357152b1a937c97f6a62b72b59024b3e4e08261b558f7aeae288a1568e813648b84348d0eaef29adcb9cbbc04b2a40aa02ab03a9c4612aef414566f84d6c2b8910d90ac22566b2e3b9aec94061fafededa61b8a27d4347979f3bf918d0630232e136c5af2daa9bbf8295cf1886c5900e35ad1b64639693280f4f838ace9075955796693c440e7778de43cd55604444108b112f79dcdcecc6ab45f810143d9beb0460b3f81163b2bfc6389e81866a0298990de28e082aa3888e2528f2d21e0fbff2ede957a86e5c838fbdc08230c36f72d33094bfcdb717b358cc0d6caaeb27a78b4e585ba2e2638073048267c546716f529c14298754b4cf10cddbacfab0685b66d8be34e1d7cc972b430a5bc2646fb1d70432404c95854dcb80fd1dd7bbc0218cc4ad1ef3331fa311b0bb975fa3aa6e11af109d6518860d6805856b86f9df7faa791bd3e37ffdeb9eabea5ffffb3f7ffd7f7ffdd51ce3f0e7fffef5e74f354fc7df553ab6c3fbcf9f7f3973361ff3bffefafffefaebdf77da0efbdfc35ccf7ffeef5f7ffe6469ded7db7c4ec5dfed98d6e53f7fce6df85f457aa4ffb4635a97e032d5ffc9d2bd24b0ff6e03d6746e4815eb996118c670fd86f76b8661198661189bf9313ac330ac7cd3e7c3300cbbc3ac1ef03ec3300cc3300cc3300cc3300cc3300cc3300cc3300ccbd83cc3300cc3300cc3300cc3300cc3300ccbd83ccbd83cc3300cc3300cc3300cc3300cc3300cc3300ccbd83cc3300cc3304c2b35542b35542b3514c3304c2b35542b35542b3514c3308cc03276233918c7d4bac4d631c3304c2db1502d3998f263ea4a62ef4672b04672b05a6221ed57cfadd4500e77538de4600cc33095f8dcadd450add450125bc795c4de0cc3308de460add45095f8dc0cc330b5e460a5d8ec8de4608de4608dd4500cc330ca8fe92b89856a89851ac9c16a89856a89851ac9c12a89bd5ba9a12af1b94bf1d92b89bd5ba9a11ac9c16a89855aa9a12af1b953e1c91986611ac9c16a898552e1c98ddf7dd7120b4502ebb75243b5524395e273fb7ca3bb3ccbd4120b310cc34482e337928395e27397e27337928325429357120b65225b6722db3792838502ebd7120b310cc39462b3b75243d5120b55e273e7a2d3c78213d7120bd5120b310cc3300cc3300cc3300cc3300cc3300cc3d4120b310cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3300cc3f87c23330cc374d2cb300cf32bfb42ea1986613ad471bd4167983b564576bf042edff5d6af780623e4ba7695cfa7b8df4402562491657737bee65b50369d64e32c80662f42f4451ad0ad4014b9098abdc0af1e28d546f6509e7bb8196758d647dadb85f64b66038e94846fc7034b67a7d644303fdc624b5a962828d8320e1c341735ac264ba25650f178262d5f9bae5036254d9796b29f7c2f889ea453321856752c2cfcc5183516c4fb6d3dc3c811effa0e1b485d4aea22cb300cc3c8bf1a4cfb68b56586611886611886611886611886611886611886611886611886611886611886611886611886611886611886611886611886611886611886611826b6b2da6618866118e6b500980f9c200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882a0f1208c6718866118869ae8831582200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882200882202ec1b35c4cf1ae7fe60e2a2ee4f7b30fc7326f884ca6763c9f9909139ffbc5ac9c1830bc3c88c86c58b271204544f154444f08ad04ae24e862909055e363d770a0d48413e561177db9d8829cb9fea54de92d1eecef071519825f054ad7b65d1f227034466e8af48e1d3f951f2064e92b86cd38a702697bb3ea864db9768f22f6c05e7bfde45d6f6a8379639ea8589fbf35455b73dbae8fdf4d1d895cb5669da0df6132b21b3e273cd675e44074aa36ac56b33c378364f28a97c92652db2c2a9092b6778363375050c9b5a4c5a1e20886bf2f6a9baa12ede397b2428ab2998c8cbe0be22d28decc5648a5bd3e81e95f82a934135487f2f436115116eb8f1766d943f84c90794e85fc5b6cbcc7b94d8628e3b504255d989fe68680edc8ee287b293b6cde350255f65eca76a9da43ac9f8f767de6898b4a29f9cccd73f31b5eecc13a3b3ef849c6c2f05c1d40fd848619ea13f676ba758364147f13ac77cec0a42623dbe17c453afb6c9c8ecd2e06566fc4684006ca957f638cb2d08662cbb7efb121e0930ba652092faccc50e87ccaa2d1632d0ad02e0fd3fd22b21630eeb91e9d393dc0358d99e6b31fa6ba98ed30a948508de7ccac339f4b4566ea8557fe7d331e4e65fbc5642497462702c18fc5de4ccb40de079dacb00230372e13e55d7dda73b5c88ff40574410d72240255d5af44056129a73195ea9c616b83c90b51df3bb945706ba62d65b6158cb589d3166eabdaa92fc9e5013ea8828e3d1cfbcd8fceb037fb8309288301bf7a85f5c82f04729b99e9c175e2b61e8a7e496cbd86f6dbd08c39a152c6748e51791cb088bc09c4671a6c50cb1825d5a50bd8e8a2c5a25a5f056c02339bed6e2ab5b59ae1d9b99a368bb6864bb0266aa996e337f58c6865557abe6fae4e5dbdc094fb20a9ffcb19aefef1f8eaa2e46d1655725054562c5e8983b71634bf7d54bd508e10c74d7fe9ef6424c6e355692b02ac7a3fa0c8916985317725505a805604899d350bb3cbb046bc2f635e63646f6cbee9a96f8274708bcab2cf712f03b106d655080ba9602cbe075734b2a30ef2c3f4ccb9126e468ed2abe5ce76f8105c1350b008b225f6c133ba412f9cdb474fb21273b73a206ac7ce5e8a4238fa47d18a7a2bdd843fb801866b7b54487548245e3d02c819160e2d957abdc1cb030baa8088855a6097638c1cb98735d921ed0b99119187fe217894162a785e1f1a7449e3ce92e55ec3962227fa137bc633b12aba0386b016bbb17fba586603c81727f45e0dfd138ceefdc013be996508891bcbaf902d6d0d214b12cfb70c0230e032dc4469f405b79a2f872c84b20a6f7c2bf31d7a00ab7009ac0199d72265c979dde8e8198f88c18b88b4d3950fb8a1b34250f742d1f9fbc19b7b2fe83b6240a91d2b5154c775df6c053ed97c6f0393d3bf99126cb11d2d898f0f545c71153c21b2d752bbf353133d2ee0a859228dce9f09916887995504db0f07b0c67592626865a71edb628db47d3c0cc7e3822329acd77188efefd94eb42aa25fd019a30fa1d3f4a5f9555dcd26c2e299f75227f2dc4ce237d8441270201b40b55991b690818957fc5a4a451a74f0d3e580357050594bf6d3f16793381e631d86e0c180e9d813833913c4339ecc488789b9dbc39710bdf047c8b2da6d48aea8d8e99464abb9d95f00961d73c72f816a807ee5297e97cc29c029385d38a8ec59cce2d992c70238cfccec9e4ba3730c05fb03f88525248188c870318f5fee459c3883c45e296c0be6b04cca6f13ef71758a73d4526d0aa3d5191b10f3a67d3449acd09dbc6ea4e31a663f0c21a8b8ccd6b2a0869b850eae0334a055fa5c34ed350d80eab24b3c6808bf96929bdae02e9fbd63d7267bd0c885ca0202c9617a54d240dc756374270139c798e697ad5c2ec809841a13e880d6adf083f8815405a326c298d769e8cb631a0eab2aa87f8f40d1cd332f5085cd3436e0dba229c95f0642ab4ade3894573c8bba1600d6bafb961979013883e3fa20d055ded13bfcd5d83c421c6c6728c34e0caf10f004ddd80aeced50800a290260cbe8f30aba98077099f7e368de5a345f797ef25bcac9ef2293c8d5f82e2dd19eb26fa6d98238ee648fc8ba4a8900121c1f9a57320114a9f2e77a2fe886b85d700663f9955c1c89ae3818c77be4827532230793c341e9cfcc34a280b6b08212066a25bac43629663152a35a5dee4ca6637edd8cd9224bf8f54f8bb76d0eadda7a9c0f39d8d410d758ecba6db9ac084040bef6142a5dedc0ca9751dec09dd21b5079b6149c155d5af35cbd5e8d81983a70b63dca7f9e13339539b9519354b1af6ad88bb073651b44a55d183716060e709f94cfb461ebb5d1532730dcbd1032452ddb4063092510f9ec13bb49983c5082304ecb7d9b215d586f6aa9891323c2ce1491e61630818e23fb5c868de5f3e8aa644558f1db99f21579880102f90636374be1026febe15c6eb2b11765e3e21bb52d05126e8389587aa272a8a32f3065e7dd440d8e5be68f2239aae721e666c0b4543b16db087d79ca6f58c14d70f4a465d0f48b281e168d5f6108f5234c3f07ec2b0bd0d68e3a909620ecd2a3262064b11f5001102f7243424be799e2f09716d80c0a696c22debdfb0184a635215a27e15d6b743127f36cb83280c09bdc38c0e8b5c6b0fb039e627b6ea680d36f92dc6df4eb50633acdef859ac93acae6543ed0b3f38b754db3db953a6da92261381974af872773984158870758895fd32fcad1ee2cabf93cb82c366f0f2569dba2c0e6b40c17447f0c106560916aa651f1666dbad7d1d2df2fbb3b1ef9956de4a3b1b4669d439a8c6417e5392860626920843c207866fa6f9b19dad44c11c0f18a1ca82342860a8bf6dbc67efb2fd81571673f8ae572384def537f720ff8e565a75575a4245fcb285c19d0d9c30f494b36d43d49bb1e8031568c6b01dae0b2afdc5038fc2f8645f7a2ba07de5c124caa387272a9d6cb2eb3a64d0b636690d0dbc194bca0338d51b7742798014e68bfd8d386f02801245ebec47d9db194874df467b1409c8b9911e444a4e0b28e8cb7af30d2df30a8021b5a8df93a607d8000805502f00b19b366e23d7246898b2c26f809d7a7ee659813cc9d02e6882d9aaa73a3c961da39815f83021d7a66140f76cb2f6468691c7a0abe1a04b2c023116b248d91186194de791484c33df3064b1edca3a7663a4cbb3bf4d88e77e76c7da0fc0b149d645a81167c61f8089331050fb436b1920a0fe11ee98626ec4a9ec00c2403b202ab4d40b71e3652988fb37aa57d7e3e065e4b7f0e7432409036c7cedc54c00a82e00f2c6ff3ecc7d444ab1bdf5781cc31c1030598dfa104b88c7c69a08cb4477fcaf7dd3e5ff4e47795479522452f5f2f2d317acf983f98a0a3f3025fb0e6aef7eb083cb1ad3ce0ac3bfb6d7cf06c946c83e8e4c27c5b411388df424c7ac580d703cc70fc6fd6b1eda76cc0affafc920c17b8f29196f9e10ef26d5000f07345e23f580207dd553292840227e7fc82675d56afd735528089d2b245c940c07459ef6b188778d1b800b93c232ec8a3233215c15a18fb47d899c3b50aca82c17bc9978b1982951266ce309aa41740168a2d2c971c0b73dbe898c59854b999116bce3be78852c9ce020d3314d988ab98f01798395bd59904d0440760a8d8828411ae15c583c382303fffd7a8b7c35890675c19496c31d31d57c3840ca9e97c3e2b019e58f7fc38060f0e1ec8d2acba498eccad3f9df1b590ca23b27a13aa02ac98e918c1b2b31ff1fdbe5ac826f6a6445c2b7830d0b950ae44fd33c19ccb8903f5c8321ce0747a4ed2c47d4ce70ffad0a022667dfe416b36ea64ba0560d6abdf5ac50299bb4712ea5f166906eb8cd8560514746b7b90026ddd6a95a308bb17bbdcf84a5825b82403303d1d607e196ecd0c0fe37b03b00bf4edcbb93e8c4cdcbf57be5e8216f5bb989d025362ddf137420395b09619b4f01caae4c4539256f057971a1dd5dca6588474b1a65eeba2f3db2dc7122d9a01470483c6a96274659235f9ca62bae58e9f0b2a3d2313840cb6237d000c0c1a5c7ee7816c8ce70e0a043fee1c6edcd28ab5f36142ac37ce2c2d75cf1473c01035bf3bbb7f6042344135ce5e6c93767bd97bcd4abf56d54011fcf9a0a5b940caaa001df813cdd400c5e1fae17130ea78eccc630528960940153423cc8c29a232a78127415bbbcd8dcc0f161034bfeb8db66909346b24f7e191b0f6b701a64af65cc13110bb517780c9e5783621939100442fc5d9b8551326f2b366b244aff7e6d1966a8d19d22e51b68ff7959c2fdc96b28713b97e49a9d442e84cbb209579eb737072fd7117a0664c26968d78ab189ab99f035f8e0e4d3a86bd6a4e2de5cb7e58a486a3596e76544ce69a2d75cda1273e8203a4a954f0027e0723f367f5612c0b2c9abec41817ab9e6b8f6989d83793da563efa1a0e15895fd4ecc0ac286334fdad3f6d202a3b0bff461d6de148e8e8f9223eac8386816d5a0eb7393b95808a1593b3e042e8b79bf4aa09d6f130cadedcec928136ec5b354a1b97f23142da56b9a0ff64544af238aa7f5118b4f4cbcaeb1383e9422b129a969b7cec8c114beeafeb3a474a9940a0b8b1e6f6f3d98c20ae0a5e669c9895e785a48985e5ec2f59196115af664bad818d5013ad3cc2fc1c61c980df2576f420616e32ca63eb08b92db54cc5c3b9ee5007a717ade722bfa2159fd6dae9b5e16a16e210a654946a5e8a3266b65cff74d17eb54d97c88ebfab0ecf97caed004122d35809e9134ae546757f30631142fd73d0cf2cc435ad05c6a8ac9ce5d9ebd6d0636f6c16aab5c4eba5ba6c3f5e4a7f2250ff782ab57fb32354ac5629240e8fbfeb723b4089740d3a2a0d3c6376f31d9725ec37fa8cdab6de2b923d5fda0799af2f86dd6e4d943756647853b0f54bd1b1da72a69d13184a9a7ef52b6894d704841b620c49e316dc2e60ed36e3d538c0d432c91b0c32cc50b265506cf3ded3ce8fb77efd9aa5a443f7a1065e6538bbacd9e59706efdd3b236342ec4609fbcf1ec237ff4d3ec121d87d6844ac945dd01161d53694664173d5016800bd7e8bd81c1e17fdafad0369ba2b4ed939f24197895d16297600b01e3d890908f6fb89b856c9d59dac4c6ce8c82f4f7257908b68960831680b63f881cef51064ba5be4af84029f05fa6a66689b59d8d5f34e08cb318da80c8637c6da0044f937524dad9d72233058adb4b3b51f5aa5713b2732ba304af297f4b44ffc2effa5203403cc2bd8b0895e253801daad28e0b2461806665c5f5c54aa30f59f588a3506147ba633d58ec4cca8e32b02df891c53ecd12bc3363c2f63d83c8b3f7e2c638f9c6f88602e23255198cb19aeb504d4550d1b44c53510bf7626b3588dd7bf85cf3ab346a013687688bddfcb2eac04d1a49c1d19be6474d5025a231e4da6b64c91458c53d183d296e03d6ada65ba13f0ac1ce9f76b80a0402355013647d47f95678b3eb363ac71e54568f72cc810cc768b12c9bd508671871266770942d9dc2ff50cb1f5be4b7312e78a0e9fbf809140a1513414a0814d825e40bde60935396bf1f63ab29df6e7464c9509d6fe19aec1406cce1cf90342f5fdd3f8907908ee96071908e641a865ceadec48c4e5c53f8cbec979fcdc967a48e51d5426dabf60925a25d4340f9b5398b3e626462c8e69bbb9b12e1ea17e5e405a6b94ea747fe76ccd663ce277cf2e961c1bb411fd4b8c7c904ecc1694109eeb9f9e4e53c06127185c700cac617911196643897f60acbc20e885d585c2032ccccaed510b943d1d4cdad5782dd5d23d76446f402031b4f257ff7e2ff745712f3b2491bd142a1335284ecf03b4979f01272d544eb5d73fb506e7a05798ed6610a07c68d664c7dba3fceafce1ae12e7b3b56bc074f8972c3ea2b8ff987dbdc64bc6aaf6f7860c3b79ecadcfd70d3f019000a175c3780194b422050c692bddc418368884724348355fd6382893f0f232f2cd9084a4078a0c33be3826f34575e55611454a74d5d5a497160928b056c346108f0ece75cf266c82315d9290668716ad549b55aba19681a884c1740c57eda140f4b09b326cccd67d65f89e7d79f6cfba99e9e707f151511f209433aa5930ac88b7933126723b98243292cd08cbc308f54843ed37ed40e2c4ccb5b673837bdd7bc23dff631ff077c6ecacd41200b8a3d37f7d87426090d124d6e792aae52c9fec050d077e577325c974b35947d5ed80ae3a920113e13fb1c2c0b23b947a64bf5b679fa489999f62d413192c57753f006db51bf55169a545eb07f66c334b04cbee5b6dda3f934b4485c99807fc4d784d32ecc2440fa9668a91b9cbce1875ae8b33500bd3e1dfe7518fb4e9ad97662586274c10495f820c9a3d1be7332bc5f2de8935df9209ced772557b30d9683aa833b9d20469bc160223f1722e6b8cccb7c76c301c75a4534d24df45a3b4964ca444ed9514ad1fd86bcdc3e631fbecba31cac77605b617b309f8b3be0266e49bd16e1177d34aa9c2e0bb75e6491a8cd9eba3ee09de1d26a0009568df3e0f400b98a0a36b6434a96f5be84838286348a26232da05d8fec96d06aa7f2503f2d6a565e3a73132df36452dd53f444a2a90dad52abaf018e4e0e0a231966411a0704061f0d96885174cac3ecd3ddc9741bd8191fac69b929865eaf8707646648d21d26f5cb380181c2460b18268df8007d8e0af96c2fb0e9b5f5bb3311c2c14f3fb3d13973c8c7c33e82de2c4e319fa2eb7b6cadca31f582da49585f0017a856eb84a5d602b5c6a465990686dd477e127678b8f31202f1b00d4c96e53efef66cf617ada62c2493d6002ed4ced0ac21b0f3eebbd4ad134f680022a105a5b0628c0063fb54a940bf2e3f81963a3e81d7d904c98d163ce2366bb1fb86e7b8c868c2d8b0d3dce6c42b57e83972cbeef5cda6a20081d0e5080da65e0251030942bdedc224d97cd60c02f874acd401905fbfac16029763b6b7d052c8a30e19effb16e27d41ac30fad0412b70e9212a512203d5b4f8078a02f1a0ceb0f68142b08de30397d73189660d2b93e331e069b59e3553ff5794b74e5affbe1cb44c7575a281def32b7c42b87cbfc6ec3e6fd6f8b86089ac02fc9c1d9bb6589b134ef974156cc686bba335d23aad411abf7254d6ec59898476b5cf32b193688c46a2e8c2a26629e9bf99419f72f9e6ac5c85689a68125373bf047d15e51fdce46ab4ae79058a92f6b90c962a5cb9b123bfca5a724dc62640a231dee122772fa809ffb8d4b35fd38748442e6b6b8d4ae7fd8a18851834a4403f8e098de2b0873dced498c18b463cb418c752886798776b6a2beb4cab1864056ed16fbb2f61c6656c00a934e16b01f2c534e6d3c5b52ff00bc812fffe014084968666faf15d0c1e2a25ba02a395008ba5a40ee4f0c7ed7ebdf014bc516f07d5e860076dfd8e5b447d953310c957a42f3d542dae20405ca0db13e7aca81a2675e061da34cc5a3e7d55a78422120eabd28ece1d8d3d6e943ecfb6cd3f5efb40a835a4c31e97895baa705c867d68d949d85a5531ba7ede73065a7d33c8f331b53de2648d5c6a6f76671f80cbbe14a78544f072e95528d15ce7db1deb53ccad824a78acdcaa81808f15d3d5a0454ecac4cfc04fe77c4920b333fec87028521f43aae35e86c8a25d0e14f95c52e2155bf64131e6d39e18b1918f3476ed347e4eff503af0296a83d1f1965aff43b212207fa91b2f27517a3aa34ed2f922bfb0c3883d12c6d93966680cc484ae58a6e8a53c8ad8b4c5bd6572fe7122f6a1cff4c6ee82328ad807447035004208ca7d7832f96c0f09bb983ab0d9b31c544df2af00ddd95f77e0917c58c58a7c8c26c9dbc36ded425f9cfe4c96d899efcb2ca9d051930e94f46e7ddf240c5fb317ead79111db8442f0a7ea3848b69cd16f55099aaecb35f570b99984a91c8c2e1a4b16ac16a366bcaa80d30a512cd596797acf42b7ce216dd8cf9e6027aab2736f6a23d26afa2baf34aab67a2aa61a27766ca18f5dccfab9eee9ff37a2aee77c3a9de44682744adcd4c1a38d2f0b4d6baa4bfb55999df59c749d2f374cc012ce8695d63393357ddcc9c8517dc0179697dc6695f94649de5cd54d1a4c9454bcbfe0feb0e85aaad4d629d941f19d660e65912ac2adf6776229245639c1b5d237d456a7dd620e00b6e897a975618364b0ea97544aef7ea401a7941a0a25fe9ba7f492dbadfab25b8fc72e111d506f6c3886448a9078e68bcb6438537ec9297a1f3db869e89ab259c0da61b764e73f39ed998f236298af1f473a77bdc7d8667c53700a18f6398e8de04ec4264c4f0fea9dd7cc6046ad426c55d9725379aec9ff86346918ae874c3b6cd947268ad488fa4b50c2fe4625d4422c33ee832d33acd0eb515ff2eccc998c67aeea2b52dbc7ef415b4ab22c541dc149a97b36c26217e8e9775590fb10ec52f20e39b8c18e06e52c63dab810e0c33a42d269d58b3356ad5cc6641b04774bacda06cb086aff393699b019a8f0492e664338acfe7d8231e944bb7ee58902603858be02fc6b40e8d93176366fcb6659d11fb5d836f30fac2d14d4d7bab7875d51bb943f430ecccb5cb615b78b52c969858f4b355e5203b426f619249b01cd759cb59b0b0ae9617aa911e556a5078ab10d868a61d10cc0fbc7f1af81848c25cb15e0b3a62d83ab34e347e0f8aa8d722ccbef583893247b43ed8893a534876c760ecc2d742cc810c580215016bb6db4c82abb2c1bcb8903f42a496b3c339776c2a9a2da333b2b4019c63c7404bfcea5ae2906ebeba03648e699a405ce12fd78046afd84cb28e4899fcf42f2b5bc0c34974df8ee37392748e29bf5a793803bf16452d21139bb5346e87776d468d58d7776da67d7ec3de3302232f608ae6828c579b21b12b638ccc749256e2b72face48665c21182b952f0e4ab9053bfc34516be0703c04d2bc385e676025bd9ddf06eec9c83bb313513a51ec1b889176383c07e356701fc8de5e2c8658cb0ffd8f1b413050998e8f5181512391a20246f6022c6996d3a73fa1d102efae7b639872da053d53fbe303c4394bf1b710cfdc5d4b042eadff87362afe6ed603ebe476098bcfe7ae58b53bd567b16f0be84f9c5ec41acb63d50b2cba8f38fef769a8a715b38bd8b423cc67f6e92c78c56a24370d165b49f6a0e439aa85666eede2fecdd889adb5dc9672dc6fd25851e82db9df47a8329844006726ecd5dcaf4b6729c174f2d5f2da5e24c0a803c743fb1c38281a37a108ac881cf3928dc19deaa137f6125f0c771036fa28ccf20cbc3f1e71ed6c9fd092ea38816658a419628e005315b2051965a7283a8e41887c89fa90fe31d26dde0f9e8b1bc7b0e52b6c40c6b22a8b6902a689bf82fc3ecd8ecd61ac9acde5d7115abde2ae53ebf3e00ae87ac8b252bb96138fd2567c5c62b8d6f067031542771b52c42f17d06843ffbb89b93cb016ec0940d2a56f6683985fbd94cc0c8da44f5fd2fdf6ff1fc0575c57aadc5f418150059a314780c45bc13b308eead12933545a94bcef826dbbba4637fb5937a86fbb0fd64bb162156ea70d5a0a449ce6b27ca7fbce871bf983228be61be57e3cf32028962314a75bc15878f9862f534a433dc91173f7730ca38f176507b3cf015ef806ffd3ac41c46c2e424677c26bf01c936edcdac3d9d6918dbb0de47816d4baeba198cdeb1a9ef80e75bb564116c6fa626bc8139418b7736166a932c9c8fd98e6327f13ee3480d9889bdc964cd19b1097e9cfc61fdcc5c4cc8c88cdcd73a24805a2efa11892bb51441791341b7c63bf10f49182aff3898cd1182680633783a2a18262cc642da3a915e0c5e9bc9c99859185fc0d4a1674eee60048d6221dded2543b30d90516d7be148ad422add8856d31128acb5b5bb981574de54061e32a1f94e635919564ea0743eb967ea8841df0e93d71a95166b319e0781916d3e5ef77a600ccc94d5a88edd88796b1d105a41fc5cfc674ea66da1b7e5c7a3c688a8aa756d0cc8db98498beb0394c06fcaec7c5c42684efd5bd998d1da2ee69d57986aa91e9c5c8a7f4033f494578f75beb207d3252aafca18b9e913f6f3d33173c1dcbd99cc4f4fa6c64385167d3013192c70020d590dc116ef89ed5f22750d36c81bc6825fe6fa7d2ccf037eadd91060654ce9468c3e731e6bdca1ccdbfd97a73dc6c286a52cbd812ce24cfd0e07b26eddccf8657be1c44c97c550311c30c7a22fce11b35b5ba3d85fc569b524376cca3876fb3e47cfe24acee8b528940fd782fdaf82b14a9f1731b97311fb1dada998c4af818bd9dda2251757c990bc2eb2a68a7813689f5a49ef49e60d608d61329e3559980fb5d90730fe364f3a71ed7a2af1a2aebbcf80dfe0a7e881a1ca16b727c12d65bd1ed5d834368d17c19e8ffbcd3a0e522c3e83c7dc822f62ac34abdebadc8cc7abf6f5ec1e63965a00d9bf498f1826e3af62bf929666f5e194b852b8858cbf4240744ae7b3ce3c7547a8566d55377b5ac75dc6f4c3982d738def955ba4e4bcfbc623e85d10f1411e678b866136e3cef2a8759c86a1bfddfe413254d5589ba6d4bc138cf62e6bfefb55f625c607c9fbf52f10c65f8080a1fa937f14e375b5cbfc784566562605d49f0ee4389d5a39d83d45c645da72f1ccc100b0ce09fab23c1e9331fba706bf9d39456b268b2b864d9036573bc0589e285fa66378dbc1b195f8ca46677c7bbcbd9c15c48b751da4057684e6288c9d28d3128eac561189e80b89793a31a1b6e1f0c2a587a414d7992c79ee205383fa07bb39e39aca1810b64cd54dc7fd10f7ab1b99291dd3aeba5787ac6fc8eae0bc653a855ed4b7bea3dd66f9c254554e164f42998c393f39106ec1f6d644a7d6f5eb9805d57dbce5ce1ffcf1682d3123b333e3ac428c1367c3f21bb4a2ff792196173d064feb374731ef9a7cc5d0f6d41dbd97a1f0cc10013330602a6656a88e23a376870e78cc7bef0a93b482c8e57457a422101c0a0fb1cbcd18c98f647e169eec4425937cfb435156f858ef157f07498a376b9c3617576388bf189bd43f51805307c54cff2be9b9ec3388c1215ff69367db1866cdfb5ffa4b7df62d6de2de9436f38f5668b9ba3fc22419ced97bb889112a566c1c3bcf3db0160bb41d661660f4d07b21c15796c5328be892c886cc7f1a2f9a046641570956b5daef5cbff7e93d6915959fd59d14ae7e4bf11303e6c81f93f8aef19206ca1ab2b7277abc0ac74bf87be0d05e6ba856f65ac985d896b000cbed1b304b96ff1d5f25fbcca17a7366300f318125f89ba09f738c7b723ad43bf898aee2cd6cd89a8552f3c4ab1d223eae48fe84e3902a4603e194a90edeab2b82177f74fe8b5962844281516306f129b9f970114e009412cbfd0d7df505db4f06d2d72d07fbd6b488d1dc7b6976b7056d53fba5de51abf37eff3e4c747c9be1faa3e6cf0e1d244656ab48c3ecf8d730df47fe767f145a4fa344bb2f59ab563c23051def6e0a61a06a7b0e7f77a48df426595d69b42a4fb75e48b0d8d556d0cdfaed2c7157c57d0cff307643bf26443c56a7621d983aeb91313ea9fef4e89e7fb0eedc4dad48b58db13fac007923ae47ccd2ec64357fc67947f65b3354f56b1f471dcc00da5355f3198b92b4b4a2e009c710169f325ed494e556e4ae8867e1c705746af2bef6be43d9b34c535bc2ccc23697035690322ee05baa28e5bd2df6217cb54051a8db09fae70b09be18b3d2ac461de852589ea4f4cb89138b82921484491a319dabfd76f12501a43799eab7e2ddcb7024ff8b38cdf375512feeb43e6d1e53dda017ce9a46c47780a8b17f8707fb915fce37369339c3affae9dccd513f59a598f2158bd98a8aae91187be4cac580c45c8f81c9e1b75b0c6d98107f6dc4889889f4b035f790bba14b61a85acea4a621249f6fd431b537cdaf2b686267d69906d33a897f03cb89168103729ecb712d5cd4bc1ca98e227c913dcc6c5b2ed03482ee3232e6625c82285ecef78d602db4ae6a3e6b72b4cef3da5c568286890a0bd4fea0e5f6780bee8f944c1cf3adec281a8e077d868d3570ed96e4e69983c1a5f7c7d7640ce01c4a1e3989a869a06c0cf1c1ec64e18779425849245c6ba06d423655f69b185376e7ae5ecc90aa973be46dd918fa2cfd8d39a330afb759dddb8c0fc3786e3698cc2db5316732a87a92da4ae9e7d7df4e91e0631736363e17e89d1fd3ed898c66374c4940b8d242810a312b1de6f3e229a8033f9c2c182c869b0a5fbfc00ecc9c0ca78c8b3081bb75d9c8f03c8b986d3bf46a852598e0b05b53fffa9e405eee8a325a04949fff3b3956107f94426ea51fc8426f24766c34a4e6615d1c5cc4ce7e23ebeaa556294daed986ddbf03f75b359e383d9391428eeb262083f4f2c7dbe1c8c8f36e33613e30479b434cd60ffb51defe5a1bb24a09b76481960822eed2468ca8f844988aaefeaa740534bf4a6cee872344b1826b8cd2202b022e86c51650b6a398d9a474a02035643885959023426e33e5c7fb755508869792291adee708989b0855fe10619e19669385276b7025e6643fc4b8b9b477d8933a4ef66afff0c21a5925e597b31e2f5524b0a9abb1c700f3021ae82bc5fd33655ffb0288517b31e7e655a7787cdb813a013d72c865ede6a216f9e5a32559dc74976d0b33b075ef768d56806e341cf53b9f950d3dbaceb488e46e3fa9a677d66e013ccbd33a994b566d066a2da6471816756c7bb5df049c31311d0f6ca9f1e9b1fc357a5a96e692ab9b465be39986794e98e5bfe02daf0c85f99e9d62aea6b45b21da5dab14ed6616e16b62bb65d6971aaff31701c555906d35727a41b9f9cdbc5acce04e54ffc01a77c29a9d250dd25f0ba0de38b101ab5d31b34d1c57354dff68236a66f07604d8dc6754e8679f00acc5ec1e03f7ec1881b732e3ad2233036cbd1562c38fc99f1fc6ae989ac561cd121663b06925fe4ce9e8262bcf2316f2d494e85a21cc1559cbf9fad33770d247b936aaf14cd26ea1a491d164f40b3f69d4509dcebd921854f1e648db8ef23db8b7d8da9181e306a166c9ea92394dca7440cb7d59c7d84a1cbffee67ea4fc650d2f05e6ec030fbad2a6cbb3a1ec7250ccb3425314fb1831a0c34d8c1dd37bb53e2b029ea2fb63f3b58498a5156e019ae3fc8918bdcec0a47fea0b6c172dc758ac8dd5c17b8694124fa6f4a26a47cc398d19f32148220e5519561eb33aea81f16266ff096ace8733b78e9898c01ce6fbcc16db155f088785074aae984032a86ccc70009230251304d04429fe0709fcbe1180c3b326637be38c92da252f219121357f51efdda715b79c973fb680b60f210531afe7318b7d31df2b306346b125931b23ed0df52fbe902467e5d695dcae1bc4a2fb38fd4e8e81c86798547c59bbb91d5b9d4ec86d3fc4581c2b1da1a5e41eab6f8e18845bf3159c1043c6a7e68ff8514c99fd521d50818a7a9c4a7ae681267e7a5aae0a1b675cf7caf1054f14478bd880d58c22d9d898e97ccd6164d8e710ecd22e43bf3c23e52997b3c34225885832092e4c0b0d40bf4a954157f207d18f4d9e39190e4150be60fb7d0a2c52ea958eaf422bf3c653a9ee4b2dc7b308367a4e0f46b0cb96669caffa858c69a3d1d9a18d8d3040a731fceae1f8740efb6ed95509bc6cca6cc2ccca619c13c330abb7a8179da1e2854dc1f53b323dc3eaab4eb14e6f524381484cc528374335191ac1eccca98c5cdacb99904b2547b035f48f050ab20bee7ea96bcfcfe824608bb3d76738e4f7dc3791c5b451f2a7598e43cab5df383282e6d526c87cb160ff4236ed6cdedeb04f196e6994f3738dc8485e3334d05a0b2092760a19263f44486424a44fbdc706917d8c2917142b4cffd9d20d2855229d7d8e3107a63a05efd6bf427179ced64ee6914b06cf44b7364b1b4fca8ad16e714f009d757f76b1434c2d8006fb639e1f7e4c669881149dee03593397ec6aee562e8ca00cb77251a57b2b36d319bc3b368393a577cdda7cc728ae8dcb8cd9a8b803d93a94510969252d6a95712dcadd68ce8946288cf67c83d424a0597d6ad8b3a6ec5cdcc9caaf6ebd7b53b70cf13b59309fae2524f2cc27013d130855306fa6efc7d4c27fc1bc04ce2f48b865e3eb8339d88755ab259e19869a5fe9c51a51ab902bf4912cf6e95334eed2d616b51aa2fe6314deb5356c4eef5f7ce97dc2587d8f3138d5678b5b3abbf9f61945d37ca281fc1cf3ce6ebb50c5dc6abbdf490b611ba8cda9cf582f4f350f633ee0f0de3fdead99eca703c5d1f10ced3af579d7cbaf23076cf23191c727e0fe74e9c79d0c94c9fa542f9b8d74461100d12d60511d38e0520e200c856accebb59276e8c27034f3605234cd1096e299b5b210eb31877123362733fec55fd3ede83696bee84bae9557cf09b8c7485033f369f311eb6efb5d743439f0207e4e5a4e8ee643b26f6911c4072e02f37bce5b34b13419c3faf704bebe595a5eeab5211768f4180e01c7b1332e31a4db06f4b3e45c075e9ba10370fdf1e429d6f2adb38c5673df2be6dc4c5cc8ef76570c301f5a0953a4e09ae428b56abcacb53fa66b0dfb2d97cc6b847b6676be1a0f79faa5a1b9c97786302d9fbc0d0c8530119340e4dbde29736d1d236bc9416a654979cc779447e5d9dfaf945df9873bc3a441f3dad97637f003a90eb341d21b4c3774f40d475e20e8d13ed8d35798fa513d9ac215ea2463824e6355c6659abb4cfbfdd84f4766d833525408a10cf8559b4c1af3cf5675d5008dafcb96a0df6bfb84d6725cacaeb09b78c4b713a22ceaac719f39188e196f5ebf21e5fa02f68d569bd37e07180e5785be25afd7cc1a30515994f0c731bf746b8095410991bb57387e9cf34b56e3b2e0c683682b6398b35120f61580b1b0fa2940bc530876e4387f210e9d77cf9d3d19806c74b34c4f894a4e51f8cde54cfda2629a559c981424233d976cb5d575c55f588b0c6f6b547f8a0515cc1c20c289a3f16cc418a71fa163a898e05b9318e0fd00cad2706ac93931c8dc144652d0a49694c9ea14703d9a95c3df8e1a58d73345d94c1ff6e1039aa3c407ba63e5d92cf7b23a6b04cdcd74bccdc472ced497652e70fd1b35ff9a639fce466066765be41bc00ef101f769034cc35e665e158ce91decbfe763488a8908afdf71c64793f2d8647328b21217601c215794e5a34bf259e38367259c599b157f1a2390c017d13c0d2ca2d4ae6b61c85ecc4c60bbb93a8a14ef7e03596eca7683a8f4012ea01c75d175673593ed82860cb561db91775e17d68307fc8936a9fb776c939dc440b6d48923db333543b36627df9de11995c1443ba75f22cf4c19cf367a8a8c10e08183f1fd640605aeac5a5339ab35c0e968650b853ef86a71ffd030443b748d9549bd0a0822b5091a189e7aad1a63f37757ea99ad59fea7e9cc169667466cd515d2480fb171661b8d6c66a552dc6b0a0ae6a259efe7595ac4e56cc7c63ffb1b7fc307ef26c5387c711ac0002441884e715f5ec8c0887be9dc423adfbfaeaf9959ce190f85212645ba994e9a38fb1da127bdc75133f6e9c2c86313d004b6fc85e371de5653c5ad34cb33bc21379bded15ff5a114565bcb023c1b7b3038c5f099d2763ec4b4c1a61bbe1d77798dda46edc6b55f8a9b9db3d90bfeeea58a195e99e014fab9d67245b352413bad80f5963a3e60253723afb60f83ea4d4f3fabe45d04220049bd7073c633be71493e286bd641c70de45e3964ccbab9b999dac71cae65386d11c4e472e602c7cc13aa3338c2ca9fa8ed76d1947b33baa86d99d99e80b1b7551017d279f61b14909a17c6a442693f5fd1be0cc6441e5a6d783acac67d1d626e8666c54eb9d9e3dacac9e3d77b37d48b671ba615894e5b521c1c2622c4142b21c16d04e19fc0da3f80afd9b455cdbb5ffd2fa71fa1566268533d944e7e43427e48c693ae7b67f76f5573b8b6193fea8706f3991bfcf1a8483db5492171f07c72c367b6dae9270d337352cef4297a76af4416a6ff1805e36deb0d3a272a91e67bd82ed5552e132af3f40edb8d71ad97308de275e9fa668cdb9a9a05efc7fce0ba0a8c41e2fc584e6c9ede19516afc5d3f20d8843e77f7283e1a73f21cc1060b929a01f89bfd8dbd6de0605698152fddfe051242a8f9cf106882e3ca24cfb1af2fef87716c5ec0e3b8bfc3b6902652dbc4fa58a1c7448d7af930b1a74fc6e1c17acc0e86bb7202e0b39377a8185ce0253582d5517e87eca64d83b63a2a4084b4ab04bc201546d5f7da5dc1720ce22b9224e8cabea9573f4a6018a0136c6e9673c6bdb45f6a6894bacb70ca36b2badb22ff600014de871d01912401bc566627bed9f3c0a8cc8ff9f9ccb448e58cc5dcb8901662d7fbcd48b846410cb29eedac08e110383fc390307ce2439d1b0c81c1d820608c981d4a930bc1556a5ddb45968691b54ad58612a43263102dde839850e88fbba12be9cbb3e337de3f9828604682aee41dbc6c6f7b8445aaba997e2392b2a97b760a053c957bbdb0c62401c188d0c6156aa944ccaf6eecdf85c57e933fd5cd869ba2c0aa7853f1c7c4d396d6528f389eba66905b496a0d479accd89a6bbda231e37117252fc5763fc39c9e1c425446ba608b4be3f8574a1e7be9f6fc78824fb12383b67eedb51d9ac629a64079ba0cc66843f554cfb966159f36960c701592728a11a91084efa969e0776035530c4fcb77ba0ca6c2db69ede211f259db589b1049948dcf13f7e30ef9985183bac4b43eb372342f9e94324cee6fe386ce8af8913c7b32529aa2ca83a712ad0781b8914a74b99f2f4c1fc35434f0950c5fd8af8c2672f5cce7c7a1be24fe869f7e430aeccd23bb5dd77567f1e8109b87322ee333632d52b5d7541e8f30f7f7ecaa6027b6759ff5af2e48b42ab25ae4bd73c8b9ca8658a20b411266689e968b73337e02ea617873e8b4a54a26dba40d2e657e0cc8606dae69134e7d0409da6fe1acda7a8cfe316c6947a3228fbcf661a2ae435d2525a69c69eb36a23513756dff650c15048f7b230a6a82da655705ab2455224db3d64368d5d4ab6a3fdc619e68f3d47a1278a4db8b9b47a21bd6a6abafb7641bdf46ec07fe2eaeeb81001b8b9d51d51cf784044427558c5d05b58ac556f6d6b99688e6351ac433f2ba02cb35ce3bf68b9a4a3e19e3b932be33463942588d3b6ba50eb69501b8c17ead52eb304bb7bcce588117b338a2c99588b1113a862c5f25563f2f07f6de943e1aa44f437d454072caa320589e55cf26f58dfcd40b0ba1b739dd838c366165db4efda66af5b6062f9c98bf05c8dbf940f1b98d37fdb69cc79bb5621db2c559f241f9ca06a88c225666e16522b85ea03819d53a00b855399faac342bd1fc7b91742f5080524d88ffa4d7a57279278447174eaa4e479daab00cecaf76ade1af77a0bd3e6861887affb22e2efb3bb990ec6f3adb74387cab402493ed71c88dbaec91afd642b072d6f70bb7241718fde5136f98114077554a3e5a619b5713e1de1f71bb1f704209134e014ac5906b47240b8f832fef9d22833f50f0e8c5a8ce29c608a237c352ee73cc60370fb533a77e9b905005bbf9e48b270ab952329ff9ec08ca65ab03ac4b2e6c96e9a2a923b141be382e689701d8434cb9061f6e54f99c6b8cdb499fbf9f51ea3b3fc33c53ecc46be7407b6f214ab68f74db0a7495d60344bb239ed19b21566e144d1857556d044b360bc57bb012b798049a1ecdb013120e258cf05775e2c2bbc9dc3303bcbd56ceec9410c7bd29c7310c9b3a5a916f8dabef204b4ee8848d2c3503308056070051f4102bdb0f84074b917d87421609c0ec6cf0ccb74072d984093c7a9246f9c238e22c1f02b3b312a563faea1fb06c0f1657833d1c2e89547db8545333335b3590b4cbcad74cd451cc458661635540848d20d48e7460eb938a8254ddbbd663982f9422837be0bcd2d66e42d9544bc8c0af4ba8b9adb3146b5e3a3e6aa7a686a86698a3e7b847a2f197dcdbaee3fc6183bbfeeec2be222c2f2b30e5693e996aab1e8dee084db52b3b0c453d9e98195ed5b6066d3629275fe6ab3161975e60afea70ff92a69855b894e5cb1ac37f83646e4d806489f7012844652681fcaa960717051535dde56f446ad8077b920fd73abdb0f9ee0166122067f24b01afceb70cdd5b26a2dd4b2cfe6142a7a11a33983e9690cc8e314472db79190c959a00e846a6005e5fc8e99df503e439387dc3ca11ba059ea855c0f0c141622bd76b79ba1ac6032e5e6ba07b845cf28f7f2a59cfd016094381abe26adabb07e7d6f8dc3206f51ff8c08b6631baeec299f9cc1e705208fd269052e09c9b393da9b581af9d73e4c6eac1e05ccb3f103ec8ab212abd3cd904db0f6e67ed4de330b6380e9c4b26e5bcd6457de3d2fef6cc69bbc4fe822c07c26a7e98290501c7feac327badf8f0d5666d180b17a14d0255f0e3521c8c94d06ac2574192e1d86d75c0aa6566090c166bacb2e54a89bf99ea9200d3c1573b927ec4d199329b19fa7d161add484067c10f1005ad54ecc3db6a58c1c00d0fdd6d4a0ae5d969e90f299ad0111b6e74db1d5edfb4730e9c3d61c49bf44fcaeba61670cd76ea648cc51f97215b22511d55163b5c25f02f61325775c1ff7e8eea4f609b81aed483e071b181927fd75f33cdb0dcc2f61aadb053e3744088ff0e522d79b0165e2287964e4f6d111984e928468dc1a517a066f338a75c046ee85d099b3e20cbad363ee6963c160de291aea5f37d6fcccb60c449c8fbc93313ea03987b544ad4b748f32deeb1ed15c9ec86f12265ab1001b6058523be0f6e5aadb2b8044a84dcca47e480396cc3c503f987d6b4591efbda5c8a2187cac256a53a11f197e1c46e7a00f4dc0155cb8283b3dca88c5549f380bec8fef3c8d1a60a76d9d79d18290f1e71a35be0ff43849fa49c37354a7cc0f74595d6140dd5657ec953694fcb979c56913d207b6f78bd01b9dd88cfde84b546d7efd099004ac0e104e935f840fb66f6a3f5f24ee97c285326d120d2691761f4abdec4e3bba85e96c0cbadc3bc54f2d8ac7956760a7a911fbf94e87327acbd075b08e04b49d4e7e632ef67339dd98e5a102831d10950947d4b391666abf6326339e09b0e4c93b592602a1176a7fbff9ea52cb8630c603b9e1aa9879815895c96e567bfac107372b663401e53d5b7904962fce5c2df2b3e4c94e9a7331de61dbb35e09f312dad4c14f318f13cb429efd962a92990600016e224cf19d399f79190b9cf7e2579016f53330b25664fdae583d6bcd02bd02b26b45787f9c825f6140c22849afcca09fdac523f89fdd15307a9a1de5484cf4fb8680071f86b1d1f9a08ccc0914809cc03833f21ff6cb3983bf3dfe5383e1340a1e8d838bb080d973c1189a4e10a69ccfe404ffb9598477df6121126056a8f2f39a81517890d57ff6b7731a64ea6df49b16b6a92d96756a6ee6ef72ec0bf2088db2fb4201b832175bc29b460e567560d2b84542af9b58d36000933ccc4c67db8dd94061ccdb9f9eccc9ce4ff7615b12d59facb6d3b579994afb6214c6b06a050f9ca305a20c2785c76be279b1d50bac8ce7c70362d1ca9a4e73d431e431fc540180c71b5c8b651ff3ebee9ff88bc5744db7b40257f252cbf55e1038b4c9e9fd3cb8baec7ed29a8fac797a6878ae81b4be02d890aaccc1348e5a5425bf76f98cc45093b9cfbb65f424a1525a05774815d0dfb82a3ed341b1b406384ddae98d5b37ed2d414fb8c72f2e525cfa6a2dbae24cb38db96fed84f1941964e414739a3e505aaeba5383520011786bfddcf0ea7acb8985eee4b6c2139ee93bdec785235bade7dbcb047d4e07dfaf66e78381195d9629c68c618d3115aa0929801bbafe0312c29801826538850f7f8156249b613365ea4284a69aa1c52b1d1de387053248294d61ce8e0c56b302cb97e345f3edfc6bed06065ddcdd680869411fcf9959a2f4570bdf275f9015d9d19ac1b07eaa6cc86e7e893ad24b7a22bcc4fca216cbb4dab0399171ef409abb7e670c94d753a58326a24dc920fcc95038241687c818abc5a4a84eb62c5fe097a0c8869ec783b6a606c616ebe58ab25cccc3e83193b07a6125f54f3919bbbec70393656875d672a5438ac2d1ea0a698899253a652f5628e2cb1c3f954151765ca5791d459e39ded8d1cea94026b216bb7a314446847e76c0b3dd826e352feb18d1f043c5092f7372dc16a1a00e00982c29d3a1bdf46f4edafdac1b08b4d39b0d2bb558a43ec13c9358a0d07da55af06a3ccb197de6a7d4117fba51ca17a66709bb9ab1d05d6ffe925e0c3a0ad48119780cdbb44089a929a89c19e08ebcf82fef0ab6fe2a00c30f60662631f6ac8a3bb5e66c5298db5070ac7206696b4e6595720619f39773a9dc6abc21d68c120e15c56ac5da6478d8f9a126e86017766045fc646050740e5fc46d7f18b4669317dfb89cc5b738497eca2fbcf322909198f10a39666626df455194fd5074dcac1ddaaa7638322c417290d81a0c9fb1afbe2ca1102ac9f61752463264d4acf9a4e9b063c6881820c189e47773190618aecd443fc6c939bc993c467c2b8bb6e859485e3d20486ae3b8eb95ec0f482e1805838d19c91e762aec65d859e439aff07839413368f01df71c40bec88422b2db987f20113e0c99897e4c415c9648cb6c862f19f34bd2aca3d6da06710bcc4c302d154ddebac9968f2560665fa991cc37b4b67bf7e5c738cc28efef7e9eb3ce47eba338b92076db4a80944befdf0650f90314fa67e8083ee2358e90a30fbc1c5dee27f20351742f69fc217900a4868c626eb3c7e2fcb783049f107a4b822396dd0a8ce0c018cc88ad450ac3c94efaf0ecefa85d530d280a148ba451d7abe6e961b96ad8585a7ca6c99eafad6a991a6a3555a3bd2c2b039fa08e639659f417f6d40ffd71db3b44700ad92c50ddebcdaa70b23f1b63a31c8750f427eb5a72a17383e92058d843fec679620b55c20eadbc4c0772bbd2f599201e51dc24fef4272d7ee06f8163ad343946428bd51675bb3956b042463853bbfcc52acb852bdcba67d6727b15f8c90bac8c8c02a1d345580ac1ff6ec788db71177386caf3d95aa5b702beb54a3006f42c3c7a09668114a9d84e731729ae84b0441a9aa20f725d5a033eebaa3ae011f127d40a6ee0dff151d5b771d57dca3ce657a6aa93906a7fb6487e1e96abcacc7eb2fcb57d46e740e03d8b628009b5db7de57f9431339653898f4afc4c0f14d24ea80e9d9b0b90e572587c8b93947966706393f151f667a7f83173f342c9e3941f0973f604ddff62716748f3542c9646bfe565a96ecdc78af0c07264cc9eac0ea359e2832b1aa29a742357af434056c2af948e1beebbc577677a52e1774b1a26db89eb826c24cba0a0563f4e673c3ada553d66be940f8163e40a333733f0f462057b6c769d8002c85922b32dd8aafb5be16ca7e7409c6035a50b8fdabec6a57ec93ecda2dbbb82f34a62a49d312c1bfb0161cbfaa3f6578b2dd3ada163ed595cff95105c0d9fe6acae8c48940757c665497256813c4e9b5314bbe346ab02be336e13a5bb3843b500df75b5853b7b281879abe834d9e20b303c82cb61ad55f47916613121b25d0ae562c73703dc787c1fc001f193d3d3455bb94a9e030a286078b2ab5ddb06a347dcacf10b3893a952be62791e33b5a9cfbb06ab64a61698c4771c907814716740fdbc3cfbf756b5497c59f2e34c864354f64c7e1d8713ac471495ad818c4d461aa19e3f1b2ff6c4be33fb0a76e6a47fe6644ade613208e373f4f376935971fa5e2ebb98fc7a0e912956001901977e9cce147e28335055200d356b60215ff02a85845d66128d708e853fde09e836085b3a26d65dc830bcc24a8c5dafe2ce0027cfd236894f27fb0a7ae62b3075e29b62dd27c0488aaf33b2d1a3b379d80cd2314246e6d2916c6156f89878bb606abc6f7c01932a956d6fd1ecab33adf9b026a9124c7c1fb57dacc2857cd63ef5b8d49bdb62657917b3322e49e05557409a7e1d6eebdd2b4bbf71664c841d92a4f33cb866bfe3c624f0723210de16b67bdadadcf06b46515bfb313fee15c9297bed1f6653c8ef072458255b5aef359d4f1477e53c1a527b1011462b3320a232ea9ec230b37848524080bf19242523dc98d7bef5914f307fed15770645429537d0393246a34119fcf1bf13958de4588b88e1bcfb777b909c2dc65af5fbc4ff863b33c231c27284e1e0a757344814ec5666a29730d7c9dba8b372272c144500be804a00601ca96e5ae15e88803c4a754cc6ac39a69a7f7090d5cc03df3d837755acd052ba28b340b1d0ea6ab585e2e54464267a3f6a3d3312c5b8f18a8150fa0c05c0cf6315b80cd595522e3d74e2f9394e54b573a886eb5c30badaa16c2877623370be73cef18ec0ed41321dff6cfcb2bfe826ddddf2d82a994540ac5586625526258873aa969f324109d0e9558f5883f3ce8cc52fb3285968a179ca4be9ed21ea434c0b6040a7ac397ddd4ed53af64330ccf12d86fb815804b9442da4cac2c53570d68a090120abdb0a5a541f398ff7a3d6c65d600c939ac05dca4cc575d3077e76deee8129ecfe6ca28cd43dd342966b01a9c72f20b323008d6f665e519e9897fb6e0b12725dfcad36cbd0399829bacdad8cdf5748c6564f82fdfc1fc01fb5c78bb9c0dc0cf0738691113123d64d887a7344e719bde6226ab41e49d527fe24a178ac5076c72fd922173ad007b60415cc763258831986ffc556960f9e13418240bd916ef3f7e10f2ed200294b32752ce54a6596c1f10b19b66ebc5194e95b6378829d3e6c1f6196e3196602c7864631f2b97c887b197dd614e00361c45620dc7cc41f086902f3e34fd933d98e03d8e1b11501688178fe8e7e701f468979ee77731dd179fb903273973388582694eccebfdff8139965983b81ed3fe2792ef06164b9061d48a44f30a0468e7f378fbee64865d8927a22bfbe32306c09e64763097fffb8ebe3477f9aedde78ecfb9199e54e5b4dac7ed2cacc4babd4557cc78c2cad130e229229b38346f6d2679a78c0d31fddad11c1b0b2fe3acc6d60b8248baf32338478d1f3d94307c695d1a8b69dfb61ae8fba7ef8334a871b3a1f62787665dcc7a558f21749caa13f0207821a3bf1cc55d3bfb89a30f9f38661cf541aaced94e1b5c8642f468d0466c5b086a3f099873f9adb6f4667a6ecc37e96b5b810eba873fdaa32ce300d592bb82dd5fa243d7bc3364c477545b8cfb93c93b3441bda0bcfa5c14c0e0fba4757765f2e59942630bfab78a603ba581f1d7636d69917eb6dc3bb4e4db7eb784c660e44eb26b3fb9a9bd5d9c020d6366a8e96bb97e31aa9a66de6c78c67f52ab0bf2fea92b996f64dbf9f24223b9ab4c54b699937024ee234d004bc00b85d975cb4d9d53acbad31a7017f0d52b36b181fb976f3d52a868b0bb195e53549b534e9cdd843cd1633edb2bef339e9e07aaded45174c2ad3f8133431665913aaf20b290795818961c14dced4318ffe8692dc2e50b7917399bacf6486bab2666c31f5bba9539f6da5e86bb56d17ea9fcf94be569b73e34c0cc6310255bd1db9cf77c28c9e13ec879e7e8c2a22967d85f05c815e6d6dc148e9f9c7b8504450177b122c88f3df62bfb2f10d6bbe9b378ef58f5b9987ca31fccdf5d3557318d86b369fd74d9d4516dd303f4e2e88df2c6135dbf1484ac740a08931ab7a33eb593e48f33f6158bc923e0d5e0fa995ed3d107237cc358931b22b1b3a59fb67c610c62854ec5b293fb3338ffc60898999eb4c5a9d44758669c7f19b53f7d07fe5e5e128e87131d2287576a719b70145602de7857517c55ad532bb8cb53bc97dff3871624e7b06283e4bf99e511fa98b60898a19abfe7a52d0b898c16ea51d7c8aae859d551eb8ac95acd7ca13f742a7b52acc026618a5ba6ae6ad4b8673d2e75a666058819797ceabc322457d9f518a5b94b5daa3fabae718859619803f2f6212b3ebcc1c3434849e5548c7ad853c84894a00a648d0009edec5ddeab68d31c14940d70371efbb69fb3bd6c9a660076e3aae175f7e2563f1aa8049487e5136ce880c8b65d85eed0e065f007cb56b6bab62d422c1b339c96d75189de109554fb362349113d11ee05c29f6bb4557e2c382137726c7449e6585d13f6ec7e67d8cb96b8e9170db654c8f70d69eed588e46def045747cdd7879536be8754737b01e6a211884073b09a265e91b6a9f017f2ee2d516012c646fc7b766309ce2d69c3349ddb8444579df2cc38abec308d9ace09a05d0f5b4d76bb3a064ae5cc9d4638e0e466f11326ac5add172a115cd22b9f855f27370f8171f2118391b23853fe2c72bd4b0c522c0f07ca840ef51738934b3f76f67b6b7961949f9cd034f527d6ea5d7eaaeea8f42a991b5b64bf92e6e50501d1cdb10b1401c9b898d222759a93ea532589b99585c8479eb07fc5a2d375e88ebd39edf19abf4033a56e71f05b2bcc82844ad62827932bc5d6acae9850e492c47ed2122c28746d79866ce2436f8731b1d8b6894f628beea0454bc7726f35646b377f6630424077ec5a5ddf02d4cf7370089fa444cce8a7681db5d2d468723af5f1b502f399970bfa069f4b63fadd22d743ce9fab6614671c04edfc1347140c6b22bc40eabd5205f5a9f95a51629416a6da11c0537d8b989b54ec6587ce617e1486d8a79cdde2ef33266cd79d73776e5067b7e2e8a993a21a69e9e1cc8d34cef51ce357dd54f9eb10c14e5aae5807d4518f3d7f3b36fda3c826eb312c1ab7b300926371c8a7e4ec4500c61cbfaecd58c1ef695f244f0171a95f8e459931cf201260fe95b60f6c22d6f306acdcdd69a808c8180e2bed71f457d597d30464dd64a82198177bf3cbda41823c5bcd203b05a259a6061025b32c3ed3200e75dd78b78d3478285a4fb35a1e34a1a5e14ea1f535c82ebcfd0d405b47ac6865eaec5b112d4268a026bb301a1dd66a79c39dd9b919504c1644c45b77ac96cf4e6b5c7d5df9c89edc316455f1e568bdaa90eceb0a69f097d42480020010a002a869886e456e76e9b60aaf21a468a4d6dda23da03679e00275e0c12ced88ffa5bf004c158019bdbe5e22226b1244534e75fc8fed89db1d74695c343b5f6995f9b7e2b681bfa6a46b78f02441f40e6c566ce8002948d0714ad274c7e2444c7bf5a0d6ee288596be6237c0d0f26c3f80e712ec4f599d83eec9f187d333b9a7cfdd1e674d10bf411ca1ddfc63e307479bd1ee8f338d845642d4d3753bd06f6111eda6078d3657571b3e4cc4be9a1850c07b0ce97a6404d798c45db704b28bb24150de867ed741ee3c983d047a18c0c3dbf6d103f8606b58baea50ac040de9549e6f274aa28a36ff00c83618d9da13f223c989ce08c774310bcfa092b63bf1bf953687f51ed9b41cea1326e7664828f2aad5af22dc2795912bb796a678c3962b81bbf7f2f863731ca08b7a4ec3d0eb02be587eb5395e3abed7d24f5af7f18f24ea612c0252bfc56a0c3581d660b16059c8245d5f50902dc6ce1be90bbf84e9bd4c251c1c84822737bd729609a1ad63ac5f4944f1ec715a10b0efeec5bfaf824aed4113be21a40f15dadd91cfc6ee616f31fdbf673b56a40adcfd549d16d08d63797f907232d706db46ef8c1f279833737d50d0c68279ed2949cdf8c2330506e8a11dd141a23b217a3df6c2fe0bef599ceadf7158cf1aa67b309cdd1adb8c0ae7c600526ed612488af569bf6e23808b65b858b7a25ea2e3b5b3e9fd29c73762f51b6ba019332dc28371f5d599c85766f773f4395f4ccdd534db30a31c3b277cdc2b7e347a178b21d08e025b7faa9ba339713883f7a14bd5f6c3154e99c99cdf98c5dcf959b00cb4402eeeddd0561b373e35c48e7333bc3cd66f5b443ce1cb96efcf63bc69e2b67df41e650cbbbc8339a2dfb17079ff3e3404657ae9157cdad4a6b2e67a216d40d8207e59f32113b385a7284f2aae0a79da153bfb97a7989100ea870ed14ad5d67b59d5b0d16c2ae5070ac4b43f27d45cb998805385ba2374a937426ad853134a11b31b7485a0dcfa90230eb08e15128fc9862ae47aa7ad201d7a805e4e69060efd1e58801da13f822f06589e7cd66b4424be5632956a3dfc0484743a179ed70398a3993ac775f77cd5131b3e00afce210574a56c078987823cf0e18615ae8eb3efdcdadbb5b2c2c4ce063804520ea2e21e6b7f7f9dd624017858f5b32a4b333252b1828c39b81c4a9837247b507f1f1548edb9294ce96f8b1f430a05dadf032f8aa98a400a30f5ec9fd14c8c66d1b64aa99894524a391cf671e16aad169b724410d0e66aaade697c160682d49254ad20fe873aa57509ba99eb7c67d5a2413be058b99952b521555cb1e6a88c9ed2a66903c77e9990f99341ad4f45a0412e6cf1a82c68153d995df02e39d5beea8be64b5d5ac7dbfdcc67275aee85dacb59adb5998addfe07ed4344720a63c8f0cccd20be0208c5563ccc52b7d52c9132c027c44d625de5db6c02a9f778416972ea86b7aa441d0e1b67bd454f69f8e805f955f844546142b63656ff8687323a51bc9a5cfa123ec7dfd8f29121b04648391763db8b56ac5f0976f7b03fb85588ca3230837041250924e5fc6890c5cc1c843c4c0efe7d7532513efef2092220dc0dcce3196a9a841925d8c03916f0ba7d4fba9183e92300fc53b27ec0299ece22fd66b8f8120acfe4cddd7d08e6b05cfaf5b79711c699a828f9b54fa9cc8284ba193fc7923924d02d03643f1022050e5e85942980b840af5121f0047b69fd160f818ea00ff30dfa825a997f1b754ec8a0e9f93514c568ea53b392f1de8049871cb292a03095c5c4a3075e9d9c5be1f6c84a1424607b4c30021e5d16a9859878c69eaa7086db81340769bf020822df0772e061a700aa6f83ec66894f974ed6b540cc7fc8c112cf6b4b66c33674f62ce9c92b91b4f00597dae404505c5e2027ffb6fc6f78305c698316a73637e0be1bf49e18398fd63ca4fbf79e2f809486ae8316b17b670d925a84b4f476828bcbe26a7cf2b09d0bdbc550d10e7a1dbfed4a23fc78a869ef807be9b3d6633c036bf53e999a01c13c42e2c10b6528859712ad368e5b133a694782b35040344fadcac24917170f8859e1fc244d4cf83bd0b7fbc32ab4a28eb33fb42c51a771eb4e17ea7a08a102ddc50467b0cfec304970b66d788ecb16668428886c3b940fd4c98c91b319c7b942d8aed9cd6eea647bb5380aadf056f1248d8e7ef641618e333eca97fe8cc57434284ec724c07e90c2678cd74abead86637359af46a07e231d7f25a9d031d4f803f8329be2ba8408fb34362063cde6e165a7242e073482abbadeffa4697281790bcd649bb8dbcb9f1fc7740f1cd6436d478ab07ace42a0d8aca9b741b620b8e59801686cc11bdd3e6b28d8e31df0bb16a1ebc7304d3d2e7ef3f31aa4cd864fc4a92c20b7013701110b1649e935396f2866a80afaa98e0862613dda82b99526d4a2734e5b9a06460bf23b0b83446beea6720e3a8d6b1b12edc6d8d5db39f8e92626846ce6ecd670711a840b2dad08af50fafb6cdeb5d7f3f3abc0b5b9a6a25e5063ef95538b4443ad0eaab67d337f791dfb6a0e0e1b1fbce8c00fb93115bcc99173204efe98803a414e1e66a269ea793e95f9a863b20643c39c8d5d8ddba8a9561bf56328ad81641c594d8966a63948c56aa9629a027105f235a67864faa9364671bee5676b698c56088ac072a184b6e5b667e3a98694edb30d7a6a407105c5aa7669d17e7d71997d55e5e7eef16912840013c539b93ac5d9e52b6bd0cec792f0b18a37c8494d65ffd5b9856bb3b1cbbcd2362841a956aad7205f0b5030ea46496fe393544f69ad4c61cc2e46ffe03dec2a7e6e876c61fa332a0949920530f44077e28185aaecf1492519b90f3d878bbda7e7b1aa340cff8d333cdc9fcd695bba1950473925c24dc64b888aba3a82b196cf16b258b642241efa9176b4998b86a6d2e796bac2f8d414306955af8b4f564bb02046799622d4649d5d6fcd1ab525fb6263336f3a50c7367ee052a9909100de0d52a8c9bba2440facd4ab67c1cf61ecf206fcbbc89346b9a1084034a5f62c68e760b3e6e9bcdee326053b082d6d3649fb3354b7109b7dc8256e11e4d020958832dcdf22d17dc724cf18dccb542ca0a8738425578799074732ccbf10c979fa6938347b5be28b948b8c99c08cbfdf2938b25837149085e74e8b0e5f4642ea680504a02ab13ce9bd5abf53bdb39b9eafba9f65276c88f3067440f173b96a39816a676d905478bf4a04ef340b8e3274ed366f79b939a478792196a636366eeaa65e70c5fb43312be206464fb41e460bdfc0e8251e5d59a1aabbcfdab4d3bed39ad537170ab99b710cf7969e91a18a90d04c70b846357b77846d35ff1b699b1aa99e385cd9095d98a19a37c4c3b18601c9a1c3da203021005a3ea6cda7c9bcdc4aac28fab96a0e65c18bccc88647a26b87fc95d66d6afa50ecc034210535b108d0bca6009615e2c83ae455bc541c0885cebcd198fab005cda55900c4990ce2094d6000a0641d2db6a79a9e7f0beeb56bbd80696c083d519ba761915e374b5baacc7a73150b93c10f9f920f0c03703dd7b6a82cd68e46cfdd68cfd4575d2c1121b332623d4ec4689a089b446755be13559515dee8ca51ca8dc720c3a67a5544bad3a98a3bd3317b3f2845f73e72ad4740e628bbd94c041ffb85b34996c3c1df765517bb5575b7e76bc3c589b3519a5658b59f4357ae22a545c59c1023787abadcd4dbcd5ff650ce39e153909a8478f2fc5e6b7c69c1c8b08dedc45f8f80617b013116dbf138098e7efe47ed0aad4ba8c0a50bdda1fffaab4c1da3f8ad56f85312dd92b3b1ded2f924a69f54d4880076ad06eeedf27b282a60a005c8b3467b52467d8940d22d9ef663cc8e71283d5ed689fec5f348e8d9e83dc05d80d48670795ca36c6c0a20d33bd7e8b10c300c22ca6626183c2fd8bb3de260ae217a1f86956f085794407a27499f2e5bcda9267c635a730ecc2e0bfd4ce49b460386664b49ab99507f94130e024267854ab847974005cc0a47a940b3eaac4a8940fa97ddfbfcaf54b6ed6950fe6db4a77b3194c3cf31f635a6a830e77824a0150d551722f053001d0bcb719f0139a9fc4089ccf0a81ae7389cd9186cf509047850c57b3b1b5146c2093a634511056816372e0af416f2860379c2d2282f18bad16843624e36a4f65ce7bb5fdafdaa4fb64eec7cc198ea33b9b93733a6b2772229ca70296870a1837b59a5076ce86abd79067904a5f119f49182e5e2ce3076eb6c4b036278efecd466a7d766253530e6055c4077044171196a1304a93b7745dff6e01c021f12e7fc44674b4cd6e6cc04e0be2d698c1fc304697906b765fc0b6c6ac43a91c5c251bc4f574651d547ef3fa778ba9ac3500393c211cd177b48796a5dc52b9ce116a79167fff741ead18be0c5222bd9bea43e2c7d4ab15a5b806a437cee1faeed5890476652964f673a5b2dbacf13b6c6c4e648c90a04a62007d4beb9957ab3b2167d83282832183c68aa8bae9eda8015febbe00ed47a6f9426d4fb604187734e6803399d967625b5a0476b73730b494fa3e998ed383e723dd25e29b4ab1a8bd2a2d38a358da01f0f0b60dbc606d0b5233d6f8826b4d9b3990589fbb19f6faf58c80dbf0e55a0cc4e58cf82328a379a81f10f0755aeb0459cc290f825da5ce3f1d844c1f34ba38ecfc2cb8285f1a98f816f69dbb6500cb19e6602c2e63b438bc1d6bdd2460b4d064d8052bf35ac7c5b0988d2dd77a3e5aa14b90abbc9f3d01ddda91992dc93f44f0ea0966458bbb4748a334ab40c5ce78a2bbb1fc4500918941036fbc7b93f090db8fded0fd6510c3704347186069dd16cdec4195b892b01c3f7010839f2ff8ac8d30c418da5cc1972dd09bbc55e03a454a2bc01811aa99c0998b8c69899cd029066c250b3b33292b31a730018b5e81b3df731e16514db579e22eff200194ed991d6a3388b51f79c33787a80063bc2d3d8191cef59234c8413d6fade7c74e7f30ecaf06398958be0e68088add9b2ec015dae490b387705bac7f129363bf34e11ae6e3280ee07a43ea8425323adecb218ad159080bfcd2e3bff25357631f1f57e459751540effa6272a12a23d00b904b7eb48c4ad80f0ca680b9a5db027e1a6363fecacc848dd9fc9ddaf4ae335c73d542b447681559ccfdd3659a7f65cdb28244b4d25a60e43574727902b84870258bbaaf273596c34c813650fc0b4044861b0082d564b1ec4799ec5ff8db2f07b96d01040a3a068d9b89137cb3f13d193d79133a9ba9fbe8675b1809f80e0ad675e5fc5c017c7d06668c2d623d9e2f19f6862599512babe25cee5d98afa42254f52a8a62748f8a01daacf055c4bed64c204b481f73bc113211ea2c31f5d469ed3d75624db6452cc239ecf7fe22fe87a2e385fc0090d4c8a38080434b329cdedcfe04c9daa794e6a8ae703b2a30ca19002f7e7c1181fec7332deb01a67549c4062e84431d8335693949117a69da72c8a18cc1a433023dd05a83caf9ae2dc0514a374605bb0c20fc76641ac8441134eed7f9e5ba138c717b80ce572b0a900b4a131bf50107ed4118f6d2e87dbf1e1fc0ab2b8aa25734a4849ef25d6a24729a202a011f88e114a6546ee2a4da96a2c5afd618c9677a2bd880e27801b600dc62233262a29e82cfd99ac30ed4674255a8b0842560f3d776569668dc9082184b807a059c76ea1a31693698c50aedcdbcb5c9a0a6be81269282f3212d3ff8f100010ca11e9f348bac1a5167f830e59bddf4f3169e49a9cd6735e7b41cbe3590b83bb302511e06fadb9fc0bb4ff64c657a26614cbb44f4b8aae2d7d9967e05b16a654c8e676a56ab553dc94b791600fcc648eb812c9b3583afda4692ac089a085c8bc4a9375b2a077219d6166e4e650c0eb1d5c9282eac300b2d5a411654d50d30ad63886b27aeda1dc8d71bc84af7ee72d6cfb037cf563cabc04f02bb5e13ec13a4348572c896620446acb9b489281d442d0d4c407aae620f52b222004f6dc2d2dba9c810e16eaeb2f6d592e49990f4d831d901b463223ae7671d2e709f4823f62d023d6bd6666bb6f18bf0a246fa04fa42cbab753ac056c9127fe212346644862794e67c9e43e2ce6536ddbb7f63df2ce78f0e70fa8b2d3af71c2b657c36e9bc8bb950c40547741b760db4bd9e4aeb0ef4aef755287edb9e9b7c540306272447f99a38c10526b376f32bc4c2cd0a3cbda5619f9f8a716ce91ade93d11af8dd6607977b4126129795451952c185bc3810152128df9601ec73d616dff2249b139490a5622048591738593ecb28a0d8a2f481c5c0e88f9efdeca6545f744787071145cdae05ac091e98036760611b2b783d7b77e0da3ba549746406b8ad3c6b8b374b716d492d8dbe839f73f1b6c8a57b650669696add415b4518dbb67c1c173c85e68ce7d66f7d5a1c065abbba5609bc3b127fe68c51bc892afc56762e6c46f957bd88898c251b845d7ad5cda045f6dfdcfe5ed7af254a3b7c1425207a880e5201befe5458d053cd56f9de4e7e0e50d671fd7ce2ea74ecb29498ab048e9b696af856eb18d9282ffe722d22c3245202132341c4a3a7d2666718bd8bee46293f6a638e3d0f4aaf54384634f5637e882591607217e0bb3a292012ec81421b09ac53057f9c436858c5c7610478a0215d06db870415930d810379161405061e5ffc4c3c6a17740ab01748acc541023984e42d60797ac55edb297ffa8adbf800e26f2dec8cc95567b2a12078dc7272f6e9bbe09c45609b0abd60652113b85b1c396b66fe03913416dfacc36440a8b87a670692a817ac67658bfb284b0f49eda836e3a77c1908ac53528293619072efa49c9774bc3d7a410ae6c05d6e2b8e1240a682205f21d68950930c7947990e26ea6c15f10074be83740fc22f231172aee3e06d598b8607407c0d5fccacaa505157e5c7a7b4c9024c5201791be47b38153b81f0ed51af5023c60f1595015e2e369206526077b6d30802da58daf4485ce9dd429da690bb40e2350612c8e902d446b2a880d5392583011df3cc18862aa48026a8c9c529754b00b7308692ecaca1036a4028ab6d9a0ccc0f116a75d6b440f3b2b0015fa825c53beeaa8c1870af92bafa1e1a4b745c9a81f2ad2e063dd3fb782d0cef40bbf1d644413305c48b63b3349e557f2022e0e5177fd21b0bcaf8c51fc8804c047dd4057ee34573b0898f6169a93d70a769cef0597e1175d68248340ddb0e7f1dcea06051d83005ec05bec2f4b31ed219397cc818e5cdd8ed51e10740d338d9a270f14104692426387474087e1f9dc3f87abee93e93c71b323fac808e09b32a85d4b737d8c008cbf7a144cbeea8a66e1db3128cac52cd14e570d72585a783d93907488fa14416002ba3bb77731488e3816a223ab48e0da8ac75028df10ec535c81e6fbfd99b66dcfbf0ea0f7431706c1d70a8d65706aec8ab0811942602ff9eb00e6764f948a5a94386744be6ced0cbc5a9697f8d871ac5e58c11b5ea3fe053c0cfc50eff84ae81dd2f927ee098915d6d7f4fef7ea99e1aaf8794c6c8ebc0103c7fd795122c18592591fd4c6b1d3cd1e6f6c8e628400ba1de3a535900a2d77cd0b56a1b0937c1a1a32d10bf23f5c65dd2256e06695e2666271a9c694a5dcc9e44e2f081986a8181a2442d901b2f985ea4c2dd92fd66e46c02b26a83606ca1bbaa32fbc204811c932a87a3c6ea81c1e882bf683c35e2f03b704fb3d06679ab7dcc647aa9afc8a19213718898848d2ec0a452221a24f50934668918ca989654bee4bc075f26467d779a2c2661cb08424dfd4f71c59805b1a04282fca375f08c9cfe1a3e69c468ef8a5427057746f1a25a8576469881cf0540aaa2cde88590a11d72faec76e603c76a15aa0b4c267079a41f9878a84b740823c4e1cd51a3b0295df1da824719cb5998df5daeb4c857808c423a0a9a2c8974408dc816ba197ccae14cfbbbadee2ce9e2f02a311eb84b1d3a7b92617a8b30ba5a1e7aad80c82849db0a3482ac98a43e18469e547accf6d2b13afb43b7134744fa2755251bd159b1d0a2f526209107f74ecfe03a6ce5cd300b1d1ed3f75c03712ca24df4ef597068b4e2b10e90eafe594402104a19814b478d1e384191d2cf2eed94767183385530167301cd09d2e6536fdb8fd06976e058100aaa3306f40bd3ab60a3e34b99c0e18b4e8c0cd3c14fefdc56ad39b8703b3ea90e991afce27898e74a81b3ce8ebb960e63235c07bd8dbeac6cb204a29e88174ac9994b2d1443c70ac3a8f169c931f72d2a2776a1641ef0b50300eeb0a1d2c180b3201098ac3253fa0b0e989cf96cac3614f001eb82f657f9aa1209efbbfd884262e76c86868d52e4c0b1d68037aa51b803f3b7a2d0d46185710d41bd992dd9f7a56679e1d3a2f5c27d9dbc039df2da079a8870a218661235d02c62dbe41ec7e2279d5f235d1c4c8b7c0508273b81c1578dababfd0281f673064b066a5289a1fc6dc981f7a749813a1e9902446b2b5e4d263270560e31e81312a6747e8d74fd848a8369d823ef96182b3a3281b56d9dc54e60e7fe49a763e323e761d9efa1734ecca7fcd27270584b4fbee407884b035b5ce118b31e2b099d2fc99aafa85ea8d207c45e62880cce00bf06a0e5701f0c3f67325a37c942b3dcbd9a4e2010001a196b34f0289fb1e869c37a0624c2eb689100643f70c6ab2fe084ee70d99a7b9491cb9e4758afde233c2f03a9c9812ba43c16f074e6cdc0f133f3e580b0a4736ba48b6924d0692e4c05af58ade8c800d2c5de8715a3d8cb81f2c44c5389fb828a844aa9f85da3e3470f9ac2cbc49df58059d1da4827c64aa002d34244760af8e5b0d11f8104db18470b20b55d1f5d21e7a8fc96f14671e7d6555a924a9695ceb6b9f6bb246bbea2b6088a3d2a8e2d24e79e7d5c8968907c06b23168ea72a4c8baf76a474436e7274b6e728118f22e2fe367151d67952c679d9d46373d8803b4f94a0a1dd74c4cac96d84e48819ac0f26e718f36cbabcac5c703d3bdb5bc45878c45638c05244122812ec7e49905641d9a68134bd5acddafad03cd5171de194260236a28257766c3177339c3814ecd8125c3810301cc6f45a1d306f36c51dc477fa556d9858d01ec598b15a89b88e429199ef1303a0d331792ffc0dafec87947d13359dc8e5db6b8cf9b742f9fc100baa8d2e6109f110afe6c13c7d39ef95ed5d6e09a034b66c3238bdefa2cf8392297b98ecc9fc6906d3ac5ee6032e6f2b5e18faed29254328d74a64d745225b5f440719bd9d2676a2f476d84c53e4075b713ac98f1b017eb8dfe08e95231335299b45279f834c6193479c6883b43fe42ae43401822304ae0ef4236e23cb00dcbf3ef8adcd9416bba4c59e9c413700011724596d465740c6cf7ee98c3c39b21ba638efa5398109e0995c7079553dbd6fc297aad961acc5058dad61165e017a9158a8e648bb297b22c04134d1d78a58223659cc285d3df3bf863480563f4a21a129b2a9c852e0c131cf2e63a52444cbf4d1837e04ee5cb12884dede38e2badbfd5e13fa2f3821a0a07d4823cea734def6c6834559e5d433637482b915add3807f2220b38788c5410588a24829603ba8043644d57744874fda33897e1c752993b4902f68930511a02060038a321a4e64b64ddbe8299dfe2094a42388908165e82985d46c4e234bc8036ad41f5e1ce05039514acc9b5b51850653fe2b8c2132087e7bb29c812bad1659f46af73d5019ee1db2bc3f2327cfe0cf7c2d1e5e085973ec881ba860bbf672f6572059e28c55987ba2234edf3e00c094f3f4ad91fdab67bb5814d8812ec57ff6a7a8639ff4114e544e9ae04ac269b637381972c537084eaab8e31c4d577ea5269512fa401b3aa712cce605ccc8e9d894996705f2156b5e0da24fa4c88a167bd531b40f1217d66be52ce1e984f6dae2fb09708cb77f65a678b5761b290bca0b515612e36ac712d9851157fc1cda00a866c0a8eaad2529c1e4d1c09bf9304e9f39a4e02089fa70a57f8d8cc6c24578bb7995a6f1549799d6a222714db05120e31c61aa3ee5d419ac1df952b7f23e5930c387a6afe9e60505c40e9b0b744101a6f33b53c1ed067a9d77164ee5ad31490e78c546bfd4f6658e6c7fc3aa0c7f0f8f26e48072c66c3387a137e0c63ef41ee9b290eaa1e9963d76467e0af1941cc61189db1cd462db8e7d531d04f4071716aae8a6ce664ec5f9b27a2e954c1b90f214d9ddd21c713f9dc0c031951453f4a8d4b869b97b64553207e8297c4308c7db889a05da0e2720dc6981c6502d704763cc3f0deacfafe8e42f588ea9858bd3f1b430b13a58a8d9199d94a85f98b69bcbb939b3a2b4228d14f2f1e047c6a86e16e08a9675fd3ce06e0566cf2c06883d6ea033829ec7f8c772c342f780d9e79db0f13f3eb8bf013d0d001a0c8963c03ebc51846956fefe017f12001b27a693ab89695225bfc34b1ddf56399dd2ad5ddf02bf57ada04ad8906a188e4b800078306c458793338078f33390e0524f5148804f509b8262f03d9e5e6ea156118dedd3c3f7ea8ab1a5a786bd42925ccbc024b501b806e001d2bc3788efdd122eacce6b30736ba76c071c671de0165b54da036001178610807327a70d6f73be96fb519af79aa605f0d28f00164395614596d38d83c505d316fd10e36d5f9747d2f6a087b0d7c74a84493c046436b02811a40783e7731afdb17c988829b108243d43a201fafd3451e44027e0650009335b3b9a594d0fc7b73c4cc2fd86e27eac922104468111c3308054aadb666d92738d7fef472522c3c5c7b81ead42c227160aa5e07982a9b9de73ee554ed7e14ab22a63ac349c9833eda043f16d4cafa07edfeccad5c8f82e44b25984ae58b5980950575304433dc9a8888b34daf8407577ef446116a6c665ba02c41f233fb1aa542d8464120bf9789d3f52b08e640a5130e88334023586a0ca0c9d683d37080203397196ca8d58c6a2075c928c57c713d103b608425595d590276de732ab086609074a224493d180e30062277b41bed17fa4e4401968875f1d3e0f3ebe09f20809d2895938c58cff645d30e801d17e59c315279a9dd03ce618178073091dd6f837135dec9a6e011bd26ea20b19d33e92d312a09e815c4a5fca8fb109864159882ad306515a59e89d7e2355b5bf70f422fa0a96e966ea3684df363c62ae2200ab084495ad46a0867f82ee4f25fa20375658b47dba36ba2079b9f82fb1519f7347442ba80400256ba71ebfe46b03413128a62052f0d1f3d980130e9a56f38d40603bc224bdbbf9aaeb00ab4add96d1ebbc087c3a900ea28a976b7298ad19b6af47286ba2010a82b7b60599c389706b8f99a46680ba493c9f23359f7e6dc7aaf87f3b9cd00eaca16e81faa26e7ba592a782247e5421a2cc4e95e97cc69413ca4ddac6d510cd068ad3245de2e620c2c1e64527c1f2c243b67b30f92d11f133319c3fce85f25f2e7d1d24947313fe1cb2cc8384600626513ce199311199edde6eb386b0e9dd8fd071e4775a26458505665e7dc7c962ec6401cc05cb38d0529cb2c556cd83fad2161301ef621bc42db607442605a8c61347b7214fe5795b2c6e8515ab5120840aaa88901c9988cc67037cb1abf82e7a9c5d5138a2d198a117e07e994ada7a4094540ac6c2204a3330ca332b46c63af2072396d67cc9b6ce480b9df1872dba5dd7ccf94cc8b4bbf8a7165e56698f1ba38744360b386f9d86946e8485723175237087c9a571c4be6eb9f8233820ea8759b5372d63881c03a1555112f45a463f92cc8eee41ca803014cc62f181dd99b2cc3278d0de860070c31f14a57255945a372b56865b3bbd0dc4cfd7331977c4f183e51fb275ac0b175606a00ae556636c4a4541cc80aa343205553fcb931920b2208085681987f5fcc2423099836cfbcde6f347f6ff1ad54505bdd801e99f461ed371a35bf7335cb30e3c344ac7836dfa9d5ec68d9dcd976f30dd9dc4d51d4c7fc9860396916637da33663c7368108c0dea5c316b8106f2e36811dc8f8daab5986efbfaaa67e1b718735451db4b23d4a218dbb9073e90c02817a333597a731b63a50c9f6bf59a31895b54c46cf61c64a31a0cc55866f252ee6c8fd9c729b116526f8553c2e6c31043186337caccdf4f7537f662b67db4af9b50617accb8d0d8eb1c71a37cc2fe61985b12ddbca7b06aee29a852e7aaf1888b318b59cc29a1f8b8bc0999a71e75aba832ea5b28562c6a5472c3b6a5fc1de6a76d9c297616ade8b995f63bedafaa56fc95c837a019520e3160304b7445debcf456f99f5479ed86ed1e2128cc1d60a9414f65b5288316a4d0ceb1fc6d43f77768d5cc24524077818d37ccfe601f6fce5923becacc6c88c2bbf26c5c00b822d11a34153add495054540ca0436f48e207b63fd62ee29a59d05888768ad6f204b71d478bdb090b3e26dd807578ae4cd332fcab455afc49289ef4c68c14485006cf96b5015cf99b353b1e4a7db3dcb145871b9793becccf3124cdde17ab1123e1e0d57c7818673b0b5e462bf8915820ecdcc8811200b87a8ea71367ba807db667ad68e1813522963334194611e198c3a284698c4332de862ebd89b519075d65ba5e89bb7c9fba78177c15051d2f3c4efc56000677a8661f429de18eea28f0b97c899e3e447abb54163b80a9c981f33b04dc4b8c4ce523f53cacf8691eb4a836284493cd3ba794611dd8ed9be32cb838b99fdad96799597981f63682d33b2d0ece94c73624c7c6b3a5875d6ef961957ee418678fc5aa408f0d62dc655bbdf0c314b65580cc3289413d59efd3252b8cd27c51dbfe35733cc4fa19ca8865d5eb1b60d97406cb4b59d33599b91b911608e2fe666b6106b4eab8b9c914f0ba7c20aa8730ffb750c7d3910a3ae182f5e2f2b3dbac3f0ec0b2a26a5001a1d9d1ba52109b7dd02ff7180ad0b144bd4577b09f55ebc88c4ac3f03b02b5708c811881c1b5623e1de4930667e905ee1ccdecbc2f98b565c7c9a330cc1cd9a79bd61d6db72d3502fd101843c866742bb0eb0753f7f1c709e194c314ffb118c27db6acfc22ef125d15c0c28c49c761dc652533a117a2184f57e440dcacd4415bbb590bee9020818680cc3e13f36ac81767b11112593239f7a56a898727bbbe249c32ad348f0e6c28a0962c1a1116e0e06107909c6921d499701e9788b229f2e5056921f4ffdd6779bd0ccf0486beb21bfb27fd94f4eb9d00968f577735a350bfa68c6e9aca9da75bcab45604c2d7dbce6000441d03dec00f690d4cc79be96e7d14c70aaa306a3f093c695fca30d66e316966a9c1d48ba478d2b99a345a148f2bdacb9973f64864d6846e85fd956629a91fa96832adb48e3ea65a78f036ca38c2bc6fa599657db0cc3300cc3bd1502f141100441100441100441100441100441100441100441100441100441e007c5f9556608c56d6d608aafcfbbcb20d928d4b351415a7d70cd4248dee95c84f10b2a8ad9dcf2c7d8fa7471276d6db52fce76490782bb7e054a6a020c5547eaa14b25362cfbfbfd449ee765598e6ddbaeeba661d9dfef27f23c2fcb726cdb765d3738d7de23784e54f9e6976bf20b8f93e18b7b97b364b7bb8324f1ecb31289e848d1beb4841cea75d8ad86cb1822948b4565a75f92f669b67ad90974092e498a481e5149244f282830021415c820d43f8811338bbe0d11ac4271387fdb1c3d889e6459768763893b35cb4d9177eb231a1ba3aa0b216e5f2ae005603381ae7292510331fea04e267d109e5fbb767818922d9c7b3ee6df1a2e7e158b92e58533c6d9121f307c98f537d889e237e714a61d5bd84175d23f43713b94dec82c0241f543320692cf4095e059e46833a29af6cda07c37ca3587b9a334e2862e6b6cef67e121e19d5ee7e54f88b36632ce94517e2104edb541b004e59baadb12d65e9b313530af7ada377348bac4a4cc2a8882fe0ee69d9c2c1ecd22524c6fc968d3b7701c2f95be66ff126cb125e0a7ade7008bd6fe8eaa5f907e27af38673396512800a33e6488d50f10cd1a5dbfe91726cf31a5bc57ff140fd8e0f37c96965b0806aa5b4a5f41fa8121085c5cff434db21f4d469a94758ef259593b8667c8e26198154888543bf19845ee34f86db09764f3cd603603d813068bc8a0c3227e7ae51df4cb10a7f44192ccb67dcde2164b857424a3868e79ac9365500cc2ba3dcc74a49d309eaf4ae3a293bbba7869fa9cb21b31b56febaf59a84a892edff8de2ac4b8110141040a558569aa8d79a4d80d370f451b47f1abc745675e292957e240776767206c8526c5a41ec04e4973f5dd49d908207118a5693326937be05bc8e1fee03aebcb12548f2f38eda808fb23b176947079baedcef78d1e2a6299e0e742da1573f68fc3b7056693c478cff0c00f0164e2fb09fa9939ddf60ab7b524c048f530a687c37b38877464e87e1bdc001f2cb90f922f061955d6628735939cd579232b1e86619b30e7e7096658a645839d3da674174583b68de577349e5554dbf55338d272af2ae8634e4c80b049dc62b1826a75a41d2ef8d35b3056d6ca1338eb96d132f1d15afca920a65766a176b8151d184662198676f9dac23c3bdcb7fe3eec44d6c785373303bc532279f99f9b107dfd5d6953212e7d67b0c464cc86b05f5b841bc2404c1f24b68207196335359a7f688db078374e1ff1bb18db96181c63284097de63fc4053937de9b65e2d673614c8a4591b0f76e62fd6576b35fb35666c83225324ccdae6c04bc56f07e045e5af5aadd4812fce5f197f4a52d4684a87b8752414b0184073d533c3ddc2cc52f4ad53edfb09de2d2a28b8b3e7508930f2b3bb09f0df1a779b7882358c8b3b30327a3bf838863d4842a219b1ce0289956f895472d3d4c652bc7d0416a06a36fd9ac985fa47fda8a673017a69e632aa8d4f219b4b20ef696e16be7252d81f04d819bc15b96eb66ddfcd346d2b644e0648d741237f31433373899383fe3a0ff26828f0053d5c44ea9c2e3333e2d406d4cd1d322c96f91cb1afc32a72667e200a5537a037f829a8f1407d8269177451905e03e1d945c56862f26efd7ddd1cf61524aea9a1e98822d57340ea680dd3ff9e3c1590253ead5f9347bfc1bbb91f9a7817c3661a54372dd3ceb08a7eaf627a85d9f58a2cf0e77d52f4483d867d8c4c9b4d2f5d54343089124045f5cf6a98916b26baa4fdaac9e8d76662e2a76b3dbcf9204c845dacc66c878c90aedc6cce63cc2c77b1b2cceafa423d977b2026dc87a3261467a606c1d0255df3eee8f40ceb861bf8bc0055b27c1d3132e22f08c342d29dee5fd955863b552094a0d35102c4f48436333002edd4fc82b0b1adb5b82baaf72fc8575b307914c071d0ceb3a681491a95b0d16d798a51ddeb2575270f3499c154ca1ace22da2046b825d8d9af13526e1e6328f943a7c505132a65ce1b44af4e3c7f2022363fd1a8129e6d6146c976f1ee9c00cb6236e588212d1f91df47a7b739a4f698afaaf0c1ba951b071fca25620663c3f83689cb1363a8ce0419742a17bbef22b03bacb02519adade6f3d44ee339e460811772e2e424aa84253cc5fbb1247b2f802d0ebd1e52bf38989815204da6f4b5fa37648c66d33f88b1eae1e9c04267ab60a50a41576eb6921855bc2f0bd0849f9d31887dd5d2cf5ae203b76339d3552644758e70085767c4fa6772ec596bdc1891c41bddfc8d33076346446b7bf8867582e15fc03e1b468d8c5fd8112d7c0bc0c358819c52d40458752c73859ed9524bdf226e7091c5085dcbe52cec8aa4ded33ff4c25d8721e290b170606865e6d5300163b219e6edc6b7f0d376f6454d8a040c4a2d6da73b781fc61aee24586ce1151372227346b29b57a67d0666e9251a878aac7959462d54e67f2f2332accbc64c5551bffda7a1d30c8253a5a02909e4fda067b07df70fa341b5c5b8f12d9a1264b87dc1fc12f66ce2381b7f368747542333461e9be6acc9fa8bd1e6a3319297302b53d5cc8feaa57e7c6ece47ad4d52183a52115056ee8a9418a086c03ee3612bcaf95385fa9d35fb1fe68635bbea29e57a3e6330027558ec5b4ed565968c25c46c24d73f802641c1b9e4f20cbcba6b1969372a85c68204b831fb69d6c5ed6279fd6160728b6be5c89ce03f06d6d88c67b353d3ec64174b37beefec60b33286899461af003055da0417903ab1d3826b297f7e9ab032180bc9df536b14fdf59afd43f19aea0d861d0e97af1905205e1d46408e87fbe8445526f19956a0a4dcc4945a2bdd8644351f5621d541f96cae2a518cd96de6ef76e399250f268dff018e779a09bb32d0a8cf64558d938bbb38c6aa8cee5cec97e522c35ef205c44364a8c414c25a56c73f9821584aae9f5ac92b2afea1067fca1ac5f216a62e53d6754dcf8cf442b93731b33c1306d57267f6cb842038e39a9842b0b9acb2c15e75ba11d05e4fd2017b2eca9445fd23c565d25f4ebe176761c4fa87185c7e1b028e6f0b53febe3b7b8081e16786557e892d73789b53ccceded59a7addcbfb05e726ad3a317a238c3a1bd61c1686131bb5ac2cc027f37320267edb71c61d46b9b5e77499ec2c39be66f0c42016b5d25d464bedee467b0be30929706db6f8b1bbdc3e8c1538617dd83f317819dbb4bffb3cae5a84f5a1032f59ea35d13757c661187634c043aa8a04d9aa4f10c030472483c01f9af283a3f064eb6e8a124e6a835eb49c51ebce19c4fa9732bc96f5cf8910bf67e0d69ab719c661a133375068bb85d63d1e23009daf3153cea95b5603a60dd17466a519b2486b718ee5f711f02fb8c06601c45cce47fa3e736913c3041321b764de99ed7979ccefd087f783a3dfbb66d48da06f772390bca6c5e6f7bd1fe37ab396f3b8c46977bbe6b93ddd8c59b818da1d84ef11004ba9cd05388bca301ec3e1714dcb39d225d06e41594744566409dae0e15ccad39e3d7ae3289cccd0d9c10cc1e50bf31123bf4b2bde669d0569d1c5d24dec3b3bd8ac7c73436cd71c07c7e6c0ca4cbdbbd52ceff26f166c3fdcfbc31613f603bc5a7047e087d4f65e42f5f09cd4c4438d55e7ba8af32ec3f316f23be5b80877e9d70b31df9d3f670be716668a65cb99e3e746fc0e3126cf53c6a231d6b8faf82ec9fcbb23ec63ffe25f8d133bc0f4fc9e201be07dbf7d1b7367c4f4c47732c6136f96e0b0da86d0515cd909d87c3deeaaf75bb50fd4e2dc677a463e25fcdd6156302d8a4fe5deb6dc1fc687ef978b2fe2e5f5238c0c77423fbd751026ec0ff3b865afeb6a3c79c31e4843496129d1f51979fa95e20ab1f3d8423b1eaa611dcaaf0929e39e24b56c7b4c318ae08fa801c6bbef31678c2ba80e7514bd6f83bca85f199fe92f9153f9871365e532d60e633027a9f9cd5a24d267ac94cbc331e5d9f457114fed9e183ba31c8312b1d391477798bfc3ddeedf81e075ee332373ebd55632489b5ad069a608e3d6127f0b15ae147dae1994f42e4d5aab0d6d7398deda69edb0e8282b3573ce258b4cabb725a5da4606a3d8cc0fc74715cef99c35243b644e16a91f3ee9695b45956f174a46735226a0596ffdd98356abe3affca98accecb8a4cf789230806e7cb73cbb0ceffed8b42e7124d678ddfa11aee223b7a81c3623c8f2a7242cf27b01b8d5f85f1962dc6db54c370630139e5dbdf7ccef347af01a954ea518986737c74c1997d1f810e3da8824484296b5240f82e3f76b1fe56d809c641efb276b3953b04827474c61615837cec6f9ac25d7fbe7b42c6cec82560fbbccfc932b12f0db2d66262535f0cbc2798d542bcd0c6a41665a0f66b11c65f55f23c735af03b5c40d462e184c8bea8748411994d633ddca416fae8cc39c4cc0b0f92fcbe96c078fc946f28c2bb468b6b126f99629f4edb9a6d998268b3927cd9a9bc7defb81ec2fa7afd8c99984b2a7ba533136610a594e31a164b45f59b8a85fcb35ca28d86fff91078bec64d816a6c384a7c66a6acced53fb23cca266648517a09f240f4ade7f4fd02bf1af7b19d74ef20cb1d06cacf999e14de912088cd96a674e734ca8228097b99914fdbbe07e9acb6ccf853eef7ed58e9c30922c6b47d5a3799a73357b99dec7e5f0597c3fec1a3104214bf480f393de0018416825ef9981913519e13a7305e6955166c6850e29b379d61cc393d1e7dfcfdc95702413497d5e5836e26759e1ddfe05eab3a9af6733062352d85392192fb4cbd570a3272f4cc2dd85d5fb37c7b3a917d51b2300d9f675b77fada5c42a962f4039c9ec5b2da8712dbe38ea154d6f73baccc9ed2ba5bf41b38db13757c661fa5aad7f3ea3e83fc43c16bd0ccb6bd12a665f7d917914a23e4330049b10d90d792731039a3668daee7d9e1816391969b6ebed67a68ccb48426fd48b6d89b15bb34b3dca43cd773c6f21047876731d698c90326a83c33d634e8398467338f30b9f88836a30dca6393babbdcc633085cfcc9cecdb5cc49a2c73eb89dc8afd3787874589822bf4bf9d4d5bcb5e8ba994c049f7276465a2fbd255fd5af441abb5b096384d036386083bffd50a8f695996c954845919de6497da870831f674c1baadc81b6560096446f460b690ccdb6844d18e6fe1fdd18bf0300ff3932394475bfc39403d2f30959b6aeec999adfe993faf410c9bc13d3fc5514607a50ac3c80a4141e7b4330f5cf091a978932b44d93f1889b81512d2540e632573a698ec961acc52980820188e11a89fc7c036d34f38e72957b7f46e3f2836d3d223a12057f291d55b98af9d1bf2332e8c7f37a450d84aa624f73628327781a2b6230f6c7257d7b13613d44acf0c32dbc24ecc2a4a69833f142b73058ef6f9e76a0c533c5cfa19f92c6260836759a732d72d71f90c530ecaa450936f2e9b9ad5fd2b70da4c1997e1dd1fd331caaa14eac9f35ebebc7c646216200d630fad12755a005b74a75721f2978ba5ab8e0199ad4016fb872da3d4f352ee893190cc7978aaeadc8408720e3103c3c97d2df64c5bb3aec770ea23502ffb6b66b453617f480dc676d25abe6e7c642630ad8b02267fc2c8aa26a80f452d18b290ec345f618295f72c75cf8cc368081c8b6be0be9db5989202c9e809981a1cb872ccda3ffcd701d56814b6ce2a633445d11eec350b51255cb5f1c9b080d8fc46ea574a305da247667d56487c550fe779a1d7810ae114a7d6a26deb99405008f4ba693b9d273761e2859047ec37262bb7282321339b2c92449e33a1a55cf02306252ab074fe2906c382b3d1fe309ff594323dacacea52bd86409051790e58bc81616405309e816da11f98f79128a3e11a32580b3385df070ca3ea68c61af00b92bbe24f10ae8c8c9165462d235c40827e7a59465cfcb0a800c39b98599e71453f4ee5b0751d52150d70ae2b1a469b1692c95d0977530a799ba3852c7354bdcb7e59cd84749c9532d43e787d03cf65f33ac459e8ce3626afbcb6089e371bb370df8e99d78b3d0b44a970cf96866c214fdb0ecdfce2e250192e1be80bae4cba87426b73ab778cf14d201e2a90950afd619c683b4246ea370bbe3d6b2a77faa64e6b0b56619004e23123533297cdd68b413783c5eb747e53a0a2db3f6efc8182f03c0d585a505f50ec684a080490886431153f73dcbbf76f5a3093cd4e9880e72be3b33d6d3bce323ef9859ac0961a4bdfc46cf3588c8260cc2b08f5c69845a180af2efe28fdaea8a5d6ee3c1ae0229a7000f8a2b6c463bcb0bceaa7aef10cffa6f49b5c6a5230531a255e837ed0ccfc4485ac279c952cc88b40780b6ab00574ce72bd59c518e4a7fd2002d999fc83871e9b21569e16cb2bdd9dc3dc52bb2beaa9b53b2c497edab98a79067db0593bb17cde2f5216e8cac3ccedfbd64145b71941d66f5d3f969b20c1539db9a884d751d4bb53b27ed3af67c69ca87f9036ef08fbec8f35d2b90b3116647a419fb371168c621f4b7333349e5877f123ddec4f26bab98b05fe82cd7327a860b13ccaac7ffbcbe6bcf1dd0a35307c6e8a49c50932137d2218680c2b383d65e3b97ca4788a8868cc6aeecf5d4eacc5dcb7cece86ae199d291b27b6e213fb4d4c6e800f1f64b5f86e3ec882cfc25c3fd45f27cc30999a2dd9507dbe539d8babc3776ebae2a6de4bcf0b866d58f69d737fea1e133c27ff7cd6e2825d57a43a94e8809411a5c0696f14df9ddb2590ef7fba0eb55dddf43da3fbb5167820320f321ffcbce73704c415545274b0fa35f16a0287a4d148af1728dccc66bc5db0796632bdade48ac9a4e39ba529711b7645e5b662aba734b70e005611f3d3758d4828a04eebac6d4d4c49fb9f0cf893a1855f555e57aec52ebe91bcd50e141f31ec4fc16fa6bc224b49191f464255bc7eb77e57946b2bf6e6c48f6dfa6a2e32bf9dfb1ae6e47265f307c802f7c8872d0462ec213d28b3c67813603896d0c3d6df4dcbe54c90c9cdb38cad9c0b21be850c75ee6c09462d726926f28ed162cc871ff8f6a8fa1548fe02383d3de48fac139eb2734e4fbb4829679a2cad0904817a0753c666b44e651e787f6b6b1b533d66e9e53b9194f1ed0254998f88cb9cb11486e33853f4499aa4616e6601291d01031818e1664b4cdec3eddbbb4f9ea3d5ca95fab63437a7bbbb3199f9370c0ef3a635fb84b18bd3f42d6a0ff7db8199c2e4dcadf5a36c4669a14f08f9e55cf7abeacaa11127b614c6ce7e013a9c1c0c3fd7465d87b43f0110569ded358ac5185886c40c9fd5dfdaa509550b309640f0477dfa93e74ecd6142713a89e497b07b73676526a09c75fb57fbd264620ad00d6d3459375c16af095c662f7a7c1f6b54e4739660e7ec03652bb7367eeddfbf65ca121bdb4489999859817e14e7cba5884c82ca06ccf44bfb95a699eb2b26bb167f6cce4b7832e719c46cf73518930b85b0520e25d5311c8b30f130315c11b20d7391e1c2887ccda2eb2c39e2e5323d331eb0cc289b91f0da8fa0e82e93eddfa46620f08bad33aad9e95688babf2ad7ae39662095fbcc61a714defe9b2640964728e5b2c2be33fb9a625eccb917bac24aec39d63be9c57db0dbb6c69f788b7268ff4ca4b519be0850a8f805083db70c5b49ab07cab11146859773a7cf99ed6d9bae9921f780d39a2ac7e6ce21779a0bd2e2956ce642448d1a3e5639035c75a9baee91d86856ca14cc5e6bb7fac0ccc5d80fcdf328b7ccb5cde696415cf86dd912180c91cdd5ac55fba606d358c602440e4ec061967bd18506891910401353c4e22f0312d7759fb31e064f8f885f98c2e1a033ba99cce73054d24c43190419cb38a6b8d0aad7c853fa70245e06f5c76d3d3e82d06c85c5467c57b62693c3a8e7abeb305b37e54e983a7114dee736d3e843cf0037c4243d5591cdd52c7bff3c399f0c120432c97b0e17636f5d29f3180a64f33e820df00e8f0ebf4f023bb749e18d2d2dbc7d189903e9ea7a4badffb8e85c9d02a9c9df2e9645687f62ba21612dd77233121f35b68bdf893fcee49ace4bfba526b49f8ada0108c7b13533780190079c3c08cd039ea7160edf524715113f9ab5d8893f05d67cf729697285ab5d86e7a9770ba770004b71d15d179f755957f6c1595fb7f3e0b0603b2cbae1fb0719a8cc70f545533ed273bc2a4224717634a3905a1b40403e5311c33ebf1859b3309a78e847f06abc744fea898c2ba607e7099960f1d9d21a5ccf7c3f9baf95300661318dd25bb229276cb6518254bc9d48c104085b657e730ba63bafa53f6a3e6308accf101a056eeed20ebae07efb90fa9b5bde1771dfaf354eaa5286f36d91b93f2fb8cdab602c5cfbd58c2201ea8637dd940e605d3d398348ac6424c726f5cafc99906ec9a33d2426f21b0b9a9acaed3904428ebb667634df567d9eb19cfebcfb60f5d206afb238d6697397a05d208565c5e7ee3bc7eb851912ec76be55618aa65698d1fb48a87f9ef558368b5b72ed3ecbfa7834598221e7e4ac25d8bfa833e6206c94a55a63d196bb956ed2b6dc3d4dce2e5d52135b532d881eb6110a6c0858616f9e2a90867e373ba88f496c18698c96d86bbffbc743fa5d95c3af1dd7375d91db2ffca43002c89e0769e46a20e0c5df6cd732d3bb625d550f175f64abfeccf597a6f12c4694d8f64b71d8cc46a879895e295551978994dff8313683f038eab652bf6e8b5c82e0aa87e40fb7c4b4163649e156927cdfe7a3bbdc6714364fceeb1610f73eafb0a2e6ca5f6e0eec573a783a2f0c005e9a2f46955bcdd2198dfd9d98783be6e849943a52d66d9f127425c9dce7f3caaa77e0b9202dd433b8ddbf2941eccb4257ac94f0b8c01b15532470cf5b9ae7a56b26f9dfc2fce246f319b259f2436cc14ecabd1787f46579cfe79c8ca746247f252a9ad98c739ccfd724690cacb2eba13879d85bafc8574ba990c366fc15ce93c9987e649362bb3d75e709f961e40cf268f2174d4d23fe9df6be858b06427917aa2371544dccc9c445d95489f59ba7944237e19b929aaabfce82537fbf0c08a31f45805da0bc10560a980e398d81ad1199bed97139a0216bcbe183f397994f63094305368d44aae1c54feb1a26ea3a220b9c4ab938be22527663875caffd2373634a5ec8cedc411d215aa2fa629a096b0cc8aaa2a953c5621e869d8f72b4c69ec3870baae7f5d78396700c5f689b5d49f20837f718f4858b0fd0a1cc652e2fe28411b82320f275183f07f8c0e0787509aa46a9f516e8079db2d69ad874634ae07a490730a6f96d4eb7d94240b1d001e5a78f3cac3ecc72c99ee9ab16391f588e2a7797262acb0d331ae87de681bddf0c8ca0e260c0a479bccd6b6e2748423150a8bf0033ce25c2d4690574bccd9e16e592211f3292d3f1ea4cafdfc9748b48b8ebe6813ed990498e90aaa9e48248da008b296d92fb439980dc945b98c6b24d3043473df32c41230c85b3e03cae0a3fba0a3fba0a3fba1998d536c3300cc330af05c07ce0044110044110044110044110044110044110044110044110044110044295d536c3300cc330af05c07ce0044110044110044110044110044110044110044110044110044110044110044110044110044110044110044110044110044110044110044110048d07613cc3300cc330d4441fac1004411004411004411004411004411004411004411004411004411004411004411004411004411004411004411004411004411004411004411004a94d992cc3300cc3f040b05b79ed330cc3300cc3300cc3301ee3ced1f41a7d75bdedcd300cc32a8e8ff35bafd475fd3ffff3bffff3d79f3f599af7f5369f53f1f7de7ee53f7ff2f92ab7fffcf5fffdff03008a71c36e1edc0200")
 }
@@ -1,9 +1,12 @@
 package renderer
 
 import (
+	cryptorand "crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"runtime"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +32,13 @@ type WebView struct {
 	config       interfaces.AppConfig
 	eventManager interfaces.EventManager
 	bindingCache []string
+
+	// Indicates the frontend runtime, bindings and user JS are ready
+	frontendReady bool
+
+	// Channels waiting for ExecJS results, keyed by ID
+	execJSResponses map[string]chan execJSResponse
+	lock            sync.Mutex
 }
 
 // NewWebView returns a new WebView struct
@@ -85,13 +95,18 @@ func (w *WebView) SetColour(colour string) error {
 	}
 	rgba := color.ToRGBA()
 	alpha := uint8(255 * rgba.A)
-	w.window.Dispatch(func() {
-		w.window.SetColor(rgba.R, rgba.G, rgba.B, alpha)
-	})
+	w.SetBackgroundColour(rgba.R, rgba.G, rgba.B, alpha)
 
 	return nil
 }
 
+// SetBackgroundColour sets the window background colour from its RGBA components
+func (w *WebView) SetBackgroundColour(r, g, b, a uint8) {
+	w.window.Dispatch(func() {
+		w.window.SetColor(r, g, b, a)
+	})
+}
+
 // evalJS evaluates the given js in the WebView
 // I should rename this to evilJS lol
 func (w *WebView) evalJS(js string) error {
@@ -159,6 +174,121 @@ func (w *WebView) evalJSSync(js string) error {
 	return nil
 }
 
+// execJSTimeout is how long ExecJS waits for the frontend to respond
+var execJSTimeout = 30 * time.Second
+
+// execJSTemplate evaluates a script in the global scope and sends its JSON
+// encoded result, or error message, back with the given ID.
+// Promises are waited on before their result is sent
+const execJSTemplate = `(function () {
+	var id = %[1]s;
+	function send(result, error) {
+		window.wails._.SystemCall('Window.ExecJSResult', { id: id, result: result, error: error });
+	}
+	function resolve(value) {
+		try {
+			send(JSON.stringify(value === undefined ? null : value), null);
+		} catch (e) {
+			reject(e);
+		}
+	}
+	function reject(error) {
+		send(null, String((error && error.message) || error));
+	}
+	try {
+		var result = (0, eval)(%[2]s);
+		if (result && typeof result.then === 'function') {
+			result.then(resolve, reject);
+		} else {
+			resolve(result);
+		}
+	} catch (e) {
+		reject(e);
+	}
+})();`
+
+// execJSResponse is the result of a script run by ExecJS
+type execJSResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+// newExecJSID returns a random ID for an ExecJS call. It can't be
+// guessed by the page, so results can't be faked
+func newExecJSID() (string, error) {
+	id := make([]byte, 16)
+	_, err := cryptorand.Read(id)
+	if err != nil {
+		return "", err
+	}
+	return "execjs:" + hex.EncodeToString(id), nil
+}
+
+// ExecJS evaluates the given script in the frontend and returns the JSON
+// encoded result. Errors thrown by the script are returned as Go errors, as
+// is a timeout if the frontend doesn't respond within execJSTimeout.
+// Do not call this from the main thread as it waits for the frontend to respond
+func (w *WebView) ExecJS(script string) (json.RawMessage, error) {
+
+	ID, err := newExecJSID()
+	if err != nil {
+		return nil, err
+	}
+	encodedID, err := json.Marshal(ID)
+	if err != nil {
+		return nil, err
+	}
+	encodedScript, err := json.Marshal(script)
+	if err != nil {
+		return nil, err
+	}
+
+	w.lock.Lock()
+	if !w.frontendReady {
+		w.lock.Unlock()
+		return nil, fmt.Errorf("cannot execute JS: the frontend has not loaded")
+	}
+	if w.execJSResponses == nil {
+		w.execJSResponses = make(map[string]chan execJSResponse)
+	}
+	response := make(chan execJSResponse, 1)
+	w.execJSResponses[ID] = response
+	w.lock.Unlock()
+
+	err = w.evalJS(fmt.Sprintf(execJSTemplate, encodedID, encodedScript))
+	if err != nil {
+		w.takeExecJSResponse(ID)
+		return nil, err
+	}
+
+	select {
+	case result := <-response:
+		return result.result, result.err
+	case <-time.After(execJSTimeout):
+		// Forget the call so a late result is ignored
+		w.takeExecJSResponse(ID)
+		return nil, fmt.Errorf("ExecJS timed out after %s", execJSTimeout)
+	}
+}
+
+// ExecJSResult passes the result of a script run by ExecJS back to the
+// waiting call. Results for unknown or expired IDs are ignored
+func (w *WebView) ExecJSResult(id string, result json.RawMessage, err error) {
+	if response, ok := w.takeExecJSResponse(id); ok {
+		response <- execJSResponse{result: result, err: err}
+	}
+}
+
+// takeExecJSResponse removes and returns the channel waiting for
+// the result of the ExecJS call with the given ID
+func (w *WebView) takeExecJSResponse(id string) (chan execJSResponse, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	response, ok := w.execJSResponses[id]
+	delete(w.execJSResponses, id)
+	return response, ok
+}
+
 // injectCSS adds the given CSS to the WebView
 func (w *WebView) injectCSS(css string) {
 	w.window.Dispatch(func() {
@@ -189,6 +319,11 @@ func (w *WebView) Run() error {
 	// Ping the wait channel when the wails runtime is loaded
 	w.eventManager.On("wails:loaded", func(...interface{}) {
 
+		// The page has (re)loaded, so wait for it to be ready again
+		w.lock.Lock()
+		w.frontendReady = false
+		w.lock.Unlock()
+
 		// Run this in a different go routine to free up the main process
 		go func() {
 
@@ -197,6 +332,11 @@ func (w *WebView) Run() error {
 				w.evalJSSync(binding)
 			}
 
+			// Disable the default context menu if requested
+			if w.config.GetDisableDefaultContextMenu() {
+				w.DisableDefaultContextMenu(true)
+			}
+
 			// Inject user CSS
 			if w.config.GetCSS() != "" {
 				outputCSS := fmt.Sprintf("%.45s", w.config.GetCSS())
@@ -224,6 +364,9 @@ func (w *WebView) Run() error {
 			}
 
 			// Emit that everything is loaded and ready
+			w.lock.Lock()
+			w.frontendReady = true
+			w.lock.Unlock()
 			w.eventManager.Emit("wails:ready")
 		}()
 	})
@@ -366,6 +509,57 @@ func (w *WebView) SetTitle(title string) {
 	})
 }
 
+// Print opens the native print dialog for the current page.
+// The macOS webview has no print handler, so window.print() does
+// nothing there and an error is returned instead
+func (w *WebView) Print() error {
+	if runtime.GOOS == "darwin" {
+		w.log.Warn("Print() unsupported on macOS")
+		return fmt.Errorf("Print() unsupported on macOS")
+	}
+	return w.evalJS("window.print();")
+}
+
+// DisableDefaultContextMenu disables or re-enables the webview's default context menu
+func (w *WebView) DisableDefaultContextMenu(disable bool) {
+	w.evalJS(fmt.Sprintf("window.wails._.DisableDefaultContextMenu(%t);", disable))
+}
+
+// setCursorTemplate overrides the cursor of every element with an
+// !important style rule, or removes the rule if the cursor is empty
+const setCursorTemplate = `(function (cursor) {
+	var style = document.getElementById('wails-cursor');
+	if (!cursor) {
+		if (style) {
+			style.parentNode.removeChild(style);
+		}
+		return;
+	}
+	if (!style) {
+		style = document.createElement('style');
+		style.id = 'wails-cursor';
+		(document.head || document.documentElement).appendChild(style);
+	}
+	style.textContent = '* { cursor: ' + cursor + ' !important; }';
+})(%s);`
+
+// SetCursor sets the CSS cursor for the whole page, overriding the cursors
+// of individual elements. An empty cursor restores the page's own cursors
+func (w *WebView) SetCursor(cursor string) {
+	encoded, err := json.Marshal(cursor)
+	if err != nil {
+		w.log.Errorf("Unable to set cursor: %s", err.Error())
+		return
+	}
+	w.evalJS(fmt.Sprintf(setCursorTemplate, encoded))
+}
+
+// GetTitle returns the configured title. Titles set at runtime
+// are tracked by the runtime Window
+func (w *WebView) GetTitle() string {
+	return w.config.GetTitle()
+}
+
 // Close closes the window
 func (w *WebView) Close() {
 	w.window.Dispatch(func() {
@@ -0,0 +1,134 @@
+package renderer
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wailsapp/wails/lib/logger"
+	wv "github.com/wailsapp/wails/lib/renderer/webview"
+)
+
+// fakeWindow runs dispatched functions straight away and passes
+// evaluated JS to onEval
+type fakeWindow struct {
+	wv.WebView
+	onEval func(js string)
+}
+
+func (f *fakeWindow) Dispatch(callback func()) {
+	callback()
+}
+
+func (f *fakeWindow) Eval(js string) error {
+	if f.onEval != nil {
+		f.onEval(js)
+	}
+	return nil
+}
+
+var execJSIDPattern = regexp.MustCompile(`var id = "([^"]+)";`)
+
+// newTestWebView returns a WebView with a ready frontend that
+// passes the ID of each ExecJS call to onExec
+func newTestWebView(t *testing.T, onExec func(w *WebView, id string)) *WebView {
+	w := &WebView{
+		log:           logger.NewCustomLogger("WebView"),
+		frontendReady: true,
+	}
+	w.window = &fakeWindow{onEval: func(js string) {
+		match := execJSIDPattern.FindStringSubmatch(js)
+		if match == nil {
+			t.Fatalf("no ExecJS ID in %s", js)
+		}
+		onExec(w, match[1])
+	}}
+	return w
+}
+
+func TestWebView_ExecJS(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  json.RawMessage
+		err     error
+		want    string
+		wantErr bool
+	}{
+		{"number", json.RawMessage("42"), nil, "42", false},
+		{"object", json.RawMessage(`{"title":"Report"}`), nil, `{"title":"Report"}`, false},
+		{"script error", nil, errors.New("ReferenceError: foo is not defined"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestWebView(t, func(w *WebView, id string) {
+				w.ExecJSResult(id, tt.result, tt.err)
+			})
+			got, err := w.ExecJS("doSomething()")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExecJS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("ExecJS() = %s, want %s", got, tt.want)
+			}
+			if len(w.execJSResponses) != 0 {
+				t.Errorf("%d ExecJS calls still waiting", len(w.execJSResponses))
+			}
+		})
+	}
+}
+
+func TestWebView_ExecJSBeforeReady(t *testing.T) {
+	evaluated := false
+	w := newTestWebView(t, func(*WebView, string) {
+		evaluated = true
+	})
+	w.frontendReady = false
+
+	_, err := w.ExecJS("1 + 1")
+	if err == nil {
+		t.Error("ExecJS() before the frontend is ready should return an error")
+	}
+	if evaluated {
+		t.Error("ExecJS() before the frontend is ready should not evaluate the script")
+	}
+}
+
+func TestWebView_ExecJSTimeout(t *testing.T) {
+	defer func(timeout time.Duration) {
+		execJSTimeout = timeout
+	}(execJSTimeout)
+	execJSTimeout = 10 * time.Millisecond
+
+	var id string
+	w := newTestWebView(t, func(_ *WebView, execID string) {
+		id = execID
+	})
+	_, err := w.ExecJS("new Promise(function () {})")
+	if err == nil {
+		t.Fatal("ExecJS() should time out when the frontend doesn't respond")
+	}
+	if len(w.execJSResponses) != 0 {
+		t.Errorf("%d ExecJS calls still waiting after timing out", len(w.execJSResponses))
+	}
+
+	// A late result is ignored
+	w.ExecJSResult(id, json.RawMessage("42"), nil)
+}
+
+func TestWebView_SetCursor(t *testing.T) {
+	var evaluated string
+	w := &WebView{
+		log:    logger.NewCustomLogger("WebView"),
+		window: &fakeWindow{onEval: func(js string) { evaluated = js }},
+	}
+
+	// U+2028 ends a line in JS strings, so must be escaped
+	w.SetCursor("url(\"cursor\u2028.png\") 1 1, default")
+	want := `})("url(\"cursor\u2028.png\") 1 1, default");`
+	if !strings.HasSuffix(evaluated, want) {
+		t.Errorf("evaluated %s, want it to end with %s", evaluated, want)
+	}
+}
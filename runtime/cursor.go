@@ -0,0 +1,52 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image/png"
+)
+
+// Cursor is a standard cursor type. The values match their CSS names
+type Cursor string
+
+const (
+	// CursorDefault is the platform's default arrow cursor
+	CursorDefault Cursor = "default"
+	// CursorPointer indicates a link
+	CursorPointer Cursor = "pointer"
+	// CursorText indicates selectable text
+	CursorText Cursor = "text"
+	// CursorCrosshair is used for precise selection
+	CursorCrosshair Cursor = "crosshair"
+	// CursorMove indicates something can be moved
+	CursorMove Cursor = "move"
+	// CursorGrab indicates something can be grabbed
+	CursorGrab Cursor = "grab"
+	// CursorGrabbing indicates something is being dragged
+	CursorGrabbing Cursor = "grabbing"
+	// CursorWait indicates the app is busy
+	CursorWait Cursor = "wait"
+	// CursorNotAllowed indicates the action is not allowed
+	CursorNotAllowed Cursor = "not-allowed"
+	// CursorResizeEW is a horizontal resize cursor
+	CursorResizeEW Cursor = "ew-resize"
+	// CursorResizeNS is a vertical resize cursor
+	CursorResizeNS Cursor = "ns-resize"
+	// CursorNone hides the cursor
+	CursorNone Cursor = "none"
+)
+
+// customCursor returns the CSS cursor value for the given PNG image.
+// The hotspot must lie within the image
+func customCursor(image []byte, hotX, hotY int) (string, error) {
+	config, err := png.DecodeConfig(bytes.NewReader(image))
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor image: %s", err)
+	}
+	if hotX < 0 || hotY < 0 || hotX >= config.Width || hotY >= config.Height {
+		return "", fmt.Errorf("cursor hotspot (%d, %d) is outside the %dx%d image", hotX, hotY, config.Width, config.Height)
+	}
+	data := base64.StdEncoding.EncodeToString(image)
+	return fmt.Sprintf("url(data:image/png;base64,%s) %d %d, %s", data, hotX, hotY, CursorDefault), nil
+}
@@ -0,0 +1,28 @@
+package runtime
+
+// AppError is an error that may be returned from bound methods and functions.
+// Rather than rejecting with a plain message, the frontend promise is
+// rejected with an object containing the code, message and details
+type AppError struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// NewAppError creates a new AppError with the given code and message.
+// Optional details may be given and will be passed to the frontend as-is
+func NewAppError(code string, message string, details ...interface{}) *AppError {
+	result := &AppError{
+		Code:    code,
+		Message: message,
+	}
+	if len(details) > 0 {
+		result.Details = details[0]
+	}
+	return result
+}
+
+// Error returns the error message
+func (e *AppError) Error() string {
+	return e.Message
+}
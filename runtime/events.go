@@ -5,12 +5,44 @@ import "github.com/wailsapp/wails/lib/interfaces"
 // Events exposes the events interface
 type Events struct {
 	eventManager interfaces.EventManager
+	watchers     map[string]*watcher
 }
 
 // NewEvents creates a new Events struct
 func NewEvents(eventManager interfaces.EventManager) *Events {
-	return &Events{
+	result := &Events{
 		eventManager: eventManager,
+		watchers:     make(map[string]*watcher),
+	}
+	result.addWatcher("wails:theme:changed", func() (string, error) {
+		return string(systemTheme()), nil
+	})
+	result.addWatcher("wails:keyboard:layout", keyboardLayout)
+
+	// The frontend asks for system events to be watched when it subscribes to them
+	eventManager.On("wails:watch", func(data ...interface{}) {
+		if len(data) == 0 {
+			return
+		}
+		if eventName, ok := data[0].(string); ok {
+			result.watch(eventName)
+		}
+	})
+	return result
+}
+
+// addWatcher polls a system setting using read, emitting eventName with the
+// new value whenever it changes. Polling starts once the event is subscribed to
+func (r *Events) addWatcher(eventName string, read func() (string, error)) {
+	r.watchers[eventName] = newWatcher(read, func(value string) {
+		r.eventManager.Emit(eventName, value)
+	})
+}
+
+// watch starts polling for the given event if it is a system event
+func (r *Events) watch(eventName string) {
+	if watcher, ok := r.watchers[eventName]; ok {
+		watcher.start()
 	}
 }
 
@@ -23,3 +55,93 @@ func (r *Events) On(eventName string, callback func(optionalData ...interface{})
 func (r *Events) Emit(eventName string, optionalData ...interface{}) {
 	r.eventManager.Emit(eventName, optionalData...)
 }
+
+// OnWindowFocus registers a callback that is invoked whenever the window gains focus
+func (r *Events) OnWindowFocus(callback func()) {
+	r.eventManager.On("wails:window:focus", func(...interface{}) {
+		callback()
+	})
+}
+
+// OnWindowBlur registers a callback that is invoked whenever the window loses focus
+func (r *Events) OnWindowBlur(callback func()) {
+	r.eventManager.On("wails:window:blur", func(...interface{}) {
+		callback()
+	})
+}
+
+// OnWindowResize registers a callback that is invoked with the new width and
+// height whenever the window is resized. Rapid resizes are coalesced by the
+// frontend to roughly one per frame
+func (r *Events) OnWindowResize(callback func(width, height int)) {
+	r.eventManager.On("wails:window:resize", func(data ...interface{}) {
+		if width, height, ok := intPair(data); ok {
+			callback(width, height)
+		}
+	})
+}
+
+// OnWindowMove registers a callback that is invoked with the new screen
+// position whenever the window is moved. The frontend polls the position
+// four times a second, so a drag is reported as a series of positions
+func (r *Events) OnWindowMove(callback func(x, y int)) {
+	r.eventManager.On("wails:window:move", func(data ...interface{}) {
+		if x, y, ok := intPair(data); ok {
+			callback(x, y)
+		}
+	})
+}
+
+// OnThemeChange registers a callback that is invoked with the new theme
+// whenever the system theme changes. The system theme is only watched once
+// this is called or the frontend listens for "wails:theme:changed" events
+func (r *Events) OnThemeChange(callback func(theme Theme)) {
+	r.eventManager.On("wails:theme:changed", func(data ...interface{}) {
+		if len(data) == 0 {
+			return
+		}
+		if theme, ok := data[0].(string); ok {
+			callback(Theme(theme))
+		}
+	})
+	r.watch("wails:theme:changed")
+}
+
+// stopWatchers stops polling the system settings
+func (r *Events) stopWatchers() {
+	for _, watcher := range r.watchers {
+		watcher.stop()
+	}
+}
+
+// OnKeyboardLayoutChange registers a callback that is invoked with the new
+// layout identifier whenever the keyboard layout changes. The layout is only
+// watched once this is called or the frontend listens for "wails:keyboard:layout" events
+func (r *Events) OnKeyboardLayoutChange(callback func(layout string)) {
+	r.eventManager.On("wails:keyboard:layout", func(data ...interface{}) {
+		if len(data) == 0 {
+			return
+		}
+		if layout, ok := data[0].(string); ok {
+			callback(layout)
+		}
+	})
+	r.watch("wails:keyboard:layout")
+}
+
+// intPair extracts two numbers from the given event data.
+// Numbers sent from the frontend are decoded as float64
+func intPair(data []interface{}) (int, int, bool) {
+	if len(data) != 2 {
+		return 0, 0, false
+	}
+	first, ok := data[0].(float64)
+	if !ok {
+		return 0, 0, false
+	}
+	second, ok := data[1].(float64)
+	if !ok {
+		return 0, 0, false
+	}
+	return int(first), int(second), true
+}
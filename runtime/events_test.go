@@ -0,0 +1,216 @@
+package runtime
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wailsapp/wails/lib/interfaces"
+)
+
+// fakeEventManager delivers emitted events to its listeners synchronously
+type fakeEventManager struct {
+	interfaces.EventManager
+	lock      sync.Mutex
+	listeners map[string][]func(...interface{})
+}
+
+func newFakeEventManager() *fakeEventManager {
+	return &fakeEventManager{
+		listeners: make(map[string][]func(...interface{})),
+	}
+}
+
+func (f *fakeEventManager) On(eventName string, callback func(...interface{})) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.listeners[eventName] = append(f.listeners[eventName], callback)
+}
+
+func (f *fakeEventManager) Emit(eventName string, optionalData ...interface{}) {
+	f.lock.Lock()
+	listeners := append([]func(...interface{}){}, f.listeners[eventName]...)
+	f.lock.Unlock()
+	for _, listener := range listeners {
+		listener(optionalData...)
+	}
+}
+
+// fakeSetting returns each of its values in turn when read, then
+// keeps returning the last one
+type fakeSetting struct {
+	lock   sync.Mutex
+	values []string
+	reads  int
+}
+
+func (f *fakeSetting) read() (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	value := f.values[len(f.values)-1]
+	if f.reads < len(f.values) {
+		value = f.values[f.reads]
+	}
+	f.reads++
+	return value, nil
+}
+
+func (f *fakeSetting) readCount() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.reads
+}
+
+// fakeWatcher replaces the reading of the given system event with setting
+func fakeWatcher(events *Events, eventName string, setting *fakeSetting) {
+	watcher := events.watchers[eventName]
+	watcher.read = setting.read
+	watcher.interval = time.Millisecond
+}
+
+func TestEvents_OnWindowFocus(t *testing.T) {
+	eventManager := newFakeEventManager()
+	events := NewEvents(eventManager)
+
+	var focused, blurred int
+	events.OnWindowFocus(func() {
+		focused++
+	})
+	events.OnWindowBlur(func() {
+		blurred++
+	})
+
+	eventManager.Emit("wails:window:focus")
+	if focused != 1 || blurred != 0 {
+		t.Errorf("after focus event: focused = %d, blurred = %d, want 1, 0", focused, blurred)
+	}
+	eventManager.Emit("wails:window:blur")
+	eventManager.Emit("wails:window:blur")
+	if focused != 1 || blurred != 2 {
+		t.Errorf("after blur events: focused = %d, blurred = %d, want 1, 2", focused, blurred)
+	}
+	eventManager.Emit("wails:window:resize", 800.0, 600.0)
+	if focused != 1 || blurred != 2 {
+		t.Errorf("after unrelated event: focused = %d, blurred = %d, want 1, 2", focused, blurred)
+	}
+}
+
+func TestIntPair(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   []interface{}
+		wantA  int
+		wantB  int
+		wantOK bool
+	}{
+		{"floats", []interface{}{800.0, 600.0}, 800, 600, true},
+		{"negative", []interface{}{-1920.0, -12.0}, -1920, -12, true},
+		{"fractional", []interface{}{100.6, 50.2}, 100, 50, true},
+		{"too few", []interface{}{800.0}, 0, 0, false},
+		{"too many", []interface{}{800.0, 600.0, 1.0}, 0, 0, false},
+		{"empty", nil, 0, 0, false},
+		{"wrong type", []interface{}{"800", 600.0}, 0, 0, false},
+		{"null", []interface{}{800.0, nil}, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b, ok := intPair(tt.data)
+			if a != tt.wantA || b != tt.wantB || ok != tt.wantOK {
+				t.Errorf("intPair(%v) = %v, %v, %v, want %v, %v, %v", tt.data, a, b, ok, tt.wantA, tt.wantB, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestEvents_OnWindowGeometry(t *testing.T) {
+	eventManager := newFakeEventManager()
+	events := NewEvents(eventManager)
+
+	var sizes, positions [][2]int
+	events.OnWindowResize(func(width, height int) {
+		sizes = append(sizes, [2]int{width, height})
+	})
+	events.OnWindowMove(func(x, y int) {
+		positions = append(positions, [2]int{x, y})
+	})
+
+	eventManager.Emit("wails:window:resize", 1024.0, 768.0)
+	eventManager.Emit("wails:window:resize", 1024.0)
+	eventManager.Emit("wails:window:resize", "1024", "768")
+	eventManager.Emit("wails:window:move", 10.0, -20.0)
+	eventManager.Emit("wails:window:move")
+	eventManager.Emit("wails:window:move", 10.0, 20.0, 30.0)
+
+	if len(sizes) != 1 || sizes[0] != [2]int{1024, 768} {
+		t.Errorf("sizes = %v, want [[1024 768]]", sizes)
+	}
+	if len(positions) != 1 || positions[0] != [2]int{10, -20} {
+		t.Errorf("positions = %v, want [[10 -20]]", positions)
+	}
+}
+
+func TestEvents_OnThemeChange(t *testing.T) {
+	eventManager := newFakeEventManager()
+	events := NewEvents(eventManager)
+	defer events.stopWatchers()
+	fakeWatcher(events, "wails:theme:changed", &fakeSetting{values: []string{"light", "dark"}})
+
+	themes := make(chan Theme, 10)
+	events.OnThemeChange(func(theme Theme) {
+		themes <- theme
+	})
+
+	select {
+	case theme := <-themes:
+		if theme != ThemeDark {
+			t.Errorf("theme = %q, want %q", theme, ThemeDark)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no theme change received")
+	}
+}
+
+func TestEvents_WatchFromFrontend(t *testing.T) {
+	eventManager := newFakeEventManager()
+	events := NewEvents(eventManager)
+	defer events.stopWatchers()
+	setting := &fakeSetting{values: []string{"light"}}
+	fakeWatcher(events, "wails:theme:changed", setting)
+
+	eventManager.Emit("wails:watch", "wails:unknown")
+	eventManager.Emit("wails:watch")
+	time.Sleep(10 * time.Millisecond)
+	if reads := setting.readCount(); reads != 0 {
+		t.Fatalf("theme read %d times before being watched, want 0", reads)
+	}
+
+	eventManager.Emit("wails:watch", "wails:theme:changed")
+	deadline := time.Now().Add(time.Second)
+	for setting.readCount() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("theme not read after the frontend asked for it to be watched")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestEvents_OnKeyboardLayoutChange(t *testing.T) {
+	eventManager := newFakeEventManager()
+	events := NewEvents(eventManager)
+	defer events.stopWatchers()
+	fakeWatcher(events, "wails:keyboard:layout", &fakeSetting{values: []string{"us", "de(nodeadkeys)"}})
+
+	layouts := make(chan string, 10)
+	events.OnKeyboardLayoutChange(func(layout string) {
+		layouts <- layout
+	})
+
+	select {
+	case layout := <-layouts:
+		if layout != "de(nodeadkeys)" {
+			t.Errorf("layout = %q, want %q", layout, "de(nodeadkeys)")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no keyboard layout change received")
+	}
+}
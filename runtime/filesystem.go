@@ -1,6 +1,12 @@
 package runtime
 
-import "os"
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/browser"
+)
 
 // FileSystem exposes file system utilities to the runtime
 type FileSystem struct{}
@@ -14,3 +20,35 @@ func NewFileSystem() *FileSystem {
 func (r *FileSystem) HomeDir() (string, error) {
 	return os.UserHomeDir()
 }
+
+// ShowItemInFolder opens the platform file manager with the given
+// file or directory selected
+func (r *FileSystem) ShowItemInFolder(path string) error {
+	path, err := existingPath(path)
+	if err != nil {
+		return err
+	}
+	return showItemInFolder(path)
+}
+
+// OpenPath opens the given file or directory with its default handler
+func (r *FileSystem) OpenPath(path string) error {
+	path, err := existingPath(path)
+	if err != nil {
+		return err
+	}
+	return browser.OpenFile(path)
+}
+
+// existingPath returns the absolute version of the given path
+// or an error if nothing exists at that path
+func existingPath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("cannot open path '%s': %s", path, err.Error())
+	}
+	return path, nil
+}
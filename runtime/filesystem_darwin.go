@@ -0,0 +1,10 @@
+// +build darwin
+
+package runtime
+
+import "os/exec"
+
+// showItemInFolder reveals the given path in Finder
+func showItemInFolder(path string) error {
+	return exec.Command("open", "-R", path).Run()
+}
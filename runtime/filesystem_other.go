@@ -0,0 +1,27 @@
+// +build !darwin,!windows
+
+package runtime
+
+import (
+	"net/url"
+	"os/exec"
+	"path/filepath"
+)
+
+// showItemInFolder asks the desktop's file manager to select the given path
+// using the freedesktop FileManager1 DBus interface. If no file manager
+// provides it, the containing directory is opened instead
+func showItemInFolder(path string) error {
+	uri := (&url.URL{Scheme: "file", Path: path}).String()
+	err := exec.Command("dbus-send", "--session", "--print-reply",
+		"--dest=org.freedesktop.FileManager1",
+		"--type=method_call",
+		"/org/freedesktop/FileManager1",
+		"org.freedesktop.FileManager1.ShowItems",
+		"array:string:"+uri,
+		"string:").Run()
+	if err == nil {
+		return nil
+	}
+	return exec.Command("xdg-open", filepath.Dir(path)).Run()
+}
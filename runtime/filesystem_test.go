@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExistingPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wails-filesystem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "report.txt")
+	err = ioutil.WriteFile(file, []byte("report"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{"file", file, false},
+		{"directory", dir, false},
+		{"missing", filepath.Join(dir, "missing.txt"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := existingPath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("existingPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.path {
+				t.Errorf("existingPath() = %v, want %v", got, tt.path)
+			}
+		})
+	}
+}
+
+func TestFileSystem_MissingPath(t *testing.T) {
+	fs := NewFileSystem()
+	missing := filepath.Join(os.TempDir(), "wails-does-not-exist", "missing.txt")
+	if err := fs.ShowItemInFolder(missing); err == nil {
+		t.Error("ShowItemInFolder() expected error for missing path")
+	}
+	if err := fs.OpenPath(missing); err == nil {
+		t.Error("OpenPath() expected error for missing path")
+	}
+}
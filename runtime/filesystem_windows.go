@@ -0,0 +1,12 @@
+// +build windows
+
+package runtime
+
+import "os/exec"
+
+// showItemInFolder selects the given path in Explorer.
+// Explorer returns a non-zero exit code even on success so
+// we only check that it could be started
+func showItemInFolder(path string) error {
+	return exec.Command("explorer", "/select,"+path).Start()
+}
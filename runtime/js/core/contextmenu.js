@@ -0,0 +1,66 @@
+/*
+ _       __      _ __    
+| |     / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  ) 
+|__/|__/\__,_/_/_/____/  
+The lightweight framework for web-like apps
+(c) Lea Anthony 2019-present
+*/
+/* jshint esversion: 6 */
+
+// Input types that do not accept text and so have no use for
+// the text editing context menu
+var nonTextInputs = ['button', 'checkbox', 'color', 'file', 'hidden', 'image', 'radio', 'range', 'reset', 'submit'];
+
+var disabled = false;
+
+/**
+ * Determines if the given element accepts text input
+ *
+ * @param {Element} element
+ * @returns {boolean}
+ */
+function isEditable(element) {
+	if (!element || !element.tagName) {
+		return false;
+	}
+	if (element.isContentEditable) {
+		return true;
+	}
+	var tag = element.tagName.toLowerCase();
+	if (tag === 'textarea') {
+		return true;
+	}
+	if (tag === 'input') {
+		var type = (element.getAttribute('type') || 'text').toLowerCase();
+		return nonTextInputs.indexOf(type) === -1;
+	}
+	return false;
+}
+
+/**
+ * Suppresses the default context menu unless it was
+ * requested on an editable element
+ *
+ * @param {MouseEvent} event
+ */
+function onContextMenu(event) {
+	if (disabled && !isEditable(event.target)) {
+		event.preventDefault();
+	}
+}
+
+document.addEventListener('contextmenu', onContextMenu);
+
+/**
+ * DisableDefaultContextMenu disables (or re-enables) the webview's built in
+ * context menu. Text inputs, textareas and contenteditable elements keep
+ * their context menu so text editing still works.
+ *
+ * @export
+ * @param {boolean} disable
+ */
+export function DisableDefaultContextMenu(disable) {
+	disabled = disable;
+}
@@ -45,6 +45,9 @@ class Listener {
 
 var eventListeners = {};
 
+// System events that the backend only watches for once they are listened to
+const watchedEvents = ['wails:theme:changed', 'wails:keyboard:layout'];
+
 /**
  * Registers an event listener that will be invoked `maxCallbacks` times before being destroyed
  *
@@ -57,6 +60,9 @@ export function OnMultiple(eventName, callback, maxCallbacks) {
 	eventListeners[eventName] = eventListeners[eventName] || [];
 	const thisListener = new Listener(callback, maxCallbacks);
 	eventListeners[eventName].push(thisListener);
+	if (watchedEvents.indexOf(eventName) !== -1) {
+		Emit('wails:watch', eventName);
+	}
 }
 
 /**
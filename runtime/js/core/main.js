@@ -12,9 +12,11 @@ import * as Log from './log';
 import * as Browser from './browser';
 import { On, OnMultiple, Emit, Notify, Heartbeat, Acknowledge } from './events';
 import { NewBinding } from './bindings';
-import { Callback } from './calls';
+import { Callback, SystemCall } from './calls';
 import { AddScript, InjectCSS, InjectFirebug } from './utils';
 import { AddIPCListener } from './ipc';
+import { TrackFocus, TrackGeometry, SetTitle, GetTitle } from './window';
+import { DisableDefaultContextMenu } from './contextmenu';
 import * as Store from './store';
 
 // Initialise global if not already
@@ -25,11 +27,13 @@ window.backend = {};
 var internal = {
 	NewBinding,
 	Callback,
+	SystemCall,
 	Notify,
 	AddScript,
 	InjectCSS,
 	Init,
 	AddIPCListener,
+	DisableDefaultContextMenu,
 };
 
 // Setup runtime structure
@@ -44,6 +48,10 @@ var runtime = {
 		Acknowledge,
 	},
 	Store,
+	Window: {
+		SetTitle,
+		GetTitle,
+	},
 	_: internal,
 };
 
@@ -65,6 +73,10 @@ if( window.usefirebug ) {
 	InjectFirebug();
 }
 
+// Report window focus and geometry changes to the backend
+TrackFocus();
+TrackGeometry();
+
 // Emit loaded event
 Emit('wails:loaded');
 
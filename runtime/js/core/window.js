@@ -0,0 +1,144 @@
+/*
+ _       __      _ __    
+| |     / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  ) 
+|__/|__/\__,_/_/_/____/  
+The lightweight framework for web-like apps
+(c) Lea Anthony 2019-present
+*/
+/* jshint esversion: 6 */
+
+import { Emit } from './events';
+import { SystemCall } from './calls';
+
+// The last focus state reported to the backend
+var focused = document.hasFocus();
+
+// The focus state waiting to be reported
+var pending = focused;
+var pendingHandle = null;
+
+/**
+ * Records the given focus state and reports it to the backend once
+ * the current burst of focus/blur events has settled. Transitions
+ * that end in the state already reported are dropped.
+ *
+ * @param {boolean} state
+ */
+function updateFocus(state) {
+	pending = state;
+	clearTimeout(pendingHandle);
+	pendingHandle = setTimeout(function () {
+		if (pending === focused) {
+			return;
+		}
+		focused = pending;
+		Emit(focused ? 'wails:window:focus' : 'wails:window:blur');
+	}, 0);
+}
+
+/**
+ * TrackFocus emits `wails:window:focus` and `wails:window:blur` events
+ * whenever the window gains or loses focus
+ *
+ * @export
+ */
+export function TrackFocus() {
+	window.addEventListener('focus', function () {
+		updateFocus(true);
+	});
+	window.addEventListener('blur', function () {
+		updateFocus(false);
+	});
+}
+
+// The last size and position reported to the backend
+var lastSize = null;
+var lastPosition = null;
+
+// Indicates a resize is waiting to be reported
+var resizePending = false;
+
+// How often, in milliseconds, the window position is checked
+var positionInterval = 250;
+
+/**
+ * Returns the screen position of the window
+ *
+ * @returns {{x: number, y: number}}
+ */
+function windowPosition() {
+	return {
+		x: window.screenX !== undefined ? window.screenX : window.screenLeft,
+		y: window.screenY !== undefined ? window.screenY : window.screenTop,
+	};
+}
+
+/**
+ * Reports the window size to the backend if it has changed
+ */
+function flushResize() {
+	resizePending = false;
+	var width = window.innerWidth;
+	var height = window.innerHeight;
+	if (lastSize && lastSize.width === width && lastSize.height === height) {
+		return;
+	}
+	lastSize = { width: width, height: height };
+	Emit('wails:window:resize', width, height);
+}
+
+/**
+ * Reports the window position to the backend if it has changed.
+ * There is no DOM event for window moves, so this is polled.
+ */
+function checkPosition() {
+	var position = windowPosition();
+	if (lastPosition.x !== position.x || lastPosition.y !== position.y) {
+		Emit('wails:window:move', position.x, position.y);
+	}
+	lastPosition = position;
+}
+
+/**
+ * TrackGeometry emits `wails:window:resize` and `wails:window:move` events
+ * when the window is resized or moved. Resizes are coalesced to at most one
+ * event per animation frame. Moves are polled a few times a second, so a
+ * drag is reported as a series of positions rather than every pixel.
+ *
+ * @export
+ */
+export function TrackGeometry() {
+	lastSize = { width: window.innerWidth, height: window.innerHeight };
+	lastPosition = windowPosition();
+	window.addEventListener('resize', function () {
+		if (resizePending) {
+			return;
+		}
+		resizePending = true;
+		window.requestAnimationFrame(flushResize);
+	});
+	window.setInterval(checkPosition, positionInterval);
+}
+
+/**
+ * Sets the window title
+ *
+ * @export
+ * @param {string} title
+ * @returns {Promise}
+ */
+export function SetTitle(title) {
+	return SystemCall('Window.SetTitle', title);
+}
+
+/**
+ * Returns a promise resolving to the current window title
+ *
+ * @export
+ * @returns {Promise<string>}
+ */
+export function GetTitle() {
+	return SystemCall('Window.GetTitle');
+}
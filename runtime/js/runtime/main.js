@@ -14,6 +14,7 @@ const Browser = require('./browser');
 const Events = require('./events');
 const Init = require('./init');
 const Store = require('./store');
+const Window = require('./window');
 
 module.exports = {
 	Log: Log,
@@ -21,4 +22,5 @@ module.exports = {
 	Events: Events,
 	Init: Init,
 	Store: Store,
+	Window: Window,
 };
\ No newline at end of file
@@ -24,6 +24,10 @@ declare const wailsapp__runtime: {
     Store: {
         New(name: string, optionalDefault?: any): any;
     };
+    Window: {
+        GetTitle(): Promise<string>;
+        SetTitle(title: string): Promise<any>;
+    };
 };
 
 
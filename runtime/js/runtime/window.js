@@ -0,0 +1,36 @@
+/*
+ _       __      _ __    
+| |     / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  ) 
+|__/|__/\__,_/_/_/____/  
+The lightweight framework for web-like apps
+(c) Lea Anthony 2019-present
+*/
+/* jshint esversion: 6 */
+
+/**
+ * Sets the window title
+ *
+ * @export
+ * @param {string} title
+ * @returns
+ */
+function SetTitle(title) {
+	return window.wails.Window.SetTitle(title);
+}
+
+/**
+ * Returns a promise resolving to the current window title
+ *
+ * @export
+ * @returns
+ */
+function GetTitle() {
+	return window.wails.Window.GetTitle();
+}
+
+module.exports = {
+	SetTitle: SetTitle,
+	GetTitle: GetTitle
+};
@@ -0,0 +1,75 @@
+/* eslint-disable */
+import { test } from 'node:test';
+import assert from 'node:assert/strict';
+import { installDOM } from './dom.mjs';
+
+const dom = installDOM();
+const { DisableDefaultContextMenu } = await import('../core/contextmenu.js');
+
+function element(tagName, attributes, isContentEditable) {
+	return {
+		tagName,
+		isContentEditable: !!isContentEditable,
+		getAttribute(name) {
+			return attributes && name in attributes ? attributes[name] : null;
+		},
+	};
+}
+
+// Dispatches a contextmenu event at the given target and
+// returns true if the default menu was suppressed
+function contextMenu(target) {
+	let prevented = false;
+	dom.dispatchDocument('contextmenu', {
+		target,
+		preventDefault() {
+			prevented = true;
+		},
+	});
+	return prevented;
+}
+
+test('the menu is left alone until disabled', () => {
+	DisableDefaultContextMenu(false);
+	assert.equal(contextMenu(element('DIV')), false);
+});
+
+test('editable elements keep their context menu', () => {
+	DisableDefaultContextMenu(true);
+	const editable = [
+		element('TEXTAREA'),
+		element('INPUT'),
+		element('INPUT', { type: 'text' }),
+		element('INPUT', { type: 'Password' }),
+		element('INPUT', { type: 'email' }),
+		element('INPUT', { type: 'search' }),
+		element('DIV', {}, true),
+	];
+	editable.forEach((target) => {
+		assert.equal(contextMenu(target), false, target.tagName + ' ' + target.getAttribute('type'));
+	});
+});
+
+test('other elements have their context menu suppressed', () => {
+	DisableDefaultContextMenu(true);
+	const blocked = [
+		element('DIV'),
+		element('IMG'),
+		element('A'),
+		element('INPUT', { type: 'checkbox' }),
+		element('INPUT', { type: 'SUBMIT' }),
+		element('INPUT', { type: 'range' }),
+		{},
+		null,
+	];
+	blocked.forEach((target) => {
+		assert.equal(contextMenu(target), true, JSON.stringify(target));
+	});
+});
+
+test('re-enabling restores the menu everywhere', () => {
+	DisableDefaultContextMenu(true);
+	DisableDefaultContextMenu(false);
+	assert.equal(contextMenu(element('DIV')), false);
+	assert.equal(contextMenu(element('IMG')), false);
+});
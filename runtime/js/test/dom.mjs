@@ -0,0 +1,92 @@
+/* eslint-disable */
+
+// Installs a minimal window and document so the core runtime can be
+// loaded in Node. Messages sent to the backend are recorded, and
+// animation frames and intervals only run when frame() and interval()
+// are called.
+export function installDOM() {
+	const windowListeners = {};
+	const documentListeners = {};
+	const frames = [];
+	const intervals = [];
+	const messages = [];
+
+	function dispatch(listeners, type, event) {
+		(listeners[type] || []).forEach((callback) => callback(event || {}));
+	}
+
+	globalThis.window = {
+		innerWidth: 800,
+		innerHeight: 600,
+		screenX: 0,
+		screenY: 0,
+		addEventListener(type, callback) {
+			(windowListeners[type] = windowListeners[type] || []).push(callback);
+		},
+		requestAnimationFrame(callback) {
+			frames.push(callback);
+		},
+		setInterval(callback, delay) {
+			intervals.push({ callback, delay });
+			return intervals.length;
+		},
+		external: {
+			invoke(message) {
+				messages.push(JSON.parse(message));
+			},
+		},
+	};
+
+	globalThis.document = {
+		hasFocus() {
+			return true;
+		},
+		addEventListener(type, callback) {
+			(documentListeners[type] = documentListeners[type] || []).push(callback);
+		},
+	};
+
+	return {
+		window: globalThis.window,
+
+		// Dispatches a DOM event to the window listeners
+		dispatch(type, event) {
+			dispatch(windowListeners, type, event);
+		},
+
+		// Dispatches a DOM event to the document listeners
+		dispatchDocument(type, event) {
+			dispatch(documentListeners, type, event);
+		},
+
+		// Runs the callbacks waiting for the next animation frame
+		frame() {
+			frames.splice(0).forEach((callback) => callback());
+		},
+
+		// Returns the delays of the registered intervals
+		intervalDelays() {
+			return intervals.map((interval) => interval.delay);
+		},
+
+		// Runs every registered interval callback once
+		interval() {
+			intervals.forEach((interval) => interval.callback());
+		},
+
+		// Returns the events emitted to the backend as [name, ...data]
+		// and clears them
+		takeEvents() {
+			const events = messages
+				.filter((message) => message.type === 'event')
+				.map((message) => [message.payload.name, ...JSON.parse(message.payload.data)]);
+			messages.length = 0;
+			return events;
+		},
+	};
+}
+
+// Waits for pending timeouts to run
+export function tick() {
+	return new Promise((resolve) => setTimeout(resolve, 0));
+}
@@ -0,0 +1,23 @@
+/* eslint-disable */
+import { test } from 'node:test';
+import assert from 'node:assert/strict';
+import { installDOM } from './dom.mjs';
+
+const dom = installDOM();
+const { On, Once } = await import('../core/events.js');
+
+test('listening for a system event asks the backend to watch it', () => {
+	On('wails:theme:changed', () => {});
+	assert.deepEqual(dom.takeEvents(), [['wails:watch', 'wails:theme:changed']]);
+
+	Once('wails:theme:changed', () => {});
+	assert.deepEqual(dom.takeEvents(), [['wails:watch', 'wails:theme:changed']]);
+
+	On('wails:keyboard:layout', () => {});
+	assert.deepEqual(dom.takeEvents(), [['wails:watch', 'wails:keyboard:layout']]);
+});
+
+test('listening for other events sends nothing to the backend', () => {
+	On('custom', () => {});
+	assert.deepEqual(dom.takeEvents(), []);
+});
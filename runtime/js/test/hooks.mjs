@@ -0,0 +1,15 @@
+/* eslint-disable */
+
+// The core runtime is written for webpack, so its imports have no
+// file extension and its files are not marked as ES modules.
+// These hooks let Node load it as is.
+export async function resolve(specifier, context, nextResolve) {
+	if (specifier.startsWith('.') && !/\.m?js$/.test(specifier)) {
+		specifier += '.js';
+	}
+	const result = await nextResolve(specifier, context);
+	if (result.url.includes('/core/')) {
+		return { ...result, format: 'module' };
+	}
+	return result;
+}
@@ -0,0 +1,7 @@
+/* eslint-disable */
+
+// Registers the module hooks needed to load core/ in Node.
+// Usage: node --import ./test/register.mjs --test test/*.test.mjs
+import { register } from 'node:module';
+
+register('./hooks.mjs', import.meta.url);
@@ -0,0 +1,88 @@
+/* eslint-disable */
+import { test } from 'node:test';
+import assert from 'node:assert/strict';
+import { installDOM, tick } from './dom.mjs';
+
+const dom = installDOM();
+const { TrackFocus, TrackGeometry } = await import('../core/window.js');
+TrackFocus();
+TrackGeometry();
+
+test('blur and focus are emitted once the state settles', async () => {
+	dom.dispatch('blur');
+	await tick();
+	assert.deepEqual(dom.takeEvents(), [['wails:window:blur']]);
+
+	dom.dispatch('focus');
+	await tick();
+	assert.deepEqual(dom.takeEvents(), [['wails:window:focus']]);
+});
+
+test('a burst of focus changes emits only the final state', async () => {
+	dom.dispatch('blur');
+	dom.dispatch('focus');
+	dom.dispatch('blur');
+	await tick();
+	assert.deepEqual(dom.takeEvents(), [['wails:window:blur']]);
+	dom.dispatch('focus');
+	await tick();
+	dom.takeEvents();
+});
+
+test('transitions back to the reported state are dropped', async () => {
+	dom.dispatch('blur');
+	dom.dispatch('focus');
+	await tick();
+	assert.deepEqual(dom.takeEvents(), []);
+
+	dom.dispatch('focus');
+	await tick();
+	assert.deepEqual(dom.takeEvents(), []);
+});
+
+test('a burst of resizes emits the final size once per frame', () => {
+	dom.window.innerWidth = 900;
+	dom.dispatch('resize');
+	dom.window.innerWidth = 1000;
+	dom.window.innerHeight = 700;
+	dom.dispatch('resize');
+	dom.dispatch('resize');
+	assert.deepEqual(dom.takeEvents(), []);
+
+	dom.frame();
+	assert.deepEqual(dom.takeEvents(), [['wails:window:resize', 1000, 700]]);
+
+	dom.window.innerWidth = 1024;
+	dom.dispatch('resize');
+	dom.frame();
+	assert.deepEqual(dom.takeEvents(), [['wails:window:resize', 1024, 700]]);
+});
+
+test('resizes that end at the reported size are dropped', () => {
+	dom.window.innerWidth = 1100;
+	dom.dispatch('resize');
+	dom.window.innerWidth = 1024;
+	dom.dispatch('resize');
+	dom.frame();
+	assert.deepEqual(dom.takeEvents(), []);
+});
+
+test('the position is polled at a low rate', () => {
+	assert.deepEqual(dom.intervalDelays(), [250]);
+});
+
+test('moves are reported at most once per poll', () => {
+	dom.interval();
+	assert.deepEqual(dom.takeEvents(), []);
+
+	dom.window.screenX = 10;
+	dom.window.screenY = 20;
+	dom.window.screenX = 30;
+	dom.frame();
+	assert.deepEqual(dom.takeEvents(), []);
+	dom.interval();
+	assert.deepEqual(dom.takeEvents(), [['wails:window:move', 30, 20]]);
+
+	dom.interval();
+	assert.deepEqual(dom.takeEvents(), []);
+});
@@ -0,0 +1,21 @@
+// +build darwin
+
+package runtime
+
+import (
+	"errors"
+	"strings"
+)
+
+// keyboardLayout reads the current input source ID, eg "com.apple.keylayout.US"
+func keyboardLayout() (string, error) {
+	output, err := commandOutput("defaults", "read", "com.apple.HIToolbox", "AppleCurrentKeyboardLayoutInputSourceID")
+	if err != nil {
+		return "", err
+	}
+	layout := strings.TrimSpace(string(output))
+	if layout == "" {
+		return "", errors.New("keyboard layout unavailable")
+	}
+	return layout, nil
+}
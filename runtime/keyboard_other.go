@@ -0,0 +1,63 @@
+// +build !darwin,!windows
+
+package runtime
+
+import (
+	"errors"
+	"strings"
+)
+
+// keyboardLayout reads the X11 layout using setxkbmap, falling back to
+// the first GNOME input source
+func keyboardLayout() (string, error) {
+	output, err := commandOutput("setxkbmap", "-query")
+	if err == nil {
+		if layout := parseSetxkbmap(string(output)); layout != "" {
+			return layout, nil
+		}
+	}
+	output, err = commandOutput("gsettings", "get", "org.gnome.desktop.input-sources", "sources")
+	if err == nil {
+		if layout := parseInputSources(string(output)); layout != "" {
+			return layout, nil
+		}
+	}
+	return "", errors.New("keyboard layout unavailable")
+}
+
+// parseSetxkbmap returns the layout, and variant if set, from the
+// output of `setxkbmap -query`, eg "us" or "de(nodeadkeys)"
+func parseSetxkbmap(output string) string {
+	var layout, variant string
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch strings.TrimSpace(parts[0]) {
+		case "layout":
+			layout = strings.TrimSpace(parts[1])
+		case "variant":
+			variant = strings.TrimSpace(parts[1])
+		}
+	}
+	if layout != "" && variant != "" {
+		return layout + "(" + variant + ")"
+	}
+	return layout
+}
+
+// parseInputSources returns the first layout from the GNOME input sources
+// setting, eg "[('xkb', 'us'), ('xkb', 'de')]" returns "us"
+func parseInputSources(output string) string {
+	start := strings.Index(output, "('xkb', '")
+	if start == -1 {
+		return ""
+	}
+	output = output[start+len("('xkb', '"):]
+	end := strings.Index(output, "'")
+	if end == -1 {
+		return ""
+	}
+	return output[:end]
+}
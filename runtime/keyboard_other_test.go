@@ -0,0 +1,43 @@
+// +build !darwin,!windows
+
+package runtime
+
+import "testing"
+
+func TestParseSetxkbmap(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{"layout", "rules:      evdev\nmodel:      pc105\nlayout:     us\n", "us"},
+		{"layout and variant", "rules:      evdev\nlayout:     de\nvariant:    nodeadkeys\n", "de(nodeadkeys)"},
+		{"no layout", "rules:      evdev\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseSetxkbmap(tt.output); got != tt.want {
+				t.Errorf("parseSetxkbmap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInputSources(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{"single", "[('xkb', 'us')]\n", "us"},
+		{"multiple", "[('xkb', 'fr+azerty'), ('xkb', 'us')]\n", "fr+azerty"},
+		{"empty", "@a(ss) []\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseInputSources(tt.output); got != tt.want {
+				t.Errorf("parseInputSources() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// +build windows
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	user32                       = windows.NewLazySystemDLL("user32.dll")
+	procGetForegroundWindow      = user32.NewProc("GetForegroundWindow")
+	procGetWindowThreadProcessID = user32.NewProc("GetWindowThreadProcessId")
+	procGetKeyboardLayout        = user32.NewProc("GetKeyboardLayout")
+)
+
+// keyboardLayout returns the input locale identifier of the foreground
+// window's thread as hex, eg "04090409" for US English
+func keyboardLayout() (string, error) {
+	window, _, _ := procGetForegroundWindow.Call()
+	threadID, _, _ := procGetWindowThreadProcessID.Call(window, 0)
+	layout, _, _ := procGetKeyboardLayout.Call(threadID)
+	if layout == 0 {
+		return "", errors.New("keyboard layout unavailable")
+	}
+	return fmt.Sprintf("%08X", uint32(layout)), nil
+}
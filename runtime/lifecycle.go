@@ -0,0 +1,71 @@
+package runtime
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/lib/logger"
+)
+
+// DefaultShutdownTimeout is how long a shutdown callback may run
+// before it is logged as slow and the next callback is started
+const DefaultShutdownTimeout = 5 * time.Second
+
+// Lifecycle exposes hooks into the application lifecycle
+type Lifecycle struct {
+	shutdownCallbacks []func()
+	lock              sync.Mutex
+	timeout           time.Duration
+	warnf             func(message string, args ...interface{})
+}
+
+// NewLifecycle creates a new Lifecycle struct
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{
+		timeout: DefaultShutdownTimeout,
+		warnf:   logger.NewCustomLogger("Lifecycle").Warnf,
+	}
+}
+
+// OnShutdown registers a callback that is invoked when the app shuts down,
+// after the window has closed and before the process exits. Callbacks are
+// run in the reverse order to which they were registered
+func (r *Lifecycle) OnShutdown(callback func()) {
+	r.lock.Lock()
+	r.shutdownCallbacks = append(r.shutdownCallbacks, callback)
+	r.lock.Unlock()
+}
+
+// Shutdown runs the registered shutdown callbacks. It is called by the
+// app during shutdown and only runs the callbacks once
+func (r *Lifecycle) Shutdown() {
+	r.lock.Lock()
+	callbacks := r.shutdownCallbacks
+	r.shutdownCallbacks = nil
+	r.lock.Unlock()
+
+	for index := len(callbacks) - 1; index >= 0; index-- {
+		r.runShutdownCallback(index, callbacks[index])
+	}
+}
+
+// runShutdownCallback runs the given callback, logging if it panics or
+// if it is still running after the timeout
+func (r *Lifecycle) runShutdownCallback(index int, callback func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if err := recover(); err != nil {
+				r.warnf("Shutdown callback %d panicked: %v", index, err)
+			}
+		}()
+		callback()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(r.timeout):
+		r.warnf("Shutdown callback %d did not complete within %s", index, r.timeout)
+	}
+}
@@ -0,0 +1,64 @@
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLifecycleShutdownOrder(t *testing.T) {
+	lifecycle := NewLifecycle()
+	var order []int
+	for i := 0; i < 3; i++ {
+		i := i
+		lifecycle.OnShutdown(func() {
+			order = append(order, i)
+		})
+	}
+
+	lifecycle.Shutdown()
+	if want := []int{2, 1, 0}; !reflect.DeepEqual(order, want) {
+		t.Errorf("callbacks ran in order %v, want %v", order, want)
+	}
+
+	// A second shutdown must not rerun the callbacks
+	lifecycle.Shutdown()
+	if len(order) != 3 {
+		t.Errorf("callbacks ran %d times, want 3", len(order))
+	}
+}
+
+func TestLifecycleShutdownTimeout(t *testing.T) {
+	lifecycle := NewLifecycle()
+	lifecycle.timeout = 10 * time.Millisecond
+	var warnings []string
+	lifecycle.warnf = func(message string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(message, args...))
+	}
+
+	release := make(chan struct{})
+	defer close(release)
+	ran := false
+	lifecycle.OnShutdown(func() {
+		ran = true
+	})
+	lifecycle.OnShutdown(func() {
+		<-release
+	})
+	lifecycle.OnShutdown(func() {
+		panic("boom")
+	})
+
+	lifecycle.Shutdown()
+	if !ran {
+		t.Error("callback after the slow one did not run")
+	}
+	want := []string{
+		"Shutdown callback 2 panicked: boom",
+		"Shutdown callback 1 did not complete within 10ms",
+	}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("warnings = %q, want %q", warnings, want)
+	}
+}
@@ -10,6 +10,8 @@ type Runtime struct {
 	Window     *Window
 	Browser    *Browser
 	FileSystem *FileSystem
+	System     *System
+	Lifecycle  *Lifecycle
 	Store      *StoreProvider
 }
 
@@ -22,8 +24,13 @@ func NewRuntime(eventManager interfaces.EventManager, renderer interfaces.Render
 		Window:     NewWindow(renderer),
 		Browser:    NewBrowser(),
 		FileSystem: NewFileSystem(),
+		System:     NewSystem(),
+		Lifecycle:  NewLifecycle(),
 	}
 	// We need a reference to itself
 	result.Store = NewStoreProvider(result)
+
+	// Stop watching for system changes when the app shuts down
+	result.Lifecycle.OnShutdown(result.Events.stopWatchers)
 	return result
 }
@@ -0,0 +1,151 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// System exposes information about the system the app is running on
+type System struct {
+	production     bool
+	keyboardLayout func() (string, error)
+}
+
+// NewSystem creates a new System struct
+func NewSystem() *System {
+	return &System{
+		keyboardLayout: keyboardLayout,
+	}
+}
+
+// SetProduction records if the app was built in production mode.
+// This is reported as EnvironmentInfo.Packaged
+func (r *System) SetProduction(production bool) {
+	r.production = production
+}
+
+// EnvironmentInfo describes where and how the app is running
+type EnvironmentInfo struct {
+	// The absolute path to the running executable
+	ExecutablePath string
+
+	// The directory containing bundled resources. Inside a macOS .app bundle
+	// this is Contents/Resources, otherwise it is the executable's directory
+	ResourcesDir string
+
+	// The operating system and architecture, as reported by Go
+	Platform string
+	Arch     string
+
+	// Indicates the app is a production build rather than a debug or bridge build
+	Packaged bool
+}
+
+// Environment returns information about the running app's environment
+func (r *System) Environment() (EnvironmentInfo, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return EnvironmentInfo{}, err
+	}
+	executable, err = filepath.EvalSymlinks(executable)
+	if err != nil {
+		return EnvironmentInfo{}, err
+	}
+	return newEnvironmentInfo(executable, runtime.GOOS, runtime.GOARCH, r.production), nil
+}
+
+// newEnvironmentInfo builds the environment info for the given executable path
+func newEnvironmentInfo(executable string, platform string, arch string, packaged bool) EnvironmentInfo {
+	return EnvironmentInfo{
+		ExecutablePath: executable,
+		ResourcesDir:   resourcesDir(executable, platform),
+		Platform:       platform,
+		Arch:           arch,
+		Packaged:       packaged,
+	}
+}
+
+// resourcesDir returns the directory holding the app's resources.
+// On macOS, executables in an app bundle live in <App>.app/Contents/MacOS
+// and their resources in <App>.app/Contents/Resources
+func resourcesDir(executable string, platform string) string {
+	dir := filepath.Dir(executable)
+	if platform != "darwin" {
+		return dir
+	}
+	contents := filepath.Dir(dir)
+	if filepath.Base(dir) == "MacOS" && filepath.Base(contents) == "Contents" && strings.HasSuffix(filepath.Dir(contents), ".app") {
+		return filepath.Join(contents, "Resources")
+	}
+	return dir
+}
+
+// KeyboardLayout returns the platform's identifier for the current keyboard
+// layout. An error is returned if the platform does not expose it
+func (r *System) KeyboardLayout() (string, error) {
+	return r.keyboardLayout()
+}
+
+// SystemInfo describes the resources available to the app. Sizes are in bytes.
+// If a metric could not be read, it is left as zero and a warning is added
+type SystemInfo struct {
+	TotalMemory uint64
+
+	// The memory available to the app. On Linux this is MemAvailable, which
+	// includes reclaimable caches. On macOS only unused pages are counted, so
+	// the value is lower than the memory that could actually be reclaimed
+	FreeMemory uint64
+
+	FreeDiskSpace uint64
+	Warnings      []string
+}
+
+// systemReadings holds the raw values read from the OS
+type systemReadings struct {
+	totalMemory uint64
+	freeMemory  uint64
+	memoryErr   error
+	freeDisk    uint64
+	diskErr     error
+}
+
+// Info returns the memory available on the system and the free disk
+// space on the volume the app is installed on. An error is only returned
+// if no metrics could be read at all
+func (r *System) Info() (SystemInfo, error) {
+	var readings systemReadings
+	readings.totalMemory, readings.freeMemory, readings.memoryErr = readMemory()
+
+	executable, err := os.Executable()
+	if err == nil {
+		readings.freeDisk, readings.diskErr = readFreeDiskSpace(filepath.Dir(executable))
+	} else {
+		readings.diskErr = err
+	}
+
+	return newSystemInfo(readings)
+}
+
+// newSystemInfo maps the OS readings to a SystemInfo
+func newSystemInfo(readings systemReadings) (SystemInfo, error) {
+	var result SystemInfo
+	if readings.memoryErr != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("memory unavailable: %s", readings.memoryErr))
+	} else {
+		result.TotalMemory = readings.totalMemory
+		result.FreeMemory = readings.freeMemory
+	}
+	if readings.diskErr != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("disk space unavailable: %s", readings.diskErr))
+	} else {
+		result.FreeDiskSpace = readings.freeDisk
+	}
+	if readings.memoryErr != nil && readings.diskErr != nil {
+		return result, errors.New("unable to read system info")
+	}
+	return result, nil
+}
@@ -0,0 +1,34 @@
+// +build darwin
+
+package runtime
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// readMemory returns the total and free memory using sysctl. The free
+// memory only counts unused pages: macOS keeps inactive and purgeable
+// pages in use for caching, so this is lower than the memory actually
+// available to the app
+func readMemory() (uint64, uint64, error) {
+	total, err := unix.SysctlUint64("hw.memsize")
+	if err != nil {
+		return 0, 0, err
+	}
+	freePages, err := unix.SysctlUint32("vm.page_free_count")
+	if err != nil {
+		return 0, 0, err
+	}
+	return total, uint64(freePages) * uint64(os.Getpagesize()), nil
+}
+
+// readFreeDiskSpace returns the space available to the user on the volume containing path
+func readFreeDiskSpace(path string) (uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
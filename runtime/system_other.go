@@ -0,0 +1,57 @@
+// +build !darwin,!windows
+
+package runtime
+
+import (
+	"errors"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// readMemory returns the total and available memory from /proc/meminfo
+func readMemory() (uint64, uint64, error) {
+	data, err := ioutil.ReadFile("/proc/meminfo")
+	if err != nil {
+		return 0, 0, err
+	}
+	return parseMeminfo(string(data))
+}
+
+// readFreeDiskSpace returns the space available to the user on the volume containing path
+func readFreeDiskSpace(path string) (uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
+
+// parseMeminfo extracts the total and available memory from the
+// contents of /proc/meminfo. Values in the file are in kB
+func parseMeminfo(meminfo string) (uint64, uint64, error) {
+	var total, available uint64
+	var haveTotal, haveAvailable bool
+	for _, line := range strings.Split(meminfo, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			total, haveTotal = value*1024, true
+		case "MemAvailable:":
+			available, haveAvailable = value*1024, true
+		}
+	}
+	if !haveTotal || !haveAvailable {
+		return 0, 0, errors.New("memory totals not found in /proc/meminfo")
+	}
+	return total, available, nil
+}
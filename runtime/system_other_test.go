@@ -0,0 +1,30 @@
+// +build !darwin,!windows
+
+package runtime
+
+import "testing"
+
+func TestParseMeminfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		meminfo       string
+		wantTotal     uint64
+		wantAvailable uint64
+		wantErr       bool
+	}{
+		{"valid", "MemTotal:       16303548 kB\nMemFree:         1245700 kB\nMemAvailable:    8651432 kB\n", 16303548 * 1024, 8651432 * 1024, false},
+		{"missing available", "MemTotal:       16303548 kB\nMemFree:         1245700 kB\n", 0, 0, true},
+		{"empty", "", 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, available, err := parseMeminfo(tt.meminfo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMeminfo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if total != tt.wantTotal || available != tt.wantAvailable {
+				t.Errorf("parseMeminfo() = %v, %v, want %v, %v", total, available, tt.wantTotal, tt.wantAvailable)
+			}
+		})
+	}
+}
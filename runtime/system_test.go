@@ -0,0 +1,97 @@
+package runtime
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestNewEnvironmentInfo(t *testing.T) {
+	tests := []struct {
+		name       string
+		executable string
+		platform   string
+		wantDir    string
+	}{
+		{"mac app bundle", "/Applications/My App.app/Contents/MacOS/myapp", "darwin", "/Applications/My App.app/Contents/Resources"},
+		{"mac plain binary", "/Users/lea/myapp/build/myapp", "darwin", "/Users/lea/myapp/build"},
+		{"mac binary in MacOS dir outside bundle", "/Users/lea/Contents/MacOS/myapp", "darwin", "/Users/lea/Contents/MacOS"},
+		{"linux", "/opt/myapp/myapp", "linux", "/opt/myapp"},
+		{"windows", `C:\Program Files\myapp\myapp.exe`, "windows", filepath.Dir(`C:\Program Files\myapp\myapp.exe`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executable := filepath.FromSlash(tt.executable)
+			got := newEnvironmentInfo(executable, tt.platform, "amd64", true)
+			if got.ExecutablePath != executable {
+				t.Errorf("ExecutablePath = %v, want %v", got.ExecutablePath, executable)
+			}
+			if want := filepath.FromSlash(tt.wantDir); got.ResourcesDir != want {
+				t.Errorf("ResourcesDir = %v, want %v", got.ResourcesDir, want)
+			}
+			if got.Platform != tt.platform || got.Arch != "amd64" || !got.Packaged {
+				t.Errorf("newEnvironmentInfo() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestNewSystemInfo(t *testing.T) {
+	readErr := errors.New("read failed")
+	tests := []struct {
+		name         string
+		readings     systemReadings
+		want         SystemInfo
+		wantWarnings int
+		wantErr      bool
+	}{
+		{"all metrics", systemReadings{totalMemory: 8, freeMemory: 4, freeDisk: 100}, SystemInfo{TotalMemory: 8, FreeMemory: 4, FreeDiskSpace: 100}, 0, false},
+		{"memory unavailable", systemReadings{totalMemory: 8, memoryErr: readErr, freeDisk: 100}, SystemInfo{FreeDiskSpace: 100}, 1, false},
+		{"disk unavailable", systemReadings{totalMemory: 8, freeMemory: 4, diskErr: readErr}, SystemInfo{TotalMemory: 8, FreeMemory: 4}, 1, false},
+		{"nothing available", systemReadings{memoryErr: readErr, diskErr: readErr}, SystemInfo{}, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newSystemInfo(tt.readings)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newSystemInfo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got.Warnings) != tt.wantWarnings {
+				t.Errorf("newSystemInfo() warnings = %q, want %d", got.Warnings, tt.wantWarnings)
+			}
+			got.Warnings = nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newSystemInfo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSystem_KeyboardLayout(t *testing.T) {
+	unavailable := errors.New("keyboard layout unavailable")
+	tests := []struct {
+		name    string
+		layout  string
+		err     error
+		wantErr bool
+	}{
+		{"available", "de(nodeadkeys)", nil, false},
+		{"unavailable", "", unavailable, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			system := NewSystem()
+			system.keyboardLayout = func() (string, error) {
+				return tt.layout, tt.err
+			}
+			got, err := system.KeyboardLayout()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("KeyboardLayout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.layout {
+				t.Errorf("KeyboardLayout() = %q, want %q", got, tt.layout)
+			}
+		})
+	}
+}
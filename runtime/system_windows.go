@@ -0,0 +1,48 @@
+// +build windows
+
+package runtime
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var procGlobalMemoryStatusEx = windows.NewLazySystemDLL("kernel32.dll").NewProc("GlobalMemoryStatusEx")
+
+// memoryStatusEx mirrors the Win32 MEMORYSTATUSEX struct
+type memoryStatusEx struct {
+	length               uint32
+	memoryLoad           uint32
+	totalPhys            uint64
+	availPhys            uint64
+	totalPageFile        uint64
+	availPageFile        uint64
+	totalVirtual         uint64
+	availVirtual         uint64
+	availExtendedVirtual uint64
+}
+
+// readMemory returns the total and available physical memory
+func readMemory() (uint64, uint64, error) {
+	status := memoryStatusEx{}
+	status.length = uint32(unsafe.Sizeof(status))
+	result, _, err := procGlobalMemoryStatusEx.Call(uintptr(unsafe.Pointer(&status)))
+	if result == 0 {
+		return 0, 0, err
+	}
+	return status.totalPhys, status.availPhys, nil
+}
+
+// readFreeDiskSpace returns the space available to the user on the volume containing path
+func readFreeDiskSpace(path string) (uint64, error) {
+	directory, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	var available, total, free uint64
+	if err := windows.GetDiskFreeSpaceEx(directory, &available, &total, &free); err != nil {
+		return 0, err
+	}
+	return available, nil
+}
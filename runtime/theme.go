@@ -0,0 +1,11 @@
+package runtime
+
+// Theme is the colour theme used by the operating system
+type Theme string
+
+const (
+	// ThemeLight is the light system theme
+	ThemeLight Theme = "light"
+	// ThemeDark is the dark system theme
+	ThemeDark Theme = "dark"
+)
@@ -0,0 +1,15 @@
+// +build darwin
+
+package runtime
+
+import "strings"
+
+// systemTheme reads the macOS appearance setting.
+// AppleInterfaceStyle is only set when dark mode is enabled
+func systemTheme() Theme {
+	output, err := commandOutput("defaults", "read", "-g", "AppleInterfaceStyle")
+	if err == nil && strings.TrimSpace(string(output)) == "Dark" {
+		return ThemeDark
+	}
+	return ThemeLight
+}
@@ -0,0 +1,19 @@
+// +build !darwin,!windows
+
+package runtime
+
+import "strings"
+
+// systemTheme reads the GNOME colour scheme setting, falling back
+// to checking if the GTK theme is a dark variant
+func systemTheme() Theme {
+	output, err := commandOutput("gsettings", "get", "org.gnome.desktop.interface", "color-scheme")
+	if err == nil && strings.Contains(string(output), "dark") {
+		return ThemeDark
+	}
+	output, err = commandOutput("gsettings", "get", "org.gnome.desktop.interface", "gtk-theme")
+	if err == nil && strings.Contains(strings.ToLower(string(output)), "dark") {
+		return ThemeDark
+	}
+	return ThemeLight
+}
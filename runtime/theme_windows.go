@@ -0,0 +1,19 @@
+// +build windows
+
+package runtime
+
+import "golang.org/x/sys/windows/registry"
+
+// systemTheme reads the Windows app mode setting
+func systemTheme() Theme {
+	key, err := registry.OpenKey(registry.CURRENT_USER, `Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`, registry.QUERY_VALUE)
+	if err != nil {
+		return ThemeLight
+	}
+	defer key.Close()
+	light, _, err := key.GetIntegerValue("AppsUseLightTheme")
+	if err == nil && light == 0 {
+		return ThemeDark
+	}
+	return ThemeLight
+}
@@ -0,0 +1,103 @@
+package runtime
+
+import (
+	"context"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// commandTimeout limits how long a command reading a system setting may run
+const commandTimeout = time.Second
+
+// commandOutput runs the given command and returns its output. The command
+// is killed if it runs for longer than commandTimeout
+func commandOutput(name string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+	return exec.CommandContext(ctx, name, args...).Output()
+}
+
+// watcher polls a system setting and emits its value whenever it changes
+type watcher struct {
+	read     func() (string, error)
+	emit     func(string)
+	interval time.Duration
+	current  string
+	lock     sync.Mutex
+	ticker   *time.Ticker
+	done     chan struct{}
+	polling  sync.WaitGroup
+}
+
+func newWatcher(read func() (string, error), emit func(string)) *watcher {
+	return &watcher{
+		read:     read,
+		emit:     emit,
+		interval: 2 * time.Second,
+	}
+}
+
+// start begins polling for changes in the background.
+// It is safe to call more than once
+func (w *watcher) start() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.ticker != nil {
+		return
+	}
+	w.ticker = time.NewTicker(w.interval)
+	w.done = make(chan struct{})
+	w.polling.Add(1)
+	go w.poll(w.ticker, w.done)
+}
+
+// poll reads the starting value, then checks for changes on
+// every tick until done is closed
+func (w *watcher) poll(ticker *time.Ticker, done chan struct{}) {
+	defer w.polling.Done()
+	value, _ := w.read()
+	w.lock.Lock()
+	w.current = value
+	w.lock.Unlock()
+	for {
+		select {
+		case <-ticker.C:
+			w.check()
+		case <-done:
+			return
+		}
+	}
+}
+
+// stop ends polling and waits for any check in progress to finish.
+// It is safe to call more than once
+func (w *watcher) stop() {
+	w.lock.Lock()
+	if w.ticker == nil {
+		w.lock.Unlock()
+		return
+	}
+	w.ticker.Stop()
+	close(w.done)
+	w.ticker = nil
+	w.lock.Unlock()
+	w.polling.Wait()
+}
+
+// check reads the current value and emits it if it has changed.
+// Failed reads are ignored so a transient error doesn't look like a change
+func (w *watcher) check() {
+	value, err := w.read()
+	if err != nil {
+		return
+	}
+	w.lock.Lock()
+	if value == w.current {
+		w.lock.Unlock()
+		return
+	}
+	w.current = value
+	w.lock.Unlock()
+	w.emit(value)
+}
@@ -0,0 +1,93 @@
+package runtime
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatcher_Check(t *testing.T) {
+	readings := []string{"light", "light", "dark", "dark", "light"}
+	var emitted []string
+
+	w := newWatcher(nil, func(value string) {
+		emitted = append(emitted, value)
+	})
+	w.current = "light"
+	for _, reading := range readings {
+		reading := reading
+		w.read = func() (string, error) {
+			return reading, nil
+		}
+		w.check()
+	}
+
+	if want := []string{"dark", "light"}; !reflect.DeepEqual(emitted, want) {
+		t.Errorf("emitted %v, want %v", emitted, want)
+	}
+}
+
+func TestWatcher_CheckIgnoresErrors(t *testing.T) {
+	type reading struct {
+		value string
+		err   error
+	}
+	readings := []reading{
+		{"us", nil},
+		{"", errors.New("unavailable")},
+		{"de", nil},
+		{"", errors.New("unavailable")},
+		{"de", nil},
+	}
+	var emitted []string
+
+	w := newWatcher(nil, func(value string) {
+		emitted = append(emitted, value)
+	})
+	w.current = "us"
+	for _, r := range readings {
+		r := r
+		w.read = func() (string, error) {
+			return r.value, r.err
+		}
+		w.check()
+	}
+
+	if want := []string{"de"}; !reflect.DeepEqual(emitted, want) {
+		t.Errorf("emitted %v, want %v", emitted, want)
+	}
+}
+
+func TestWatcher_StartStop(t *testing.T) {
+	var lock sync.Mutex
+	reads := 0
+	w := newWatcher(func() (string, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		reads++
+		return "value", nil
+	}, func(string) {})
+	w.interval = time.Millisecond
+
+	w.start()
+	w.start()
+	time.Sleep(20 * time.Millisecond)
+	w.stop()
+	w.stop()
+
+	lock.Lock()
+	stopped := reads
+	lock.Unlock()
+	if stopped < 2 {
+		t.Fatalf("watcher read %d times while running, want at least 2", stopped)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	lock.Lock()
+	defer lock.Unlock()
+	if reads != stopped {
+		t.Errorf("watcher read %d times after stop, want %d", reads, stopped)
+	}
+}
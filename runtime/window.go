@@ -2,7 +2,9 @@ package runtime
 
 import (
 	"bytes"
+	"encoding/json"
 	"runtime"
+	"sync"
 
 	"github.com/abadojack/whatlanggo"
 	"github.com/wailsapp/wails/lib/interfaces"
@@ -53,6 +55,11 @@ func ProcessEncoding(text string) string {
 // Window exposes an interface for manipulating the window
 type Window struct {
 	renderer interfaces.Renderer
+
+	// The last title given to SetTitle, before any encoding
+	title    string
+	titleSet bool
+	titleMux sync.Mutex
 }
 
 // NewWindow creates a new Window struct
@@ -67,6 +74,13 @@ func (r *Window) SetColour(colour string) error {
 	return r.renderer.SetColour(colour)
 }
 
+// SetBackgroundColour sets the window background colour from its RGBA
+// components. The alpha value is only honoured where the platform supports
+// translucent windows
+func (r *Window) SetBackgroundColour(red, green, blue, alpha uint8) {
+	r.renderer.SetBackgroundColour(red, green, blue, alpha)
+}
+
 // Fullscreen makes the window fullscreen
 func (r *Window) Fullscreen() {
 	r.renderer.Fullscreen()
@@ -79,10 +93,75 @@ func (r *Window) UnFullscreen() {
 
 // SetTitle sets the the window title
 func (r *Window) SetTitle(title string) {
+	r.titleMux.Lock()
+	r.title = title
+	r.titleSet = true
+	r.titleMux.Unlock()
 	title = ProcessEncoding(title)
 	r.renderer.SetTitle(title)
 }
 
+// GetTitle returns the window title
+func (r *Window) GetTitle() string {
+	r.titleMux.Lock()
+	defer r.titleMux.Unlock()
+	if r.titleSet {
+		return r.title
+	}
+	return r.renderer.GetTitle()
+}
+
+// Print opens the print dialog for the current page. An error is returned
+// where printing is unsupported, which includes macOS and bridge mode
+func (r *Window) Print() error {
+	return r.renderer.Print()
+}
+
+// DisableDefaultContextMenu disables or re-enables the webview's default
+// context menu. Editable elements keep their context menu, except where the
+// native webview blocks all context menus (see AppConfig.DisableDefaultContextMenu)
+func (r *Window) DisableDefaultContextMenu(disable bool) {
+	r.renderer.DisableDefaultContextMenu(disable)
+}
+
+// SystemTheme returns the current system colour theme
+func (r *Window) SystemTheme() Theme {
+	return systemTheme()
+}
+
+// ExecJS evaluates the given script in the frontend and returns its result
+// as JSON. If the script returns a promise, the resolved value is returned.
+// Errors thrown by the script are returned as Go errors. An error is also
+// returned if no result arrives within 30 seconds, eg if the page navigates
+// away or a returned promise never settles
+func (r *Window) ExecJS(script string) (json.RawMessage, error) {
+	return r.renderer.ExecJS(script)
+}
+
+// SetCursor sets the cursor shown over the window. It takes precedence
+// over any cursors set by the page's CSS until ResetCursor is called
+func (r *Window) SetCursor(cursor Cursor) {
+	r.renderer.SetCursor(string(cursor))
+}
+
+// SetCustomCursor sets the cursor to the given PNG image, with the hotspot
+// at (hotX, hotY). If the image is invalid, the cursor is reset as if
+// ResetCursor was called and an error is returned
+func (r *Window) SetCustomCursor(image []byte, hotX, hotY int) error {
+	cursor, err := customCursor(image, hotX, hotY)
+	if err != nil {
+		r.ResetCursor()
+		return err
+	}
+	r.renderer.SetCursor(cursor)
+	return nil
+}
+
+// ResetCursor removes any cursor set by SetCursor or SetCustomCursor
+func (r *Window) ResetCursor() {
+	r.renderer.SetCursor("")
+}
+
 // Close shuts down the window and therefore the app
 func (r *Window) Close() {
 	r.renderer.Close()
@@ -0,0 +1,142 @@
+package runtime
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/wailsapp/wails/lib/interfaces"
+)
+
+// cursorRenderer records the cursors set on the window
+type cursorRenderer struct {
+	interfaces.Renderer
+	cursors []string
+}
+
+func (c *cursorRenderer) SetCursor(cursor string) {
+	c.cursors = append(c.cursors, cursor)
+}
+
+// colourRenderer records the background colour set on the window
+type colourRenderer struct {
+	interfaces.Renderer
+	colours [][4]uint8
+}
+
+func (c *colourRenderer) SetBackgroundColour(r, g, b, a uint8) {
+	c.colours = append(c.colours, [4]uint8{r, g, b, a})
+}
+
+func TestWindowSetBackgroundColour(t *testing.T) {
+	tests := []struct {
+		name                    string
+		red, green, blue, alpha uint8
+	}{
+		{"opaque", 255, 128, 0, 255},
+		{"translucent", 27, 38, 54, 128},
+		{"transparent black", 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := &colourRenderer{}
+			NewWindow(renderer).SetBackgroundColour(tt.red, tt.green, tt.blue, tt.alpha)
+			want := [4]uint8{tt.red, tt.green, tt.blue, tt.alpha}
+			if len(renderer.colours) != 1 || renderer.colours[0] != want {
+				t.Errorf("colours = %v, want [%v]", renderer.colours, want)
+			}
+		})
+	}
+}
+
+// printRenderer records calls to Print and returns the given error
+type printRenderer struct {
+	interfaces.Renderer
+	calls int
+	err   error
+}
+
+func (p *printRenderer) Print() error {
+	p.calls++
+	return p.err
+}
+
+func TestWindowPrint(t *testing.T) {
+	printErr := errors.New("print failed")
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"success", nil},
+		{"renderer error", printErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := &printRenderer{err: tt.err}
+			if err := NewWindow(renderer).Print(); err != tt.err {
+				t.Errorf("Print() error = %v, want %v", err, tt.err)
+			}
+			if renderer.calls != 1 {
+				t.Errorf("renderer.Print called %d times, want 1", renderer.calls)
+			}
+		})
+	}
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func TestWindowCursor(t *testing.T) {
+	renderer := &cursorRenderer{}
+	window := NewWindow(renderer)
+
+	window.SetCursor(CursorCrosshair)
+	window.ResetCursor()
+
+	want := []string{"crosshair", ""}
+	if len(renderer.cursors) != len(want) || renderer.cursors[0] != want[0] || renderer.cursors[1] != want[1] {
+		t.Errorf("cursors = %q, want %q", renderer.cursors, want)
+	}
+}
+
+func TestWindowSetCustomCursor(t *testing.T) {
+	icon := testPNG(t, 16, 16)
+	tests := []struct {
+		name       string
+		image      []byte
+		hotX, hotY int
+		wantPrefix string
+		wantErr    bool
+	}{
+		{"valid", icon, 8, 8, "url(data:image/png;base64,", false},
+		{"invalid data", []byte("not a png"), 0, 0, "", true},
+		{"hotspot outside image", icon, 16, 0, "", true},
+		{"negative hotspot", icon, -1, 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := &cursorRenderer{}
+			err := NewWindow(renderer).SetCustomCursor(tt.image, tt.hotX, tt.hotY)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetCustomCursor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(renderer.cursors) != 1 || !strings.HasPrefix(renderer.cursors[0], tt.wantPrefix) {
+				t.Fatalf("cursors = %q, want one starting with %q", renderer.cursors, tt.wantPrefix)
+			}
+			if tt.wantErr && renderer.cursors[0] != "" {
+				t.Errorf("cursor = %q, want it reset", renderer.cursors[0])
+			}
+			if !tt.wantErr && !strings.HasSuffix(renderer.cursors[0], ") 8 8, default") {
+				t.Errorf("cursor = %q, want hotspot 8 8 with default fallback", renderer.cursors[0])
+			}
+		})
+	}
+}